@@ -8,12 +8,23 @@ import (
 	"personalfinancedss/internal/module/cashflow/budget"
 	"personalfinancedss/internal/module/cashflow/budget_profile"
 	"personalfinancedss/internal/module/cashflow/category"
+	"personalfinancedss/internal/module/cashflow/credit_card"
 	"personalfinancedss/internal/module/cashflow/debt"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
 	"personalfinancedss/internal/module/cashflow/goal"
 	"personalfinancedss/internal/module/cashflow/income_profile"
+	"personalfinancedss/internal/module/cashflow/installment"
+	"personalfinancedss/internal/module/cashflow/investment"
+	"personalfinancedss/internal/module/cashflow/manual_asset"
+	"personalfinancedss/internal/module/cashflow/networth"
+	"personalfinancedss/internal/module/cashflow/performance"
+	"personalfinancedss/internal/module/cashflow/reminder"
+	"personalfinancedss/internal/module/cashflow/split"
+	"personalfinancedss/internal/module/cashflow/term_deposit"
 	"personalfinancedss/internal/module/cashflow/transaction"
 	"personalfinancedss/internal/module/identify/auth"
 	"personalfinancedss/internal/module/identify/broker"
+	"personalfinancedss/internal/module/identify/household"
 	"personalfinancedss/internal/module/identify/profile"
 	"personalfinancedss/internal/module/identify/user"
 	"personalfinancedss/internal/module/notification"
@@ -35,6 +46,7 @@ func Application() *fx.App {
 		profile.Module,
 		auth.Module,
 		broker.Module,
+		household.Module,
 		account.Module,
 		category.Module,
 		transaction.Module,
@@ -44,6 +56,16 @@ func Application() *fx.App {
 		budget_profile.Module,
 		goal.Module,
 		debt.Module,
+		exchangerate.Module,
+		networth.Module,
+		credit_card.Module,
+		investment.Module,
+		performance.Module,
+		term_deposit.Module,
+		manual_asset.Module,
+		reminder.Module,
+		split.Module,
+		installment.Module,
 
 		// Analytics module (new - contains all 7 modules for problems)
 		analytics.Module,
@@ -7,13 +7,22 @@ import (
 	budgetdomain "personalfinancedss/internal/module/cashflow/budget/domain"
 	budgetprofiledomain "personalfinancedss/internal/module/cashflow/budget_profile/domain"
 	categorydomain "personalfinancedss/internal/module/cashflow/category/domain"
+	creditcarddomain "personalfinancedss/internal/module/cashflow/credit_card/domain"
 	debtdomain "personalfinancedss/internal/module/cashflow/debt/domain"
 	goaldomain "personalfinancedss/internal/module/cashflow/goal/domain"
 	incomeprofiledomain "personalfinancedss/internal/module/cashflow/income_profile/domain"
+	installmentdomain "personalfinancedss/internal/module/cashflow/installment/domain"
+	investmentdomain "personalfinancedss/internal/module/cashflow/investment/domain"
+	manualassetdomain "personalfinancedss/internal/module/cashflow/manual_asset/domain"
+	networthdomain "personalfinancedss/internal/module/cashflow/networth/domain"
+	performancedomain "personalfinancedss/internal/module/cashflow/performance/domain"
+	splitdomain "personalfinancedss/internal/module/cashflow/split/domain"
+	termdepositdomain "personalfinancedss/internal/module/cashflow/term_deposit/domain"
 	transactiondomain "personalfinancedss/internal/module/cashflow/transaction/domain"
 	// chatbotdomain "personalfinancedss/internal/module/chatbot/domain" // Temporarily disabled
 	authdomain "personalfinancedss/internal/module/identify/auth/domain"
 	brokerdomain "personalfinancedss/internal/module/identify/broker/domain"
+	householddomain "personalfinancedss/internal/module/identify/household/domain"
 	profiledomain "personalfinancedss/internal/module/identify/profile/domain"
 	userdomain "personalfinancedss/internal/module/identify/user/domain"
 	notificationdomain "personalfinancedss/internal/module/notification/domain"
@@ -45,8 +54,17 @@ func AutoMigrate(db *gorm.DB, log *zap.Logger) error {
 		&authdomain.VerificationToken{},
 		&authdomain.TokenBlacklist{},
 		&brokerdomain.BrokerConnection{}, // Broker connections (FK to User)
-		&accountdomain.Account{},         // Accounts (FK to User, BrokerConnection)
+		&householddomain.Household{},     // Shared workspaces (FK to User)
+		&householddomain.Member{},        // Household memberships and roles (FK to Household, User)
+		&accountdomain.Account{},         // Accounts (FK to User, BrokerConnection; optional Household)
 		&debtdomain.Debt{},
+		&debtdomain.DebtScheduleEntry{}, // Amortization schedule rows (FK to Debt)
+		&debtdomain.DebtPayment{},       // Recorded payments split into fees, interest and principal (FK to Debt)
+		&debtdomain.DebtRatePeriod{},    // Fixed and floating rate periods (FK to Debt)
+		&debtdomain.ReferenceRate{},     // Reference index values floating rates follow
+		&debtdomain.DebtRefinance{},     // Applied refinances and their expected savings (FK to Debt)
+		&debtdomain.DebtRefinanceItem{}, // Debts paid off by a refinance (FK to DebtRefinance, Debt)
+		&debtdomain.DebtStatusEvent{},   // Payment state and status changes of a debt (FK to Debt)
 		&notificationdomain.Notification{},
 		&notificationdomain.NotificationPreference{},
 
@@ -61,8 +79,29 @@ func AutoMigrate(db *gorm.DB, log *zap.Logger) error {
 		&monthdomain.Month{}, // Month (FK to Budget)
 		&goaldomain.Goal{},
 		&goaldomain.GoalContribution{}, // Goal contributions (FK to Goal, Account)
+		&goaldomain.AutoContributionRun{},
+		&goaldomain.GoalMilestone{},
 		&incomeprofiledomain.IncomeProfile{},
 		&budgetprofiledomain.BudgetConstraint{},
+		&networthdomain.BalanceSnapshot{},   // Daily balances of accounts and debts
+		&creditcarddomain.StatementConfig{}, // Card statement cycles (FK to Account, Debt)
+		&creditcarddomain.Statement{},
+		&investmentdomain.Holding{}, // Investment holdings (FK to Account)
+		&investmentdomain.Trade{},   // Trades (FK to Holding)
+		&investmentdomain.TaxLot{},  // Open and closed lots (FK to Holding, Trade)
+		&performancedomain.Benchmark{},
+		&performancedomain.BenchmarkPrice{},    // Benchmark closing prices (FK to Benchmark)
+		&termdepositdomain.TermDeposit{},       // Term deposits held in savings accounts
+		&manualassetdomain.ManualAsset{},       // Assets and liabilities held outside accounts
+		&manualassetdomain.Valuation{},         // Dated valuations (FK to ManualAsset)
+		&manualassetdomain.AssetPrice{},        // Price series for manual assets
+		&splitdomain.Group{},                   // Shared expense groups
+		&splitdomain.Member{},                  // People in a group (FK to Group)
+		&splitdomain.Expense{},                 // Group expenses (FK to Group)
+		&splitdomain.Share{},                   // Each member's portion of an expense (FK to Expense)
+		&splitdomain.Settlement{},              // Payments settling balances (FK to Group)
+		&installmentdomain.InstallmentPlan{},   // Purchases paid in monthly installments (FK to Account, Transaction)
+		&installmentdomain.InstallmentCharge{}, // Monthly charges of a plan (FK to InstallmentPlan)
 	}
 
 	log.Info("Migrating entities", zap.Int("entity_count", len(entities)))
@@ -72,15 +111,30 @@ func AutoMigrate(db *gorm.DB, log *zap.Logger) error {
 		return fmt.Errorf("auto migration failed: %w", err)
 	}
 
+	// 3. Backfill columns added to existing tables and move legacy data
+	if err := backfillData(db, log); err != nil {
+		log.Error("Data backfill failed", zap.Error(err))
+		return fmt.Errorf("data backfill failed: %w", err)
+	}
+
 	log.Info("Database migrations completed successfully",
 		zap.Strings("tables", []string{
 			"users",
 			"user_profiles",
 			"verification_tokens",
 			"token_blacklist",
+			"households",
+			"household_members",
 			"periods",
 			"accounts",
 			"debts",
+			"debt_schedule_entries",
+			"debt_payments",
+			"debt_rate_periods",
+			"reference_rates",
+			"debt_refinances",
+			"debt_refinance_items",
+			"debt_status_history",
 			"calendar_events",
 			"notifications",
 			"investment_assets",
@@ -92,6 +146,25 @@ func AutoMigrate(db *gorm.DB, log *zap.Logger) error {
 			"goals",
 			"income_profiles",
 			"budget_constraints",
+			"balance_snapshots",
+			"card_statement_configs",
+			"card_statements",
+			"investment_holdings",
+			"investment_trades",
+			"investment_tax_lots",
+			"performance_benchmarks",
+			"performance_benchmark_prices",
+			"term_deposits",
+			"manual_assets",
+			"manual_asset_valuations",
+			"manual_asset_prices",
+			"split_groups",
+			"split_members",
+			"split_expenses",
+			"split_expense_shares",
+			"split_settlements",
+			"installment_plans",
+			"installment_charges",
 		}),
 	)
 
@@ -113,6 +186,66 @@ func enableUUIDExtension(db *gorm.DB, log *zap.Logger) error {
 	return nil
 }
 
+// backfillData fills in columns added to tables that already hold rows. Each step only touches
+// rows still missing the value, so it is safe to run on every start.
+func backfillData(db *gorm.DB, log *zap.Logger) error {
+	// Debts created before interest accrual was tracked would otherwise accrue from their start
+	// date on today's balance; their balances already include the interest owed so far
+	result := db.Exec(`UPDATE debts SET interest_accrued_through = GREATEST(start_date, CURRENT_DATE)
+		WHERE interest_accrued_through IS NULL AND deleted_at IS NULL`)
+	if result.Error != nil {
+		return fmt.Errorf("failed to backfill debt interest accrual: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		log.Info("Backfilled debt interest accrual", zap.Int64("debts", result.RowsAffected))
+	}
+
+	if err := migrateLegacyMilestones(db, log); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// migrateLegacyMilestones moves milestones kept in the deprecated goals.milestones JSON into
+// goal_milestones. Each goal's column is cleared in the same transaction, so the data moves once.
+func migrateLegacyMilestones(db *gorm.DB, log *zap.Logger) error {
+	var goals []goaldomain.Goal
+	if err := db.Where("milestones IS NOT NULL").Find(&goals).Error; err != nil {
+		return fmt.Errorf("failed to load legacy goal milestones: %w", err)
+	}
+
+	moved := 0
+	for i := range goals {
+		goal := &goals[i]
+		milestones, err := goal.LegacyMilestones()
+		if err != nil {
+			log.Warn("Skipping unreadable legacy goal milestones",
+				zap.String("goal_id", goal.ID.String()),
+				zap.Error(err))
+			continue
+		}
+
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if len(milestones) > 0 {
+				if err := tx.Create(&milestones).Error; err != nil {
+					return err
+				}
+			}
+			return tx.Model(&goaldomain.Goal{}).Where("id = ?", goal.ID).Update("milestones", gorm.Expr("NULL")).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to migrate milestones of goal %s: %w", goal.ID, err)
+		}
+		moved += len(milestones)
+	}
+
+	if moved > 0 {
+		log.Info("Migrated legacy goal milestones", zap.Int("milestones", moved))
+	}
+	return nil
+}
+
 // DropAllTables drops all tables (useful for development reset)
 // WARNING: This will delete all data!
 func DropAllTables(db *gorm.DB, log *zap.Logger) error {
@@ -120,8 +253,30 @@ func DropAllTables(db *gorm.DB, log *zap.Logger) error {
 
 	// Drop in reverse dependency order (opposite of migration order)
 	entities := []interface{}{
+		&installmentdomain.InstallmentCharge{},
+		&installmentdomain.InstallmentPlan{},
+		&splitdomain.Settlement{},
+		&splitdomain.Share{},
+		&splitdomain.Expense{},
+		&splitdomain.Member{},
+		&splitdomain.Group{},
+		&manualassetdomain.AssetPrice{},
+		&manualassetdomain.Valuation{},
+		&manualassetdomain.ManualAsset{},
+		&termdepositdomain.TermDeposit{},
+		&performancedomain.BenchmarkPrice{},
+		&performancedomain.Benchmark{},
+		&investmentdomain.TaxLot{},
+		&investmentdomain.Trade{},
+		&investmentdomain.Holding{},
+		&networthdomain.BalanceSnapshot{},
+		&creditcarddomain.Statement{},
+		&creditcarddomain.StatementConfig{},
+
 		// Budget and Goals tables (drop first - have FKs to User, Category, Account)
 		&monthdomain.Month{},
+		&goaldomain.AutoContributionRun{},
+		&goaldomain.GoalMilestone{},
 		&goaldomain.GoalContribution{},
 		&goaldomain.Goal{},
 		&budgetdomain.Budget{},
@@ -136,8 +291,17 @@ func DropAllTables(db *gorm.DB, log *zap.Logger) error {
 
 		&notificationdomain.NotificationPreference{},
 		&notificationdomain.Notification{},
+		&debtdomain.DebtStatusEvent{},
+		&debtdomain.DebtRefinanceItem{},
+		&debtdomain.DebtRefinance{},
+		&debtdomain.DebtRatePeriod{},
+		&debtdomain.ReferenceRate{},
+		&debtdomain.DebtPayment{},
+		&debtdomain.DebtScheduleEntry{},
 		&debtdomain.Debt{},
 		&accountdomain.Account{},
+		&householddomain.Member{},
+		&householddomain.Household{},
 		&authdomain.TokenBlacklist{},
 		&authdomain.VerificationToken{},
 		&profiledomain.UserProfile{},
@@ -19,6 +19,15 @@ type ConstraintModel struct {
 	GoalTargets map[uuid.UUID]GoalConstraint // GoalID -> constraint
 }
 
+// TotalFixedGoalContributions sums the goal contributions reserved as hard constraints
+func (m *ConstraintModel) TotalFixedGoalContributions() float64 {
+	var total float64
+	for _, goal := range m.GoalTargets {
+		total += goal.FixedContribution
+	}
+	return total
+}
+
 // CategoryConstraint represents a budget constraint for a category
 type CategoryConstraint struct {
 	CategoryID uuid.UUID
@@ -48,6 +57,7 @@ type GoalConstraint struct {
 	Priority              string // low, medium, high, critical
 	PriorityWeight        int    // Numerical weight for sorting
 	RemainingAmount       float64
+	FixedContribution     float64 // If > 0, reserved before the solver runs (e.g. a sinking fund's monthly set-aside)
 }
 
 // AllocationResult represents the result of the allocation algorithm
@@ -62,6 +72,7 @@ type AllocationResult struct {
 	AchievedGoals       []string
 	UnachievedGoals     []string
 	SolverType          string // "preemptive" or "weighted"
+	Warnings            []AllocationWarning
 }
 
 // DebtPayment represents a debt payment breakdown
@@ -128,6 +128,7 @@ type GoalInput struct {
 	Priority              string    `json:"priority"` // "critical", "high", "medium", "low"
 	RemainingAmount       float64   `json:"remaining_amount" binding:"gte=0"`
 	SuggestedContribution float64   `json:"suggested_contribution" binding:"gte=0"`
+	FixedContribution     float64   `json:"fixed_contribution,omitempty" binding:"gte=0"` // Hard constraint: always allocated (sinking funds)
 }
 
 // SensitivityOptions configures sensitivity analysis parameters
@@ -24,6 +24,9 @@ type DebtInfo struct {
 	IsVariableRate bool    `json:"is_variable_rate"`   // For sensitivity analysis
 	Behavior       string  `json:"behavior,omitempty"` // "revolving", "installment", "interest_only" - payment behavior type
 
+	// Promotional / floating rate schedule; InterestRate applies before the first period and when empty
+	RateSchedule []RatePeriod `json:"rate_schedule,omitempty"`
+
 	// Psychological factors
 	IsEmbarrassing bool `json:"is_embarrassing"` // Nợ gia đình, bạn bè
 	StressScore    int  `json:"stress_score"`    // 1-10 scale
@@ -34,6 +37,78 @@ type DebtInfo struct {
 	AffectsCreditScore bool    `json:"affects_credit_score"`
 }
 
+// RatePeriod một giai đoạn lãi suất, áp dụng từ FromMonth đến khi giai đoạn kế tiếp bắt đầu
+type RatePeriod struct {
+	FromMonth int      `json:"from_month"`           // First simulated month (1-based) the rate applies to
+	Rate      float64  `json:"rate"`                 // Annual rate (decimal); for floating periods the reference rate plus margin
+	Floating  bool     `json:"floating"`             // Follows a reference index and moves with rate shocks
+	RateFloor *float64 `json:"rate_floor,omitempty"` // Lowest annual rate (decimal) a floating period charges
+	RateCap   *float64 `json:"rate_cap,omitempty"`   // Highest annual rate (decimal) a floating period charges
+}
+
+// RateAt returns the annual rate (decimal) charged in the given simulated month
+func (d DebtInfo) RateAt(month int) float64 {
+	rate, from := d.InterestRate, 0
+	for _, p := range d.RateSchedule {
+		if p.FromMonth <= month && p.FromMonth >= from {
+			rate, from = p.Rate, p.FromMonth
+		}
+	}
+	return rate
+}
+
+// AverageRate returns the mean annual rate (decimal) over the first months simulated months
+func (d DebtInfo) AverageRate(months int) float64 {
+	if months <= 1 || len(d.RateSchedule) == 0 {
+		return d.RateAt(1)
+	}
+	sum := 0.0
+	for month := 1; month <= months; month++ {
+		sum += d.RateAt(month)
+	}
+	return sum / float64(months)
+}
+
+// HasFloatingRate reports whether the debt's rate moves with a reference rate
+func (d DebtInfo) HasFloatingRate() bool {
+	if len(d.RateSchedule) == 0 {
+		return d.IsVariableRate
+	}
+	for _, p := range d.RateSchedule {
+		if p.Floating {
+			return true
+		}
+	}
+	return false
+}
+
+// WithRateShock returns a copy of the debt whose floating rates are raised by shock (decimal),
+// within their floor and cap. Without a schedule a variable-rate debt's InterestRate is raised.
+func (d DebtInfo) WithRateShock(shock float64) DebtInfo {
+	if len(d.RateSchedule) == 0 {
+		if d.IsVariableRate {
+			d.InterestRate += shock
+		}
+		return d
+	}
+
+	schedule := make([]RatePeriod, len(d.RateSchedule))
+	for i, p := range d.RateSchedule {
+		if p.Floating {
+			p.Rate += shock
+			if p.RateFloor != nil && p.Rate < *p.RateFloor {
+				p.Rate = *p.RateFloor
+			}
+			if p.RateCap != nil && p.Rate > *p.RateCap {
+				p.Rate = *p.RateCap
+			}
+		}
+		schedule[i] = p
+	}
+	d.RateSchedule = schedule
+	return d
+}
+
 // HybridWeights weights cho hybrid strategy
 type HybridWeights struct {
 	InterestRateWeight float64 `json:"interest_rate_weight"` // α - default 0.4
@@ -161,6 +236,9 @@ type RefinanceOption struct {
 	OriginationFee float64  `json:"origination_fee"`  // Upfront fee
 	MonthlyFee     float64  `json:"monthly_fee"`      // Monthly service fee
 	IncludeDebtIDs []string `json:"include_debt_ids"` // Which debts to consolidate
+
+	// Promotional / floating schedule of the new loan; NewRate applies before the first period
+	RateSchedule []RatePeriod `json:"rate_schedule,omitempty"`
 }
 
 // RefinanceAnalysis result of refinancing analysis
@@ -186,7 +264,7 @@ type RefinanceAnalysis struct {
 
 // SensitivityScenario represents a sensitivity test
 type SensitivityScenario struct {
-	Type        string  `json:"type"`       // "income_decrease", "rate_increase", "expense_increase"
+	Type        string  `json:"type"`       // "income_decrease", "rate_increase", "rate_shock", "expense_increase"
 	Percentage  float64 `json:"percentage"` // e.g., 0.10 = 10%
 	Description string  `json:"description"`
 }
@@ -26,6 +26,9 @@ type DebtStrategyInput struct {
 
 	// Sensitivity analysis (optional)
 	RunSensitivity bool `json:"run_sensitivity,omitempty"`
+
+	// Reference-rate stress test (optional): shock added to floating rates, decimal (0.02 = +2%)
+	RateShock float64 `json:"rate_shock,omitempty"`
 }
 
 // CalculateExtraPayment tính extra payment sau khi trả minimum
@@ -118,10 +121,10 @@ func (i *DebtStrategyInput) HasHighStressDebt() bool {
 	return false
 }
 
-// HasVariableRateDebt checks if any debt has variable rate
+// HasVariableRateDebt checks if any debt has a variable or floating rate
 func (i *DebtStrategyInput) HasVariableRateDebt() bool {
 	for _, d := range i.Debts {
-		if d.IsVariableRate {
+		if d.HasFloatingRate() {
 			return true
 		}
 	}
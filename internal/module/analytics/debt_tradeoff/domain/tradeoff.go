@@ -28,6 +28,27 @@ type InvestmentProfile struct {
 	ExpectedReturn     float64 `json:"expected_return"`     // Annual return (decimal, e.g., 0.07 = 7%)
 	TimeHorizon        int     `json:"time_horizon"`        // Years
 	CurrentInvestments float64 `json:"current_investments"` // Current investment balance
+	DepositBalance     float64 `json:"deposit_balance"`     // Term deposit balance (filled from term deposits when omitted)
+	DepositYield       float64 `json:"deposit_yield"`       // Effective annual deposit yield (decimal)
+}
+
+// EffectiveReturn lợi suất dùng cho mô phỏng.
+// Term deposits contribute their real yield, weighted by balance against the other investments;
+// without deposits the expected return is used as given, including an explicit 0.
+func (p InvestmentProfile) EffectiveReturn() float64 {
+	if p.DepositBalance <= 0 || p.DepositYield <= 0 {
+		return p.ExpectedReturn
+	}
+	if p.CurrentInvestments <= 0 {
+		return p.DepositYield
+	}
+	total := p.CurrentInvestments + p.DepositBalance
+	return (p.ExpectedReturn*p.CurrentInvestments + p.DepositYield*p.DepositBalance) / total
+}
+
+// TotalInvestments tổng số dư đầu tư, bao gồm tiền gửi có kỳ hạn
+func (p InvestmentProfile) TotalInvestments() float64 {
+	return p.CurrentInvestments + p.DepositBalance
 }
 
 // EmergencyFundStatus trạng thái quỹ khẩn cấp
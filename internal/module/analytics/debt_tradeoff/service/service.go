@@ -5,7 +5,9 @@ import (
 
 	"personalfinancedss/internal/module/analytics/debt_tradeoff/dto"
 	tradeoff "personalfinancedss/internal/module/analytics/models/tradeoff"
+	termDepositService "personalfinancedss/internal/module/cashflow/term_deposit/service"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -14,18 +16,22 @@ type Service interface {
 }
 
 type service struct {
-	model  *tradeoff.TradeoffModel
-	logger *zap.Logger
+	model    *tradeoff.TradeoffModel
+	deposits termDepositService.YieldReporter
+	logger   *zap.Logger
 }
 
-func NewService(logger *zap.Logger) Service {
+func NewService(deposits termDepositService.YieldReporter, logger *zap.Logger) Service {
 	return &service{
-		model:  tradeoff.NewTradeoffModel(),
-		logger: logger,
+		model:    tradeoff.NewTradeoffModel(),
+		deposits: deposits,
+		logger:   logger,
 	}
 }
 
 func (s *service) ExecuteTradeoff(ctx context.Context, input *dto.TradeoffInput) (*dto.TradeoffOutput, error) {
+	s.applyDepositYield(ctx, input)
+
 	if err := s.model.Validate(ctx, input); err != nil {
 		s.logger.Error("Tradeoff validation failed", zap.Error(err))
 		return nil, err
@@ -39,3 +45,26 @@ func (s *service) ExecuteTradeoff(ctx context.Context, input *dto.TradeoffInput)
 
 	return result.(*dto.TradeoffOutput), nil
 }
+
+// applyDepositYield fills the user's term deposit balance and yield when the input omits them,
+// so projections use real deposit yields instead of a fixed return
+func (s *service) applyDepositYield(ctx context.Context, input *dto.TradeoffInput) {
+	profile := &input.InvestmentProfile
+	if s.deposits == nil || profile.DepositBalance > 0 || profile.DepositYield > 0 {
+		return
+	}
+
+	userID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return
+	}
+
+	summary, err := s.deposits.GetYieldSummary(ctx, userID)
+	if err != nil {
+		s.logger.Warn("Failed to load term deposit yield", zap.Error(err))
+		return
+	}
+
+	profile.DepositBalance = summary.Balance
+	profile.DepositYield = summary.AnnualYield
+}
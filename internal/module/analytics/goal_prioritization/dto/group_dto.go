@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+
+	"personalfinancedss/internal/module/analytics/goal_prioritization/domain"
+)
+
+// Group aggregation methods
+const (
+	// GroupAggregationJudgments aggregates every pairwise judgment by weighted geometric mean (AIJ)
+	GroupAggregationJudgments = "judgments"
+	// GroupAggregationPriorities aggregates each participant's priorities by weighted geometric mean (AIP)
+	GroupAggregationPriorities = "priorities"
+)
+
+// GroupCriteria are the criteria every participant of a group prioritization judges
+// NOTE: Impact is temporarily disabled, matching the auto-scorer
+var GroupCriteria = []string{"urgency", "importance", "feasibility"}
+
+// ParticipantJudgment holds one participant's judgments for a group prioritization.
+// A participant gives either full pairwise comparisons or direct criteria ratings.
+type ParticipantJudgment struct {
+	ParticipantID   string  `json:"participant_id"`
+	ParticipantName string  `json:"participant_name,omitempty"`
+	Weight          float64 `json:"weight,omitempty"` // Optional member weight (defaults to 1)
+
+	// Pairwise comparisons between criteria and between goals per criterion
+	CriteriaComparisons    []domain.PairwiseComparison            `json:"criteria_comparisons,omitempty"`
+	AlternativeComparisons map[string][]domain.PairwiseComparison `json:"alternative_comparisons,omitempty"`
+
+	// Direct criteria ratings (1-10 scale); goals are auto-scored
+	CriteriaRatings map[string]int `json:"criteria_ratings,omitempty"`
+	// Optional per-goal score overrides (0-1 scale): goalID -> criterion -> score
+	GoalScoreOverrides map[string]map[string]float64 `json:"goal_score_overrides,omitempty"`
+}
+
+// UsesPairwise reports whether the participant gave pairwise comparisons
+func (p *ParticipantJudgment) UsesPairwise() bool {
+	return len(p.CriteriaComparisons) > 0
+}
+
+// Validate checks that the participant gave exactly one kind of judgment
+func (p *ParticipantJudgment) Validate() error {
+	hasPairwise := p.UsesPairwise() || len(p.AlternativeComparisons) > 0
+	hasRatings := len(p.CriteriaRatings) > 0
+	if hasPairwise == hasRatings {
+		return errors.New("provide either pairwise comparisons or criteria ratings")
+	}
+	if hasRatings {
+		for _, criterion := range GroupCriteria {
+			rating, exists := p.CriteriaRatings[criterion]
+			if !exists {
+				return fmt.Errorf("missing criterion rating: %s", criterion)
+			}
+			if rating < 1 || rating > 10 {
+				return fmt.Errorf("%s rating must be between 1 and 10", criterion)
+			}
+		}
+	}
+	if p.Weight < 0 {
+		return errors.New("weight must not be negative")
+	}
+	return nil
+}
+
+// GroupPrioritizationInput is the input for a group goal prioritization
+type GroupPrioritizationInput struct {
+	UserID        string                `json:"user_id"`
+	MonthlyIncome float64               `json:"monthly_income"`
+	Goals         []GoalForRating       `json:"goals"`
+	Participants  []ParticipantJudgment `json:"participants"`
+
+	// Aggregation: "judgments" (default) or "priorities"
+	Aggregation string `json:"aggregation,omitempty"`
+	// Optional member weights keyed by participant ID; override participant weights
+	MemberWeights map[string]float64 `json:"member_weights,omitempty"`
+}
+
+// ParticipantPriorities is one participant's individual result
+type ParticipantPriorities struct {
+	ParticipantID    string             `json:"participant_id"`
+	ParticipantName  string             `json:"participant_name,omitempty"`
+	Weight           float64            `json:"weight"`
+	Method           string             `json:"method"` // "pairwise" or "direct_rating"
+	ConsistencyRatio float64            `json:"consistency_ratio"`
+	CriteriaWeights  map[string]float64 `json:"criteria_weights"`
+	Ranking          []domain.RankItem  `json:"ranking"`
+}
+
+// GroupDisagreement describes an element the participants disagree on
+type GroupDisagreement struct {
+	Type           string   `json:"type"` // "criteria_weight" or "alternative_ranking"
+	ElementID      string   `json:"element_id"`
+	ElementName    string   `json:"element_name,omitempty"`
+	Variance       float64  `json:"variance"`
+	MinValue       float64  `json:"min_value"`
+	MaxValue       float64  `json:"max_value"`
+	Level          string   `json:"level"`
+	ParticipantIDs []string `json:"participant_ids"` // Participants holding the extreme values
+}
+
+// GroupPrioritizationOutput is the aggregated group result.
+// The embedded AHPOutput can be used as a regular Step 1 result.
+type GroupPrioritizationOutput struct {
+	*AHPOutput
+
+	Aggregation       string                  `json:"aggregation"`
+	ConsensusScore    float64                 `json:"consensus_score"` // 0-1, higher = more agreement
+	CriteriaConsensus float64                 `json:"criteria_consensus"`
+	RankingConsensus  float64                 `json:"ranking_consensus"`
+	ConsensusLevel    string                  `json:"consensus_level"` // "high", "medium", "low"
+	Recommendation    string                  `json:"recommendation"`
+	Participants      []ParticipantPriorities `json:"participants"`
+	Disagreements     []GroupDisagreement     `json:"disagreements,omitempty"` // Largest first
+}
@@ -0,0 +1,41 @@
+package dto
+
+// SensitivityOutput reports how robust a goal ranking is to criteria weight changes
+type SensitivityOutput struct {
+	TopGoalID   string `json:"top_goal_id"`
+	TopGoalName string `json:"top_goal_name"`
+
+	// Criteria ordered from most to least sensitive
+	Criteria  []CriterionSensitivity `json:"criteria"`
+	Stability RankingStability       `json:"stability"`
+}
+
+// CriterionSensitivity shows how far one criterion weight can move before the top goal changes
+type CriterionSensitivity struct {
+	CriterionID      string  `json:"criterion_id"`
+	CurrentWeight    float64 `json:"current_weight"`
+	SensitivityScore float64 `json:"sensitivity_score"` // Change of the top goal's priority for a ±10% weight change
+	SensitivityLevel string  `json:"sensitivity_level"` // "high", "medium", "low"
+
+	// Nil when no weight in that direction flips the top goal
+	Increase *WeightThreshold `json:"increase,omitempty"`
+	Decrease *WeightThreshold `json:"decrease,omitempty"`
+}
+
+// WeightThreshold is the criterion weight at which another goal becomes #1
+type WeightThreshold struct {
+	ThresholdWeight    float64 `json:"threshold_weight"`
+	WeightChange       float64 `json:"weight_change"`   // Absolute change from the current weight
+	RelativeChange     float64 `json:"relative_change"` // Change as a fraction of the current weight
+	OvertakingGoalID   string  `json:"overtaking_goal_id"`
+	OvertakingGoalName string  `json:"overtaking_goal_name"`
+}
+
+// RankingStability summarizes the robustness of the top choice
+type RankingStability struct {
+	IsStable        bool    `json:"is_stable"`
+	StabilityScore  float64 `json:"stability_score"`   // 0-100
+	TopTwoGap       float64 `json:"top_two_gap"`       // Priority gap between #1 and #2
+	MinWeightChange float64 `json:"min_weight_change"` // Smallest single weight change that flips #1 (1 = none can)
+	Recommendation  string  `json:"recommendation"`
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"personalfinancedss/internal/module/analytics/goal_prioritization/domain"
+	"personalfinancedss/internal/module/analytics/goal_prioritization/dto"
+	"personalfinancedss/internal/module/analytics/models/ahp"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxGroupDisagreements caps how many disagreements are reported
+	maxGroupDisagreements = 5
+
+	// minImpliedScore keeps ratios of auto-scores finite when a goal scores zero
+	minImpliedScore = 0.05
+)
+
+// ExecuteGroupPrioritization aggregates several participants' judgments into one ranking
+func (s *service) ExecuteGroupPrioritization(ctx context.Context, input *dto.GroupPrioritizationInput) (*dto.GroupPrioritizationOutput, error) {
+	s.logger.Info("Executing group AHP",
+		zap.String("user_id", input.UserID),
+		zap.Int("goal_count", len(input.Goals)),
+		zap.Int("participant_count", len(input.Participants)))
+
+	if len(input.Goals) < 2 {
+		return nil, fmt.Errorf("at least 2 goals required for prioritization")
+	}
+	if len(input.Participants) == 0 {
+		return nil, fmt.Errorf("at least one participant required")
+	}
+
+	aggregation := input.Aggregation
+	if aggregation == "" {
+		aggregation = dto.GroupAggregationJudgments
+	}
+	var method string
+	switch aggregation {
+	case dto.GroupAggregationJudgments:
+		method = "aggregate_judgments"
+	case dto.GroupAggregationPriorities:
+		method = "weighted_geometric_mean"
+	default:
+		return nil, fmt.Errorf("unsupported aggregation: %s", aggregation)
+	}
+
+	decisionMakers := make([]ahp.DecisionMakerInput, 0, len(input.Participants))
+	methods := make(map[string]string, len(input.Participants))
+	for i := range input.Participants {
+		participant := &input.Participants[i]
+		if err := participant.Validate(); err != nil {
+			return nil, fmt.Errorf("participant %s: %w", participant.ParticipantID, err)
+		}
+
+		ahpInput := s.buildParticipantInput(input, participant)
+		if err := s.model.Validate(ctx, ahpInput); err != nil {
+			return nil, fmt.Errorf("participant %s: %w", participant.ParticipantID, err)
+		}
+
+		weight := participant.Weight
+		if w, ok := input.MemberWeights[participant.ParticipantID]; ok && w > 0 {
+			weight = w
+		}
+
+		methods[participant.ParticipantID] = "direct_rating"
+		if participant.UsesPairwise() {
+			methods[participant.ParticipantID] = "pairwise"
+		}
+
+		decisionMakers = append(decisionMakers, ahp.DecisionMakerInput{
+			DecisionMakerID:   participant.ParticipantID,
+			DecisionMakerName: participant.ParticipantName,
+			Input:             ahpInput,
+			Weight:            weight,
+		})
+	}
+
+	result, err := s.model.ExecuteGroupDecision(ctx, &ahp.GroupDecisionInput{
+		DecisionMakers:    decisionMakers,
+		AggregationMethod: method,
+	})
+	if err != nil {
+		s.logger.Error("Group AHP execution failed", zap.Error(err))
+		return nil, err
+	}
+
+	output := &dto.GroupPrioritizationOutput{
+		AHPOutput:         result.AggregatedResult,
+		Aggregation:       aggregation,
+		ConsensusScore:    result.ConsensusMetrics.ConsensusIndex,
+		CriteriaConsensus: result.ConsensusMetrics.CriteriaConsensus,
+		RankingConsensus:  result.ConsensusMetrics.RankingConsensus,
+		ConsensusLevel:    result.ConsensusMetrics.ConsensusLevel,
+		Recommendation:    result.ConsensusMetrics.Recommendation,
+		Participants:      make([]dto.ParticipantPriorities, 0, len(result.IndividualResults)),
+	}
+
+	for _, r := range result.IndividualResults {
+		output.Participants = append(output.Participants, dto.ParticipantPriorities{
+			ParticipantID:    r.DecisionMakerID,
+			ParticipantName:  r.DecisionMakerName,
+			Weight:           r.Weight,
+			Method:           methods[r.DecisionMakerID],
+			ConsistencyRatio: r.Output.ConsistencyRatio,
+			CriteriaWeights:  r.Output.CriteriaWeights,
+			Ranking:          r.Output.Ranking,
+		})
+	}
+
+	for _, item := range result.DisagreementAnalysis {
+		if len(output.Disagreements) == maxGroupDisagreements {
+			break
+		}
+		output.Disagreements = append(output.Disagreements, dto.GroupDisagreement{
+			Type:           item.Type,
+			ElementID:      item.ElementID,
+			ElementName:    item.ElementName,
+			Variance:       item.Variance,
+			MinValue:       item.MinValue,
+			MaxValue:       item.MaxValue,
+			Level:          item.DisagreementLevel,
+			ParticipantIDs: item.AffectedBy,
+		})
+	}
+
+	s.logger.Info("Group AHP completed",
+		zap.String("user_id", input.UserID),
+		zap.String("aggregation", aggregation),
+		zap.Float64("consensus_score", output.ConsensusScore),
+		zap.Int("disagreements", len(output.Disagreements)))
+
+	return output, nil
+}
+
+// buildParticipantInput converts a participant's judgments into a full AHP input.
+// Direct ratings become the pairwise ratios they imply, so they can be aggregated
+// together with pairwise judgments.
+func (s *service) buildParticipantInput(input *dto.GroupPrioritizationInput, participant *dto.ParticipantJudgment) *dto.AHPInput {
+	criteria := make([]domain.Criteria, 0, len(dto.GroupCriteria))
+	for _, id := range dto.GroupCriteria {
+		criteria = append(criteria, domain.Criteria{ID: id, Name: id})
+	}
+
+	alternatives := make([]domain.Alternative, 0, len(input.Goals))
+	for _, goal := range input.Goals {
+		alternatives = append(alternatives, domain.Alternative{
+			ID:            goal.ID,
+			Name:          goal.Name,
+			TargetAmount:  goal.TargetAmount,
+			CurrentAmount: goal.CurrentAmount,
+			Deadline:      goal.TargetDate,
+		})
+	}
+
+	ahpInput := &dto.AHPInput{
+		UserID:       participant.ParticipantID,
+		Criteria:     criteria,
+		Alternatives: alternatives,
+	}
+
+	if participant.UsesPairwise() {
+		ahpInput.CriteriaComparisons = participant.CriteriaComparisons
+		ahpInput.AlternativeComparisons = participant.AlternativeComparisons
+		return ahpInput
+	}
+
+	criteriaScores := make(map[string]float64, len(dto.GroupCriteria))
+	for _, id := range dto.GroupCriteria {
+		criteriaScores[id] = float64(participant.CriteriaRatings[id])
+	}
+	ahpInput.CriteriaComparisons = impliedComparisons(dto.GroupCriteria, criteriaScores)
+
+	goalScores := make(map[string]map[string]float64, len(dto.GroupCriteria))
+	for _, id := range dto.GroupCriteria {
+		goalScores[id] = make(map[string]float64, len(input.Goals))
+	}
+	goalIDs := make([]string, 0, len(input.Goals))
+	for _, goal := range input.Goals {
+		goalIDs = append(goalIDs, goal.ID)
+		scores := s.autoScorer.CalculateAllCriteria(convertToGoalLike(goal), input.MonthlyIncome)
+		for criterion, score := range participant.GoalScoreOverrides[goal.ID] {
+			scores[criterion] = score
+		}
+		for _, id := range dto.GroupCriteria {
+			goalScores[id][goal.ID] = math.Max(scores[id], minImpliedScore)
+		}
+	}
+
+	ahpInput.AlternativeComparisons = make(map[string][]domain.PairwiseComparison, len(dto.GroupCriteria))
+	for _, id := range dto.GroupCriteria {
+		ahpInput.AlternativeComparisons[id] = impliedComparisons(goalIDs, goalScores[id])
+	}
+
+	return ahpInput
+}
+
+// impliedComparisons builds pairwise comparisons from scores,
+// clamped to Saaty's 1/9 - 9 scale
+func impliedComparisons(ids []string, scores map[string]float64) []domain.PairwiseComparison {
+	comparisons := make([]domain.PairwiseComparison, 0, len(ids)*(len(ids)-1)/2)
+	for i := 0; i < len(ids)-1; i++ {
+		for j := i + 1; j < len(ids); j++ {
+			ratio := scores[ids[i]] / scores[ids[j]]
+			comparisons = append(comparisons, domain.PairwiseComparison{
+				ElementA: ids[i],
+				ElementB: ids[j],
+				Value:    math.Min(9, math.Max(1.0/9, ratio)),
+			})
+		}
+	}
+	return comparisons
+}
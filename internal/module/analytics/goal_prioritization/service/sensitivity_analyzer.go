@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"personalfinancedss/internal/module/analytics/goal_prioritization/domain"
+	"personalfinancedss/internal/module/analytics/goal_prioritization/dto"
+
+	"go.uber.org/zap"
+)
+
+// AnalyzeSensitivity reports how much each criterion weight can change before the top goal flips
+func (s *service) AnalyzeSensitivity(ctx context.Context, output *dto.AHPOutput) (*dto.SensitivityOutput, error) {
+	if output == nil || len(output.Ranking) == 0 {
+		return nil, fmt.Errorf("no ranking to analyze")
+	}
+
+	// Criteria in a stable order, with comparisons implied by their weights
+	criteriaIDs := make([]string, 0, len(output.CriteriaWeights))
+	for id := range output.CriteriaWeights {
+		criteriaIDs = append(criteriaIDs, id)
+	}
+	sort.Strings(criteriaIDs)
+
+	criteria := make([]domain.Criteria, 0, len(criteriaIDs))
+	for _, id := range criteriaIDs {
+		criteria = append(criteria, domain.Criteria{ID: id, Name: id})
+	}
+	input := &dto.AHPInput{
+		Criteria:            criteria,
+		CriteriaComparisons: impliedComparisons(criteriaIDs, output.CriteriaWeights),
+	}
+
+	result := s.model.AnalyzeSensitivity(input, output)
+
+	thresholds := make(map[string]map[string]*dto.WeightThreshold, len(criteriaIDs))
+	for _, th := range result.CriticalThresholds {
+		if thresholds[th.CriterionID] == nil {
+			thresholds[th.CriterionID] = make(map[string]*dto.WeightThreshold, 2)
+		}
+		thresholds[th.CriterionID][th.ChangeDirection] = &dto.WeightThreshold{
+			ThresholdWeight:    th.ThresholdWeight,
+			WeightChange:       th.WeightChange,
+			RelativeChange:     th.RelativeChange,
+			OvertakingGoalID:   th.OvertakingID,
+			OvertakingGoalName: th.OvertakingName,
+		}
+	}
+
+	analysis := &dto.SensitivityOutput{
+		TopGoalID:   output.Ranking[0].AlternativeID,
+		TopGoalName: output.Ranking[0].AlternativeName,
+		Criteria:    make([]dto.CriterionSensitivity, 0, len(result.CriteriaSensitivity)),
+		Stability: dto.RankingStability{
+			IsStable:        result.RankingStability.IsStable,
+			StabilityScore:  result.RankingStability.StabilityScore,
+			TopTwoGap:       result.RankingStability.TopTwoGap,
+			MinWeightChange: math.Min(1, result.RankingStability.MinWeightChange),
+			Recommendation:  result.RankingStability.Recommendation,
+		},
+	}
+
+	for _, item := range result.CriteriaSensitivity {
+		analysis.Criteria = append(analysis.Criteria, dto.CriterionSensitivity{
+			CriterionID:      item.CriterionID,
+			CurrentWeight:    item.CurrentWeight,
+			SensitivityScore: item.SensitivityScore,
+			SensitivityLevel: item.SensitivityLevel,
+			Increase:         thresholds[item.CriterionID]["increase"],
+			Decrease:         thresholds[item.CriterionID]["decrease"],
+		})
+	}
+
+	s.logger.Info("AHP sensitivity analysis completed",
+		zap.String("top_goal_id", analysis.TopGoalID),
+		zap.Bool("is_stable", analysis.Stability.IsStable),
+		zap.Float64("min_weight_change", analysis.Stability.MinWeightChange))
+
+	return analysis, nil
+}
@@ -29,6 +29,13 @@ type Service interface {
 	// ExecuteDirectRatingWithOverrides runs direct rating with user-modified scores
 	// This is step 2 of "auto-score with user review" flow
 	ExecuteDirectRatingWithOverrides(ctx context.Context, input *dto.DirectRatingWithOverridesInput) (*dto.AHPOutput, error)
+
+	// ExecuteGroupPrioritization aggregates several participants' judgments into one ranking
+	// with consensus and disagreement analysis
+	ExecuteGroupPrioritization(ctx context.Context, input *dto.GroupPrioritizationInput) (*dto.GroupPrioritizationOutput, error)
+
+	// AnalyzeSensitivity reports how much each criterion weight can change before the top goal flips
+	AnalyzeSensitivity(ctx context.Context, output *dto.AHPOutput) (*dto.SensitivityOutput, error)
 }
 
 // service implements Service interface
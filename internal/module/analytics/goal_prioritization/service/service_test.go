@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"personalfinancedss/internal/module/analytics/goal_prioritization/domain"
 	"personalfinancedss/internal/module/analytics/goal_prioritization/dto"
@@ -410,3 +411,135 @@ func TestService_ExecuteAHP_WithLogging(t *testing.T) {
 	// If we got here, logging didn't cause any panics or errors
 	// More detailed logging tests would require capturing log output
 }
+
+func TestService_ExecuteGroupPrioritization(t *testing.T) {
+	svc := NewService(zap.NewNop())
+	ctx := context.Background()
+
+	goals := []dto.GoalForRating{
+		{ID: "emergency", Name: "Emergency Fund", TargetAmount: 50000000, CurrentAmount: 10000000, TargetDate: time.Now().AddDate(0, 6, 0), Type: "emergency", Priority: "critical"},
+		{ID: "travel", Name: "Vacation", TargetAmount: 30000000, CurrentAmount: 0, TargetDate: time.Now().AddDate(2, 0, 0), Type: "travel", Priority: "low"},
+	}
+	pairwise := func(value float64) dto.ParticipantJudgment {
+		return dto.ParticipantJudgment{
+			ParticipantID: "alice",
+			CriteriaComparisons: []domain.PairwiseComparison{
+				{ElementA: "urgency", ElementB: "importance", Value: 1},
+				{ElementA: "urgency", ElementB: "feasibility", Value: 1},
+				{ElementA: "importance", ElementB: "feasibility", Value: 1},
+			},
+			AlternativeComparisons: map[string][]domain.PairwiseComparison{
+				"urgency":     {{ElementA: "emergency", ElementB: "travel", Value: value}},
+				"importance":  {{ElementA: "emergency", ElementB: "travel", Value: value}},
+				"feasibility": {{ElementA: "emergency", ElementB: "travel", Value: value}},
+			},
+		}
+	}
+
+	t.Run("mixes pairwise and direct ratings", func(t *testing.T) {
+		input := &dto.GroupPrioritizationInput{
+			UserID:        "owner",
+			MonthlyIncome: 20000000,
+			Goals:         goals,
+			Participants: []dto.ParticipantJudgment{
+				pairwise(5),
+				{
+					ParticipantID:   "bob",
+					CriteriaRatings: map[string]int{"urgency": 8, "importance": 6, "feasibility": 4},
+				},
+			},
+		}
+
+		output, err := svc.ExecuteGroupPrioritization(ctx, input)
+		require.NoError(t, err)
+
+		assert.Equal(t, dto.GroupAggregationJudgments, output.Aggregation)
+		assert.Equal(t, "emergency", output.Ranking[0].AlternativeID)
+		assert.Len(t, output.Participants, 2)
+		assert.Equal(t, "pairwise", output.Participants[0].Method)
+		assert.Equal(t, "direct_rating", output.Participants[1].Method)
+		assert.GreaterOrEqual(t, output.ConsensusScore, 0.0)
+		assert.LessOrEqual(t, output.ConsensusScore, 1.0)
+	})
+
+	t.Run("member weights favour the heavier participant", func(t *testing.T) {
+		against := pairwise(1.0 / 5)
+		against.ParticipantID = "bob"
+		input := &dto.GroupPrioritizationInput{
+			Goals:         goals,
+			Participants:  []dto.ParticipantJudgment{pairwise(5), against},
+			Aggregation:   dto.GroupAggregationPriorities,
+			MemberWeights: map[string]float64{"bob": 3},
+		}
+
+		output, err := svc.ExecuteGroupPrioritization(ctx, input)
+		require.NoError(t, err)
+
+		assert.Equal(t, "travel", output.Ranking[0].AlternativeID)
+		require.NotEmpty(t, output.Disagreements)
+		assert.ElementsMatch(t, []string{"alice", "bob"}, output.Disagreements[0].ParticipantIDs)
+	})
+
+	t.Run("rejects mixed judgments from one participant", func(t *testing.T) {
+		invalid := pairwise(3)
+		invalid.CriteriaRatings = map[string]int{"urgency": 5, "importance": 5, "feasibility": 5}
+		input := &dto.GroupPrioritizationInput{
+			Goals:        goals,
+			Participants: []dto.ParticipantJudgment{invalid},
+		}
+
+		_, err := svc.ExecuteGroupPrioritization(ctx, input)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects unknown aggregation", func(t *testing.T) {
+		input := &dto.GroupPrioritizationInput{
+			Goals:        goals,
+			Participants: []dto.ParticipantJudgment{pairwise(3)},
+			Aggregation:  "median",
+		}
+
+		_, err := svc.ExecuteGroupPrioritization(ctx, input)
+		assert.Error(t, err)
+	})
+}
+
+func TestService_AnalyzeSensitivity(t *testing.T) {
+	svc := NewService(zap.NewNop())
+	ctx := context.Background()
+
+	output := &dto.AHPOutput{
+		CriteriaWeights: map[string]float64{"urgency": 0.6, "importance": 0.4},
+		LocalPriorities: map[string]map[string]float64{
+			"urgency":    {"emergency": 0.8, "travel": 0.2},
+			"importance": {"emergency": 0.3, "travel": 0.7},
+		},
+		AlternativePriorities: map[string]float64{"emergency": 0.6, "travel": 0.4},
+		Ranking: []domain.RankItem{
+			{AlternativeID: "emergency", AlternativeName: "Emergency Fund", Priority: 0.6, Rank: 1},
+			{AlternativeID: "travel", AlternativeName: "Vacation", Priority: 0.4, Rank: 2},
+		},
+	}
+
+	analysis, err := svc.AnalyzeSensitivity(ctx, output)
+	require.NoError(t, err)
+
+	assert.Equal(t, "emergency", analysis.TopGoalID)
+	require.Len(t, analysis.Criteria, 2)
+	for _, c := range analysis.Criteria {
+		switch c.CriterionID {
+		case "urgency":
+			require.NotNil(t, c.Decrease)
+			assert.Nil(t, c.Increase)
+			assert.InDelta(t, 0.4, c.Decrease.ThresholdWeight, 1e-9)
+			assert.Equal(t, "travel", c.Decrease.OvertakingGoalID)
+		case "importance":
+			require.NotNil(t, c.Increase)
+			assert.InDelta(t, 0.2, c.Increase.WeightChange, 1e-9)
+		}
+	}
+	assert.InDelta(t, 0.2, analysis.Stability.MinWeightChange, 1e-9)
+
+	_, err = svc.AnalyzeSensitivity(ctx, &dto.AHPOutput{})
+	assert.Error(t, err)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
+
 	"personalfinancedss/internal/module/analytics/goal_prioritization/domain"
 	"personalfinancedss/internal/module/analytics/goal_prioritization/dto"
 )
@@ -13,10 +15,13 @@ type GroupDecisionInput struct {
 	// Individual inputs from each decision maker
 	DecisionMakers []DecisionMakerInput `json:"decision_makers"`
 
-	// Aggregation method: "geometric_mean" (default), "arithmetic_mean", "weighted"
+	// Aggregation method: "geometric_mean" (default), "arithmetic_mean", "weighted",
+	// "weighted_geometric_mean" (aggregation of individual priorities) or
+	// "aggregate_judgments" (weighted geometric mean of pairwise judgments)
 	AggregationMethod string `json:"aggregation_method"`
 
-	// Weights for each decision maker (only used if method is "weighted")
+	// Weights for each decision maker, keyed by decision maker ID
+	// Used when a decision maker has no Weight of their own
 	DecisionMakerWeights map[string]float64 `json:"decision_maker_weights,omitempty"`
 }
 
@@ -126,6 +131,9 @@ func (m *AHPModel) ExecuteGroupDecision(
 
 		weight := dm.Weight
 		if weight == 0 {
+			weight = groupInput.DecisionMakerWeights[dm.DecisionMakerID]
+		}
+		if weight <= 0 {
 			weight = 1.0
 		}
 
@@ -138,7 +146,16 @@ func (m *AHPModel) ExecuteGroupDecision(
 	}
 
 	// Aggregate results
-	aggregatedResult := m.aggregateResults(groupInput, individualResults)
+	var aggregatedResult *dto.AHPOutput
+	if groupInput.AggregationMethod == "aggregate_judgments" {
+		aggregated, err := m.executeAggregatedJudgments(ctx, groupInput.DecisionMakers, individualResults)
+		if err != nil {
+			return nil, err
+		}
+		aggregatedResult = aggregated
+	} else {
+		aggregatedResult = m.aggregateResults(groupInput, individualResults)
+	}
 
 	// Calculate consensus metrics
 	consensusMetrics := m.calculateConsensusMetrics(individualResults)
@@ -233,6 +250,8 @@ func (m *AHPModel) aggregateWeights(
 			aggregated[key] = arithmeticMean(values)
 		case "weighted":
 			aggregated[key] = weightedMean(values, weights)
+		case "weighted_geometric_mean":
+			aggregated[key] = weightedGeometricMean(values, weights)
 		default:
 			aggregated[key] = geometricMean(values)
 		}
@@ -264,8 +283,10 @@ func (m *AHPModel) aggregateLocalPriorities(
 		// Get alternative IDs
 		for altID := range results[0].Output.LocalPriorities[criterionID] {
 			values := make([]float64, len(results))
+			weights := make([]float64, len(results))
 			for i, r := range results {
 				values[i] = r.Output.LocalPriorities[criterionID][altID]
+				weights[i] = r.Weight
 			}
 
 			switch method {
@@ -273,6 +294,8 @@ func (m *AHPModel) aggregateLocalPriorities(
 				aggregated[criterionID][altID] = geometricMean(values)
 			case "arithmetic_mean":
 				aggregated[criterionID][altID] = arithmeticMean(values)
+			case "weighted_geometric_mean":
+				aggregated[criterionID][altID] = weightedGeometricMean(values, weights)
 			default:
 				aggregated[criterionID][altID] = geometricMean(values)
 			}
@@ -429,7 +452,8 @@ func (m *AHPModel) calculateRankingConsensus(results []IndividualResult) float64
 	return float64(agreements) / float64(comparisons)
 }
 
-// analyzeDisagreements identifies where decision makers disagree most
+// analyzeDisagreements identifies where decision makers disagree most,
+// ordered from the largest variance to the smallest
 func (m *AHPModel) analyzeDisagreements(results []IndividualResult) []DisagreementItem {
 	items := make([]DisagreementItem, 0)
 
@@ -440,31 +464,167 @@ func (m *AHPModel) analyzeDisagreements(results []IndividualResult) []Disagreeme
 			values[i] = r.Output.CriteriaWeights[criterionID]
 		}
 
-		variance := calculateVariance(values)
-		minVal, maxVal := minMax(values)
+		if item, ok := disagreementItem("criteria_weight", criterionID, values, results); ok {
+			items = append(items, item)
+		}
+	}
 
-		if variance > 0.01 { // Threshold for significant disagreement
-			level := "low"
-			if variance > 0.05 {
-				level = "high"
-			} else if variance > 0.02 {
-				level = "medium"
-			}
+	// Analyze alternative priority disagreements
+	altNames := make(map[string]string)
+	for _, item := range results[0].Output.Ranking {
+		altNames[item.AlternativeID] = item.AlternativeName
+	}
+	for altID := range results[0].Output.AlternativePriorities {
+		values := make([]float64, len(results))
+		for i, r := range results {
+			values[i] = r.Output.AlternativePriorities[altID]
+		}
 
-			items = append(items, DisagreementItem{
-				Type:              "criteria_weight",
-				ElementID:         criterionID,
-				Variance:          variance,
-				MinValue:          minVal,
-				MaxValue:          maxVal,
-				DisagreementLevel: level,
-			})
+		if item, ok := disagreementItem("alternative_ranking", altID, values, results); ok {
+			item.ElementName = altNames[altID]
+			items = append(items, item)
 		}
 	}
 
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Variance != items[j].Variance {
+			return items[i].Variance > items[j].Variance
+		}
+		return items[i].ElementID < items[j].ElementID
+	})
+
 	return items
 }
 
+// disagreementItem builds a disagreement entry when the variance of values is significant.
+// AffectedBy lists the decision makers holding the lowest and highest values.
+func disagreementItem(itemType, elementID string, values []float64, results []IndividualResult) (DisagreementItem, bool) {
+	variance := calculateVariance(values)
+	if variance <= 0.01 { // Threshold for significant disagreement
+		return DisagreementItem{}, false
+	}
+
+	level := "low"
+	if variance > 0.05 {
+		level = "high"
+	} else if variance > 0.02 {
+		level = "medium"
+	}
+
+	minVal, maxVal := minMax(values)
+	affectedBy := make([]string, 0, 2)
+	for i, v := range values {
+		if v == minVal || v == maxVal {
+			affectedBy = append(affectedBy, results[i].DecisionMakerID)
+		}
+	}
+
+	return DisagreementItem{
+		Type:              itemType,
+		ElementID:         elementID,
+		Variance:          variance,
+		MinValue:          minVal,
+		MaxValue:          maxVal,
+		DisagreementLevel: level,
+		AffectedBy:        affectedBy,
+	}, true
+}
+
+// executeAggregatedJudgments runs AHP on the weighted geometric mean of every
+// pairwise judgment (AIJ), which keeps the reciprocal property of the matrices
+func (m *AHPModel) executeAggregatedJudgments(
+	ctx context.Context,
+	decisionMakers []DecisionMakerInput,
+	results []IndividualResult,
+) (*dto.AHPOutput, error) {
+	weights := make([]float64, len(results))
+	for i, r := range results {
+		weights[i] = r.Weight
+	}
+
+	base := decisionMakers[0].Input
+	aggregated := &dto.AHPInput{
+		UserID:                 base.UserID,
+		Criteria:               base.Criteria,
+		Alternatives:           base.Alternatives,
+		AlternativeComparisons: make(map[string][]domain.PairwiseComparison, len(base.AlternativeComparisons)),
+	}
+
+	criteriaComparisons, err := aggregateComparisons(decisionMakers, weights, func(in *dto.AHPInput) []domain.PairwiseComparison {
+		return in.CriteriaComparisons
+	})
+	if err != nil {
+		return nil, err
+	}
+	aggregated.CriteriaComparisons = criteriaComparisons
+
+	for _, criterion := range base.Criteria {
+		criterionID := criterion.ID
+		comparisons, err := aggregateComparisons(decisionMakers, weights, func(in *dto.AHPInput) []domain.PairwiseComparison {
+			return in.AlternativeComparisons[criterionID]
+		})
+		if err != nil {
+			return nil, fmt.Errorf("criterion %s: %w", criterionID, err)
+		}
+		aggregated.AlternativeComparisons[criterionID] = comparisons
+	}
+
+	if err := m.Validate(ctx, aggregated); err != nil {
+		return nil, fmt.Errorf("aggregated judgments are invalid: %w", err)
+	}
+
+	result, err := m.Execute(ctx, aggregated)
+	if err != nil {
+		return nil, fmt.Errorf("execution failed for aggregated judgments: %w", err)
+	}
+
+	return result.(*dto.AHPOutput), nil
+}
+
+// aggregateComparisons combines the same pairwise comparison from every decision maker
+// using the weighted geometric mean. Comparisons given in reverse order are inverted.
+func aggregateComparisons(
+	decisionMakers []DecisionMakerInput,
+	weights []float64,
+	comparisonsOf func(*dto.AHPInput) []domain.PairwiseComparison,
+) ([]domain.PairwiseComparison, error) {
+	base := comparisonsOf(decisionMakers[0].Input)
+	aggregated := make([]domain.PairwiseComparison, 0, len(base))
+
+	for _, pair := range base {
+		values := make([]float64, len(decisionMakers))
+		for i, dm := range decisionMakers {
+			value, ok := findComparison(comparisonsOf(dm.Input), pair.ElementA, pair.ElementB)
+			if !ok {
+				return nil, fmt.Errorf("decision maker %s has no comparison between %s and %s",
+					dm.DecisionMakerID, pair.ElementA, pair.ElementB)
+			}
+			values[i] = value
+		}
+
+		aggregated = append(aggregated, domain.PairwiseComparison{
+			ElementA: pair.ElementA,
+			ElementB: pair.ElementB,
+			Value:    weightedGeometricMean(values, weights),
+		})
+	}
+
+	return aggregated, nil
+}
+
+// findComparison returns how much a is preferred over b in a set of comparisons
+func findComparison(comparisons []domain.PairwiseComparison, a, b string) (float64, bool) {
+	for _, c := range comparisons {
+		if c.ElementA == a && c.ElementB == b && c.Value > 0 {
+			return c.Value, true
+		}
+		if c.ElementA == b && c.ElementB == a && c.Value > 0 {
+			return 1 / c.Value, true
+		}
+	}
+	return 0, false
+}
+
 // Helper functions
 
 func geometricMean(values []float64) float64 {
@@ -480,6 +640,25 @@ func geometricMean(values []float64) float64 {
 	return math.Pow(product, 1.0/float64(len(values)))
 }
 
+func weightedGeometricMean(values, weights []float64) float64 {
+	if len(values) == 0 || len(values) != len(weights) {
+		return 0
+	}
+	logSum := 0.0
+	weightSum := 0.0
+	for i, v := range values {
+		if v <= 0 || weights[i] <= 0 {
+			continue
+		}
+		logSum += weights[i] * math.Log(v)
+		weightSum += weights[i]
+	}
+	if weightSum == 0 {
+		return 0
+	}
+	return math.Exp(logSum / weightSum)
+}
+
 func arithmeticMean(values []float64) float64 {
 	if len(values) == 0 {
 		return 0
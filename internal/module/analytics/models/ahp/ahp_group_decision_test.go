@@ -232,6 +232,97 @@ func TestAHPModel_ExecuteGroupDecision_EmptyInput(t *testing.T) {
 	assert.Contains(t, err.Error(), "at least one decision maker")
 }
 
+func TestAHPModel_ExecuteGroupDecision_AggregateJudgments(t *testing.T) {
+	model := NewAHPModel()
+	ctx := context.Background()
+
+	// Second decision maker states the comparison in reverse order
+	reversed := createTestAHPInput("dm2", 3.0, 3.0)
+	reversed.CriteriaComparisons = []domain.PairwiseComparison{
+		{ElementA: "c2", ElementB: "c1", Value: 1.0 / 3.0},
+	}
+
+	groupInput := &GroupDecisionInput{
+		DecisionMakers: []DecisionMakerInput{
+			{DecisionMakerID: "dm1", Input: createTestAHPInput("dm1", 3.0, 3.0)},
+			{DecisionMakerID: "dm2", Input: reversed},
+		},
+		AggregationMethod: "aggregate_judgments",
+	}
+
+	result, err := model.ExecuteGroupDecision(ctx, groupInput)
+	require.NoError(t, err)
+
+	// Identical judgments aggregate to the same 3:1 preference
+	assert.InDelta(t, 0.75, result.AggregatedResult.CriteriaWeights["c1"], 0.001)
+	assert.InDelta(t, 0.75, result.AggregatedResult.AlternativePriorities["a1"], 0.001)
+	assert.Equal(t, "a1", result.AggregatedResult.Ranking[0].AlternativeID)
+}
+
+func TestAHPModel_ExecuteGroupDecision_MemberWeightsAndDisagreements(t *testing.T) {
+	model := NewAHPModel()
+	ctx := context.Background()
+
+	groupInput := &GroupDecisionInput{
+		DecisionMakers: []DecisionMakerInput{
+			{DecisionMakerID: "alice", Input: createTestAHPInput("alice", 9.0, 9.0)},
+			{DecisionMakerID: "bob", Input: createTestAHPInput("bob", 1.0/9.0, 1.0/9.0)},
+		},
+		AggregationMethod:    "aggregate_judgments",
+		DecisionMakerWeights: map[string]float64{"alice": 3.0},
+	}
+
+	result, err := model.ExecuteGroupDecision(ctx, groupInput)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3.0, result.IndividualResults[0].Weight)
+	assert.Equal(t, 1.0, result.IndividualResults[1].Weight)
+	// 9^(3/4) * (1/9)^(1/4) = 3, so alice's preference wins
+	assert.InDelta(t, 0.75, result.AggregatedResult.CriteriaWeights["c1"], 0.001)
+
+	require.NotEmpty(t, result.DisagreementAnalysis)
+	for i := 1; i < len(result.DisagreementAnalysis); i++ {
+		assert.GreaterOrEqual(t, result.DisagreementAnalysis[i-1].Variance, result.DisagreementAnalysis[i].Variance)
+	}
+	var altItem *DisagreementItem
+	for i := range result.DisagreementAnalysis {
+		if result.DisagreementAnalysis[i].ElementID == "a1" {
+			altItem = &result.DisagreementAnalysis[i]
+		}
+	}
+	require.NotNil(t, altItem)
+	assert.Equal(t, "alternative_ranking", altItem.Type)
+	assert.Equal(t, "Emergency Fund", altItem.ElementName)
+	assert.ElementsMatch(t, []string{"alice", "bob"}, altItem.AffectedBy)
+}
+
+func TestAHPModel_ExecuteGroupDecision_AggregateJudgmentsMissingComparison(t *testing.T) {
+	model := NewAHPModel()
+	ctx := context.Background()
+
+	other := createTestAHPInput("dm2", 3.0, 3.0)
+	other.CriteriaComparisons = []domain.PairwiseComparison{
+		{ElementA: "c1", ElementB: "c3", Value: 3.0},
+	}
+	other.Criteria = []domain.Criteria{{ID: "c1"}, {ID: "c3"}}
+	other.AlternativeComparisons = map[string][]domain.PairwiseComparison{
+		"c1": {{ElementA: "a1", ElementB: "a2", Value: 3.0}},
+		"c3": {{ElementA: "a1", ElementB: "a2", Value: 3.0}},
+	}
+
+	groupInput := &GroupDecisionInput{
+		DecisionMakers: []DecisionMakerInput{
+			{DecisionMakerID: "dm1", Input: createTestAHPInput("dm1", 3.0, 3.0)},
+			{DecisionMakerID: "dm2", Input: other},
+		},
+		AggregationMethod: "aggregate_judgments",
+	}
+
+	_, err := model.ExecuteGroupDecision(ctx, groupInput)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no comparison between c1 and c2")
+}
+
 func TestHelperFunctions(t *testing.T) {
 	t.Run("geometricMean", func(t *testing.T) {
 		result := geometricMean([]float64{2, 8})
@@ -241,6 +332,11 @@ func TestHelperFunctions(t *testing.T) {
 		assert.InDelta(t, 1.0, result, 0.01)
 	})
 
+	t.Run("weightedGeometricMean", func(t *testing.T) {
+		result := weightedGeometricMean([]float64{9, 1.0 / 9}, []float64{3, 1})
+		assert.InDelta(t, 3.0, result, 0.001)
+	})
+
 	t.Run("arithmeticMean", func(t *testing.T) {
 		result := arithmeticMean([]float64{2, 4, 6})
 		assert.InDelta(t, 4.0, result, 0.01)
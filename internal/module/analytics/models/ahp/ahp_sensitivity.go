@@ -64,6 +64,11 @@ type CriticalThreshold struct {
 	ThresholdWeight float64 `json:"threshold_weight"` // Weight at which ranking changes
 	ChangeDirection string  `json:"change_direction"` // "increase" or "decrease"
 	AffectedRanking string  `json:"affected_ranking"` // e.g., "A overtakes B"
+
+	WeightChange   float64 `json:"weight_change"`   // ThresholdWeight - CurrentWeight
+	RelativeChange float64 `json:"relative_change"` // WeightChange / CurrentWeight
+	OvertakingID   string  `json:"overtaking_id"`   // Alternative that becomes #1
+	OvertakingName string  `json:"overtaking_name"`
 }
 
 // AnalyzeSensitivity performs sensitivity analysis on AHP results
@@ -89,6 +94,15 @@ func (m *AHPModel) AnalyzeSensitivity(
 	// 4. Find critical thresholds
 	result.CriticalThresholds = m.findCriticalThresholds(input, output)
 
+	// The exact thresholds replace the gap-based estimate of the minimum change.
+	// Without any threshold no single criterion weight can flip the top choice.
+	if len(output.Ranking) >= 2 {
+		result.RankingStability.MinWeightChange = 1
+		for _, th := range result.CriticalThresholds {
+			result.RankingStability.MinWeightChange = math.Min(result.RankingStability.MinWeightChange, math.Abs(th.WeightChange))
+		}
+	}
+
 	return result
 }
 
@@ -261,7 +275,10 @@ func (m *AHPModel) calculateRankingStability(output *dto.AHPOutput) RankingStabi
 	return result
 }
 
-// findCriticalThresholds finds weight values where ranking would change
+// findCriticalThresholds finds, for each criterion and direction, the nearest weight at
+// which another alternative overtakes the top one. When one criterion weight changes the
+// others are rescaled proportionally so the weights still sum to 1, which makes every
+// global priority linear in that weight.
 func (m *AHPModel) findCriticalThresholds(
 	input *dto.AHPInput,
 	output *dto.AHPOutput,
@@ -273,37 +290,82 @@ func (m *AHPModel) findCriticalThresholds(
 	}
 
 	topAlt := output.Ranking[0]
-	secondAlt := output.Ranking[1]
 
-	// For each criterion, find threshold where #2 would overtake #1
 	for _, criterion := range input.Criteria {
 		currentWeight := output.CriteriaWeights[criterion.ID]
+		if currentWeight >= 1 {
+			continue
+		}
 
-		// Local priorities for top two alternatives under this criterion
-		topLocal := output.LocalPriorities[criterion.ID][topAlt.AlternativeID]
-		secondLocal := output.LocalPriorities[criterion.ID][secondAlt.AlternativeID]
-
-		// If second alternative is better under this criterion, increasing weight helps it
-		if secondLocal > topLocal {
-			// Calculate threshold (simplified)
-			gap := topAlt.Priority - secondAlt.Priority
-			localGap := secondLocal - topLocal
-
-			if localGap > 0 {
-				// Threshold = current + (gap / localGap)
-				threshold := currentWeight + (gap / localGap)
-				if threshold > 0 && threshold < 1 {
-					thresholds = append(thresholds, CriticalThreshold{
-						CriterionID:     criterion.ID,
-						CurrentWeight:   currentWeight,
-						ThresholdWeight: threshold,
-						ChangeDirection: "increase",
-						AffectedRanking: secondAlt.AlternativeName + " overtakes " + topAlt.AlternativeName,
-					})
-				}
+		var nearest [2]*CriticalThreshold // [0] = increase, [1] = decrease
+		for _, challenger := range output.Ranking[1:] {
+			threshold, ok := flipWeight(output, criterion.ID, topAlt.AlternativeID, challenger.AlternativeID)
+			if !ok {
+				continue
+			}
+
+			direction, slot := "increase", 0
+			if threshold < currentWeight {
+				direction, slot = "decrease", 1
+			}
+			change := threshold - currentWeight
+			if nearest[slot] != nil && math.Abs(change) >= math.Abs(nearest[slot].WeightChange) {
+				continue
+			}
+
+			relative := 0.0
+			if currentWeight > 0 {
+				relative = change / currentWeight
+			}
+			nearest[slot] = &CriticalThreshold{
+				CriterionID:     criterion.ID,
+				CurrentWeight:   currentWeight,
+				ThresholdWeight: threshold,
+				ChangeDirection: direction,
+				AffectedRanking: challenger.AlternativeName + " overtakes " + topAlt.AlternativeName,
+				WeightChange:    change,
+				RelativeChange:  relative,
+				OvertakingID:    challenger.AlternativeID,
+				OvertakingName:  challenger.AlternativeName,
+			}
+		}
+
+		for _, th := range nearest {
+			if th != nil {
+				thresholds = append(thresholds, *th)
 			}
 		}
 	}
 
+	// Smallest change first
+	sort.SliceStable(thresholds, func(i, j int) bool {
+		return math.Abs(thresholds[i].WeightChange) < math.Abs(thresholds[j].WeightChange)
+	})
+
 	return thresholds
 }
+
+// flipWeight returns the weight of a criterion at which the challenger's global priority
+// equals the top alternative's, if that weight lies strictly within (0, 1)
+func flipWeight(output *dto.AHPOutput, criterionID, topID, challengerID string) (float64, bool) {
+	w := output.CriteriaWeights[criterionID]
+	topLocal := output.LocalPriorities[criterionID][topID]
+	challengerLocal := output.LocalPriorities[criterionID][challengerID]
+
+	// Contribution of the other criteria, scaled to a total weight of 1
+	topRest := (output.AlternativePriorities[topID] - w*topLocal) / (1 - w)
+	challengerRest := (output.AlternativePriorities[challengerID] - w*challengerLocal) / (1 - w)
+
+	// gap(x) = x*(topLocal-challengerLocal) + (1-x)*(topRest-challengerRest)
+	restGap := topRest - challengerRest
+	slope := (topLocal - challengerLocal) - restGap
+	if math.Abs(slope) < 1e-12 {
+		return 0, false
+	}
+
+	threshold := -restGap / slope
+	if threshold <= 0 || threshold >= 1 || math.Abs(threshold-w) < 1e-9 {
+		return 0, false
+	}
+	return threshold, true
+}
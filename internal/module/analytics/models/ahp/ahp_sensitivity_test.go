@@ -168,3 +168,75 @@ func TestRankingStability_LowGap(t *testing.T) {
 	assert.False(t, stability.IsStable)
 	assert.Contains(t, stability.Recommendation, "sensitive")
 }
+
+func TestAHPModel_AnalyzeSensitivity_CriticalThresholds(t *testing.T) {
+	model := NewAHPModel()
+
+	input := &dto.AHPInput{
+		Criteria: []domain.Criteria{
+			{ID: "c1", Name: "Importance"},
+			{ID: "c2", Name: "Urgency"},
+		},
+	}
+	// A wins on c1, B wins on c2
+	output := &dto.AHPOutput{
+		CriteriaWeights: map[string]float64{"c1": 0.6, "c2": 0.4},
+		LocalPriorities: map[string]map[string]float64{
+			"c1": {"a": 0.8, "b": 0.2},
+			"c2": {"a": 0.3, "b": 0.7},
+		},
+		AlternativePriorities: map[string]float64{"a": 0.6, "b": 0.4},
+		Ranking: []domain.RankItem{
+			{AlternativeID: "a", AlternativeName: "A", Priority: 0.6, Rank: 1},
+			{AlternativeID: "b", AlternativeName: "B", Priority: 0.4, Rank: 2},
+		},
+	}
+
+	result := model.AnalyzeSensitivity(input, output)
+
+	require.Len(t, result.CriticalThresholds, 2)
+	byCriterion := make(map[string]CriticalThreshold)
+	for _, th := range result.CriticalThresholds {
+		byCriterion[th.CriterionID] = th
+	}
+
+	c1 := byCriterion["c1"]
+	assert.Equal(t, "decrease", c1.ChangeDirection)
+	assert.InDelta(t, 0.4, c1.ThresholdWeight, 1e-9)
+	assert.InDelta(t, -0.2, c1.WeightChange, 1e-9)
+	assert.InDelta(t, -1.0/3, c1.RelativeChange, 1e-9)
+	assert.Equal(t, "b", c1.OvertakingID)
+
+	c2 := byCriterion["c2"]
+	assert.Equal(t, "increase", c2.ChangeDirection)
+	assert.InDelta(t, 0.6, c2.ThresholdWeight, 1e-9)
+	assert.Equal(t, "B overtakes A", c2.AffectedRanking)
+
+	assert.InDelta(t, 0.2, result.RankingStability.MinWeightChange, 1e-9)
+}
+
+func TestAHPModel_AnalyzeSensitivity_DominantAlternativeHasNoThreshold(t *testing.T) {
+	model := NewAHPModel()
+
+	input := &dto.AHPInput{
+		Criteria: []domain.Criteria{{ID: "c1"}, {ID: "c2"}},
+	}
+	// A wins on every criterion, so no weight change can flip the ranking
+	output := &dto.AHPOutput{
+		CriteriaWeights: map[string]float64{"c1": 0.5, "c2": 0.5},
+		LocalPriorities: map[string]map[string]float64{
+			"c1": {"a": 0.7, "b": 0.3},
+			"c2": {"a": 0.6, "b": 0.4},
+		},
+		AlternativePriorities: map[string]float64{"a": 0.65, "b": 0.35},
+		Ranking: []domain.RankItem{
+			{AlternativeID: "a", Priority: 0.65, Rank: 1},
+			{AlternativeID: "b", Priority: 0.35, Rank: 2},
+		},
+	}
+
+	result := model.AnalyzeSensitivity(input, output)
+
+	assert.Empty(t, result.CriticalThresholds)
+	assert.Equal(t, 1.0, result.RankingStability.MinWeightChange)
+}
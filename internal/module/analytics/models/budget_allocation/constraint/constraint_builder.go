@@ -2,6 +2,7 @@ package constraint
 
 import (
 	"fmt"
+	"time"
 
 	budgetprofile "personalfinancedss/internal/module/cashflow/budget_profile/domain"
 	debtdomain "personalfinancedss/internal/module/cashflow/debt/domain"
@@ -77,6 +78,13 @@ func (cb *ConstraintBuilder) BuildConstraints(
 			// Calculate suggested contribution if not set
 			suggestedContribution := cb.calculateGoalContribution(goal)
 
+			// Sinking funds must put aside their monthly amount to meet the yearly expense
+			var fixedContribution float64
+			if goal.IsSinkingFund() {
+				fixedContribution = goal.MonthlySetAside(time.Now())
+				suggestedContribution = fixedContribution
+			}
+
 			model.GoalTargets[goal.ID] = domain.GoalConstraint{
 				GoalID:                goal.ID,
 				GoalName:              goal.Name,
@@ -85,6 +93,7 @@ func (cb *ConstraintBuilder) BuildConstraints(
 				Priority:              string(goal.Priority),
 				PriorityWeight:        cb.goalPriorityToWeight(goal.Priority),
 				RemainingAmount:       goal.RemainingAmount,
+				FixedContribution:     fixedContribution,
 			}
 		}
 	}
@@ -92,7 +101,7 @@ func (cb *ConstraintBuilder) BuildConstraints(
 	return model, nil
 }
 
-// CheckFeasibility checks if the allocation is feasible (income >= mandatory expenses + debt minimums)
+// CheckFeasibility checks if the allocation is feasible (income >= mandatory expenses + debt minimums + fixed goal contributions)
 func (cb *ConstraintBuilder) CheckFeasibility(model *domain.ConstraintModel) (bool, float64) {
 	var totalMandatory float64
 
@@ -106,13 +115,16 @@ func (cb *ConstraintBuilder) CheckFeasibility(model *domain.ConstraintModel) (bo
 		totalMandatory += debt.MinimumPayment
 	}
 
+	// Sum goal contributions reserved as hard constraints
+	totalMandatory += model.TotalFixedGoalContributions()
+
 	deficit := totalMandatory - model.TotalIncome
 	isFeasible := model.TotalIncome >= totalMandatory
 
 	return isFeasible, deficit
 }
 
-// CalculateSurplus calculates available income after mandatory expenses and minimum debt payments
+// CalculateSurplus calculates available income after mandatory expenses, minimum debt payments and fixed goal contributions
 func (cb *ConstraintBuilder) CalculateSurplus(model *domain.ConstraintModel) float64 {
 	var totalMandatory float64
 
@@ -126,6 +138,9 @@ func (cb *ConstraintBuilder) CalculateSurplus(model *domain.ConstraintModel) flo
 		totalMandatory += debt.MinimumPayment
 	}
 
+	// Sum goal contributions reserved as hard constraints
+	totalMandatory += model.TotalFixedGoalContributions()
+
 	surplus := model.TotalIncome - totalMandatory
 	if surplus < 0 {
 		surplus = 0
@@ -120,6 +120,41 @@ func TestConstraintBuilder_BuildConstraints(t *testing.T) {
 	}
 }
 
+func TestConstraintBuilder_BuildConstraints_SinkingFund(t *testing.T) {
+	builder := NewConstraintBuilder()
+
+	dueDate := time.Now().AddDate(0, 10, 15)
+	goal := &goaldomain.Goal{
+		ID:            uuid.New(),
+		Name:          "Car registration",
+		Behavior:      goaldomain.GoalBehaviorRecurring,
+		SinkingFund:   true,
+		Category:      goaldomain.GoalCategoryOther,
+		Priority:      goaldomain.GoalPriorityLow,
+		Status:        goaldomain.GoalStatusActive,
+		TargetAmount:  12000000,
+		CurrentAmount: 2000000,
+		TargetDate:    &dueDate,
+	}
+	goal.UpdateCalculatedFields()
+
+	model, err := builder.BuildConstraints(30000000, nil, []*goaldomain.Goal{goal}, nil)
+	if err != nil {
+		t.Fatalf("BuildConstraints failed: %v", err)
+	}
+
+	constraint := model.GoalTargets[goal.ID]
+	if constraint.FixedContribution != 1000000 {
+		t.Errorf("Expected fixed contribution 1000000, got %f", constraint.FixedContribution)
+	}
+	if constraint.SuggestedContribution != constraint.FixedContribution {
+		t.Errorf("Expected suggested contribution to match the set-aside, got %f", constraint.SuggestedContribution)
+	}
+	if surplus := builder.CalculateSurplus(model); surplus != 29000000 {
+		t.Errorf("Expected surplus 29000000, got %f", surplus)
+	}
+}
+
 func TestConstraintBuilder_CheckFeasibility(t *testing.T) {
 	builder := NewConstraintBuilder()
 
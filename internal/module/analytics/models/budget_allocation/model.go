@@ -215,6 +215,7 @@ func (m *BudgetAllocationModel) buildConstraintModel(input *dto.BudgetAllocation
 			Priority:              goal.Priority,
 			PriorityWeight:        m.goalPriorityToWeight(goal.Priority),
 			RemainingAmount:       goal.RemainingAmount,
+			FixedContribution:     goal.FixedContribution,
 		}
 	}
 
@@ -511,7 +512,7 @@ func (m *BudgetAllocationModel) generateSensitivitySummary(
 		KeyRecommendations: make([]string, 0),
 	}
 
-	// Find income break-even point (total mandatory expenses + minimum debt payments)
+	// Find income break-even point (total mandatory expenses + minimum debt payments + fixed goal contributions)
 	var totalMandatory float64
 	for _, cat := range constraintModel.MandatoryExpenses {
 		totalMandatory += cat.Minimum
@@ -519,6 +520,7 @@ func (m *BudgetAllocationModel) generateSensitivitySummary(
 	for _, debt := range constraintModel.DebtPayments {
 		totalMandatory += debt.MinimumPayment
 	}
+	totalMandatory += constraintModel.TotalFixedGoalContributions()
 	summary.IncomeBreakEvenPoint = totalMandatory
 
 	// Check if sensitive to income
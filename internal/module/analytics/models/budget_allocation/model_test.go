@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"personalfinancedss/internal/module/analytics/budget_allocation/domain"
 	"personalfinancedss/internal/module/analytics/budget_allocation/dto"
 
 	"github.com/google/uuid"
@@ -184,6 +185,121 @@ func TestBudgetAllocationModel_Execute_AllScenarios(t *testing.T) {
 	assert.True(t, scenarioTypes["balanced"])
 }
 
+func TestBudgetAllocationModel_Execute_FixedGoalContribution(t *testing.T) {
+	model := NewBudgetAllocationModel()
+	ctx := context.Background()
+
+	sinkingFundID := uuid.New()
+	input := &dto.BudgetAllocationModelInput{
+		UserID:          uuid.New(),
+		Year:            2024,
+		Month:           12,
+		TotalIncome:     20000000,
+		UseAllScenarios: true,
+		MandatoryExpenses: []dto.MandatoryExpense{
+			{CategoryID: uuid.New(), Name: "Rent", Amount: 8000000, Priority: 1},
+		},
+		FlexibleExpenses: []dto.FlexibleExpense{
+			{CategoryID: uuid.New(), Name: "Food", MinAmount: 3000000, MaxAmount: 6000000, Priority: 3},
+		},
+		Goals: []dto.GoalInput{
+			{GoalID: uuid.New(), Name: "Vacation", Type: "travel", Priority: "high", RemainingAmount: 30000000, SuggestedContribution: 5000000},
+			{GoalID: sinkingFundID, Name: "Tet", Type: "other", Priority: "low", RemainingAmount: 10000000, SuggestedContribution: 2500000, FixedContribution: 2500000},
+		},
+	}
+
+	result, err := model.Execute(ctx, input)
+	require.NoError(t, err)
+
+	output := result.(*dto.BudgetAllocationModelOutput)
+	require.NotEmpty(t, output.Scenarios)
+	for _, scenario := range output.Scenarios {
+		found := false
+		for _, ga := range scenario.GoalAllocations {
+			if ga.GoalID == sinkingFundID {
+				found = true
+				assert.GreaterOrEqual(t, ga.Amount, 2500000.0, "scenario %s", scenario.ScenarioType)
+			}
+		}
+		assert.True(t, found, "scenario %s", scenario.ScenarioType)
+	}
+}
+
+func TestBudgetAllocationModel_Execute_FixedGoalContributionExceedsIncome(t *testing.T) {
+	model := NewBudgetAllocationModel()
+	ctx := context.Background()
+
+	sinkingFundID := uuid.New()
+	input := &dto.BudgetAllocationModelInput{
+		UserID:          uuid.New(),
+		Year:            2024,
+		Month:           12,
+		TotalIncome:     10000000,
+		UseAllScenarios: true,
+		MandatoryExpenses: []dto.MandatoryExpense{
+			{CategoryID: uuid.New(), Name: "Rent", Amount: 8000000, Priority: 1},
+		},
+		Goals: []dto.GoalInput{
+			{GoalID: sinkingFundID, Name: "Tet", Type: "other", Priority: "low", RemainingAmount: 16000000, SuggestedContribution: 4000000, FixedContribution: 4000000},
+		},
+	}
+
+	result, err := model.Execute(ctx, input)
+	require.NoError(t, err)
+
+	output := result.(*dto.BudgetAllocationModelOutput)
+	require.NotEmpty(t, output.Scenarios)
+	for _, scenario := range output.Scenarios {
+		for _, ga := range scenario.GoalAllocations {
+			if ga.GoalID == sinkingFundID {
+				assert.Equal(t, 2000000.0, ga.Amount, "scenario %s reserves only the income left", scenario.ScenarioType)
+			}
+		}
+		assert.True(t, hasWarning(scenario.Warnings, "goal"), "scenario %s", scenario.ScenarioType)
+	}
+}
+
+func TestBudgetAllocationModel_Execute_NoIncomeLeftForFixedGoalContribution(t *testing.T) {
+	model := NewBudgetAllocationModel()
+	ctx := context.Background()
+
+	sinkingFundID := uuid.New()
+	input := &dto.BudgetAllocationModelInput{
+		UserID:      uuid.New(),
+		Year:        2024,
+		Month:       12,
+		TotalIncome: 5000000,
+		MandatoryExpenses: []dto.MandatoryExpense{
+			{CategoryID: uuid.New(), Name: "Rent", Amount: 8000000, Priority: 1},
+		},
+		Goals: []dto.GoalInput{
+			{GoalID: sinkingFundID, Name: "Tet", Type: "other", Priority: "low", RemainingAmount: 12000000, SuggestedContribution: 1000000, FixedContribution: 1000000},
+		},
+	}
+
+	result, err := model.Execute(ctx, input)
+	require.NoError(t, err)
+
+	output := result.(*dto.BudgetAllocationModelOutput)
+	assert.False(t, output.IsFeasible)
+	require.Len(t, output.Scenarios, 1)
+	scenario := output.Scenarios[0]
+	assert.Equal(t, 0.0, scenario.FeasibilityScore)
+	assert.True(t, hasWarning(scenario.Warnings, "income"))
+	for _, ga := range scenario.GoalAllocations {
+		assert.Zero(t, ga.Amount)
+	}
+}
+
+func hasWarning(warnings []domain.AllocationWarning, category string) bool {
+	for _, warning := range warnings {
+		if warning.Category == category {
+			return true
+		}
+	}
+	return false
+}
+
 func TestBudgetAllocationModel_Execute_InfeasibleBudget(t *testing.T) {
 	model := NewBudgetAllocationModel()
 	ctx := context.Background()
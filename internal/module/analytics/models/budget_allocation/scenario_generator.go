@@ -181,6 +181,7 @@ func (sg *ScenarioGenerator) buildScenario(
 
 	scenario := domain.NewAllocationScenario(scenarioType)
 	scenario.FeasibilityScore = result.FeasibilityScore
+	scenario.Warnings = append(scenario.Warnings, result.Warnings...)
 
 	// Build goal allocations
 	for goalID, amount := range result.GoalAllocations {
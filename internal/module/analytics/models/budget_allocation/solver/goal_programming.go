@@ -14,6 +14,9 @@ import (
 type GoalProgrammingSolver struct {
 	constraintModel *domain.ConstraintModel
 	params          domain.ScenarioParameters
+
+	// fixedReservations holds the fixed goal contributions actually reserved by the last solve
+	fixedReservations map[uuid.UUID]float64
 }
 
 // NewGoalProgrammingSolver creates a new solver
@@ -60,13 +63,31 @@ func (gp *GoalProgrammingSolver) SolveFuzzy() (*domain.AllocationResult, error)
 		totalDebtAllocated += payment.TotalPayment
 	}
 
-	// 3. Tạo constraint model mới KHÔNG có mandatory expenses và debts (chỉ flexible expenses và goals)
+	// 3. Fixed goal contributions (sinking funds) are hard constraints too - reserved outside the LP
+	// from the income left after mandatory expenses and debts
+	available := gp.constraintModel.TotalIncome - totalMandatoryAllocated - totalDebtAllocated
+	totalFixedGoalAllocated := gp.allocateFixedGoalContributions(result, available)
+	if available < 0 {
+		// Nothing is left for the LP to allocate
+		gp.addIncomeShortfallWarning(result, -available)
+		result.TotalAllocated = totalMandatoryAllocated + totalDebtAllocated
+		result.Surplus = available
+		return result, nil
+	}
+	goalsForLP := make(map[uuid.UUID]domain.GoalConstraint, len(gp.constraintModel.GoalTargets))
+	for goalID, goal := range gp.constraintModel.GoalTargets {
+		if goal.FixedContribution <= 0 {
+			goalsForLP[goalID] = goal
+		}
+	}
+
+	// 4. Tạo constraint model mới KHÔNG có mandatory expenses và debts (chỉ flexible expenses và goals)
 	modelForLP := &domain.ConstraintModel{
-		TotalIncome:       gp.constraintModel.TotalIncome - totalMandatoryAllocated - totalDebtAllocated,
+		TotalIncome:       gp.constraintModel.TotalIncome - totalMandatoryAllocated - totalDebtAllocated - totalFixedGoalAllocated,
 		MandatoryExpenses: make(map[uuid.UUID]domain.CategoryConstraint), // Empty - không đưa vào LP
 		FlexibleExpenses:  gp.constraintModel.FlexibleExpenses,
 		DebtPayments:      make(map[uuid.UUID]domain.DebtConstraint), // Empty - không đưa vào LP
-		GoalTargets:       gp.constraintModel.GoalTargets,
+		GoalTargets:       goalsForLP,
 	}
 
 	fuzzySolver := fuzzy.BuildFuzzyGPFromConstraintModel(modelForLP, gp.params)
@@ -78,6 +99,16 @@ func (gp *GoalProgrammingSolver) SolveFuzzy() (*domain.AllocationResult, error)
 	}
 
 	gp.mapFuzzyResultWithoutDebts(result, fuzzyResult)
+	for goalID, goal := range gp.constraintModel.GoalTargets {
+		if goal.FixedContribution <= 0 {
+			continue
+		}
+		if gp.fixedReservations[goalID] >= goal.FixedContribution {
+			result.AchievedGoals = append(result.AchievedGoals, goal.GoalName)
+		} else {
+			result.UnachievedGoals = append(result.UnachievedGoals, goal.GoalName)
+		}
+	}
 
 	// Làm tròn các allocations
 	gp.roundAllocations(result)
@@ -137,8 +168,11 @@ func (gp *GoalProgrammingSolver) mapFuzzyResultWithoutDebts(result *domain.Alloc
 
 	// Debt KHÔNG lấy từ LP - đã được tính bằng heuristic
 
-	// Map goals từ LP
-	for id := range gp.constraintModel.GoalTargets {
+	// Map goals từ LP (fixed goal contributions đã được tính bằng heuristic)
+	for id, goal := range gp.constraintModel.GoalTargets {
+		if goal.FixedContribution > 0 {
+			continue
+		}
 		if val, exists := fuzzyResult.VariableValues[id]; exists {
 			result.GoalAllocations[id] = val
 			totalAllocated += val
@@ -251,9 +285,12 @@ func (gp *GoalProgrammingSolver) solveHeuristic() (*domain.AllocationResult, err
 		remainingIncome -= paymentAmount
 	}
 
+	// Phase 2b: Reserve fixed goal contributions (sinking funds) - hard constraints like debts
+	remainingIncome -= gp.allocateFixedGoalContributions(result, remainingIncome)
+
 	// Check if we've gone negative (infeasible)
 	if remainingIncome < 0 {
-		result.FeasibilityScore = 0
+		gp.addIncomeShortfallWarning(result, -remainingIncome)
 		result.Surplus = remainingIncome
 		return result, nil
 	}
@@ -267,6 +304,64 @@ func (gp *GoalProgrammingSolver) solveHeuristic() (*domain.AllocationResult, err
 	return result, nil
 }
 
+// allocateFixedGoalContributions reserves the fixed goal contributions out of the available income
+// and returns their total. When they do not all fit, every contribution is scaled down by the same
+// factor and a warning is added to the result.
+func (gp *GoalProgrammingSolver) allocateFixedGoalContributions(result *domain.AllocationResult, available float64) float64 {
+	gp.fixedReservations = make(map[uuid.UUID]float64)
+	requested := gp.constraintModel.TotalFixedGoalContributions()
+	if requested <= 0 {
+		return 0
+	}
+
+	scale := 1.0
+	if requested > available {
+		scale = max(available, 0) / requested
+		if available > 0 {
+			// A shortfall that leaves nothing at all is reported by addIncomeShortfallWarning
+			result.Warnings = append(result.Warnings, domain.AllocationWarning{
+				Severity: domain.SeverityCritical,
+				Category: "goal",
+				Message: fmt.Sprintf("Income left after mandatory expenses and debt payments covers only %.0f%% of the fixed goal contributions",
+					scale*100),
+				Suggestions: []string{
+					"Lower or pause a sinking fund contribution",
+					"Reduce mandatory expenses or increase income",
+				},
+			})
+		}
+	}
+
+	total := 0.0
+	for goalID, goal := range gp.constraintModel.GoalTargets {
+		if goal.FixedContribution > 0 {
+			amount := goal.FixedContribution * scale
+			result.GoalAllocations[goalID] = amount
+			gp.fixedReservations[goalID] = amount
+			total += amount
+		}
+	}
+	return total
+}
+
+// fixedGoalContribution returns the reserved contribution a goal must keep when allocations are reduced
+func (gp *GoalProgrammingSolver) fixedGoalContribution(goalID uuid.UUID) float64 {
+	if amount, ok := gp.fixedReservations[goalID]; ok {
+		return amount
+	}
+	return gp.constraintModel.GoalTargets[goalID].FixedContribution
+}
+
+// addIncomeShortfallWarning marks the result infeasible when mandatory expenses and debt payments alone exceed income
+func (gp *GoalProgrammingSolver) addIncomeShortfallWarning(result *domain.AllocationResult, shortfall float64) {
+	result.FeasibilityScore = 0
+	result.Warnings = append(result.Warnings, domain.AllocationWarning{
+		Severity: domain.SeverityCritical,
+		Category: "income",
+		Message:  fmt.Sprintf("Mandatory expenses and debt payments exceed income by %.0f, leaving nothing for goals or flexible spending", shortfall),
+	})
+}
+
 // allocateSurplusHeuristic distributes surplus income according to scenario strategy
 func (gp *GoalProgrammingSolver) allocateSurplusHeuristic(result *domain.AllocationResult, surplus float64) {
 	if surplus <= 0 {
@@ -367,7 +462,8 @@ func (gp *GoalProgrammingSolver) allocateToGoals(result *domain.AllocationResult
 
 	var sortedGoals []goalWithPriority
 	for goalID, goal := range gp.constraintModel.GoalTargets {
-		if goal.GoalType == "emergency" {
+		// Emergency funds are handled separately; fixed contributions are already reserved
+		if goal.GoalType == "emergency" || goal.FixedContribution > 0 {
 			continue
 		}
 		sortedGoals = append(sortedGoals, goalWithPriority{goalID, goal})
@@ -556,7 +652,7 @@ func (gp *GoalProgrammingSolver) normalizeSurplus(result *domain.AllocationResul
 						break
 					}
 					if amount > 0 {
-						reduction := min(amount, -finalSurplus)
+						reduction := min(amount-gp.fixedGoalContribution(goalID), -finalSurplus)
 						if reduction > 0 {
 							result.GoalAllocations[goalID] = amount - reduction
 							totalAllocated -= reduction
@@ -686,7 +782,7 @@ func (gp *GoalProgrammingSolver) reduceAllocations(result *domain.AllocationResu
 	goalsWithExtra := make([]goalExtra, 0)
 	for goalID, amount := range result.GoalAllocations {
 		if constraint, ok := gp.constraintModel.GoalTargets[goalID]; ok && amount > 0 {
-			extra := amount - constraint.SuggestedContribution
+			extra := amount - math.Max(constraint.SuggestedContribution, constraint.FixedContribution)
 			if extra < 0 {
 				extra = 0
 			}
@@ -712,9 +808,10 @@ func (gp *GoalProgrammingSolver) reduceAllocations(result *domain.AllocationResu
 			continue
 		}
 		constraint := gp.constraintModel.GoalTargets[g.goalID]
+		floor := math.Max(constraint.SuggestedContribution, constraint.FixedContribution)
 		newAmount := result.GoalAllocations[g.goalID] - reduction
-		if newAmount < constraint.SuggestedContribution {
-			newAmount = constraint.SuggestedContribution
+		if newAmount < floor {
+			newAmount = floor
 			reduction = result.GoalAllocations[g.goalID] - newAmount
 		}
 		result.GoalAllocations[g.goalID] = newAmount
@@ -754,14 +851,14 @@ func (gp *GoalProgrammingSolver) reduceAllocations(result *domain.AllocationResu
 		remainingDeficit -= reduction
 	}
 
-	// Bước 3: Giảm goals còn lại (xuống dưới suggested), ưu tiên amount nhiều nhất trước
+	// Bước 3: Giảm goals còn lại (xuống dưới suggested, không dưới fixed contribution), ưu tiên amount nhiều nhất trước
 	if remainingDeficit <= 0 {
 		return
 	}
 	goalsRemain := make([]goalExtra, 0)
 	for goalID, amount := range result.GoalAllocations {
-		if _, ok := gp.constraintModel.GoalTargets[goalID]; ok && amount > 0 {
-			goalsRemain = append(goalsRemain, goalExtra{goalID, amount, amount})
+		if constraint, ok := gp.constraintModel.GoalTargets[goalID]; ok && amount > constraint.FixedContribution {
+			goalsRemain = append(goalsRemain, goalExtra{goalID, amount, amount - constraint.FixedContribution})
 		}
 	}
 	for i := 0; i < len(goalsRemain)-1; i++ {
@@ -775,7 +872,7 @@ func (gp *GoalProgrammingSolver) reduceAllocations(result *domain.AllocationResu
 		if remainingDeficit <= 0 {
 			break
 		}
-		reduction := min(g.amount, remainingDeficit)
+		reduction := min(g.extra, remainingDeficit)
 		if reduction > 0 {
 			result.GoalAllocations[g.goalID] = g.amount - reduction
 			remainingDeficit -= reduction
@@ -893,7 +990,12 @@ func (gp *GoalProgrammingSolver) roundAllocations(result *domain.AllocationResul
 	}
 
 	// Làm tròn goal allocations đến hàng 100,000
+	// Fixed goal contributions làm tròn lên để vẫn đủ hard constraint
 	for goalID, amount := range result.GoalAllocations {
+		if fixed := gp.fixedGoalContribution(goalID); fixed > 0 && amount < math.Ceil(fixed/100000)*100000 {
+			result.GoalAllocations[goalID] = math.Ceil(amount/100000) * 100000
+			continue
+		}
 		result.GoalAllocations[goalID] = math.Round(amount/100000) * 100000
 	}
 
@@ -944,7 +1046,7 @@ func (gp *GoalProgrammingSolver) reduceSurplusByDeducting(result *domain.Allocat
 				typ:      "goal",
 				id:       goalID,
 				amount:   amount,
-				minValue: 0, // Goals có thể về 0
+				minValue: gp.fixedGoalContribution(goalID), // Goals có thể về 0, trừ fixed contribution
 			})
 		}
 	}
@@ -25,9 +25,8 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 	option domain.RefinanceOption,
 	currentExtraPayment float64,
 ) *domain.RefinanceAnalysis {
-	// Calculate current weighted average rate
+	// Collect the debts to consolidate
 	totalBalance := 0.0
-	weightedRateSum := 0.0
 	debtsToConsolidate := make([]domain.DebtInfo, 0)
 
 	for _, d := range debts {
@@ -41,7 +40,6 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 		if include {
 			debtsToConsolidate = append(debtsToConsolidate, d)
 			totalBalance += d.Balance
-			weightedRateSum += d.Balance * d.InterestRate
 		}
 	}
 
@@ -52,8 +50,6 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 		}
 	}
 
-	currentWeightedRate := weightedRateSum / totalBalance
-
 	// Simulate current scenario
 	currentResult := a.simulator.SimulateStrategy(
 		domain.StrategyAvalanche,
@@ -62,6 +58,17 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 		nil,
 	)
 
+	// Weighted average rate, each debt's rate averaged over the months until it is paid off
+	weightedRateSum := 0.0
+	for _, d := range debtsToConsolidate {
+		months := currentResult.Months
+		if timeline := currentResult.DebtTimelines[d.ID]; timeline != nil && timeline.PayoffMonth > 0 {
+			months = timeline.PayoffMonth
+		}
+		weightedRateSum += d.Balance * d.AverageRate(months)
+	}
+	currentWeightedRate := weightedRateSum / totalBalance
+
 	// Calculate refinanced scenario
 	consolidatedDebt := domain.DebtInfo{
 		ID:             "consolidated",
@@ -69,6 +76,7 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 		Balance:        totalBalance + option.OriginationFee,
 		InterestRate:   option.NewRate,
 		MinimumPayment: (totalBalance + option.OriginationFee) / float64(option.NewTerm),
+		RateSchedule:   option.RateSchedule,
 	}
 
 	refinanceResult := a.simulator.SimulateStrategy(
@@ -78,6 +86,9 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 		nil,
 	)
 
+	// Effective rate of the new loan, averaged over its payoff period
+	newEffectiveRate := consolidatedDebt.AverageRate(refinanceResult.Months)
+
 	// Calculate total fees
 	totalFees := option.OriginationFee + (option.MonthlyFee * float64(refinanceResult.Months))
 
@@ -93,9 +104,15 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 
 	// Warnings
 	warnings := make([]string, 0)
-	if option.NewRate >= currentWeightedRate {
+	if newEffectiveRate >= currentWeightedRate {
 		warnings = append(warnings, "New rate is not lower than current weighted average")
 	}
+	if month, rate := promotionEnd(consolidatedDebt, refinanceResult.Months); month > 0 && rate >= currentWeightedRate {
+		warnings = append(warnings, fmt.Sprintf("Promotional rate ends in month %d; the rate then rises to %.2f%%, above your current weighted average", month, rate*100))
+	}
+	if consolidatedDebt.HasFloatingRate() {
+		warnings = append(warnings, "The new loan floats with a reference rate; payments rise if rates go up")
+	}
 	if breakEvenMonths > 24 {
 		warnings = append(warnings, "Break-even period is over 2 years")
 	}
@@ -106,7 +123,7 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 	// Recommendation
 	recommendation := ""
 	shouldRefinance := false
-	if netSavings > 500 && breakEvenMonths < 24 && option.NewRate < currentWeightedRate {
+	if netSavings > 500 && breakEvenMonths < 24 && newEffectiveRate < currentWeightedRate {
 		shouldRefinance = true
 		recommendation = fmt.Sprintf("Refinancing recommended. You'll save $%.2f over the life of the loan with break-even at month %d.", netSavings, breakEvenMonths)
 	} else if netSavings > 0 {
@@ -118,7 +135,7 @@ func (a *DebtAnalyzer) AnalyzeRefinancing(
 	return &domain.RefinanceAnalysis{
 		ShouldRefinance:        shouldRefinance,
 		CurrentWeightedRate:    currentWeightedRate,
-		NewEffectiveRate:       option.NewRate,
+		NewEffectiveRate:       newEffectiveRate,
 		CurrentTotalInterest:   currentResult.TotalInterest,
 		RefinanceTotalInterest: refinanceResult.TotalInterest,
 		TotalFees:              totalFees,
@@ -227,8 +244,8 @@ func (a *DebtAnalyzer) AnalyzeSensitivity(
 	scenarios := []domain.SensitivityScenario{
 		{Type: "income_decrease", Percentage: 0.10, Description: "10% income decrease"},
 		{Type: "income_decrease", Percentage: 0.20, Description: "20% income decrease"},
-		{Type: "rate_increase", Percentage: 0.02, Description: "2% rate increase (variable debts)"},
-		{Type: "rate_increase", Percentage: 0.05, Description: "5% rate increase (variable debts)"},
+		{Type: "rate_increase", Percentage: 0.02, Description: "2% rate increase (variable and floating debts)"},
+		{Type: "rate_increase", Percentage: 0.05, Description: "5% rate increase (variable and floating debts)"},
 	}
 
 	for _, scenario := range scenarios {
@@ -247,9 +264,7 @@ func (a *DebtAnalyzer) AnalyzeSensitivity(
 
 		case "rate_increase":
 			for i := range modifiedDebts {
-				if modifiedDebts[i].IsVariableRate {
-					modifiedDebts[i].InterestRate += scenario.Percentage
-				}
+				modifiedDebts[i] = modifiedDebts[i].WithRateShock(scenario.Percentage)
 			}
 			adjustedResult = a.simulator.SimulateStrategy(strategy, modifiedDebts, currentExtra, hybridWeights)
 		}
@@ -258,54 +273,106 @@ func (a *DebtAnalyzer) AnalyzeSensitivity(
 			continue
 		}
 
-		monthsImpact := adjustedResult.Months - baseline.Months
-		interestImpact := adjustedResult.TotalInterest - baseline.TotalInterest
+		results = append(results, a.sensitivityResult(scenario, strategy, baseline, adjustedResult))
+	}
 
-		// Determine risk level
-		riskLevel := "low"
-		if monthsImpact > 12 || interestImpact > 1000 {
-			riskLevel = "high"
-		} else if monthsImpact > 6 || interestImpact > 500 {
-			riskLevel = "medium"
-		}
+	return results
+}
 
-		// Check if strategy is still valid
-		strategyStillValid := true
-		newRecommendation := strategy
-		if scenario.Type == "income_decrease" && scenario.Percentage >= 0.20 {
-			// With significant income decrease, might need to switch to cash flow strategy
-			if strategy != domain.StrategyCashFlow {
-				strategyStillValid = false
-				newRecommendation = domain.StrategyCashFlow
-			}
-		}
+// AnalyzeRateShock compares the payoff with the same payoff after the reference rates of the
+// floating debts rise by shock (decimal, e.g. 0.02 = +2 percentage points)
+func (a *DebtAnalyzer) AnalyzeRateShock(
+	debts []domain.DebtInfo,
+	currentBudget float64,
+	strategy domain.Strategy,
+	hybridWeights *domain.HybridWeights,
+	shock float64,
+) *domain.SensitivityResult {
+	currentExtra := currentBudget - sumMinPayments(debts)
+	baseline := a.simulator.SimulateStrategy(strategy, debts, currentExtra, hybridWeights)
+
+	shocked := make([]domain.DebtInfo, len(debts))
+	for i, d := range debts {
+		shocked[i] = d.WithRateShock(shock)
+	}
+	adjusted := a.simulator.SimulateStrategy(strategy, shocked, currentExtra, hybridWeights)
+
+	scenario := domain.SensitivityScenario{
+		Type:        "rate_shock",
+		Percentage:  shock,
+		Description: fmt.Sprintf("+%.1f%% reference-rate shock (floating debts)", shock*100),
+	}
+	result := a.sensitivityResult(scenario, strategy, baseline, adjusted)
+	return &result
+}
+
+// sensitivityResult rates the impact of a scenario against the baseline payoff
+func (a *DebtAnalyzer) sensitivityResult(
+	scenario domain.SensitivityScenario,
+	strategy domain.Strategy,
+	baseline *domain.SimulationResult,
+	adjustedResult *domain.SimulationResult,
+) domain.SensitivityResult {
+	monthsImpact := adjustedResult.Months - baseline.Months
+	interestImpact := adjustedResult.TotalInterest - baseline.TotalInterest
+
+	// Determine risk level
+	riskLevel := "low"
+	if monthsImpact > 12 || interestImpact > 1000 {
+		riskLevel = "high"
+	} else if monthsImpact > 6 || interestImpact > 500 {
+		riskLevel = "medium"
+	}
 
-		advice := ""
-		switch riskLevel {
-		case "high":
-			advice = "Build emergency fund buffer. Consider reducing discretionary spending."
-		case "medium":
-			advice = "Monitor situation closely. Have contingency plan ready."
-		case "low":
-			advice = "Current strategy remains robust under this scenario."
+	// Check if strategy is still valid
+	strategyStillValid := true
+	newRecommendation := strategy
+	if scenario.Type == "income_decrease" && scenario.Percentage >= 0.20 {
+		// With significant income decrease, might need to switch to cash flow strategy
+		if strategy != domain.StrategyCashFlow {
+			strategyStillValid = false
+			newRecommendation = domain.StrategyCashFlow
 		}
+	}
 
-		results = append(results, domain.SensitivityResult{
-			Scenario:           scenario,
-			BaselineMonths:     baseline.Months,
-			AdjustedMonths:     adjustedResult.Months,
-			MonthsImpact:       monthsImpact,
-			BaselineInterest:   baseline.TotalInterest,
-			AdjustedInterest:   adjustedResult.TotalInterest,
-			InterestImpact:     interestImpact,
-			StrategyStillValid: strategyStillValid,
-			NewRecommendation:  newRecommendation,
-			RiskLevel:          riskLevel,
-			Advice:             advice,
-		})
+	advice := ""
+	switch riskLevel {
+	case "high":
+		advice = "Build emergency fund buffer. Consider reducing discretionary spending."
+	case "medium":
+		advice = "Monitor situation closely. Have contingency plan ready."
+	case "low":
+		advice = "Current strategy remains robust under this scenario."
 	}
 
-	return results
+	return domain.SensitivityResult{
+		Scenario:           scenario,
+		BaselineMonths:     baseline.Months,
+		AdjustedMonths:     adjustedResult.Months,
+		MonthsImpact:       monthsImpact,
+		BaselineInterest:   baseline.TotalInterest,
+		AdjustedInterest:   adjustedResult.TotalInterest,
+		InterestImpact:     interestImpact,
+		StrategyStillValid: strategyStillValid,
+		NewRecommendation:  newRecommendation,
+		RiskLevel:          riskLevel,
+		Advice:             advice,
+	}
+}
+
+// promotionEnd returns the first month within months whose rate is above the opening rate of the
+// debt's schedule, with that rate, or zero when the rate never rises
+func promotionEnd(debt domain.DebtInfo, months int) (int, float64) {
+	if len(debt.RateSchedule) == 0 {
+		return 0, 0
+	}
+	opening := debt.RateAt(1)
+	for month := 2; month <= months; month++ {
+		if rate := debt.RateAt(month); rate > opening {
+			return month, rate
+		}
+	}
+	return 0, 0
 }
 
 // CalculatePsychologicalScore calculates gamification metrics
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"personalfinancedss/internal/module/analytics/debt_strategy/domain"
+	"strings"
 	"testing"
 )
 
@@ -164,6 +165,82 @@ func TestDebtAnalyzer_AnalyzeSensitivity(t *testing.T) {
 	}
 }
 
+func TestDebtAnalyzer_AnalyzeRefinancing_PromotionalRate(t *testing.T) {
+	analyzer := NewDebtAnalyzer()
+
+	debts := []domain.DebtInfo{
+		{ID: "cc", Name: "Credit Card", Balance: 8000, InterestRate: 0.15, MinimumPayment: 240},
+	}
+
+	// 5% teaser for six months, then floating at 20%
+	option := domain.RefinanceOption{
+		NewRate: 0.05,
+		NewTerm: 36,
+		RateSchedule: []domain.RatePeriod{
+			{FromMonth: 1, Rate: 0.05},
+			{FromMonth: 7, Rate: 0.20, Floating: true},
+		},
+	}
+
+	result := analyzer.AnalyzeRefinancing(debts, option, 0)
+
+	if result.NewEffectiveRate <= option.NewRate {
+		t.Errorf("Expected effective rate above the teaser rate, got %.4f", result.NewEffectiveRate)
+	}
+	if result.ShouldRefinance {
+		t.Error("Should not recommend a teaser that resets above the current rate")
+	}
+
+	hasPromoWarning := false
+	for _, w := range result.Warnings {
+		if strings.Contains(w, "Promotional rate ends in month 7") {
+			hasPromoWarning = true
+		}
+	}
+	if !hasPromoWarning {
+		t.Errorf("Expected promotional rate warning, got %v", result.Warnings)
+	}
+}
+
+func TestDebtAnalyzer_AnalyzeRateShock(t *testing.T) {
+	analyzer := NewDebtAnalyzer()
+
+	cap := 0.13
+	debts := []domain.DebtInfo{
+		{ID: "home", Name: "Home Loan", Balance: 20000, InterestRate: 0.08, MinimumPayment: 400,
+			RateSchedule: []domain.RatePeriod{
+				{FromMonth: 1, Rate: 0.08},
+				{FromMonth: 13, Rate: 0.12, Floating: true, RateCap: &cap},
+			}},
+		{ID: "car", Name: "Car Loan", Balance: 5000, InterestRate: 0.06, MinimumPayment: 200},
+	}
+
+	result := analyzer.AnalyzeRateShock(debts, 700, domain.StrategyAvalanche, nil, 0.02)
+
+	if result.Scenario.Type != "rate_shock" {
+		t.Errorf("Expected rate_shock scenario, got %s", result.Scenario.Type)
+	}
+	if result.InterestImpact <= 0 {
+		t.Errorf("Expected more interest after the shock, got %.2f", result.InterestImpact)
+	}
+
+	// The shock is capped at 13%, so it costs less than an uncapped +2%
+	uncapped := []domain.DebtInfo{debts[0], debts[1]}
+	uncapped[0].RateSchedule = []domain.RatePeriod{
+		{FromMonth: 1, Rate: 0.08},
+		{FromMonth: 13, Rate: 0.12, Floating: true},
+	}
+	full := analyzer.AnalyzeRateShock(uncapped, 700, domain.StrategyAvalanche, nil, 0.02)
+	if result.InterestImpact >= full.InterestImpact {
+		t.Errorf("Expected capped impact %.2f below uncapped %.2f", result.InterestImpact, full.InterestImpact)
+	}
+
+	fixedOnly := analyzer.AnalyzeRateShock(debts[1:], 700, domain.StrategyAvalanche, nil, 0.02)
+	if fixedOnly.InterestImpact != 0 {
+		t.Errorf("Expected no impact on fixed-rate debts, got %.2f", fixedOnly.InterestImpact)
+	}
+}
+
 func TestDebtAnalyzer_CalculatePsychologicalScore(t *testing.T) {
 	analyzer := NewDebtAnalyzer()
 	simulator := NewDebtSimulator()
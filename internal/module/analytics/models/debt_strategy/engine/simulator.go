@@ -61,7 +61,7 @@ func (s *DebtSimulator) SimulateStrategy(
 		for i := range currentDebts {
 			if currentDebts[i].Balance > 0 {
 				startBalance := currentDebts[i].Balance
-				monthlyRate := currentDebts[i].InterestRate / 12
+				monthlyRate := currentDebts[i].RateAt(month) / 12
 				interest := currentDebts[i].Balance * monthlyRate
 				currentDebts[i].Balance += interest
 				totalInterest += interest
@@ -94,7 +94,7 @@ func (s *DebtSimulator) SimulateStrategy(
 
 		// Step 3: Allocate extra payment based on strategy
 		remainingExtra := extraPayment
-		sortedIndices := s.sortDebtsByStrategy(currentDebts, strategy, hybridWeights)
+		sortedIndices := s.sortDebtsByStrategy(currentDebts, strategy, hybridWeights, month)
 
 		for _, idx := range sortedIndices {
 			if remainingExtra <= 0 {
@@ -197,11 +197,12 @@ func (s *DebtSimulator) FindBestDebtForLumpSum(
 	return bestDebtID, bestSavings
 }
 
-// sortDebtsByStrategy returns sorted indices based on strategy
+// sortDebtsByStrategy returns sorted indices based on strategy, comparing the rates charged in month
 func (s *DebtSimulator) sortDebtsByStrategy(
 	debts []domain.DebtInfo,
 	strategy domain.Strategy,
 	hybridWeights *domain.HybridWeights,
+	month int,
 ) []int {
 	indices := make([]int, len(debts))
 	for i := range indices {
@@ -212,7 +213,7 @@ func (s *DebtSimulator) sortDebtsByStrategy(
 	case domain.StrategyAvalanche:
 		// Sort by interest rate descending (highest first)
 		sort.Slice(indices, func(i, j int) bool {
-			return debts[indices[i]].InterestRate > debts[indices[j]].InterestRate
+			return debts[indices[i]].RateAt(month) > debts[indices[j]].RateAt(month)
 		})
 
 	case domain.StrategySnowball:
@@ -247,7 +248,7 @@ func (s *DebtSimulator) sortDebtsByStrategy(
 		if hybridWeights != nil {
 			weights = *hybridWeights
 		}
-		scores := s.calculateHybridScores(debts, weights)
+		scores := s.calculateHybridScores(debts, weights, month)
 		sort.Slice(indices, func(i, j int) bool {
 			return scores[indices[i]] > scores[indices[j]]
 		})
@@ -255,7 +256,7 @@ func (s *DebtSimulator) sortDebtsByStrategy(
 	default:
 		// Default to avalanche
 		sort.Slice(indices, func(i, j int) bool {
-			return debts[indices[i]].InterestRate > debts[indices[j]].InterestRate
+			return debts[indices[i]].RateAt(month) > debts[indices[j]].RateAt(month)
 		})
 	}
 
@@ -263,7 +264,7 @@ func (s *DebtSimulator) sortDebtsByStrategy(
 }
 
 // calculateHybridScores calculates weighted scores for hybrid strategy
-func (s *DebtSimulator) calculateHybridScores(debts []domain.DebtInfo, weights domain.HybridWeights) []float64 {
+func (s *DebtSimulator) calculateHybridScores(debts []domain.DebtInfo, weights domain.HybridWeights, month int) []float64 {
 	scores := make([]float64, len(debts))
 
 	// Find max values for normalization
@@ -273,8 +274,8 @@ func (s *DebtSimulator) calculateHybridScores(debts []domain.DebtInfo, weights d
 	maxCashFlow := 0.0
 
 	for _, d := range debts {
-		if d.InterestRate > maxRate {
-			maxRate = d.InterestRate
+		if rate := d.RateAt(month); rate > maxRate {
+			maxRate = rate
 		}
 		if d.Balance > maxBalance {
 			maxBalance = d.Balance
@@ -295,7 +296,7 @@ func (s *DebtSimulator) calculateHybridScores(debts []domain.DebtInfo, weights d
 		// Interest rate component (higher = higher score)
 		rateScore := 0.0
 		if maxRate > 0 {
-			rateScore = d.InterestRate / maxRate
+			rateScore = d.RateAt(month) / maxRate
 		}
 
 		// Balance component (lower balance = higher score, so invert)
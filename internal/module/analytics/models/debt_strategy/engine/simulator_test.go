@@ -212,3 +212,56 @@ func TestDebtSimulator_CompareStrategies(t *testing.T) {
 		snowball.Months, snowball.TotalInterest, snowball.FirstCleared)
 	t.Logf("  Interest saved by Avalanche: $%.2f", snowball.TotalInterest-avalanche.TotalInterest)
 }
+
+func TestDebtSimulator_SimulateStrategy_PromotionalRateSchedule(t *testing.T) {
+	simulator := NewDebtSimulator()
+
+	promo := domain.DebtInfo{
+		ID: "home", Name: "Home Loan", Balance: 10000, InterestRate: 0.06, MinimumPayment: 300,
+		RateSchedule: []domain.RatePeriod{
+			{FromMonth: 1, Rate: 0.06},
+			{FromMonth: 13, Rate: 0.12, Floating: true},
+		},
+	}
+	fixedLow := promo
+	fixedLow.RateSchedule = nil
+	fixedHigh := fixedLow
+	fixedHigh.InterestRate = 0.12
+
+	promoResult := simulator.SimulateStrategy(domain.StrategyAvalanche, []domain.DebtInfo{promo}, 0, nil)
+	lowResult := simulator.SimulateStrategy(domain.StrategyAvalanche, []domain.DebtInfo{fixedLow}, 0, nil)
+	highResult := simulator.SimulateStrategy(domain.StrategyAvalanche, []domain.DebtInfo{fixedHigh}, 0, nil)
+
+	snapshots := promoResult.DebtTimelines["home"].Snapshots
+	if got := snapshots[0].Interest; got != 50 {
+		t.Errorf("Expected month 1 interest at the promotional 6%%, got %.2f", got)
+	}
+	if rate := snapshots[12].Interest / snapshots[12].StartBalance * 12; rate < 0.1199 || rate > 0.1201 {
+		t.Errorf("Expected month 13 interest at the floating 12%%, got %.4f", rate)
+	}
+	if promoResult.TotalInterest <= lowResult.TotalInterest || promoResult.TotalInterest >= highResult.TotalInterest {
+		t.Errorf("Expected promotional interest between %.2f and %.2f, got %.2f",
+			lowResult.TotalInterest, highResult.TotalInterest, promoResult.TotalInterest)
+	}
+}
+
+func TestDebtSimulator_SimulateStrategy_AvalancheFollowsScheduledRates(t *testing.T) {
+	simulator := NewDebtSimulator()
+
+	debts := []domain.DebtInfo{
+		{ID: "cc", Name: "Credit Card", Balance: 5000, InterestRate: 0.18, MinimumPayment: 150},
+		// 0% teaser for the first year, then 24%
+		{ID: "teaser", Name: "Teaser Card", Balance: 5000, InterestRate: 0, MinimumPayment: 150,
+			RateSchedule: []domain.RatePeriod{{FromMonth: 13, Rate: 0.24}}},
+	}
+
+	result := simulator.SimulateStrategy(domain.StrategyAvalanche, debts, 300, nil)
+
+	first := result.DebtTimelines["cc"].Snapshots[0].Payment
+	if first != 450 {
+		t.Errorf("Expected extra payment on the 18%% card during the teaser, got %.2f", first)
+	}
+	if result.DebtTimelines["teaser"].Snapshots[0].Interest != 0 {
+		t.Error("Expected no interest during the teaser period")
+	}
+}
@@ -61,6 +61,14 @@ func (m *DebtStrategyModel) Validate(ctx context.Context, input interface{}) err
 		if debt.InterestRate < 0 || debt.InterestRate > 1 {
 			return fmt.Errorf("debt %d: interest rate must be between 0 and 1", i)
 		}
+		for _, period := range debt.RateSchedule {
+			if period.FromMonth < 1 {
+				return fmt.Errorf("debt %d: rate periods start from month 1", i)
+			}
+			if period.Rate < 0 || period.Rate > 1 {
+				return fmt.Errorf("debt %d: rate period rate must be between 0 and 1", i)
+			}
+		}
 	}
 
 	return nil
@@ -191,6 +199,12 @@ func (m *DebtStrategyModel) Execute(ctx context.Context, input interface{}) (int
 		output.SensitivityResults = m.analyzer.AnalyzeSensitivity(di.Debts, di.TotalDebtBudget, recommended, &hybridWeights)
 	}
 
+	// Step 10: Reference-rate stress test (if requested)
+	if di.RateShock > 0 {
+		shockResult := m.analyzer.AnalyzeRateShock(di.Debts, di.TotalDebtBudget, recommended, &hybridWeights, di.RateShock)
+		output.SensitivityResults = append(output.SensitivityResults, *shockResult)
+	}
+
 	return output, nil
 }
 
@@ -137,11 +137,8 @@ func (m *TradeoffModel) analyzeStrategy(s domain.Strategy, ratio domain.Allocati
 	debtPay := extra * ratio.DebtPercent
 	savePay := extra * ratio.SavingsPercent
 	months, totalInt, intSaved := m.calculator.SimulateDebtPayoff(ti.Debts, debtPay, cfg.ProjectionMonths)
-	ret := ti.InvestmentProfile.ExpectedReturn
-	if ret == 0 {
-		ret = 0.07
-	}
-	invVal := m.calculator.SimulateInvestmentGrowth(ti.InvestmentProfile.CurrentInvestments, savePay, ret, cfg.ProjectionMonths)
+	ret := ti.InvestmentProfile.EffectiveReturn()
+	invVal := m.calculator.SimulateInvestmentGrowth(ti.InvestmentProfile.TotalInvestments(), savePay, ret, cfg.ProjectionMonths)
 	npv := intSaved + m.calculator.PresentValue(invVal, cfg.DiscountRate/12, cfg.ProjectionMonths)
 	goals := make(map[string]int)
 	for _, g := range ti.Goals {
@@ -266,8 +263,8 @@ func (m *TradeoffModel) find(results []domain.StrategyResult, s domain.Strategy)
 func (m *TradeoffModel) runMonteCarlo(ratio domain.AllocationRatio, ti *dto.TradeoffInput, cfg domain.SimulationConfig) *domain.MonteCarloResult {
 	return m.simulator.RunSimulation(engine.SimulationInput{
 		Debts: ti.Debts, MonthlyIncome: ti.MonthlyIncome, EssentialExpenses: ti.EssentialExpenses,
-		TotalMinPayments: ti.TotalMinPayments, Ratio: ratio, ExpectedReturn: ti.InvestmentProfile.ExpectedReturn,
-		InitialSavings: ti.InvestmentProfile.CurrentInvestments, Goals: ti.Goals, Config: cfg,
+		TotalMinPayments: ti.TotalMinPayments, Ratio: ratio, ExpectedReturn: ti.InvestmentProfile.EffectiveReturn(),
+		InitialSavings: ti.InvestmentProfile.TotalInvestments(), Goals: ti.Goals, Config: cfg,
 	})
 }
 
@@ -280,10 +277,7 @@ func (m *TradeoffModel) generateProjections(ratio domain.AllocationRatio, extra
 	if sp > 0 && efGap > 0 {
 		efMonths = int(efGap / sp)
 	}
-	ret := ti.InvestmentProfile.ExpectedReturn
-	if ret == 0 {
-		ret = 0.07
-	}
+	ret := ti.InvestmentProfile.EffectiveReturn()
 	gd := make(map[string]time.Time)
 	for _, g := range ti.Goals {
 		c := sp
@@ -292,7 +286,7 @@ func (m *TradeoffModel) generateProjections(ratio domain.AllocationRatio, extra
 		}
 		gd[g.ID] = time.Now().AddDate(0, m.calculator.CalculateGoalMonths(g.CurrentAmount, g.TargetAmount, c, ret), 0)
 	}
-	nw := m.calculator.GenerateNetWorthTimeline(ti.Debts, ti.InvestmentProfile.CurrentInvestments, dp, sp, ret, cfg.ProjectionMonths, 6)
+	nw := m.calculator.GenerateNetWorthTimeline(ti.Debts, ti.InvestmentProfile.TotalInvestments(), dp, sp, ret, cfg.ProjectionMonths, 6)
 	return dto.ProjectionResult{DebtFreeDate: time.Now().AddDate(0, months, 0), EmergencyFundDate: time.Now().AddDate(0, efMonths, 0), GoalDates: gd, NetWorthGrowth: nw}
 }
 
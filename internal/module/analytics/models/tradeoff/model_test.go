@@ -301,3 +301,33 @@ func TestTradeoffModel_UserPreference(t *testing.T) {
 	t.Logf("Recommended: %s", output.RecommendedStrategy)
 	t.Logf("Reasoning: %s", output.Reasoning)
 }
+
+func TestInvestmentProfile_EffectiveReturn(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile domain.InvestmentProfile
+		want    float64
+	}{
+		{"explicit zero return is kept", domain.InvestmentProfile{ExpectedReturn: 0}, 0},
+		{"expected return only", domain.InvestmentProfile{ExpectedReturn: 0.1}, 0.1},
+		{"deposits only", domain.InvestmentProfile{DepositBalance: 100, DepositYield: 0.055}, 0.055},
+		{
+			"deposits blended with investments by balance",
+			domain.InvestmentProfile{ExpectedReturn: 0.1, CurrentInvestments: 100, DepositBalance: 300, DepositYield: 0.06},
+			0.07,
+		},
+		{
+			"zero-return investments dilute the deposit yield",
+			domain.InvestmentProfile{CurrentInvestments: 100, DepositBalance: 100, DepositYield: 0.06},
+			0.03,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.profile.EffectiveReturn(); got < tt.want-1e-9 || got > tt.want+1e-9 {
+				t.Errorf("EffectiveReturn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
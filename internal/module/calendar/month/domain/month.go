@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,7 +14,9 @@ import (
 type Month struct {
 	ID     uuid.UUID `gorm:"type:uuid;primaryKey" json:"id"` // UUIDv7 generated in BeforeCreate
 	UserID uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
-	Month  string    `gorm:"type:varchar(10);not null;index" json:"month"` // Display name: "2024-02"
+	// WorkspaceID is the household the month is shared into; nil keeps it personal
+	WorkspaceID *uuid.UUID `gorm:"type:uuid;index" json:"workspace_id,omitempty"`
+	Month       string     `gorm:"type:varchar(10);not null;index" json:"month"` // Display name: "2024-02"
 
 	// Budget period dates - supports custom periods (e.g., pay period 15/01-14/02)
 	StartDate time.Time `gorm:"type:date;not null;index" json:"start_date"`
@@ -160,8 +163,12 @@ type MonthClosedSnapshot struct {
 	GoalsCompleted    []uuid.UUID           `json:"goals_completed,omitempty"`    // Goals that reached 100%
 
 	// ===== DEBT PROGRESS =====
-	DebtPayments map[uuid.UUID]float64 `json:"debt_payments,omitempty"`  // Amount paid to each debt
-	DebtsPaidOff []uuid.UUID           `json:"debts_paid_off,omitempty"` // Debts that reached 0
+	DebtPayments      map[uuid.UUID]float64 `json:"debt_payments,omitempty"`       // Amount paid to each debt
+	DebtInterestPaid  map[uuid.UUID]float64 `json:"debt_interest_paid,omitempty"`  // Interest portion paid to each debt
+	DebtPrincipalPaid map[uuid.UUID]float64 `json:"debt_principal_paid,omitempty"` // Principal portion paid to each debt
+	DebtsPaidOff      []uuid.UUID           `json:"debts_paid_off,omitempty"`      // Debts that reached 0
+	TotalDebtPaid     float64               `json:"total_debt_paid"`               // Sum of all debt payments
+	TotalInterestPaid float64               `json:"total_interest_paid"`           // Sum of interest paid across debts
 
 	// ===== TRANSACTION SUMMARY (for CSV export) =====
 	TransactionCount    int                          `json:"transaction_count"`            // Total transactions
@@ -174,6 +181,29 @@ type MonthClosedSnapshot struct {
 	AppliedIteration  *int `json:"applied_iteration,omitempty"` // Which iteration was applied
 }
 
+// RecordDebtPayment adds a debt payment made during the month to the debt progress figures
+func (s *MonthClosedSnapshot) RecordDebtPayment(debtID uuid.UUID, amount, interest, principal float64, paidOff bool) {
+	if s.DebtPayments == nil {
+		s.DebtPayments = make(map[uuid.UUID]float64)
+	}
+	if s.DebtInterestPaid == nil {
+		s.DebtInterestPaid = make(map[uuid.UUID]float64)
+	}
+	if s.DebtPrincipalPaid == nil {
+		s.DebtPrincipalPaid = make(map[uuid.UUID]float64)
+	}
+
+	s.DebtPayments[debtID] += amount
+	s.DebtInterestPaid[debtID] += interest
+	s.DebtPrincipalPaid[debtID] += principal
+	s.TotalDebtPaid += amount
+	s.TotalInterestPaid += interest
+
+	if paidOff && !slices.Contains(s.DebtsPaidOff, debtID) {
+		s.DebtsPaidOff = append(s.DebtsPaidOff, debtID)
+	}
+}
+
 // CategoryTransactionSummary summarizes transactions for a single category
 type CategoryTransactionSummary struct {
 	CategoryID       uuid.UUID `json:"category_id"`
@@ -25,6 +25,8 @@ type InitGoalInput struct {
 	TargetDate    string  `json:"target_date,omitempty"`
 	Type          string  `json:"type" binding:"required"`
 	Priority      string  `json:"priority,omitempty"`
+	// Sinking funds: the goal's monthly set-aside, allocated as a hard constraint
+	MonthlySetAside float64 `json:"monthly_set_aside,omitempty" binding:"gte=0"`
 }
 
 // InitDebtInput is simplified debt input for DSS
@@ -35,6 +37,19 @@ type InitDebtInput struct {
 	InterestRate   float64 `json:"interest_rate" binding:"gte=0"`
 	MinimumPayment float64 `json:"minimum_payment" binding:"gte=0"`
 	Behavior       string  `json:"behavior,omitempty"` // "revolving", "installment", "interest_only"
+	// Interest accrued but not yet paid, already included in CurrentBalance for recorded debts
+	AccruedInterest float64 `json:"accrued_interest,omitempty"`
+	// Rate changes ahead (promotional ends, floating resets), filled from the debt's rate schedule
+	RateSchedule []InitRatePeriod `json:"rate_schedule,omitempty"`
+}
+
+// InitRatePeriod is the annual rate a debt charges from a month of the plan onwards
+type InitRatePeriod struct {
+	FromMonth int      `json:"from_month"` // 1 = the current month
+	Rate      float64  `json:"rate"`       // Annual rate (%)
+	Floating  bool     `json:"floating"`
+	RateFloor *float64 `json:"rate_floor,omitempty"`
+	RateCap   *float64 `json:"rate_cap,omitempty"`
 }
 
 // InitConstraintInput is simplified constraint input for DSS
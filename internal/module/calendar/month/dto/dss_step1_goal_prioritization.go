@@ -1,6 +1,9 @@
 package dto
 
 import (
+	"time"
+
+	goalDomain "personalfinancedss/internal/module/analytics/goal_prioritization/domain"
 	goalDto "personalfinancedss/internal/module/analytics/goal_prioritization/dto"
 
 	"github.com/google/uuid"
@@ -29,3 +32,70 @@ type ApplyGoalPrioritizationRequest struct {
 	MonthID         uuid.UUID   `json:"month_id" binding:"required"`
 	AcceptedRanking []uuid.UUID `json:"accepted_ranking" binding:"required"`
 }
+
+// PreviewGoalSensitivityResponse shows whether the Step 1 ranking is robust before it is applied
+type PreviewGoalSensitivityResponse struct {
+	MonthID uuid.UUID `json:"month_id"`
+	*goalDto.SensitivityOutput
+}
+
+// ==================== Step 1 (Group): Household Goal Prioritization ====================
+// The facilitator opens a session for a month shared with a household. Members who can
+// edit the month submit their own judgments, and the aggregated ranking becomes the
+// facilitator's Step 1 preview (apply it with /goal-prioritization/apply as usual).
+
+// StartGroupPrioritizationRequest opens a group prioritization session
+type StartGroupPrioritizationRequest struct {
+	// MonthID is taken from the path param
+	MonthID uuid.UUID `json:"month_id"`
+	// GoalIDs limits the session to these goals (defaults to every goal in the DSS snapshot)
+	GoalIDs []string `json:"goal_ids,omitempty"`
+}
+
+// SubmitGroupJudgmentRequest holds one participant's judgments.
+// Give either pairwise comparisons or direct criteria ratings.
+type SubmitGroupJudgmentRequest struct {
+	DisplayName string `json:"display_name,omitempty"`
+
+	CriteriaComparisons    []goalDomain.PairwiseComparison            `json:"criteria_comparisons,omitempty"`
+	AlternativeComparisons map[string][]goalDomain.PairwiseComparison `json:"alternative_comparisons,omitempty"`
+
+	CriteriaRatings    map[string]int                `json:"criteria_ratings,omitempty"`     // 1-10 scale
+	GoalScoreOverrides map[string]map[string]float64 `json:"goal_score_overrides,omitempty"` // goalID -> criterion -> 0-1
+}
+
+// PreviewGroupPrioritizationRequest aggregates the submitted judgments
+type PreviewGroupPrioritizationRequest struct {
+	// MonthID is taken from the path param
+	MonthID uuid.UUID `json:"month_id"`
+	// Aggregation: "judgments" (geometric mean of judgments, default) or "priorities" (geometric mean of priorities)
+	Aggregation string `json:"aggregation,omitempty" binding:"omitempty,oneof=judgments priorities"`
+	// MemberWeights weights participants by user ID (defaults to 1)
+	MemberWeights map[string]float64 `json:"member_weights,omitempty"`
+}
+
+// GroupParticipantResponse shows who has submitted judgments
+type GroupParticipantResponse struct {
+	UserID      uuid.UUID `json:"user_id"`
+	DisplayName string    `json:"display_name,omitempty"`
+	Method      string    `json:"method"` // "pairwise" or "direct_rating"
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// GroupPrioritizationSessionResponse describes a group session.
+// Share SessionID with household members so they can submit judgments.
+type GroupPrioritizationSessionResponse struct {
+	SessionID    uuid.UUID                  `json:"session_id"`
+	MonthID      uuid.UUID                  `json:"month_id"`
+	OwnerID      uuid.UUID                  `json:"owner_id"`
+	Criteria     []string                   `json:"criteria"`
+	Goals        []goalDto.GoalForRating    `json:"goals"`
+	Participants []GroupParticipantResponse `json:"participants"`
+	ExpiresAt    time.Time                  `json:"expires_at"`
+}
+
+// PreviewGroupPrioritizationResponse is the aggregated group result with consensus analysis
+type PreviewGroupPrioritizationResponse struct {
+	*goalDto.GroupPrioritizationOutput
+	SessionID uuid.UUID `json:"session_id"`
+}
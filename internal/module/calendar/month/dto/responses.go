@@ -11,6 +11,7 @@ import (
 type MonthViewResponse struct {
 	MonthID      uuid.UUID              `json:"month_id"`
 	UserID       uuid.UUID              `json:"user_id"`
+	WorkspaceID  *uuid.UUID             `json:"workspace_id,omitempty"`
 	Month        string                 `json:"month"`      // Display: "2024-02"
 	StartDate    time.Time              `json:"start_date"` // Actual period start
 	EndDate      time.Time              `json:"end_date"`   // Actual period end
@@ -104,15 +105,16 @@ type CategoryLineResponse struct {
 
 // MonthResponse is a summary response for listing months
 type MonthResponse struct {
-	MonthID      uuid.UUID `json:"month_id"`
-	UserID       uuid.UUID `json:"user_id"`
-	Month        string    `json:"month"`      // Display: "2024-02"
-	StartDate    time.Time `json:"start_date"` // Actual period start
-	EndDate      time.Time `json:"end_date"`   // Actual period end
-	Status       string    `json:"status"`
-	ToBeBudgeted float64   `json:"to_be_budgeted"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	MonthID      uuid.UUID  `json:"month_id"`
+	UserID       uuid.UUID  `json:"user_id"`
+	WorkspaceID  *uuid.UUID `json:"workspace_id,omitempty"`
+	Month        string     `json:"month"`      // Display: "2024-02"
+	StartDate    time.Time  `json:"start_date"` // Actual period start
+	EndDate      time.Time  `json:"end_date"`   // Actual period end
+	Status       string     `json:"status"`
+	ToBeBudgeted float64    `json:"to_be_budgeted"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
 }
 
 // PlanningIterationResponse is the response for a planning iteration
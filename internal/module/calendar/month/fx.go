@@ -28,6 +28,8 @@ var Module = fx.Module("calendar-month",
 				``,                                 // repo
 				``,                                 // categoryService
 				``,                                 // incomeProfileRepo
+				``,                                 // debtRepo
+				``,                                 // installmentRepo
 				``,                                 // budgetService
 				`name:"goalPrioritizationService"`, // goalPrioritization
 				`name:"debtStrategyService"`,       // debtStrategy
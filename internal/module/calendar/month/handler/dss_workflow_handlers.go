@@ -10,6 +10,7 @@ import (
 	"personalfinancedss/internal/shared"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // handleDSSError handles DSS-specific errors with proper HTTP status codes
@@ -32,6 +33,13 @@ func (h *Handler) RegisterDSSWorkflowRoutes(months *gin.RouterGroup, auth *middl
 	// Step 1: Goal Prioritization
 	months.POST("/:month/goal-prioritization/preview", h.previewGoalPrioritization)
 	months.POST("/:month/goal-prioritization/apply", h.applyGoalPrioritization)
+	months.GET("/:month/goal-prioritization/sensitivity", h.previewGoalSensitivity)
+
+	// Step 1 (Group): Household goal prioritization
+	months.POST("/:month/goal-prioritization/group", h.startGroupPrioritization)
+	months.POST("/:month/goal-prioritization/group/preview", h.previewGroupPrioritization)
+	months.GET("/group-prioritization/:session_id", h.getGroupPrioritization)
+	months.PUT("/group-prioritization/:session_id/judgments", h.submitGroupJudgment)
 
 	// Step 2: Debt Strategy
 	months.POST("/:month/debt-strategy/preview", h.previewDebtStrategy)
@@ -222,6 +230,201 @@ func (h *Handler) applyGoalPrioritization(c *gin.Context) {
 	shared.RespondWithSuccess(c, http.StatusOK, "Goal prioritization applied (Step 1 complete)", gin.H{})
 }
 
+// previewGoalSensitivity godoc
+// @Summary Preview goal prioritization sensitivity
+// @Description Show how much each criterion weight can change before the top-ranked goal flips, based on the current Step 1 preview
+// @Tags month-dss
+// @Produce json
+// @Param month path string true "Month (YYYY-MM)"
+// @Success 200 {object} dto.PreviewGoalSensitivityResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Router /api/v1/months/{month}/goal-prioritization/sensitivity [get]
+func (h *Handler) previewGoalSensitivity(c *gin.Context) {
+	monthStr := c.Param("month")
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	monthView, err := h.service.GetMonth(c.Request.Context(), currentUser.ID, monthStr)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	result, err := h.service.PreviewGoalSensitivity(c.Request.Context(), monthView.MonthID, &currentUser.ID)
+	if err != nil {
+		handleDSSError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Goal prioritization sensitivity generated", result)
+}
+
+// ==================== Step 1 (Group): Household Goal Prioritization ====================
+
+// startGroupPrioritization godoc
+// @Summary Start group goal prioritization
+// @Description Open a session where household members submit their own judgments for the month's shared goals
+// @Tags month-dss
+// @Accept json
+// @Produce json
+// @Param month path string true "Month (YYYY-MM)"
+// @Param request body dto.StartGroupPrioritizationRequest false "Goals to include"
+// @Success 201 {object} dto.GroupPrioritizationSessionResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Router /api/v1/months/{month}/goal-prioritization/group [post]
+func (h *Handler) startGroupPrioritization(c *gin.Context) {
+	monthStr := c.Param("month")
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	monthView, err := h.service.GetMonth(c.Request.Context(), currentUser.ID, monthStr)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	var req dto.StartGroupPrioritizationRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			shared.RespondWithError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	req.MonthID = monthView.MonthID
+
+	result, err := h.service.StartGroupPrioritization(c.Request.Context(), req, &currentUser.ID)
+	if err != nil {
+		handleDSSError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Group goal prioritization started", result)
+}
+
+// getGroupPrioritization godoc
+// @Summary Get group goal prioritization session
+// @Description Get the goals, criteria and participants of a group prioritization session
+// @Tags month-dss
+// @Produce json
+// @Param session_id path string true "Session ID"
+// @Success 200 {object} dto.GroupPrioritizationSessionResponse
+// @Failure 403 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Router /api/v1/months/group-prioritization/{session_id} [get]
+func (h *Handler) getGroupPrioritization(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("session_id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	result, err := h.service.GetGroupPrioritization(c.Request.Context(), sessionID, &currentUser.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Group goal prioritization retrieved", result)
+}
+
+// submitGroupJudgment godoc
+// @Summary Submit group judgments
+// @Description Submit (or replace) your pairwise comparisons or direct ratings in a group prioritization session
+// @Tags month-dss
+// @Accept json
+// @Produce json
+// @Param session_id path string true "Session ID"
+// @Param request body dto.SubmitGroupJudgmentRequest true "Judgments"
+// @Success 200 {object} dto.GroupPrioritizationSessionResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 403 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Router /api/v1/months/group-prioritization/{session_id}/judgments [put]
+func (h *Handler) submitGroupJudgment(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("session_id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	var req dto.SubmitGroupJudgmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.service.SubmitGroupJudgment(c.Request.Context(), sessionID, req, &currentUser.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Judgments submitted", result)
+}
+
+// previewGroupPrioritization godoc
+// @Summary Preview group goal prioritization
+// @Description Aggregate the submitted judgments into one ranking with consensus and disagreement analysis. The ranking becomes the Step 1 preview.
+// @Tags month-dss
+// @Accept json
+// @Produce json
+// @Param month path string true "Month (YYYY-MM)"
+// @Param request body dto.PreviewGroupPrioritizationRequest false "Aggregation options"
+// @Success 200 {object} dto.PreviewGroupPrioritizationResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Router /api/v1/months/{month}/goal-prioritization/group/preview [post]
+func (h *Handler) previewGroupPrioritization(c *gin.Context) {
+	monthStr := c.Param("month")
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	monthView, err := h.service.GetMonth(c.Request.Context(), currentUser.ID, monthStr)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	var req dto.PreviewGroupPrioritizationRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			shared.RespondWithError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	req.MonthID = monthView.MonthID
+
+	result, err := h.service.PreviewGroupPrioritization(c.Request.Context(), req, &currentUser.ID)
+	if err != nil {
+		handleDSSError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Group goal prioritization preview generated", result)
+}
+
 // ==================== Step 2: Debt Strategy ====================
 
 // previewDebtStrategy godoc
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"personalfinancedss/internal/module/calendar/month/domain"
+	householddomain "personalfinancedss/internal/module/identify/household/domain"
+	householdrepo "personalfinancedss/internal/module/identify/household/repository"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -87,7 +89,7 @@ func (r *gormRepository) UpdateMonth(ctx context.Context, month *domain.Month) e
 func (r *gormRepository) ListMonths(ctx context.Context, userID uuid.UUID) ([]*domain.Month, error) {
 	var months []*domain.Month
 	err := r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
+		Scopes(householdrepo.VisibleTo(userID, householddomain.ResourceMonth)).
 		Order("month DESC").
 		Find(&months).Error
 
@@ -112,3 +114,14 @@ func (r *gormRepository) GetPreviousMonth(ctx context.Context, userID uuid.UUID,
 
 	return &result, nil
 }
+
+func (r *gormRepository) CanEditMonth(ctx context.Context, monthID uuid.UUID, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.Month{}).
+		Scopes(householdrepo.EditableBy(userID)).
+		Where("id = ?", monthID).
+		Count(&count).Error
+
+	return count > 0, err
+}
@@ -33,4 +33,7 @@ type Repository interface {
 
 	// GetPreviousMonth retrieves the month before the given month
 	GetPreviousMonth(ctx context.Context, userID uuid.UUID, month string) (*domain.Month, error)
+
+	// CanEditMonth reports whether a user owns a month or edits it through a shared household
+	CanEditMonth(ctx context.Context, monthID uuid.UUID, userID uuid.UUID) (bool, error)
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	goalDto "personalfinancedss/internal/module/analytics/goal_prioritization/dto"
 	"personalfinancedss/internal/module/calendar/month/dto"
 
 	"github.com/google/uuid"
@@ -50,6 +51,7 @@ type DSSCachedState struct {
 	// ===== Step 1: Goal prioritization =====
 	GoalPrioritizationPreview interface{} `json:"goal_prioritization_preview,omitempty"`
 	AcceptedGoalRanking       []uuid.UUID `json:"accepted_goal_ranking,omitempty"`
+	GroupSessionID            *uuid.UUID  `json:"group_session_id,omitempty"` // Open household prioritization session
 
 	// ===== Step 2: Debt strategy =====
 	DebtStrategyPreview  interface{} `json:"debt_strategy_preview,omitempty"`
@@ -167,3 +169,133 @@ func (c *DSSCache) ClearState(ctx context.Context, monthID, userID uuid.UUID) er
 
 	return nil
 }
+
+// GroupPrioritizationSession holds a household goal prioritization session in Redis.
+// It is keyed by its own ID so every participant reads and writes the same session.
+// Judgments live in a hash next to the session, one field per participant, so
+// participants submitting at the same time do not overwrite each other.
+type GroupPrioritizationSession struct {
+	ID            uuid.UUID                         `json:"id"`
+	MonthID       uuid.UUID                         `json:"month_id"`
+	OwnerID       uuid.UUID                         `json:"owner_id"`
+	MonthlyIncome float64                           `json:"monthly_income"`
+	Goals         []goalDto.GoalForRating           `json:"goals"`
+	Judgments     map[uuid.UUID]*GroupJudgmentEntry `json:"-"`
+	CreatedAt     time.Time                         `json:"created_at"`
+	UpdatedAt     time.Time                         `json:"updated_at"`
+}
+
+// GroupJudgmentEntry is one participant's latest submission
+type GroupJudgmentEntry struct {
+	Judgment    goalDto.ParticipantJudgment `json:"judgment"`
+	SubmittedAt time.Time                   `json:"submitted_at"`
+}
+
+// buildGroupSessionKey constructs Redis key for a group session
+// Format: dss:group:{sessionID}
+func (c *DSSCache) buildGroupSessionKey(sessionID uuid.UUID) string {
+	return fmt.Sprintf("dss:group:%s", sessionID.String())
+}
+
+// buildGroupJudgmentsKey constructs Redis key for the judgments hash of a group session
+// Format: dss:group:{sessionID}:judgments
+func (c *DSSCache) buildGroupJudgmentsKey(sessionID uuid.UUID) string {
+	return fmt.Sprintf("dss:group:%s:judgments", sessionID.String())
+}
+
+// GetGroupSession retrieves a group prioritization session, or nil if it expired
+func (c *DSSCache) GetGroupSession(ctx context.Context, sessionID uuid.UUID) (*GroupPrioritizationSession, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("redis unavailable")
+	}
+
+	bytes, err := c.client.Get(ctx, c.buildGroupSessionKey(sessionID)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group session: %w", err)
+	}
+
+	var session GroupPrioritizationSession
+	if err := json.Unmarshal(bytes, &session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal group session: %w", err)
+	}
+
+	fields, err := c.client.HGetAll(ctx, c.buildGroupJudgmentsKey(sessionID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group judgments: %w", err)
+	}
+	session.Judgments = make(map[uuid.UUID]*GroupJudgmentEntry, len(fields))
+	for field, value := range fields {
+		participantID, err := uuid.Parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group judgment participant %q: %w", field, err)
+		}
+		var entry GroupJudgmentEntry
+		if err := json.Unmarshal([]byte(value), &entry); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal group judgment: %w", err)
+		}
+		session.Judgments[participantID] = &entry
+		// Each judgment refreshes the session TTL
+		if entry.SubmittedAt.After(session.UpdatedAt) {
+			session.UpdatedAt = entry.SubmittedAt
+		}
+	}
+
+	return &session, nil
+}
+
+// SaveGroupSession saves a group prioritization session with the DSS TTL. Judgments are
+// not part of it; they are saved one at a time with SaveGroupJudgment.
+func (c *DSSCache) SaveGroupSession(ctx context.Context, session *GroupPrioritizationSession) error {
+	if c.client == nil {
+		return fmt.Errorf("redis unavailable")
+	}
+
+	session.UpdatedAt = time.Now()
+	key := c.buildGroupSessionKey(session.ID)
+
+	bytes, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal group session: %w", err)
+	}
+
+	if err := c.client.Set(ctx, key, bytes, dssCacheTTL).Err(); err != nil {
+		c.logger.Error("Failed to save group session",
+			zap.String("key", key),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// SaveGroupJudgment records (or replaces) one participant's judgment in its own hash field
+// and refreshes the TTL of the session and its judgments in the same MULTI/EXEC block
+func (c *DSSCache) SaveGroupJudgment(ctx context.Context, sessionID, participantID uuid.UUID, entry *GroupJudgmentEntry) error {
+	if c.client == nil {
+		return fmt.Errorf("redis unavailable")
+	}
+
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal group judgment: %w", err)
+	}
+
+	sessionKey := c.buildGroupSessionKey(sessionID)
+	judgmentsKey := c.buildGroupJudgmentsKey(sessionID)
+	if _, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, judgmentsKey, participantID.String(), bytes)
+		pipe.Expire(ctx, judgmentsKey, dssCacheTTL)
+		pipe.Expire(ctx, sessionKey, dssCacheTTL)
+		return nil
+	}); err != nil {
+		c.logger.Error("Failed to save group judgment",
+			zap.String("key", judgmentsKey),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
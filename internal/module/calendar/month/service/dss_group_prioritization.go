@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	goalDto "personalfinancedss/internal/module/analytics/goal_prioritization/dto"
+	"personalfinancedss/internal/module/calendar/month/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ==================== Step 1 (Group): Household Goal Prioritization ====================
+
+// StartGroupPrioritization opens a group session over the goals in the DSS snapshot
+func (s *monthService) StartGroupPrioritization(ctx context.Context, req dto.StartGroupPrioritizationRequest, userID *uuid.UUID) (*dto.GroupPrioritizationSessionResponse, error) {
+	month, err := s.repo.GetMonthByID(ctx, req.MonthID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load month: %w", err)
+	}
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
+	}
+	if !month.CanBeModified() {
+		return nil, errors.New("cannot modify closed month")
+	}
+	if month.WorkspaceID == nil {
+		return nil, shared.ErrBadRequest.WithDetails("month", "share the month with a household before starting a group prioritization")
+	}
+
+	state, err := s.dssCache.GetOrInitState(ctx, req.MonthID, *userID)
+	if err != nil {
+		return nil, err
+	}
+
+	goals := toGoalsForRating(state.InputGoals)
+	if len(req.GoalIDs) > 0 {
+		selected := make(map[string]bool, len(req.GoalIDs))
+		for _, id := range req.GoalIDs {
+			selected[id] = true
+		}
+		filtered := goals[:0]
+		for _, g := range goals {
+			if selected[g.ID] {
+				filtered = append(filtered, g)
+			}
+		}
+		goals = filtered
+	}
+	if len(goals) < 2 {
+		return nil, shared.ErrBadRequest.WithDetails("goal_ids", "at least 2 goals are required for group prioritization")
+	}
+
+	session := &GroupPrioritizationSession{
+		ID:            uuid.New(),
+		MonthID:       req.MonthID,
+		OwnerID:       *userID,
+		MonthlyIncome: state.MonthlyIncome,
+		Goals:         goals,
+		Judgments:     make(map[uuid.UUID]*GroupJudgmentEntry),
+		CreatedAt:     time.Now(),
+	}
+	if err := s.dssCache.SaveGroupSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to save group session: %w", err)
+	}
+
+	state.GroupSessionID = &session.ID
+	if err := s.dssCache.SaveState(ctx, state); err != nil {
+		return nil, fmt.Errorf("failed to save DSS state: %w", err)
+	}
+
+	s.logger.Info("Started group goal prioritization",
+		zap.String("session_id", session.ID.String()),
+		zap.String("month_id", req.MonthID.String()),
+		zap.Int("goal_count", len(goals)),
+	)
+
+	return toGroupSessionResponse(session), nil
+}
+
+// GetGroupPrioritization returns a group session to one of its participants
+func (s *monthService) GetGroupPrioritization(ctx context.Context, sessionID uuid.UUID, userID *uuid.UUID) (*dto.GroupPrioritizationSessionResponse, error) {
+	session, err := s.loadGroupSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toGroupSessionResponse(session), nil
+}
+
+// SubmitGroupJudgment records (or replaces) the user's judgments in a group session
+func (s *monthService) SubmitGroupJudgment(ctx context.Context, sessionID uuid.UUID, req dto.SubmitGroupJudgmentRequest, userID *uuid.UUID) (*dto.GroupPrioritizationSessionResponse, error) {
+	session, err := s.loadGroupSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	judgment := goalDto.ParticipantJudgment{
+		ParticipantID:          userID.String(),
+		ParticipantName:        req.DisplayName,
+		CriteriaComparisons:    req.CriteriaComparisons,
+		AlternativeComparisons: req.AlternativeComparisons,
+		CriteriaRatings:        req.CriteriaRatings,
+		GoalScoreOverrides:     req.GoalScoreOverrides,
+	}
+
+	// Run the judgments alone so invalid submissions are rejected now rather than at aggregation
+	if _, err := s.goalPrioritization.ExecuteGroupPrioritization(ctx, &goalDto.GroupPrioritizationInput{
+		UserID:        userID.String(),
+		MonthlyIncome: session.MonthlyIncome,
+		Goals:         session.Goals,
+		Participants:  []goalDto.ParticipantJudgment{judgment},
+	}); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("judgment", err.Error())
+	}
+
+	entry := &GroupJudgmentEntry{
+		Judgment:    judgment,
+		SubmittedAt: time.Now(),
+	}
+	if err := s.dssCache.SaveGroupJudgment(ctx, sessionID, *userID, entry); err != nil {
+		return nil, fmt.Errorf("failed to save group judgment: %w", err)
+	}
+	session.Judgments[*userID] = entry
+	session.UpdatedAt = entry.SubmittedAt
+
+	s.logger.Info("Recorded group judgment",
+		zap.String("session_id", sessionID.String()),
+		zap.String("user_id", userID.String()),
+		zap.Int("participants", len(session.Judgments)),
+	)
+
+	return toGroupSessionResponse(session), nil
+}
+
+// PreviewGroupPrioritization aggregates the submitted judgments and stores the
+// aggregated ranking as the facilitator's Step 1 preview
+func (s *monthService) PreviewGroupPrioritization(ctx context.Context, req dto.PreviewGroupPrioritizationRequest, userID *uuid.UUID) (*dto.PreviewGroupPrioritizationResponse, error) {
+	month, err := s.repo.GetMonthByID(ctx, req.MonthID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load month: %w", err)
+	}
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
+	}
+
+	state, err := s.dssCache.GetOrInitState(ctx, req.MonthID, *userID)
+	if err != nil {
+		return nil, err
+	}
+	if state.GroupSessionID == nil {
+		return nil, shared.ErrBadRequest.WithDetails("session", "no group prioritization session started for this month")
+	}
+
+	session, err := s.dssCache.GetGroupSession(ctx, *state.GroupSessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, shared.ErrNotFound.WithDetails("session", "group prioritization session has expired")
+	}
+	if len(session.Judgments) == 0 {
+		return nil, shared.ErrBadRequest.WithDetails("session", "no participant has submitted judgments yet")
+	}
+
+	// Order participants by submission time so the result is deterministic
+	entries := make([]*GroupJudgmentEntry, 0, len(session.Judgments))
+	for _, entry := range session.Judgments {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].SubmittedAt.Before(entries[j].SubmittedAt)
+	})
+	participants := make([]goalDto.ParticipantJudgment, 0, len(entries))
+	for _, entry := range entries {
+		participants = append(participants, entry.Judgment)
+	}
+
+	result, err := s.goalPrioritization.ExecuteGroupPrioritization(ctx, &goalDto.GroupPrioritizationInput{
+		UserID:        userID.String(),
+		MonthlyIncome: session.MonthlyIncome,
+		Goals:         session.Goals,
+		Participants:  participants,
+		Aggregation:   req.Aggregation,
+		MemberWeights: req.MemberWeights,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("group AHP execution failed: %w", err)
+	}
+
+	// Re-calc step 1 => clear 1..4, then keep the aggregated ranking as the Step 1 preview
+	clearFromStep(state, 1)
+	state.GoalPrioritizationPreview = &dto.PreviewGoalPrioritizationResponse{
+		AHPOutput: result.AHPOutput,
+	}
+	if err := s.dssCache.SaveState(ctx, state); err != nil {
+		s.logger.Warn("Failed to save group prioritization results", zap.Error(err))
+	}
+
+	return &dto.PreviewGroupPrioritizationResponse{
+		GroupPrioritizationOutput: result,
+		SessionID:                 session.ID,
+	}, nil
+}
+
+// loadGroupSession loads a session and checks that the user may take part in it
+func (s *monthService) loadGroupSession(ctx context.Context, sessionID uuid.UUID, userID *uuid.UUID) (*GroupPrioritizationSession, error) {
+	session, err := s.dssCache.GetGroupSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, shared.ErrNotFound.WithDetails("session", "group prioritization session not found or expired")
+	}
+
+	month, err := s.repo.GetMonthByID(ctx, session.MonthID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load month: %w", err)
+	}
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, shared.ErrForbidden.WithError(err)
+	}
+
+	return session, nil
+}
+
+// toGoalsForRating converts cached DSS goals to AHP rating input
+func toGoalsForRating(inputGoals []dto.InitGoalInput) []goalDto.GoalForRating {
+	goals := make([]goalDto.GoalForRating, 0, len(inputGoals))
+	for _, g := range inputGoals {
+		targetDate, _ := time.Parse("2006-01-02", g.TargetDate)
+		goals = append(goals, goalDto.GoalForRating{
+			ID:            g.ID,
+			Name:          g.Name,
+			TargetAmount:  g.TargetAmount,
+			CurrentAmount: g.CurrentAmount,
+			TargetDate:    targetDate,
+			Type:          g.Type,
+			Priority:      g.Priority,
+		})
+	}
+	return goals
+}
+
+func toGroupSessionResponse(session *GroupPrioritizationSession) *dto.GroupPrioritizationSessionResponse {
+	participants := make([]dto.GroupParticipantResponse, 0, len(session.Judgments))
+	for participantID, entry := range session.Judgments {
+		method := "direct_rating"
+		if entry.Judgment.UsesPairwise() {
+			method = "pairwise"
+		}
+		participants = append(participants, dto.GroupParticipantResponse{
+			UserID:      participantID,
+			DisplayName: entry.Judgment.ParticipantName,
+			Method:      method,
+			SubmittedAt: entry.SubmittedAt,
+		})
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].SubmittedAt.Before(participants[j].SubmittedAt)
+	})
+
+	return &dto.GroupPrioritizationSessionResponse{
+		SessionID:    session.ID,
+		MonthID:      session.MonthID,
+		OwnerID:      session.OwnerID,
+		Criteria:     goalDto.GroupCriteria,
+		Goals:        session.Goals,
+		Participants: participants,
+		ExpiresAt:    session.UpdatedAt.Add(dssCacheTTL),
+	}
+}
@@ -39,6 +39,11 @@ func (m *mockMonthRepository) UpdateMonthState(ctx context.Context, monthID uuid
 	return m.Called(ctx, monthID, state, version).Error(0)
 }
 
+func (m *mockMonthRepository) CanEditMonth(ctx context.Context, monthID uuid.UUID, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, monthID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
 // Verify interface implementation at compile time
 var _ repository.Repository = (*mockMonthRepository)(nil)
 
@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"personalfinancedss/internal/module/calendar/month/domain"
 	"personalfinancedss/internal/module/calendar/month/dto"
+	"personalfinancedss/internal/shared"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -25,8 +28,17 @@ import (
 
 	// Budget domain
 	budgetDomain "personalfinancedss/internal/module/cashflow/budget/domain"
+
+	// Debt domain
+	debtDomain "personalfinancedss/internal/module/cashflow/debt/domain"
+
+	// Installment domain
+	installmentDomain "personalfinancedss/internal/module/cashflow/installment/domain"
 )
 
+// debtRateHorizonMonths is how far ahead recorded rate schedules are passed to the debt strategy
+const debtRateHorizonMonths = 360
+
 // getDebtBehavior returns the debt behavior with default fallback
 // Default: "installment" (matches domain.Debt default value)
 func getDebtBehavior(behavior string) string {
@@ -155,8 +167,8 @@ func (s *monthService) InitializeDSS(ctx context.Context, req dto.InitializeDSSR
 	if err != nil {
 		return nil, fmt.Errorf("failed to load month: %w", err)
 	}
-	if userID != nil && month.UserID != *userID {
-		return nil, errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
 	}
 	if !month.CanBeModified() {
 		return nil, errors.New("cannot initialize DSS on closed month")
@@ -168,6 +180,8 @@ func (s *monthService) InitializeDSS(ctx context.Context, req dto.InitializeDSSR
 	}
 
 	// 3. Create new DSS cached state with fresh input snapshot
+	req.Debts = s.withRecordedDebtFigures(ctx, *userID, req.Debts, time.Now())
+	req.Constraints = s.withInstallmentObligations(ctx, *userID, req.Constraints, month)
 	cachedState := &DSSCachedState{
 		MonthID:          req.MonthID,
 		UserID:           *userID,
@@ -200,6 +214,145 @@ func (s *monthService) InitializeDSS(ctx context.Context, req dto.InitializeDSSR
 	}, nil
 }
 
+// withRecordedDebtFigures replaces the balance and rate of debt inputs that match one of the
+// user's recorded debts with the stored figures, adding the interest accrued up to now
+func (s *monthService) withRecordedDebtFigures(ctx context.Context, userID uuid.UUID, inputs []dto.InitDebtInput, now time.Time) []dto.InitDebtInput {
+	if s.debtRepo == nil || len(inputs) == 0 {
+		return inputs
+	}
+
+	debts, err := s.debtRepo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Warn("Failed to load debts for DSS inputs, using submitted figures", zap.Error(err))
+		return inputs
+	}
+
+	byID := make(map[string]int, len(debts))
+	for i := range debts {
+		byID[debts[i].ID.String()] = i
+	}
+
+	result := make([]dto.InitDebtInput, len(inputs))
+	for i, input := range inputs {
+		result[i] = input
+		idx, ok := byID[input.ID]
+		if !ok {
+			continue
+		}
+
+		debt := &debts[idx]
+		interest := debt.InterestDue(now)
+		result[i].CurrentBalance = debt.CurrentBalance + interest
+		result[i].AccruedInterest = interest
+		result[i].InterestRate = debt.InterestRate
+		result[i].RateSchedule = s.recordedRateSchedule(ctx, debt, now)
+	}
+	return result
+}
+
+// withInstallmentObligations adds the charges the user's active installment plans fall due for in
+// the month as fixed expenses, one per plan, unless a constraint for the plan was already submitted
+func (s *monthService) withInstallmentObligations(ctx context.Context, userID uuid.UUID, inputs []dto.InitConstraintInput, month *domain.Month) []dto.InitConstraintInput {
+	if s.installmentRepo == nil {
+		return inputs
+	}
+
+	status := installmentDomain.PlanStatusActive
+	plans, err := s.installmentRepo.ListByUserID(ctx, userID, &status)
+	if err != nil {
+		s.logger.Warn("Failed to load installment plans for DSS inputs", zap.Error(err))
+		return inputs
+	}
+
+	submitted := make(map[string]bool, len(inputs))
+	for _, input := range inputs {
+		submitted[input.ID] = true
+	}
+
+	startDate, endDate := CalculateMonthBoundaries(month.Month)
+	result := inputs
+	for i := range plans {
+		plan := &plans[i]
+		if submitted[plan.ID.String()] {
+			continue
+		}
+
+		amount := 0.0
+		for _, charge := range plan.ChargesBetween(startDate, endDate) {
+			amount += charge.Amount
+		}
+		if amount <= 0 {
+			continue
+		}
+
+		result = append(result, dto.InitConstraintInput{
+			ID:            plan.ID.String(),
+			Name:          "Installment: " + plan.Name,
+			CategoryID:    plan.CategoryID,
+			MinimumAmount: amount,
+			MaximumAmount: amount,
+			IsFlexible:    false,
+		})
+	}
+	return result
+}
+
+// recordedRateSchedule lists the rate changes ahead of a recorded debt over the planning horizon,
+// or nil when the debt has no rate schedule
+func (s *monthService) recordedRateSchedule(ctx context.Context, debt *debtDomain.Debt, now time.Time) []dto.InitRatePeriod {
+	periods, err := s.debtRepo.FindRatePeriodsByDebtID(ctx, debt.ID)
+	if err != nil || len(periods) == 0 {
+		return nil
+	}
+
+	var references []debtDomain.ReferenceRate
+	if indexes := debtDomain.NewRateSchedule(periods, nil).Indexes(); len(indexes) > 0 {
+		references, err = s.debtRepo.FindReferenceRates(ctx, debt.UserID, indexes)
+		if err != nil {
+			s.logger.Warn("Failed to load reference rates for DSS inputs", zap.String("debt_id", debt.ID.String()), zap.Error(err))
+		}
+	}
+
+	steps := debtDomain.NewRateSchedule(periods, references).Steps(now, debtRateHorizonMonths, debt.InterestRate)
+	schedule := make([]dto.InitRatePeriod, len(steps))
+	for i, step := range steps {
+		schedule[i] = dto.InitRatePeriod{
+			FromMonth: step.FromMonth,
+			Rate:      step.Rate,
+			Floating:  step.Floating,
+			RateFloor: step.RateFloor,
+			RateCap:   step.RateCap,
+		}
+	}
+	return schedule
+}
+
+// toStrategyRateSchedule converts DSS rate periods (%) to debt strategy rate periods (decimal)
+func toStrategyRateSchedule(periods []dto.InitRatePeriod) []debtStrategyDomain.RatePeriod {
+	if len(periods) == 0 {
+		return nil
+	}
+	schedule := make([]debtStrategyDomain.RatePeriod, len(periods))
+	for i, p := range periods {
+		schedule[i] = debtStrategyDomain.RatePeriod{
+			FromMonth: p.FromMonth,
+			Rate:      p.Rate / 100,
+			Floating:  p.Floating,
+			RateFloor: percentToDecimal(p.RateFloor),
+			RateCap:   percentToDecimal(p.RateCap),
+		}
+	}
+	return schedule
+}
+
+func percentToDecimal(v *float64) *float64 {
+	if v == nil {
+		return nil
+	}
+	d := *v / 100
+	return &d
+}
+
 // ==================== Step 0: Auto-Scoring Preview ====================
 
 // PreviewAutoScoring runs goal auto-scoring using cached input
@@ -209,8 +362,8 @@ func (s *monthService) PreviewAutoScoring(ctx context.Context, req dto.PreviewAu
 	if err != nil {
 		return nil, fmt.Errorf("failed to load month: %w", err)
 	}
-	if userID != nil && month.UserID != *userID {
-		return nil, errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
 	}
 
 	// 2. GET CACHED STATE (requires Initialize first)
@@ -220,19 +373,7 @@ func (s *monthService) PreviewAutoScoring(ctx context.Context, req dto.PreviewAu
 	}
 
 	// 3. Convert cached goals to service input format
-	goals := make([]goalDto.GoalForRating, 0, len(cachedState.InputGoals))
-	for _, g := range cachedState.InputGoals {
-		targetDate, _ := time.Parse("2006-01-02", g.TargetDate)
-		goals = append(goals, goalDto.GoalForRating{
-			ID:            g.ID,
-			Name:          g.Name,
-			TargetAmount:  g.TargetAmount,
-			CurrentAmount: g.CurrentAmount,
-			TargetDate:    targetDate,
-			Type:          g.Type,
-			Priority:      g.Priority,
-		})
-	}
+	goals := toGoalsForRating(cachedState.InputGoals)
 
 	// 4. Call Goal Service with cached data
 	// Nếu user cung cấp goal_allocation_pct, adjust MonthlyIncome để phản ánh budget available cho goals
@@ -270,8 +411,8 @@ func (s *monthService) PreviewGoalPrioritization(ctx context.Context, req dto.Pr
 	if err != nil {
 		return nil, fmt.Errorf("failed to load month: %w", err)
 	}
-	if userID != nil && month.UserID != *userID {
-		return nil, errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
 	}
 
 	// 2. GET CACHED STATE (requires Initialize first)
@@ -457,8 +598,8 @@ func (s *monthService) ApplyGoalPrioritization(ctx context.Context, req dto.Appl
 	if err != nil {
 		return fmt.Errorf("failed to load month: %w", err)
 	}
-	if userID != nil && month.UserID != *userID {
-		return errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return err
 	}
 	if !month.CanBeModified() {
 		return errors.New("cannot modify closed month")
@@ -488,6 +629,51 @@ func (s *monthService) ApplyGoalPrioritization(ctx context.Context, req dto.Appl
 	return nil
 }
 
+// PreviewGoalSensitivity analyzes how robust the cached Step 1 ranking is to criteria weight changes
+func (s *monthService) PreviewGoalSensitivity(ctx context.Context, monthID uuid.UUID, userID *uuid.UUID) (*dto.PreviewGoalSensitivityResponse, error) {
+	// 1. Validate month ownership
+	month, err := s.repo.GetMonthByID(ctx, monthID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load month: %w", err)
+	}
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
+	}
+
+	// 2. Read the Step 1 preview from cache
+	cachedState, err := s.dssCache.GetOrInitState(ctx, monthID, *userID)
+	if err != nil {
+		return nil, err
+	}
+	if cachedState.GoalPrioritizationPreview == nil {
+		return nil, shared.ErrBadRequest.WithDetails("step", "run goal prioritization preview first")
+	}
+
+	// Cached previews come back from Redis as generic JSON
+	var preview dto.PreviewGoalPrioritizationResponse
+	raw, err := json.Marshal(cachedState.GoalPrioritizationPreview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read goal prioritization preview: %w", err)
+	}
+	if err := json.Unmarshal(raw, &preview); err != nil {
+		return nil, fmt.Errorf("failed to read goal prioritization preview: %w", err)
+	}
+	if preview.AHPOutput == nil || len(preview.Ranking) == 0 {
+		return nil, shared.ErrBadRequest.WithDetails("step", "goal prioritization preview has no ranked goals")
+	}
+
+	// 3. Run sensitivity analysis
+	analysis, err := s.goalPrioritization.AnalyzeSensitivity(ctx, preview.AHPOutput)
+	if err != nil {
+		return nil, fmt.Errorf("sensitivity analysis failed: %w", err)
+	}
+
+	return &dto.PreviewGoalSensitivityResponse{
+		MonthID:           monthID,
+		SensitivityOutput: analysis,
+	}, nil
+}
+
 // ==================== Step 2: Debt Strategy ====================
 
 // PreviewDebtStrategy runs debt repayment simulations using cached input
@@ -497,8 +683,8 @@ func (s *monthService) PreviewDebtStrategy(ctx context.Context, req dto.PreviewD
 	if err != nil {
 		return nil, fmt.Errorf("failed to load month: %w", err)
 	}
-	if userID != nil && month.UserID != *userID {
-		return nil, errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
 	}
 
 	// 2. GET CACHED STATE (requires Initialize first)
@@ -532,6 +718,7 @@ func (s *monthService) PreviewDebtStrategy(ctx context.Context, req dto.PreviewD
 				InterestRate:   d.InterestRate / 100, // Convert to decimal
 				MinimumPayment: d.MinimumPayment,
 				Behavior:       behavior, // "revolving", "installment", "interest_only"
+				RateSchedule:   toStrategyRateSchedule(d.RateSchedule),
 			})
 			revolvingMinPayments += d.MinimumPayment
 		} else if behavior == "installment" || behavior == "interest_only" {
@@ -843,8 +1030,8 @@ func (s *monthService) ApplyDebtStrategy(ctx context.Context, req dto.ApplyDebtS
 	if err != nil {
 		return fmt.Errorf("failed to load month: %w", err)
 	}
-	if userID != nil && month.UserID != *userID {
-		return errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return err
 	}
 	if !month.CanBeModified() {
 		return errors.New("cannot modify closed month")
@@ -883,8 +1070,8 @@ func (s *monthService) PreviewBudgetAllocation(ctx context.Context, req dto.Prev
 	if err != nil {
 		return nil, fmt.Errorf("failed to load month: %w", err)
 	}
-	if userID != nil && month.UserID != *userID {
-		return nil, errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
 	}
 
 	// 2. GET CACHED STATE (requires Initialize first)
@@ -1139,6 +1326,13 @@ func (s *monthService) PreviewBudgetAllocation(ctx context.Context, req dto.Prev
 			// Apply goal allocation percentage (adjust suggested contribution)
 			suggestedContribution = suggestedContribution * goalAllocationPct
 
+			// Sinking funds: the monthly set-aside is a hard constraint, not scaled by the goal allocation
+			var fixedContribution float64
+			if g.MonthlySetAside > 0 {
+				fixedContribution = math.Min(g.MonthlySetAside, remaining)
+				suggestedContribution = fixedContribution
+			}
+
 			allocationInput.Goals = append(allocationInput.Goals, budgetAllocationService.GoalInput{
 				GoalID:                uuid.MustParse(g.ID),
 				Name:                  g.Name,
@@ -1146,6 +1340,7 @@ func (s *monthService) PreviewBudgetAllocation(ctx context.Context, req dto.Prev
 				Priority:              g.Priority,
 				RemainingAmount:       remaining,
 				SuggestedContribution: suggestedContribution,
+				FixedContribution:     fixedContribution,
 			})
 		}
 	}
@@ -1266,8 +1461,8 @@ func (s *monthService) GetDSSWorkflowStatus(ctx context.Context, monthID uuid.UU
 	}
 
 	// 2. Validate ownership
-	if userID != nil && month.UserID != *userID {
-		return nil, errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
 	}
 
 	// 3. Get current state
@@ -1307,8 +1502,8 @@ func (s *monthService) ResetDSSWorkflow(ctx context.Context, req dto.ResetDSSWor
 	}
 
 	// 2. Validate ownership
-	if userID != nil && month.UserID != *userID {
-		return errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return err
 	}
 
 	// 3. Validate month is open
@@ -1368,8 +1563,8 @@ func (s *monthService) FinalizeDSS(ctx context.Context, req dto.FinalizeDSSReque
 	if err != nil {
 		return nil, fmt.Errorf("failed to load month: %w", err)
 	}
-	if userID != nil && month.UserID != *userID {
-		return nil, errors.New("unauthorized: month does not belong to user")
+	if err := s.authorizeMonth(ctx, month, userID); err != nil {
+		return nil, err
 	}
 	if !month.CanBeModified() {
 		return nil, errors.New("cannot modify closed month")
@@ -1786,3 +1981,20 @@ func (s *monthService) FinalizeDSS(ctx context.Context, req dto.FinalizeDSSReque
 
 	return response, nil
 }
+
+// authorizeMonth checks that a user owns a month or edits it through a shared household
+func (s *monthService) authorizeMonth(ctx context.Context, month *domain.Month, userID *uuid.UUID) error {
+	if userID == nil || month.UserID == *userID {
+		return nil
+	}
+	if month.WorkspaceID != nil {
+		canEdit, err := s.repo.CanEditMonth(ctx, month.ID, *userID)
+		if err != nil {
+			return fmt.Errorf("failed to check month access: %w", err)
+		}
+		if canEdit {
+			return nil
+		}
+	}
+	return errors.New("unauthorized: month does not belong to user")
+}
@@ -30,6 +30,23 @@ type MonthDSSWorkflowHandler interface {
 	// ApplyGoalPrioritization saves the user's accepted goal ranking to MonthState
 	ApplyGoalPrioritization(ctx context.Context, req dto.ApplyGoalPrioritizationRequest, userID *uuid.UUID) error
 
+	// PreviewGoalSensitivity shows how much each criterion weight can change before the top goal flips
+	PreviewGoalSensitivity(ctx context.Context, monthID uuid.UUID, userID *uuid.UUID) (*dto.PreviewGoalSensitivityResponse, error)
+
+	// ===== Step 1 (Group): Household Goal Prioritization =====
+
+	// StartGroupPrioritization opens a session where household members submit their own judgments
+	StartGroupPrioritization(ctx context.Context, req dto.StartGroupPrioritizationRequest, userID *uuid.UUID) (*dto.GroupPrioritizationSessionResponse, error)
+
+	// GetGroupPrioritization returns a group session to one of its participants
+	GetGroupPrioritization(ctx context.Context, sessionID uuid.UUID, userID *uuid.UUID) (*dto.GroupPrioritizationSessionResponse, error)
+
+	// SubmitGroupJudgment records the user's pairwise comparisons or direct ratings in a group session
+	SubmitGroupJudgment(ctx context.Context, sessionID uuid.UUID, req dto.SubmitGroupJudgmentRequest, userID *uuid.UUID) (*dto.GroupPrioritizationSessionResponse, error)
+
+	// PreviewGroupPrioritization aggregates the judgments and stores the ranking as the Step 1 preview
+	PreviewGroupPrioritization(ctx context.Context, req dto.PreviewGroupPrioritizationRequest, userID *uuid.UUID) (*dto.PreviewGroupPrioritizationResponse, error)
+
 	// ===== Step 2: Debt Strategy =====
 
 	// PreviewDebtStrategy runs debt repayment simulations and returns scenarios
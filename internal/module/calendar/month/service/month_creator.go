@@ -109,6 +109,9 @@ func (s *monthService) CloseMonth(ctx context.Context, userID uuid.UUID, monthSt
 	if err != nil {
 		return fmt.Errorf("failed to close month: %w", err)
 	}
+	if err := s.recordDebtPayments(ctx, userID, month, snapshot); err != nil {
+		return err
+	}
 
 	if err := s.repo.UpdateMonth(ctx, month); err != nil {
 		return fmt.Errorf("failed to update month: %w", err)
@@ -120,6 +123,8 @@ func (s *monthService) CloseMonth(ctx context.Context, userID uuid.UUID, monthSt
 		zap.Float64("total_spent", snapshot.TotalSpent),
 		zap.Float64("net_savings", snapshot.NetSavings),
 		zap.Int("overspent_count", len(snapshot.OverspentCategories)),
+		zap.Float64("debt_paid", snapshot.TotalDebtPaid),
+		zap.Float64("interest_paid", snapshot.TotalInterestPaid),
 	)
 
 	// Auto-create next month with carryover data
@@ -164,3 +169,38 @@ func (s *monthService) autoCreateNextMonth(ctx context.Context, closedMonth *dom
 
 	return nil
 }
+
+// recordDebtPayments fills the closed snapshot's debt progress from the payments recorded
+// against the user's debts during the month
+func (s *monthService) recordDebtPayments(ctx context.Context, userID uuid.UUID, month *domain.Month, snapshot *domain.MonthClosedSnapshot) error {
+	if s.debtRepo == nil {
+		return nil
+	}
+
+	payments, err := s.debtRepo.FindPaymentsByUserInRange(ctx, userID, month.StartDate, month.EndDate)
+	if err != nil {
+		return fmt.Errorf("failed to load debt payments: %w", err)
+	}
+
+	if len(payments) == 0 {
+		return nil
+	}
+
+	// Repayments of money the user lent are received, not paid
+	receivables, err := s.debtRepo.FindReceivablesByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load receivables: %w", err)
+	}
+	lent := make(map[uuid.UUID]bool, len(receivables))
+	for _, r := range receivables {
+		lent[r.ID] = true
+	}
+
+	for _, p := range payments {
+		if lent[p.DebtID] {
+			continue
+		}
+		snapshot.RecordDebtPayment(p.DebtID, p.Amount, p.InterestPortion, p.PrincipalPortion, p.BalanceAfter <= 0)
+	}
+	return nil
+}
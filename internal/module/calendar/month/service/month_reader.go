@@ -38,6 +38,7 @@ func (s *monthService) GetMonth(ctx context.Context, userID uuid.UUID, monthStr
 	response := &dto.MonthViewResponse{
 		MonthID:      monthEntity.ID,
 		UserID:       monthEntity.UserID,
+		WorkspaceID:  monthEntity.WorkspaceID,
 		Month:        monthEntity.Month,
 		StartDate:    monthEntity.StartDate,
 		EndDate:      monthEntity.EndDate,
@@ -236,6 +237,7 @@ func (s *monthService) ListMonths(ctx context.Context, userID uuid.UUID) ([]*dto
 		response = append(response, &dto.MonthResponse{
 			MonthID:      month.ID,
 			UserID:       month.UserID,
+			WorkspaceID:  month.WorkspaceID,
 			Month:        month.Month,
 			StartDate:    month.StartDate,
 			EndDate:      month.EndDate,
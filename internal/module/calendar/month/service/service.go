@@ -13,7 +13,9 @@ import (
 	// External services
 	budgetService "personalfinancedss/internal/module/cashflow/budget/service"
 	categoryservice "personalfinancedss/internal/module/cashflow/category/service"
+	debtRepository "personalfinancedss/internal/module/cashflow/debt/repository"
 	incomeprofilerepo "personalfinancedss/internal/module/cashflow/income_profile/repository"
+	installmentRepository "personalfinancedss/internal/module/cashflow/installment/repository"
 
 	// Analytics services
 	budgetAllocationService "personalfinancedss/internal/module/analytics/budget_allocation/service"
@@ -62,6 +64,8 @@ type monthService struct {
 	repo              repository.Repository
 	categoryService   categoryservice.Service
 	incomeProfileRepo incomeprofilerepo.Repository
+	debtRepo          debtRepository.Repository
+	installmentRepo   installmentRepository.Repository
 	budgetService     budgetService.Service
 	logger            *zap.Logger
 
@@ -82,6 +86,8 @@ func NewService(
 	repo repository.Repository,
 	categoryService categoryservice.Service,
 	incomeProfileRepo incomeprofilerepo.Repository,
+	debtRepo debtRepository.Repository,
+	installmentRepo installmentRepository.Repository,
 	budgetService budgetService.Service,
 	goalPrioritization goalService.Service,
 	debtStrategy debtStrategyService.Service,
@@ -95,6 +101,8 @@ func NewService(
 		repo:               repo,
 		categoryService:    categoryService,
 		incomeProfileRepo:  incomeProfileRepo,
+		debtRepo:           debtRepo,
+		installmentRepo:    installmentRepo,
 		budgetService:      budgetService,
 		goalPrioritization: goalPrioritization,
 		debtStrategy:       debtStrategy,
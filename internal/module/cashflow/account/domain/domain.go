@@ -12,6 +12,8 @@ import (
 type Account struct {
 	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
 	UserID uuid.UUID `gorm:"type:uuid;not null;column:user_id" json:"userId"`
+	// WorkspaceID is the household the account is shared into; nil keeps it personal
+	WorkspaceID *uuid.UUID `gorm:"type:uuid;index;column:workspace_id" json:"workspaceId,omitempty"`
 
 	AccountName     string      `gorm:"type:varchar(255);not null;column:account_name" json:"accountName"`
 	AccountType     AccountType `gorm:"type:varchar(50);not null;column:account_type" json:"accountType"`
@@ -6,4 +6,5 @@ type ListAccountsFilter struct {
 	IsActive       *bool
 	IsPrimary      *bool
 	IncludeDeleted bool
+	OwnedOnly      bool // Excludes accounts other household members shared
 }
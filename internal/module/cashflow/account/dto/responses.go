@@ -10,6 +10,7 @@ import (
 type AccountResponse struct {
 	ID                  string     `json:"id"`
 	UserID              string     `json:"userId"`
+	WorkspaceID         *string    `json:"workspaceId,omitempty"`
 	AccountName         string     `json:"accountName"`
 	AccountType         string     `json:"accountType"`
 	InstitutionName     *string    `json:"institutionName,omitempty"`
@@ -35,9 +36,16 @@ func ToResponse(account domain.Account) AccountResponse {
 		syncStatus = &status
 	}
 
+	var workspaceID *string
+	if account.WorkspaceID != nil {
+		id := account.WorkspaceID.String()
+		workspaceID = &id
+	}
+
 	return AccountResponse{
 		ID:                  account.ID.String(),
 		UserID:              account.UserID.String(),
+		WorkspaceID:         workspaceID,
 		AccountName:         account.AccountName,
 		AccountType:         string(account.AccountType),
 		InstitutionName:     account.InstitutionName,
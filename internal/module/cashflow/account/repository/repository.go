@@ -11,7 +11,13 @@ import (
 // Repository defines data access methods for accounts.
 type Repository interface {
 	GetByID(ctx context.Context, id string) (*domain.Account, error)
+	// GetByIDWithTx retrieves and locks an account within an existing database transaction
+	GetByIDWithTx(tx *gorm.DB, id string) (*domain.Account, error)
+	// GetByIDAndUserID retrieves an account the user owns or can see through a household
 	GetByIDAndUserID(ctx context.Context, id, userID string) (*domain.Account, error)
+	// GetEditableByIDAndUserID retrieves an account the user owns or edits through a household
+	GetEditableByIDAndUserID(ctx context.Context, id, userID string) (*domain.Account, error)
+	// ListByUserID lists the user's accounts and, unless filters.OwnedOnly, those shared with them
 	ListByUserID(ctx context.Context, userID string, filters domain.ListAccountsFilter) ([]domain.Account, error)
 	Create(ctx context.Context, account *domain.Account) error
 	Update(ctx context.Context, account *domain.Account) error
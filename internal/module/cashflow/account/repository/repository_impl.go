@@ -5,9 +5,12 @@ import (
 	"errors"
 
 	"personalfinancedss/internal/module/cashflow/account/domain"
+	householddomain "personalfinancedss/internal/module/identify/household/domain"
+	householdrepo "personalfinancedss/internal/module/identify/household/repository"
 	"personalfinancedss/internal/shared"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type gormRepository struct {
@@ -34,10 +37,30 @@ func (r *gormRepository) GetByID(ctx context.Context, id string) (*domain.Accoun
 	return &account, nil
 }
 
+func (r *gormRepository) GetByIDWithTx(tx *gorm.DB, id string) (*domain.Account, error) {
+	var account domain.Account
+	if err := base(tx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&account, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &account, nil
+}
+
 func (r *gormRepository) GetByIDAndUserID(ctx context.Context, id, userID string) (*domain.Account, error) {
+	return r.first(ctx, householdrepo.VisibleTo(userID, householddomain.ResourceAccount), id)
+}
+
+func (r *gormRepository) GetEditableByIDAndUserID(ctx context.Context, id, userID string) (*domain.Account, error) {
+	return r.first(ctx, householdrepo.EditableBy(userID), id)
+}
+
+// first loads an account within an access scope
+func (r *gormRepository) first(ctx context.Context, scope func(*gorm.DB) *gorm.DB, id string) (*domain.Account, error) {
 	var account domain.Account
-	if err := base(r.db).WithContext(ctx).
-		First(&account, "id = ? AND user_id = ?", id, userID).Error; err != nil {
+	if err := base(r.db).WithContext(ctx).Scopes(scope).
+		First(&account, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, shared.ErrNotFound
 		}
@@ -51,7 +74,7 @@ func (r *gormRepository) ListByUserID(ctx context.Context, userID string, filter
 	query := r.applyFilters(base(r.db), filters)
 
 	if err := query.WithContext(ctx).
-		Where("user_id = ?", userID).
+		Scopes(ownerScope(userID, filters)).
 		Order("created_at DESC").
 		Find(&accounts).Error; err != nil {
 		return nil, err
@@ -65,13 +88,21 @@ func (r *gormRepository) CountByUserID(ctx context.Context, userID string, filte
 
 	if err := query.WithContext(ctx).
 		Model(&domain.Account{}).
-		Where("user_id = ?", userID).
+		Scopes(ownerScope(userID, filters)).
 		Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return count, nil
 }
 
+// ownerScope limits a listing to the user's own accounts, plus shared ones unless OwnedOnly is set
+func ownerScope(userID string, filters domain.ListAccountsFilter) func(*gorm.DB) *gorm.DB {
+	if filters.OwnedOnly {
+		return func(db *gorm.DB) *gorm.DB { return db.Where("user_id = ?", userID) }
+	}
+	return householdrepo.VisibleTo(userID, householddomain.ResourceAccount)
+}
+
 func (r *gormRepository) applyFilters(db *gorm.DB, filters domain.ListAccountsFilter) *gorm.DB {
 	q := db
 	if filters.AccountType != nil {
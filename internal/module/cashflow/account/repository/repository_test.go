@@ -62,8 +62,8 @@ func TestCountByUserID(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"count"}).AddRow(5)
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "accounts" WHERE deleted_at IS NULL AND user_id = $1`)).
-			WithArgs(userID.String()).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "accounts" WHERE deleted_at IS NULL AND (user_id = $1 OR workspace_id IN (SELECT household_id FROM household_members WHERE user_id = $2 AND role IN ($3,$4,$5)))`)).
+			WithArgs(userID.String(), userID.String(), "owner", "editor", "viewer").
 			WillReturnRows(rows)
 
 		count, err := repo.CountByUserID(ctx, userID.String(), domain.ListAccountsFilter{})
@@ -86,8 +86,8 @@ func TestCountByUserID(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "accounts" WHERE deleted_at IS NULL AND is_active = $1 AND user_id = $2`)).
-			WithArgs(isActive, userID.String()).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "accounts" WHERE deleted_at IS NULL AND is_active = $1 AND (user_id = $2 OR workspace_id IN (SELECT household_id FROM household_members WHERE user_id = $3 AND role IN ($4,$5,$6)))`)).
+			WithArgs(isActive, userID.String(), userID.String(), "owner", "editor", "viewer").
 			WillReturnRows(rows)
 
 		count, err := repo.CountByUserID(ctx, userID.String(), filters)
@@ -97,18 +97,37 @@ func TestCountByUserID(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("zero count", func(t *testing.T) {
+	t.Run("owned only excludes shared accounts", func(t *testing.T) {
 		db, mock, cleanup := setupTestDB(t)
 		defer cleanup()
 
 		repo := New(db)
 
-		rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "accounts" WHERE deleted_at IS NULL AND user_id = $1`)).
 			WithArgs(userID.String()).
 			WillReturnRows(rows)
 
+		count, err := repo.CountByUserID(ctx, userID.String(), domain.ListAccountsFilter{OwnedOnly: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("zero count", func(t *testing.T) {
+		db, mock, cleanup := setupTestDB(t)
+		defer cleanup()
+
+		repo := New(db)
+
+		rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
+
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "accounts" WHERE deleted_at IS NULL AND (user_id = $1 OR workspace_id IN (SELECT household_id FROM household_members WHERE user_id = $2 AND role IN ($3,$4,$5)))`)).
+			WithArgs(userID.String(), userID.String(), "owner", "editor", "viewer").
+			WillReturnRows(rows)
+
 		count, err := repo.CountByUserID(ctx, userID.String(), domain.ListAccountsFilter{})
 
 		require.NoError(t, err)
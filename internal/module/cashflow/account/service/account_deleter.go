@@ -8,7 +8,7 @@ import (
 
 // DeleteAccount soft deletes an account
 func (s *accountService) DeleteAccount(ctx context.Context, id, userID string) error {
-	if _, err := s.repo.GetByIDAndUserID(ctx, id, userID); err != nil {
+	if _, err := s.repo.GetEditableByIDAndUserID(ctx, id, userID); err != nil {
 		if err == shared.ErrNotFound {
 			return err
 		}
@@ -14,7 +14,7 @@ import (
 
 // UpdateAccount updates an existing account
 func (s *accountService) UpdateAccount(ctx context.Context, id, userID string, req accountdto.UpdateAccountRequest) (*domain.Account, error) {
-	account, err := s.repo.GetByIDAndUserID(ctx, id, userID)
+	account, err := s.repo.GetEditableByIDAndUserID(ctx, id, userID)
 	if err != nil {
 		if err == shared.ErrNotFound {
 			return nil, err
@@ -94,7 +94,7 @@ func (s *accountService) UpdateAccount(ctx context.Context, id, userID string, r
 
 // unsetPrimaryAccount removes primary flag from all user accounts
 func (s *accountService) unsetPrimaryAccount(ctx context.Context, userID string) error {
-	accounts, err := s.repo.ListByUserID(ctx, userID, domain.ListAccountsFilter{IsPrimary: boolPtr(true)})
+	accounts, err := s.repo.ListByUserID(ctx, userID, domain.ListAccountsFilter{IsPrimary: boolPtr(true), OwnedOnly: true})
 	if err != nil {
 		return shared.ErrInternal.WithError(err)
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // MockRepository is a mock implementation of repository.Repository
@@ -30,6 +31,14 @@ func (m *MockRepository) GetByID(ctx context.Context, id string) (*domain.Accoun
 	return args.Get(0).(*domain.Account), args.Error(1)
 }
 
+func (m *MockRepository) GetByIDWithTx(tx *gorm.DB, id string) (*domain.Account, error) {
+	args := m.Called(tx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Account), args.Error(1)
+}
+
 func (m *MockRepository) GetByIDAndUserID(ctx context.Context, id, userID string) (*domain.Account, error) {
 	args := m.Called(ctx, id, userID)
 	if args.Get(0) == nil {
@@ -38,6 +47,14 @@ func (m *MockRepository) GetByIDAndUserID(ctx context.Context, id, userID string
 	return args.Get(0).(*domain.Account), args.Error(1)
 }
 
+func (m *MockRepository) GetEditableByIDAndUserID(ctx context.Context, id, userID string) (*domain.Account, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Account), args.Error(1)
+}
+
 func (m *MockRepository) ListByUserID(ctx context.Context, userID string, filters domain.ListAccountsFilter) ([]domain.Account, error) {
 	args := m.Called(ctx, userID, filters)
 	if args.Get(0) == nil {
@@ -190,7 +207,7 @@ func TestCreateAccount(t *testing.T) {
 			IsPrimary:   true,
 		}
 
-		mockRepo.On("ListByUserID", ctx, userID, domain.ListAccountsFilter{IsPrimary: &isPrimary}).Return([]domain.Account{}, nil)
+		mockRepo.On("ListByUserID", ctx, userID, domain.ListAccountsFilter{IsPrimary: &isPrimary, OwnedOnly: true}).Return([]domain.Account{}, nil)
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Account")).Return(nil)
 		mockRepo.On("GetByIDAndUserID", ctx, mock.AnythingOfType("string"), userID).Return(createdAccount, nil)
 
@@ -482,7 +499,7 @@ func TestUpdateAccount(t *testing.T) {
 			AccountType: domain.AccountTypeCash,
 		}
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil).Once()
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil).Once()
 		mockRepo.On("UpdateColumns", ctx, accountID, map[string]any{
 			"account_name": newName,
 		}).Return(nil)
@@ -524,7 +541,7 @@ func TestUpdateAccount(t *testing.T) {
 			IsActive:       false,
 		}
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil).Once()
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil).Once()
 		mockRepo.On("UpdateColumns", ctx, accountID, mock.AnythingOfType("map[string]interface {}")).Return(nil)
 		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(updatedAccount, nil).Once()
 
@@ -555,8 +572,8 @@ func TestUpdateAccount(t *testing.T) {
 			IsPrimary: true,
 		}
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil).Once()
-		mockRepo.On("ListByUserID", ctx, userID, domain.ListAccountsFilter{IsPrimary: &isPrimary}).Return([]domain.Account{}, nil)
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil).Once()
+		mockRepo.On("ListByUserID", ctx, userID, domain.ListAccountsFilter{IsPrimary: &isPrimary, OwnedOnly: true}).Return([]domain.Account{}, nil)
 		mockRepo.On("UpdateColumns", ctx, accountID, map[string]any{"is_primary": true}).Return(nil)
 		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(updatedAccount, nil).Once()
 
@@ -577,7 +594,7 @@ func TestUpdateAccount(t *testing.T) {
 
 		req := accountdto.UpdateAccountRequest{}
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil).Once()
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil).Once()
 
 		result, err := svc.UpdateAccount(ctx, accountID, userID, req)
 
@@ -594,7 +611,7 @@ func TestUpdateAccount(t *testing.T) {
 			AccountName: &newName,
 		}
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(nil, shared.ErrNotFound)
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(nil, shared.ErrNotFound)
 
 		result, err := svc.UpdateAccount(ctx, accountID, userID, req)
 
@@ -617,7 +634,7 @@ func TestUpdateAccount(t *testing.T) {
 			AccountType: &invalidType,
 		}
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil)
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil)
 
 		result, err := svc.UpdateAccount(ctx, accountID, userID, req)
 
@@ -641,7 +658,7 @@ func TestDeleteAccount(t *testing.T) {
 			UserID: uuid.MustParse(userID),
 		}
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil)
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil)
 		mockRepo.On("SoftDelete", ctx, accountID).Return(nil)
 
 		err := svc.DeleteAccount(ctx, accountID, userID)
@@ -653,7 +670,7 @@ func TestDeleteAccount(t *testing.T) {
 	t.Run("account not found", func(t *testing.T) {
 		svc, mockRepo := setupService()
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(nil, shared.ErrNotFound)
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(nil, shared.ErrNotFound)
 
 		err := svc.DeleteAccount(ctx, accountID, userID)
 
@@ -665,7 +682,7 @@ func TestDeleteAccount(t *testing.T) {
 	t.Run("repository error on get", func(t *testing.T) {
 		svc, mockRepo := setupService()
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(nil, errors.New("database error"))
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(nil, errors.New("database error"))
 
 		err := svc.DeleteAccount(ctx, accountID, userID)
 
@@ -681,7 +698,7 @@ func TestDeleteAccount(t *testing.T) {
 			UserID: uuid.MustParse(userID),
 		}
 
-		mockRepo.On("GetByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil)
+		mockRepo.On("GetEditableByIDAndUserID", ctx, accountID, userID).Return(existingAccount, nil)
 		mockRepo.On("SoftDelete", ctx, accountID).Return(errors.New("database error"))
 
 		err := svc.DeleteAccount(ctx, accountID, userID)
@@ -11,6 +11,8 @@ import (
 type Budget struct {
 	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
 	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	// WorkspaceID is the household the budget is shared into; nil keeps it personal
+	WorkspaceID *uuid.UUID `gorm:"type:uuid;index;column:workspace_id" json:"workspace_id,omitempty"`
 
 	// Budget Details
 	Name        string  `gorm:"type:varchar(255);not null;column:name" json:"name"`
@@ -10,8 +10,9 @@ import (
 
 // BudgetResponse represents a budget in API responses
 type BudgetResponse struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"user_id"`
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	WorkspaceID *uuid.UUID `json:"workspace_id,omitempty"`
 
 	Name        string  `json:"name"`
 	Description *string `json:"description,omitempty"`
@@ -130,6 +131,7 @@ func ToBudgetResponse(budget *domain.Budget) *BudgetResponse {
 	return &BudgetResponse{
 		ID:               budget.ID,
 		UserID:           budget.UserID,
+		WorkspaceID:      budget.WorkspaceID,
 		Name:             budget.Name,
 		Description:      budget.Description,
 		Amount:           budget.Amount,
@@ -31,10 +31,13 @@ type Repository interface {
 	// FindByID retrieves a budget by its ID
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Budget, error)
 
-	// FindByIDAndUserID retrieves a budget by ID and verifies user ownership
+	// FindByIDAndUserID retrieves a budget the user owns or can see through a household
 	FindByIDAndUserID(ctx context.Context, id, userID uuid.UUID) (*domain.Budget, error)
 
-	// FindByUserID retrieves all budgets for a user
+	// FindEditableByIDAndUserID retrieves a budget the user owns or edits through a household
+	FindEditableByIDAndUserID(ctx context.Context, id, userID uuid.UUID) (*domain.Budget, error)
+
+	// FindByUserID retrieves a user's budgets, including those shared with them
 	FindByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Budget, error)
 
 	// FindByUserIDPaginated retrieves budgets for a user with pagination
@@ -58,7 +61,7 @@ type Repository interface {
 	// Delete soft deletes a budget
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// DeleteByIDAndUserID deletes a budget with ownership verification
+	// DeleteByIDAndUserID deletes a budget the user owns or edits through a household
 	DeleteByIDAndUserID(ctx context.Context, id, userID uuid.UUID) error
 
 	// UpdateSpentAmount updates the spent amount for a budget
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"personalfinancedss/internal/module/cashflow/budget/domain"
+	householddomain "personalfinancedss/internal/module/identify/household/domain"
+	householdrepo "personalfinancedss/internal/module/identify/household/repository"
 	"time"
 
 	"github.com/google/uuid"
@@ -38,11 +40,22 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Budget
 	return &budget, nil
 }
 
-// FindByIDAndUserID retrieves a budget by ID and verifies user ownership
+// FindByIDAndUserID retrieves a budget the user owns or can see through a household
 func (r *repository) FindByIDAndUserID(ctx context.Context, id, userID uuid.UUID) (*domain.Budget, error) {
+	return r.first(ctx, visibleTo(userID), id)
+}
+
+// FindEditableByIDAndUserID retrieves a budget the user owns or edits through a household
+func (r *repository) FindEditableByIDAndUserID(ctx context.Context, id, userID uuid.UUID) (*domain.Budget, error) {
+	return r.first(ctx, householdrepo.EditableBy(userID), id)
+}
+
+// first loads a budget within an access scope
+func (r *repository) first(ctx context.Context, scope func(*gorm.DB) *gorm.DB, id uuid.UUID) (*domain.Budget, error) {
 	var budget domain.Budget
 	err := r.db.WithContext(ctx).
-		Where("id = ? AND user_id = ?", id, userID).
+		Scopes(scope).
+		Where("id = ?", id).
 		First(&budget).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -56,7 +69,7 @@ func (r *repository) FindByIDAndUserID(ctx context.Context, id, userID uuid.UUID
 func (r *repository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Budget, error) {
 	var budgets []domain.Budget
 	err := r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
+		Scopes(visibleTo(userID)).
 		Order("created_at DESC").
 		Find(&budgets).Error
 	return budgets, err
@@ -65,7 +78,8 @@ func (r *repository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]doma
 func (r *repository) FindActiveByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Budget, error) {
 	var budgets []domain.Budget
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND status IN (?)", userID, []string{
+		Scopes(visibleTo(userID)).
+		Where("status IN (?)", []string{
 			string(domain.BudgetStatusActive),
 			string(domain.BudgetStatusWarning),
 		}).
@@ -77,7 +91,8 @@ func (r *repository) FindActiveByUserID(ctx context.Context, userID uuid.UUID) (
 func (r *repository) FindByUserIDAndCategory(ctx context.Context, userID, categoryID uuid.UUID) ([]domain.Budget, error) {
 	var budgets []domain.Budget
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND category_id = ?", userID, categoryID).
+		Scopes(visibleTo(userID)).
+		Where("category_id = ?", categoryID).
 		Order("created_at DESC").
 		Find(&budgets).Error
 	return budgets, err
@@ -86,7 +101,8 @@ func (r *repository) FindByUserIDAndCategory(ctx context.Context, userID, catego
 func (r *repository) FindByConstraintID(ctx context.Context, userID, constraintID uuid.UUID) ([]domain.Budget, error) {
 	var budgets []domain.Budget
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND constraint_id = ?", userID, constraintID).
+		Scopes(visibleTo(userID)).
+		Where("constraint_id = ?", constraintID).
 		Order("created_at DESC").
 		Find(&budgets).Error
 	return budgets, err
@@ -95,7 +111,8 @@ func (r *repository) FindByConstraintID(ctx context.Context, userID, constraintI
 func (r *repository) FindByPeriod(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) ([]domain.Budget, error) {
 	var budgets []domain.Budget
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND start_date >= ? AND (end_date IS NULL OR end_date <= ?)", userID, startDate, endDate).
+		Scopes(visibleTo(userID)).
+		Where("start_date >= ? AND (end_date IS NULL OR end_date <= ?)", startDate, endDate).
 		Order("start_date DESC").
 		Find(&budgets).Error
 	return budgets, err
@@ -162,7 +179,7 @@ func (r *repository) FindByUserIDPaginated(ctx context.Context, userID uuid.UUID
 	// Count total
 	if err := r.db.WithContext(ctx).
 		Model(&domain.Budget{}).
-		Where("user_id = ?", userID).
+		Scopes(visibleTo(userID)).
 		Count(&total).Error; err != nil {
 		return nil, err
 	}
@@ -170,7 +187,7 @@ func (r *repository) FindByUserIDPaginated(ctx context.Context, userID uuid.UUID
 	// Get paginated data
 	offset := (params.Page - 1) * params.PageSize
 	if err := r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
+		Scopes(visibleTo(userID)).
 		Order("created_at DESC").
 		Offset(offset).
 		Limit(params.PageSize).
@@ -192,10 +209,11 @@ func (r *repository) FindByUserIDPaginated(ctx context.Context, userID uuid.UUID
 	}, nil
 }
 
-// DeleteByIDAndUserID deletes a budget with ownership verification
+// DeleteByIDAndUserID deletes a budget the user owns or edits through a household
 func (r *repository) DeleteByIDAndUserID(ctx context.Context, id, userID uuid.UUID) error {
 	result := r.db.WithContext(ctx).
-		Where("id = ? AND user_id = ?", id, userID).
+		Scopes(householdrepo.EditableBy(userID)).
+		Where("id = ?", id).
 		Delete(&domain.Budget{})
 
 	if result.Error != nil {
@@ -221,3 +239,8 @@ func (r *repository) ExistsByUserIDAndName(ctx context.Context, userID uuid.UUID
 	}
 	return count > 0, nil
 }
+
+// visibleTo limits a query to budgets the user owns or that are shared with them
+func visibleTo(userID uuid.UUID) func(*gorm.DB) *gorm.DB {
+	return householdrepo.VisibleTo(userID, householddomain.ResourceBudget)
+}
@@ -61,8 +61,14 @@ func (s *budgetService) recalculateSpending(ctx context.Context, budget *domain.
 	// Calculate spent amount from transactions that have link to this budget
 	var spentAmount float64
 	query := s.db.Table("transactions").
-		Where("user_id = ?", budget.UserID).
 		Where("direction = ?", "DEBIT")
+	if budget.WorkspaceID != nil {
+		// Shared budgets count spending linked by any household member
+		query = query.Where("user_id = ? OR user_id IN (SELECT user_id FROM household_members WHERE household_id = ?)",
+			budget.UserID, *budget.WorkspaceID)
+	} else {
+		query = query.Where("user_id = ?", budget.UserID)
+	}
 	// 	Where("booking_date >= ?", budget.StartDate)
 
 	// if budget.EndDate != nil {
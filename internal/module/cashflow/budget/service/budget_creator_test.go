@@ -103,6 +103,14 @@ func (m *MockRepository) FindByIDAndUserID(ctx context.Context, id, userID uuid.
 	return args.Get(0).(*domain.Budget), args.Error(1)
 }
 
+func (m *MockRepository) FindEditableByIDAndUserID(ctx context.Context, id, userID uuid.UUID) (*domain.Budget, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Budget), args.Error(1)
+}
+
 func (m *MockRepository) FindByUserIDPaginated(ctx context.Context, userID uuid.UUID, params repository.PaginationParams) (*repository.PaginatedResult, error) {
 	args := m.Called(ctx, userID, params)
 	return args.Get(0).(*repository.PaginatedResult), args.Error(1)
@@ -10,14 +10,15 @@ import (
 
 // UpdateBudgetForUser updates a budget with ownership verification
 func (s *budgetService) UpdateBudgetForUser(ctx context.Context, budget *domain.Budget, userID uuid.UUID) error {
-	// Verify ownership first
-	existingBudget, err := s.repo.FindByIDAndUserID(ctx, budget.ID, userID)
+	// Verify the user owns the budget or edits it through a household
+	existingBudget, err := s.repo.FindEditableByIDAndUserID(ctx, budget.ID, userID)
 	if err != nil {
 		return err
 	}
 
-	// Ensure we're not changing the owner
+	// Ensure we're not changing the owner or household; sharing goes through the household API
 	budget.UserID = existingBudget.UserID
+	budget.WorkspaceID = existingBudget.WorkspaceID
 
 	// Validate and update
 	if err := s.validateBudget(budget); err != nil {
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatementConfig holds the statement cycle settings of a credit card account
+type StatementConfig struct {
+	ID        uuid.UUID  `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	AccountID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex;column:account_id" json:"account_id"` // Credit card account
+	DebtID    *uuid.UUID `gorm:"type:uuid;index;column:debt_id" json:"debt_id,omitempty"`            // Debt kept in sync with statements
+
+	// Cycle
+	ClosingDay      int `gorm:"not null;column:closing_day" json:"closing_day"`              // Day of month the statement closes (1-31)
+	DueDay          int `gorm:"not null;column:due_day" json:"due_day"`                      // Day of month payment is due (1-31)
+	GracePeriodDays int `gorm:"default:0;column:grace_period_days" json:"grace_period_days"` // Days after due date before a payment is late
+
+	// Minimum payment
+	MinPaymentFormula MinimumPaymentFormula `gorm:"type:varchar(30);not null;default:'percent_of_balance';column:min_payment_formula" json:"min_payment_formula"`
+	MinPaymentPercent float64               `gorm:"type:decimal(5,2);default:5;column:min_payment_percent" json:"min_payment_percent"` // Percent of balance (%)
+	MinPaymentAmount  float64               `gorm:"type:decimal(15,2);default:0;column:min_payment_amount" json:"min_payment_amount"`  // Floor for percent formulas, amount for fixed
+
+	// Interest
+	InterestRate float64 `gorm:"type:decimal(5,2);default:0;column:interest_rate" json:"interest_rate"` // Annual interest rate (%) on carried balances
+
+	IsActive          bool       `gorm:"default:true;column:is_active" json:"is_active"`
+	LastStatementDate *time.Time `gorm:"type:date;column:last_statement_date" json:"last_statement_date,omitempty"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for StatementConfig
+func (StatementConfig) TableName() string {
+	return "card_statement_configs"
+}
+
+// Validate checks the cycle and minimum payment settings
+func (c *StatementConfig) Validate() error {
+	if c.ClosingDay < 1 || c.ClosingDay > 31 {
+		return errors.New("closing day must be between 1 and 31")
+	}
+	if c.DueDay < 1 || c.DueDay > 31 {
+		return errors.New("due day must be between 1 and 31")
+	}
+	if c.GracePeriodDays < 0 || c.GracePeriodDays > 60 {
+		return errors.New("grace period must be between 0 and 60 days")
+	}
+	if !c.MinPaymentFormula.IsValid() {
+		return errors.New("invalid minimum payment formula")
+	}
+	if c.MinPaymentPercent < 0 || c.MinPaymentPercent > 100 {
+		return errors.New("minimum payment percent must be between 0 and 100")
+	}
+	if c.MinPaymentAmount < 0 {
+		return errors.New("minimum payment amount cannot be negative")
+	}
+	if c.MinPaymentFormula == MinPaymentFixedAmount && c.MinPaymentAmount <= 0 {
+		return errors.New("fixed minimum payment requires a positive amount")
+	}
+	if c.InterestRate < 0 {
+		return errors.New("interest rate cannot be negative")
+	}
+	return nil
+}
+
+// ClosingDateOnOrBefore returns the most recent statement closing date on or before t
+func (c *StatementConfig) ClosingDateOnOrBefore(t time.Time) time.Time {
+	t = truncateToDay(t)
+	closing := dayInMonth(t.Year(), t.Month(), c.ClosingDay)
+	if closing.After(t) {
+		prev := t.AddDate(0, 0, -t.Day()) // last day of previous month
+		closing = dayInMonth(prev.Year(), prev.Month(), c.ClosingDay)
+	}
+	return closing
+}
+
+// NextClosingDate returns the first statement closing date after t
+func (c *StatementConfig) NextClosingDate(t time.Time) time.Time {
+	t = truncateToDay(t)
+	closing := dayInMonth(t.Year(), t.Month(), c.ClosingDay)
+	if !closing.After(t) {
+		next := time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		closing = dayInMonth(next.Year(), next.Month(), c.ClosingDay)
+	}
+	return closing
+}
+
+// PreviousClosingDate returns the closing date of the cycle before the one closing on `closing`
+func (c *StatementConfig) PreviousClosingDate(closing time.Time) time.Time {
+	return c.ClosingDateOnOrBefore(closing.AddDate(0, 0, -closing.Day()))
+}
+
+// PeriodStart returns the first day of the cycle closing on `closing`
+func (c *StatementConfig) PeriodStart(closing time.Time) time.Time {
+	return c.PreviousClosingDate(closing).AddDate(0, 0, 1)
+}
+
+// DueDateFor returns the payment due date of the cycle closing on `closing`.
+// The due day falls in the same month when it is after the closing day, otherwise in the next month.
+func (c *StatementConfig) DueDateFor(closing time.Time) time.Time {
+	if c.DueDay > c.ClosingDay {
+		if due := dayInMonth(closing.Year(), closing.Month(), c.DueDay); due.After(closing) {
+			return due
+		}
+	}
+	next := time.Date(closing.Year(), closing.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	return dayInMonth(next.Year(), next.Month(), c.DueDay)
+}
+
+// MinimumDue calculates the minimum payment for a statement balance
+func (c *StatementConfig) MinimumDue(balance, interest float64) float64 {
+	if balance <= 0 {
+		return 0
+	}
+
+	var minimum float64
+	switch c.MinPaymentFormula {
+	case MinPaymentFixedAmount:
+		minimum = c.MinPaymentAmount
+	case MinPaymentPercentPlusInterest:
+		minimum = math.Max(balance*c.MinPaymentPercent/100+interest, c.MinPaymentAmount)
+	default:
+		minimum = math.Max(balance*c.MinPaymentPercent/100, c.MinPaymentAmount)
+	}
+
+	return roundMoney(math.Min(minimum, balance))
+}
+
+// dayInMonth returns the given day of the month, clamped to the month's last day
+func dayInMonth(year int, month time.Month, day int) time.Time {
+	last := time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1).Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
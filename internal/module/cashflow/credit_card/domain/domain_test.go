@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func testConfig() *StatementConfig {
+	return &StatementConfig{
+		ClosingDay:        25,
+		DueDay:            10,
+		GracePeriodDays:   3,
+		MinPaymentFormula: MinPaymentPercentOfBalance,
+		MinPaymentPercent: 5,
+		MinPaymentAmount:  50000,
+		InterestRate:      24,
+	}
+}
+
+func TestStatementConfig_Validate(t *testing.T) {
+	cfg := testConfig()
+	assert.NoError(t, cfg.Validate())
+
+	cfg.ClosingDay = 32
+	assert.Error(t, cfg.Validate())
+
+	cfg = testConfig()
+	cfg.MinPaymentFormula = MinPaymentFixedAmount
+	cfg.MinPaymentAmount = 0
+	assert.Error(t, cfg.Validate())
+}
+
+func TestStatementConfig_CycleDates(t *testing.T) {
+	cfg := testConfig()
+
+	assert.Equal(t, date(2025, 3, 25), cfg.ClosingDateOnOrBefore(date(2025, 3, 25)))
+	assert.Equal(t, date(2025, 2, 25), cfg.ClosingDateOnOrBefore(date(2025, 3, 24)))
+	assert.Equal(t, date(2025, 4, 25), cfg.NextClosingDate(date(2025, 3, 25)))
+	assert.Equal(t, date(2025, 2, 26), cfg.PeriodStart(date(2025, 3, 25)))
+	assert.Equal(t, date(2025, 4, 10), cfg.DueDateFor(date(2025, 3, 25)))
+
+	// Due day after closing day falls in the same month
+	cfg.ClosingDay = 5
+	cfg.DueDay = 20
+	assert.Equal(t, date(2025, 3, 20), cfg.DueDateFor(date(2025, 3, 5)))
+
+	// Closing day is clamped to short months
+	cfg.ClosingDay = 31
+	cfg.DueDay = 15
+	assert.Equal(t, date(2025, 2, 28), cfg.ClosingDateOnOrBefore(date(2025, 3, 10)))
+	assert.Equal(t, date(2025, 3, 1), cfg.PeriodStart(date(2025, 3, 31)))
+}
+
+func TestStatementConfig_MinimumDue(t *testing.T) {
+	cfg := testConfig()
+
+	assert.Equal(t, 500000.0, cfg.MinimumDue(10000000, 0))
+	assert.Equal(t, 50000.0, cfg.MinimumDue(200000, 0)) // floor
+	assert.Equal(t, 30000.0, cfg.MinimumDue(30000, 0))  // capped at balance
+	assert.Equal(t, 0.0, cfg.MinimumDue(0, 0))
+
+	cfg.MinPaymentFormula = MinPaymentPercentPlusInterest
+	assert.Equal(t, 700000.0, cfg.MinimumDue(10000000, 200000))
+
+	cfg.MinPaymentFormula = MinPaymentFixedAmount
+	cfg.MinPaymentAmount = 1000000
+	assert.Equal(t, 1000000.0, cfg.MinimumDue(10000000, 200000))
+}
+
+func TestBuildStatement(t *testing.T) {
+	cfg := testConfig()
+	limit := 20000000.0
+	previous := &Statement{StatementBalance: 4000000}
+
+	activity := CycleActivity{
+		Purchases:             3000000,
+		Payments:              3000000,
+		PaymentsByPreviousDue: 3000000,
+		ChargesAfterClose:     500000,
+		PaymentsAfterClose:    0,
+	}
+
+	statement := BuildStatement(cfg, date(2025, 3, 25), 4500000, activity, previous, &limit)
+
+	require.NotNil(t, statement)
+	assert.Equal(t, date(2025, 2, 26), statement.PeriodStart)
+	assert.Equal(t, date(2025, 4, 10), statement.DueDate)
+	assert.Equal(t, date(2025, 4, 13), statement.GraceEndDate)
+	assert.Equal(t, 4000000.0, statement.OpeningBalance)
+	assert.Equal(t, 4000000.0, statement.StatementBalance)
+	assert.Equal(t, 20000.0, statement.InterestCharged) // 1,000,000 carried at 24%/12
+	assert.Equal(t, 200000.0, statement.MinimumDue)
+	assert.Equal(t, 20.0, statement.Utilization)
+	assert.Equal(t, StatementStatusIssued, statement.Status)
+}
+
+func TestStatement_RefreshStatus(t *testing.T) {
+	statement := &Statement{
+		StatementBalance: 1000000,
+		MinimumDue:       100000,
+		GraceEndDate:     date(2025, 4, 13),
+	}
+
+	statement.RefreshStatus(0, date(2025, 4, 1))
+	assert.Equal(t, StatementStatusIssued, statement.Status)
+
+	statement.RefreshStatus(50000, date(2025, 4, 1))
+	assert.Equal(t, StatementStatusPartiallyPaid, statement.Status)
+
+	statement.RefreshStatus(50000, date(2025, 4, 14))
+	assert.Equal(t, StatementStatusOverdue, statement.Status)
+
+	statement.RefreshStatus(100000, date(2025, 4, 14))
+	assert.Equal(t, StatementStatusMinimumPaid, statement.Status)
+	assert.Equal(t, 900000.0, statement.RemainingDue())
+
+	statement.RefreshStatus(1000000, date(2025, 4, 14))
+	assert.Equal(t, StatementStatusPaid, statement.Status)
+}
+
+func TestUtilization(t *testing.T) {
+	limit := 10000000.0
+	assert.Equal(t, 25.0, Utilization(2500000, &limit))
+	assert.Equal(t, 0.0, Utilization(2500000, nil))
+	assert.Equal(t, 0.0, OwedFromAccountBalance(100))
+	assert.Equal(t, 300.0, OwedFromAccountBalance(-300))
+}
@@ -0,0 +1,45 @@
+package domain
+
+// MinimumPaymentFormula determines how the minimum amount due is calculated
+type MinimumPaymentFormula string
+
+const (
+	MinPaymentPercentOfBalance    MinimumPaymentFormula = "percent_of_balance"    // percent × statement balance
+	MinPaymentPercentPlusInterest MinimumPaymentFormula = "percent_plus_interest" // percent × statement balance + interest charged
+	MinPaymentFixedAmount         MinimumPaymentFormula = "fixed_amount"          // fixed amount each cycle
+)
+
+// IsValid checks if the minimum payment formula is valid
+func (f MinimumPaymentFormula) IsValid() bool {
+	switch f {
+	case MinPaymentPercentOfBalance, MinPaymentPercentPlusInterest, MinPaymentFixedAmount:
+		return true
+	}
+	return false
+}
+
+// StatementStatus represents the payment status of a statement
+type StatementStatus string
+
+const (
+	StatementStatusIssued        StatementStatus = "issued"         // Generated, nothing paid yet
+	StatementStatusPartiallyPaid StatementStatus = "partially_paid" // Paid less than the minimum due
+	StatementStatusMinimumPaid   StatementStatus = "minimum_paid"   // Minimum due covered, balance remains
+	StatementStatusPaid          StatementStatus = "paid"           // Statement balance paid in full
+	StatementStatusOverdue       StatementStatus = "overdue"        // Minimum not paid by the end of the grace period
+)
+
+// IsValid checks if the statement status is valid
+func (s StatementStatus) IsValid() bool {
+	switch s {
+	case StatementStatusIssued, StatementStatusPartiallyPaid, StatementStatusMinimumPaid,
+		StatementStatusPaid, StatementStatusOverdue:
+		return true
+	}
+	return false
+}
+
+// IsSettled reports whether no further payment is expected for the statement
+func (s StatementStatus) IsSettled() bool {
+	return s == StatementStatusPaid
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statement is a closed billing cycle of a credit card account.
+// Amounts are what the user owes, so a positive balance is debt on the card.
+type Statement struct {
+	ID        uuid.UUID  `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	AccountID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_card_statement_account_period;column:account_id" json:"account_id"`
+	DebtID    *uuid.UUID `gorm:"type:uuid;column:debt_id" json:"debt_id,omitempty"`
+
+	// Cycle
+	PeriodStart  time.Time `gorm:"type:date;not null;column:period_start" json:"period_start"`
+	PeriodEnd    time.Time `gorm:"type:date;not null;uniqueIndex:idx_card_statement_account_period;column:period_end" json:"period_end"` // Closing date
+	DueDate      time.Time `gorm:"type:date;not null;column:due_date" json:"due_date"`
+	GraceEndDate time.Time `gorm:"type:date;not null;column:grace_end_date" json:"grace_end_date"`
+
+	// Amounts
+	OpeningBalance   float64 `gorm:"type:decimal(15,2);default:0;column:opening_balance" json:"opening_balance"`
+	Purchases        float64 `gorm:"type:decimal(15,2);default:0;column:purchases" json:"purchases"`
+	Payments         float64 `gorm:"type:decimal(15,2);default:0;column:payments" json:"payments"`
+	InterestCharged  float64 `gorm:"type:decimal(15,2);default:0;column:interest_charged" json:"interest_charged"` // Estimated interest on the balance carried from the previous statement
+	StatementBalance float64 `gorm:"type:decimal(15,2);not null;column:statement_balance" json:"statement_balance"`
+	MinimumDue       float64 `gorm:"type:decimal(15,2);not null;column:minimum_due" json:"minimum_due"`
+	PaidAmount       float64 `gorm:"type:decimal(15,2);default:0;column:paid_amount" json:"paid_amount"` // Payments received after closing
+	Currency         string  `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+
+	// Utilization at closing
+	CreditLimit *float64 `gorm:"type:decimal(15,2);column:credit_limit" json:"credit_limit,omitempty"`
+	Utilization float64  `gorm:"type:decimal(7,2);default:0;column:utilization" json:"utilization"` // Percent of credit limit
+
+	Status StatementStatus `gorm:"type:varchar(20);not null;default:'issued';column:status" json:"status"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for Statement
+func (Statement) TableName() string {
+	return "card_statements"
+}
+
+// CycleActivity summarizes card transactions relative to a closing date
+type CycleActivity struct {
+	Purchases             float64 // Charges booked within the cycle
+	Payments              float64 // Payments booked within the cycle
+	PaymentsByPreviousDue float64 // Payments within the cycle up to the previous statement's due date
+	ChargesAfterClose     float64 // Charges booked after the closing date
+	PaymentsAfterClose    float64 // Payments booked after the closing date
+}
+
+// BuildStatement calculates a statement for the cycle closing on `closing`.
+//
+// currentOwed is what is owed on the card right now; activity after the closing
+// date is undone to get the balance at closing. When the previous statement was
+// not paid in full by its due date, interest on the unpaid part is estimated and
+// added to the minimum due for formulas that include interest.
+func BuildStatement(cfg *StatementConfig, closing time.Time, currentOwed float64, activity CycleActivity, previous *Statement, creditLimit *float64) *Statement {
+	closing = truncateToDay(closing)
+	due := cfg.DueDateFor(closing)
+
+	balance := math.Max(currentOwed-activity.ChargesAfterClose+activity.PaymentsAfterClose, 0)
+
+	opening := math.Max(balance-activity.Purchases+activity.Payments, 0)
+	interest := 0.0
+	if previous != nil {
+		opening = previous.StatementBalance
+		unpaid := previous.StatementBalance - activity.PaymentsByPreviousDue
+		if unpaid > 0 && cfg.InterestRate > 0 {
+			interest = roundMoney(unpaid * cfg.InterestRate / 100 / 12)
+		}
+	}
+
+	statement := &Statement{
+		UserID:           cfg.UserID,
+		AccountID:        cfg.AccountID,
+		DebtID:           cfg.DebtID,
+		PeriodStart:      cfg.PeriodStart(closing),
+		PeriodEnd:        closing,
+		DueDate:          due,
+		GraceEndDate:     due.AddDate(0, 0, cfg.GracePeriodDays),
+		OpeningBalance:   roundMoney(opening),
+		Purchases:        roundMoney(activity.Purchases),
+		Payments:         roundMoney(activity.Payments),
+		InterestCharged:  interest,
+		StatementBalance: roundMoney(balance),
+		MinimumDue:       cfg.MinimumDue(balance, interest),
+		CreditLimit:      creditLimit,
+		Utilization:      Utilization(balance, creditLimit),
+		Status:           StatementStatusIssued,
+	}
+
+	return statement
+}
+
+// RefreshStatus updates the paid amount and status as of the given time
+func (s *Statement) RefreshStatus(paid float64, asOf time.Time) {
+	s.PaidAmount = roundMoney(paid)
+
+	switch {
+	case s.PaidAmount >= s.StatementBalance:
+		s.Status = StatementStatusPaid
+	case s.PaidAmount >= s.MinimumDue:
+		s.Status = StatementStatusMinimumPaid
+	case truncateToDay(asOf).After(s.GraceEndDate):
+		s.Status = StatementStatusOverdue
+	case s.PaidAmount > 0:
+		s.Status = StatementStatusPartiallyPaid
+	default:
+		s.Status = StatementStatusIssued
+	}
+}
+
+// RemainingDue returns the part of the statement balance not yet paid
+func (s *Statement) RemainingDue() float64 {
+	return math.Max(s.StatementBalance-s.PaidAmount, 0)
+}
+
+// Utilization returns the owed amount as a percentage of the credit limit
+func Utilization(owed float64, creditLimit *float64) float64 {
+	if creditLimit == nil || *creditLimit <= 0 || owed <= 0 {
+		return 0
+	}
+	return roundMoney(owed / *creditLimit * 100)
+}
+
+// OwedFromAccountBalance converts a card account balance to the amount owed.
+// Card spending is booked as DEBIT, which drives the account balance negative.
+func OwedFromAccountBalance(balance float64) float64 {
+	if balance >= 0 {
+		return 0
+	}
+	return -balance
+}
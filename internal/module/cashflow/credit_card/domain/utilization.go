@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardUtilization is the current utilization of a single card
+type CardUtilization struct {
+	AccountID   uuid.UUID
+	AccountName string
+	Currency    string
+	CreditLimit *float64
+	Owed        float64
+	Available   *float64
+	Utilization float64
+
+	LatestStatement *Statement
+	NextDueDate     *time.Time
+}
+
+// UtilizationReport aggregates utilization across all of a user's cards.
+// Totals are expressed in Currency; cards without a credit limit are excluded from the overall percentage.
+type UtilizationReport struct {
+	Cards            []CardUtilization
+	Currency         string
+	TotalCreditLimit float64
+	TotalOwed        float64
+	Utilization      float64
+}
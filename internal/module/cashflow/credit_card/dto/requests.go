@@ -0,0 +1,19 @@
+package dto
+
+// ConfigureStatementRequest represents the statement cycle settings of a card account
+type ConfigureStatementRequest struct {
+	ClosingDay        int      `json:"closing_day" binding:"required,min=1,max=31"`
+	DueDay            int      `json:"due_day" binding:"required,min=1,max=31"`
+	GracePeriodDays   *int     `json:"grace_period_days,omitempty" binding:"omitempty,min=0,max=60"`
+	MinPaymentFormula *string  `json:"min_payment_formula,omitempty" binding:"omitempty,oneof=percent_of_balance percent_plus_interest fixed_amount"`
+	MinPaymentPercent *float64 `json:"min_payment_percent,omitempty" binding:"omitempty,min=0,max=100"`
+	MinPaymentAmount  *float64 `json:"min_payment_amount,omitempty" binding:"omitempty,min=0"`
+	InterestRate      *float64 `json:"interest_rate,omitempty" binding:"omitempty,min=0"`
+	DebtID            *string  `json:"debt_id,omitempty" binding:"omitempty,uuid"` // Existing debt to drive; one is created when omitted
+	IsActive          *bool    `json:"is_active,omitempty"`
+}
+
+// ListStatementsQuery represents query parameters for listing statements
+type ListStatementsQuery struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=120"`
+}
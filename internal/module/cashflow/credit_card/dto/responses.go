@@ -0,0 +1,177 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/credit_card/domain"
+
+	"github.com/google/uuid"
+)
+
+// StatementConfigResponse represents a statement config in API responses
+type StatementConfigResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	AccountID uuid.UUID  `json:"account_id"`
+	DebtID    *uuid.UUID `json:"debt_id,omitempty"`
+
+	ClosingDay      int `json:"closing_day"`
+	DueDay          int `json:"due_day"`
+	GracePeriodDays int `json:"grace_period_days"`
+
+	MinPaymentFormula domain.MinimumPaymentFormula `json:"min_payment_formula"`
+	MinPaymentPercent float64                      `json:"min_payment_percent"`
+	MinPaymentAmount  float64                      `json:"min_payment_amount"`
+	InterestRate      float64                      `json:"interest_rate"`
+
+	IsActive          bool       `json:"is_active"`
+	LastStatementDate *time.Time `json:"last_statement_date,omitempty"`
+	NextClosingDate   time.Time  `json:"next_closing_date"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StatementResponse represents a statement in API responses
+type StatementResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	AccountID uuid.UUID  `json:"account_id"`
+	DebtID    *uuid.UUID `json:"debt_id,omitempty"`
+
+	PeriodStart  time.Time `json:"period_start"`
+	PeriodEnd    time.Time `json:"period_end"`
+	DueDate      time.Time `json:"due_date"`
+	GraceEndDate time.Time `json:"grace_end_date"`
+
+	OpeningBalance   float64 `json:"opening_balance"`
+	Purchases        float64 `json:"purchases"`
+	Payments         float64 `json:"payments"`
+	InterestCharged  float64 `json:"interest_charged"`
+	StatementBalance float64 `json:"statement_balance"`
+	MinimumDue       float64 `json:"minimum_due"`
+	PaidAmount       float64 `json:"paid_amount"`
+	RemainingDue     float64 `json:"remaining_due"`
+	Currency         string  `json:"currency"`
+
+	CreditLimit *float64 `json:"credit_limit,omitempty"`
+	Utilization float64  `json:"utilization"`
+
+	Status    domain.StatementStatus `json:"status"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// CardUtilizationResponse represents the utilization of a single card
+type CardUtilizationResponse struct {
+	AccountID   uuid.UUID  `json:"account_id"`
+	AccountName string     `json:"account_name"`
+	Currency    string     `json:"currency"`
+	CreditLimit *float64   `json:"credit_limit,omitempty"`
+	Owed        float64    `json:"owed"`
+	Available   *float64   `json:"available,omitempty"`
+	Utilization float64    `json:"utilization"`
+	NextDueDate *time.Time `json:"next_due_date,omitempty"`
+
+	LatestStatement *StatementResponse `json:"latest_statement,omitempty"`
+}
+
+// UtilizationReportResponse represents utilization across all cards
+type UtilizationReportResponse struct {
+	Cards            []CardUtilizationResponse `json:"cards"`
+	Currency         string                    `json:"currency"`
+	TotalCreditLimit float64                   `json:"total_credit_limit"`
+	TotalOwed        float64                   `json:"total_owed"`
+	Utilization      float64                   `json:"utilization"`
+}
+
+// ToStatementConfigResponse converts a domain config to response DTO
+func ToStatementConfigResponse(config *domain.StatementConfig) *StatementConfigResponse {
+	if config == nil {
+		return nil
+	}
+
+	return &StatementConfigResponse{
+		ID:                config.ID,
+		AccountID:         config.AccountID,
+		DebtID:            config.DebtID,
+		ClosingDay:        config.ClosingDay,
+		DueDay:            config.DueDay,
+		GracePeriodDays:   config.GracePeriodDays,
+		MinPaymentFormula: config.MinPaymentFormula,
+		MinPaymentPercent: config.MinPaymentPercent,
+		MinPaymentAmount:  config.MinPaymentAmount,
+		InterestRate:      config.InterestRate,
+		IsActive:          config.IsActive,
+		LastStatementDate: config.LastStatementDate,
+		NextClosingDate:   config.NextClosingDate(time.Now()),
+		CreatedAt:         config.CreatedAt,
+		UpdatedAt:         config.UpdatedAt,
+	}
+}
+
+// ToStatementResponse converts a domain statement to response DTO
+func ToStatementResponse(statement *domain.Statement) *StatementResponse {
+	if statement == nil {
+		return nil
+	}
+
+	return &StatementResponse{
+		ID:               statement.ID,
+		AccountID:        statement.AccountID,
+		DebtID:           statement.DebtID,
+		PeriodStart:      statement.PeriodStart,
+		PeriodEnd:        statement.PeriodEnd,
+		DueDate:          statement.DueDate,
+		GraceEndDate:     statement.GraceEndDate,
+		OpeningBalance:   statement.OpeningBalance,
+		Purchases:        statement.Purchases,
+		Payments:         statement.Payments,
+		InterestCharged:  statement.InterestCharged,
+		StatementBalance: statement.StatementBalance,
+		MinimumDue:       statement.MinimumDue,
+		PaidAmount:       statement.PaidAmount,
+		RemainingDue:     statement.RemainingDue(),
+		Currency:         statement.Currency,
+		CreditLimit:      statement.CreditLimit,
+		Utilization:      statement.Utilization,
+		Status:           statement.Status,
+		CreatedAt:        statement.CreatedAt,
+	}
+}
+
+// ToStatementResponses converts domain statements to response DTOs
+func ToStatementResponses(statements []domain.Statement) []StatementResponse {
+	responses := make([]StatementResponse, len(statements))
+	for i := range statements {
+		responses[i] = *ToStatementResponse(&statements[i])
+	}
+	return responses
+}
+
+// ToUtilizationReportResponse converts a domain utilization report to response DTO
+func ToUtilizationReportResponse(report *domain.UtilizationReport) *UtilizationReportResponse {
+	if report == nil {
+		return nil
+	}
+
+	cards := make([]CardUtilizationResponse, len(report.Cards))
+	for i, card := range report.Cards {
+		cards[i] = CardUtilizationResponse{
+			AccountID:       card.AccountID,
+			AccountName:     card.AccountName,
+			Currency:        card.Currency,
+			CreditLimit:     card.CreditLimit,
+			Owed:            card.Owed,
+			Available:       card.Available,
+			Utilization:     card.Utilization,
+			NextDueDate:     card.NextDueDate,
+			LatestStatement: ToStatementResponse(card.LatestStatement),
+		}
+	}
+
+	return &UtilizationReportResponse{
+		Cards:            cards,
+		Currency:         report.Currency,
+		TotalCreditLimit: report.TotalCreditLimit,
+		TotalOwed:        report.TotalOwed,
+		Utilization:      report.Utilization,
+	}
+}
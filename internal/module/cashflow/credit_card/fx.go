@@ -0,0 +1,43 @@
+package credit_card
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/credit_card/handler"
+	"personalfinancedss/internal/module/cashflow/credit_card/repository"
+	"personalfinancedss/internal/module/cashflow/credit_card/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides credit card statement dependencies. The statement job runs on the notification
+// scheduler through the scheduled_jobs group.
+var Module = fx.Module("credit_card",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Handler
+		handler.NewHandler,
+
+		// Scheduled job
+		fx.Annotate(
+			service.NewStatementJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
+	),
+	fx.Invoke(registerCreditCardRoutes),
+)
+
+func registerCreditCardRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/credit_card/dto"
+	"personalfinancedss/internal/module/cashflow/credit_card/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages credit card statement endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs a credit card handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("credit_card.handler"),
+	}
+}
+
+// RegisterRoutes wires credit card routes under /api/v1/credit-cards.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	cards := r.Group("/api/v1/credit-cards")
+	cards.Use(authMiddleware.AuthMiddleware())
+	{
+		cards.GET("/utilization", h.getUtilization)
+		cards.GET("/:accountId/statement-config", h.getStatementConfig)
+		cards.PUT("/:accountId/statement-config", h.configureStatements)
+		cards.GET("/:accountId/statements", h.listStatements)
+		cards.POST("/:accountId/statements/generate", h.generateStatement)
+	}
+}
+
+// configureStatements godoc
+// @Summary Configure card statement cycle
+// @Description Set closing day, due day, grace period and minimum-payment formula for a credit card account. A linked credit card debt is created when none is given.
+// @Tags credit-cards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param accountId path string true "Credit card account ID"
+// @Param config body dto.ConfigureStatementRequest true "Statement cycle settings"
+// @Success 200 {object} dto.StatementConfigResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/credit-cards/{accountId}/statement-config [put]
+func (h *Handler) configureStatements(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid account id")
+		return
+	}
+
+	var req dto.ConfigureStatementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	config, err := h.service.ConfigureStatements(c.Request.Context(), currentUser.ID, accountID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Statement cycle configured successfully", dto.ToStatementConfigResponse(config))
+}
+
+// getStatementConfig godoc
+// @Summary Get card statement cycle
+// @Description Get the statement cycle settings of a credit card account
+// @Tags credit-cards
+// @Produce json
+// @Security BearerAuth
+// @Param accountId path string true "Credit card account ID"
+// @Success 200 {object} dto.StatementConfigResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/credit-cards/{accountId}/statement-config [get]
+func (h *Handler) getStatementConfig(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid account id")
+		return
+	}
+
+	config, err := h.service.GetStatementConfig(c.Request.Context(), currentUser.ID, accountID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Statement cycle retrieved successfully", dto.ToStatementConfigResponse(config))
+}
+
+// listStatements godoc
+// @Summary List card statements
+// @Description List statements of a credit card account, newest first
+// @Tags credit-cards
+// @Produce json
+// @Security BearerAuth
+// @Param accountId path string true "Credit card account ID"
+// @Param limit query int false "Maximum number of statements" default(12)
+// @Success 200 {array} dto.StatementResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/credit-cards/{accountId}/statements [get]
+func (h *Handler) listStatements(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid account id")
+		return
+	}
+
+	var query dto.ListStatementsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	statements, err := h.service.ListStatements(c.Request.Context(), currentUser.ID, accountID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Statements retrieved successfully", dto.ToStatementResponses(statements))
+}
+
+// generateStatement godoc
+// @Summary Generate card statement
+// @Description Generate the statement of the most recently closed cycle, or refresh its payment status if it already exists
+// @Tags credit-cards
+// @Produce json
+// @Security BearerAuth
+// @Param accountId path string true "Credit card account ID"
+// @Success 200 {object} dto.StatementResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/credit-cards/{accountId}/statements/generate [post]
+func (h *Handler) generateStatement(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	accountID, err := uuid.Parse(c.Param("accountId"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid account id")
+		return
+	}
+
+	statement, err := h.service.GenerateStatement(c.Request.Context(), currentUser.ID, accountID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Statement generated successfully", dto.ToStatementResponse(statement))
+}
+
+// getUtilization godoc
+// @Summary Get credit utilization
+// @Description Get utilization per credit card and across all cards (totals in VND)
+// @Tags credit-cards
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UtilizationReportResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/credit-cards/utilization [get]
+func (h *Handler) getUtilization(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	report, err := h.service.GetUtilization(c.Request.Context(), currentUser.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Credit utilization retrieved successfully", dto.ToUtilizationReportResponse(report))
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/credit_card/domain"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines data access for card statement configs and statements
+type Repository interface {
+	// CreateConfig creates a statement config
+	CreateConfig(ctx context.Context, config *domain.StatementConfig) error
+
+	// UpdateConfig saves a statement config
+	UpdateConfig(ctx context.Context, config *domain.StatementConfig) error
+
+	// GetConfigByAccountID retrieves the statement config of a card account
+	GetConfigByAccountID(ctx context.Context, accountID uuid.UUID) (*domain.StatementConfig, error)
+
+	// ListConfigsByUserID retrieves all statement configs of a user
+	ListConfigsByUserID(ctx context.Context, userID uuid.UUID) ([]domain.StatementConfig, error)
+
+	// ListActiveConfigs retrieves all active statement configs
+	ListActiveConfigs(ctx context.Context) ([]domain.StatementConfig, error)
+
+	// CreateStatement inserts a statement; returns false if one already exists for the cycle
+	CreateStatement(ctx context.Context, statement *domain.Statement) (bool, error)
+
+	// UpdateStatement saves a statement
+	UpdateStatement(ctx context.Context, statement *domain.Statement) error
+
+	// GetStatementByPeriod retrieves the statement of a card closing on the given date
+	GetStatementByPeriod(ctx context.Context, accountID uuid.UUID, periodEnd time.Time) (*domain.Statement, error)
+
+	// GetLatestStatement retrieves the most recent statement of a card
+	GetLatestStatement(ctx context.Context, accountID uuid.UUID) (*domain.Statement, error)
+
+	// ListStatements retrieves statements of a card, newest first
+	ListStatements(ctx context.Context, accountID uuid.UUID, limit int) ([]domain.Statement, error)
+}
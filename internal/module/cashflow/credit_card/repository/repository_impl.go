@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/credit_card/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new credit card statement repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateConfig(ctx context.Context, config *domain.StatementConfig) error {
+	return r.db.WithContext(ctx).Create(config).Error
+}
+
+func (r *repository) UpdateConfig(ctx context.Context, config *domain.StatementConfig) error {
+	return r.db.WithContext(ctx).Save(config).Error
+}
+
+func (r *repository) GetConfigByAccountID(ctx context.Context, accountID uuid.UUID) (*domain.StatementConfig, error) {
+	var config domain.StatementConfig
+	if err := r.db.WithContext(ctx).First(&config, "account_id = ?", accountID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (r *repository) ListConfigsByUserID(ctx context.Context, userID uuid.UUID) ([]domain.StatementConfig, error) {
+	var configs []domain.StatementConfig
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&configs).Error
+	return configs, err
+}
+
+func (r *repository) ListActiveConfigs(ctx context.Context) ([]domain.StatementConfig, error) {
+	var configs []domain.StatementConfig
+	err := r.db.WithContext(ctx).
+		Where("is_active = ?", true).
+		Find(&configs).Error
+	return configs, err
+}
+
+func (r *repository) CreateStatement(ctx context.Context, statement *domain.Statement) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "account_id"}, {Name: "period_end"}},
+			DoNothing: true,
+		}).
+		Create(statement)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *repository) UpdateStatement(ctx context.Context, statement *domain.Statement) error {
+	return r.db.WithContext(ctx).Save(statement).Error
+}
+
+func (r *repository) GetStatementByPeriod(ctx context.Context, accountID uuid.UUID, periodEnd time.Time) (*domain.Statement, error) {
+	var statement domain.Statement
+	if err := r.db.WithContext(ctx).
+		First(&statement, "account_id = ? AND period_end = ?", accountID, periodEnd).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &statement, nil
+}
+
+func (r *repository) GetLatestStatement(ctx context.Context, accountID uuid.UUID) (*domain.Statement, error) {
+	var statement domain.Statement
+	if err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID).
+		Order("period_end DESC").
+		First(&statement).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &statement, nil
+}
+
+func (r *repository) ListStatements(ctx context.Context, accountID uuid.UUID, limit int) ([]domain.Statement, error) {
+	var statements []domain.Statement
+	db := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID).
+		Order("period_end DESC")
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+	err := db.Find(&statements).Error
+	return statements, err
+}
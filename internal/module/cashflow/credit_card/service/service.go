@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	"personalfinancedss/internal/module/cashflow/credit_card/domain"
+	"personalfinancedss/internal/module/cashflow/credit_card/dto"
+	"personalfinancedss/internal/module/cashflow/credit_card/repository"
+	debtRepo "personalfinancedss/internal/module/cashflow/debt/repository"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StatementConfigurer defines statement cycle configuration operations
+type StatementConfigurer interface {
+	// ConfigureStatements creates or updates the statement cycle of a card account
+	ConfigureStatements(ctx context.Context, userID, accountID uuid.UUID, req dto.ConfigureStatementRequest) (*domain.StatementConfig, error)
+
+	// GetStatementConfig retrieves the statement cycle of a card account
+	GetStatementConfig(ctx context.Context, userID, accountID uuid.UUID) (*domain.StatementConfig, error)
+}
+
+// StatementManager defines statement generation and retrieval operations
+type StatementManager interface {
+	// GenerateStatement generates (or refreshes) the statement of the most recently closed cycle
+	GenerateStatement(ctx context.Context, userID, accountID uuid.UUID) (*domain.Statement, error)
+
+	// ListStatements retrieves statements of a card account, newest first
+	ListStatements(ctx context.Context, userID, accountID uuid.UUID, query dto.ListStatementsQuery) ([]domain.Statement, error)
+
+	// GenerateDueStatements generates missing statements and refreshes payment status for all active cards
+	GenerateDueStatements(ctx context.Context, asOf time.Time) (int, error)
+}
+
+// UtilizationReporter defines credit utilization reporting
+type UtilizationReporter interface {
+	GetUtilization(ctx context.Context, userID uuid.UUID) (*domain.UtilizationReport, error)
+}
+
+// Service is the composite interface for all credit card operations
+type Service interface {
+	StatementConfigurer
+	StatementManager
+	UtilizationReporter
+}
+
+// creditCardService implements all credit card use cases
+type creditCardService struct {
+	repo            repository.Repository
+	accountRepo     accountRepo.Repository
+	debtRepo        debtRepo.Repository
+	transactionRepo transactionRepo.Repository
+	rates           exchangerate.Provider
+	logger          *zap.Logger
+}
+
+// NewService creates a new credit card service
+func NewService(
+	repo repository.Repository,
+	accountRepo accountRepo.Repository,
+	debtRepo debtRepo.Repository,
+	transactionRepo transactionRepo.Repository,
+	rates exchangerate.Provider,
+	logger *zap.Logger,
+) Service {
+	return &creditCardService{
+		repo:            repo,
+		accountRepo:     accountRepo,
+		debtRepo:        debtRepo,
+		transactionRepo: transactionRepo,
+		rates:           rates,
+		logger:          logger.Named("credit_card.service"),
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/credit_card/domain"
+	"personalfinancedss/internal/module/cashflow/credit_card/dto"
+	debtDomain "personalfinancedss/internal/module/cashflow/debt/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const defaultMinPaymentPercent = 5.0
+
+// ConfigureStatements creates or updates the statement cycle of a card account.
+// The config is linked to a credit card debt; one is created from the account when none is given.
+func (s *creditCardService) ConfigureStatements(ctx context.Context, userID, accountID uuid.UUID, req dto.ConfigureStatementRequest) (*domain.StatementConfig, error) {
+	account, err := s.getEditableCardAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := s.repo.GetConfigByAccountID(ctx, accountID)
+	isNew := false
+	if err != nil {
+		if err != shared.ErrNotFound {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+		isNew = true
+		config = &domain.StatementConfig{
+			UserID:            userID,
+			AccountID:         accountID,
+			MinPaymentFormula: domain.MinPaymentPercentOfBalance,
+			MinPaymentPercent: defaultMinPaymentPercent,
+			IsActive:          true,
+		}
+	}
+
+	config.ClosingDay = req.ClosingDay
+	config.DueDay = req.DueDay
+	if req.GracePeriodDays != nil {
+		config.GracePeriodDays = *req.GracePeriodDays
+	}
+	if req.MinPaymentFormula != nil {
+		config.MinPaymentFormula = domain.MinimumPaymentFormula(*req.MinPaymentFormula)
+	}
+	if req.MinPaymentPercent != nil {
+		config.MinPaymentPercent = *req.MinPaymentPercent
+	}
+	if req.MinPaymentAmount != nil {
+		config.MinPaymentAmount = *req.MinPaymentAmount
+	}
+	if req.InterestRate != nil {
+		config.InterestRate = *req.InterestRate
+	}
+	if req.IsActive != nil {
+		config.IsActive = *req.IsActive
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("config", err.Error())
+	}
+
+	if err := s.linkDebt(ctx, config, account, req.DebtID); err != nil {
+		return nil, err
+	}
+
+	if isNew {
+		err = s.repo.CreateConfig(ctx, config)
+	} else {
+		err = s.repo.UpdateConfig(ctx, config)
+	}
+	if err != nil {
+		s.logger.Error("Failed to save statement config",
+			zap.String("account_id", accountID.String()),
+			zap.Error(err),
+		)
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Statement cycle configured",
+		zap.String("account_id", accountID.String()),
+		zap.Int("closing_day", config.ClosingDay),
+		zap.Int("due_day", config.DueDay),
+	)
+
+	return config, nil
+}
+
+// GetStatementConfig retrieves the statement cycle of a card account
+func (s *creditCardService) GetStatementConfig(ctx context.Context, userID, accountID uuid.UUID) (*domain.StatementConfig, error) {
+	if _, err := s.getCardAccount(ctx, userID, accountID); err != nil {
+		return nil, err
+	}
+
+	config, err := s.repo.GetConfigByAccountID(ctx, accountID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return config, nil
+}
+
+// linkDebt attaches the requested debt to the config, or creates one for the card when none is linked yet
+func (s *creditCardService) linkDebt(ctx context.Context, config *domain.StatementConfig, account *accountDomain.Account, requested *string) error {
+	if requested != nil {
+		debtID, err := uuid.Parse(*requested)
+		if err != nil {
+			return shared.ErrBadRequest.WithDetails("debt_id", "invalid debt id")
+		}
+
+		debt, err := s.debtRepo.FindByID(ctx, debtID)
+		if err != nil || debt.UserID != config.UserID {
+			return shared.ErrNotFound.WithDetails("debt_id", "debt not found")
+		}
+
+		if debt.LinkedAccountID == nil {
+			debt.LinkedAccountID = &account.ID
+			if err := s.debtRepo.Update(ctx, debt); err != nil {
+				return shared.ErrInternal.WithError(err)
+			}
+		}
+		if config.InterestRate == 0 {
+			config.InterestRate = debt.InterestRate
+		}
+		config.DebtID = &debt.ID
+		return nil
+	}
+
+	if config.DebtID != nil {
+		return nil
+	}
+
+	owed := domain.OwedFromAccountBalance(account.CurrentBalance)
+	principal := owed
+	if account.CreditLimit != nil && *account.CreditLimit > principal {
+		principal = *account.CreditLimit
+	}
+	frequency := debtDomain.FrequencyMonthly
+	nextDue := config.DueDateFor(config.NextClosingDate(time.Now()))
+
+	debt := &debtDomain.Debt{
+		UserID:           config.UserID,
+		Name:             account.AccountName,
+		Type:             debtDomain.DebtTypeCreditCard,
+		Behavior:         debtDomain.DebtBehaviorRevolving,
+		Status:           debtDomain.DebtStatusActive,
+		PrincipalAmount:  principal,
+		CurrentBalance:   owed,
+		InterestRate:     config.InterestRate,
+		MinimumPayment:   config.MinimumDue(owed, 0),
+		Currency:         string(account.Currency),
+		PaymentFrequency: &frequency,
+		NextPaymentDate:  &nextDue,
+		StartDate:        time.Now(),
+		CreditorName:     account.InstitutionName,
+		LinkedAccountID:  &account.ID,
+		EnableReminders:  true,
+	}
+	debt.UpdateCalculatedFields()
+
+	if err := s.debtRepo.Create(ctx, debt); err != nil {
+		s.logger.Error("Failed to create card debt",
+			zap.String("account_id", account.ID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrInternal.WithError(err)
+	}
+
+	config.DebtID = &debt.ID
+	return nil
+}
+
+// getCardAccount loads an account the user can see and checks that it is a credit card
+func (s *creditCardService) getCardAccount(ctx context.Context, userID, accountID uuid.UUID) (*accountDomain.Account, error) {
+	return cardAccount(s.accountRepo.GetByIDAndUserID(ctx, accountID.String(), userID.String()))
+}
+
+// getEditableCardAccount loads an account the user can edit and checks that it is a credit card
+func (s *creditCardService) getEditableCardAccount(ctx context.Context, userID, accountID uuid.UUID) (*accountDomain.Account, error) {
+	return cardAccount(s.accountRepo.GetEditableByIDAndUserID(ctx, accountID.String(), userID.String()))
+}
+
+// cardAccount checks the result of an account lookup and that the account is a credit card
+func cardAccount(account *accountDomain.Account, err error) (*accountDomain.Account, error) {
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	if account.AccountType != accountDomain.AccountTypeCreditCard {
+		return nil, shared.ErrBadRequest.WithDetails("account_id", "account is not a credit card")
+	}
+	return account, nil
+}
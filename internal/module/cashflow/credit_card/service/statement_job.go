@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// statementJobSpec runs every hour so closed cycles and payments are reflected within the hour
+const statementJobSpec = "0 10 * * * *"
+
+// statementJob runs the card statement run on the notification scheduler
+type statementJob struct {
+	service StatementManager
+}
+
+// NewStatementJob creates the scheduled job that generates statements when card cycles close
+func NewStatementJob(service Service) notificationService.ScheduledJob {
+	return &statementJob{service: service}
+}
+
+func (j *statementJob) Name() string {
+	return "card_statements"
+}
+
+func (j *statementJob) Spec() string {
+	return statementJobSpec
+}
+
+func (j *statementJob) Run(ctx context.Context) error {
+	_, err := j.service.GenerateDueStatements(ctx, time.Now())
+	return err
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/credit_card/domain"
+	"personalfinancedss/internal/module/cashflow/credit_card/dto"
+	debtDomain "personalfinancedss/internal/module/cashflow/debt/domain"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const defaultStatementLimit = 12
+
+// GenerateStatement generates (or refreshes) the statement of the most recently closed cycle
+func (s *creditCardService) GenerateStatement(ctx context.Context, userID, accountID uuid.UUID) (*domain.Statement, error) {
+	if _, err := s.getEditableCardAccount(ctx, userID, accountID); err != nil {
+		return nil, err
+	}
+
+	config, err := s.repo.GetConfigByAccountID(ctx, accountID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	return s.generateForConfig(ctx, config, time.Now())
+}
+
+// ListStatements retrieves statements of a card account, newest first
+func (s *creditCardService) ListStatements(ctx context.Context, userID, accountID uuid.UUID, query dto.ListStatementsQuery) ([]domain.Statement, error) {
+	if _, err := s.getCardAccount(ctx, userID, accountID); err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultStatementLimit
+	}
+
+	statements, err := s.repo.ListStatements(ctx, accountID, limit)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return statements, nil
+}
+
+// GenerateDueStatements generates missing statements and refreshes payment status for all active cards.
+// Safe to run repeatedly: statements are unique per card and closing date.
+func (s *creditCardService) GenerateDueStatements(ctx context.Context, asOf time.Time) (int, error) {
+	configs, err := s.repo.ListActiveConfigs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for i := range configs {
+		if _, err := s.generateForConfig(ctx, &configs[i], asOf); err != nil {
+			s.logger.Error("Failed to generate card statement",
+				zap.String("account_id", configs[i].AccountID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// generateForConfig builds the statement of the last cycle closed before asOf, or refreshes it if it already exists,
+// then pushes the result to the linked debt.
+func (s *creditCardService) generateForConfig(ctx context.Context, config *domain.StatementConfig, asOf time.Time) (*domain.Statement, error) {
+	// A cycle closes at the end of its closing day
+	closing := config.ClosingDateOnOrBefore(asOf.AddDate(0, 0, -1))
+
+	account, err := s.accountRepo.GetByID(ctx, config.AccountID.String())
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	statement, err := s.repo.GetStatementByPeriod(ctx, config.AccountID, closing)
+	if err != nil && err != shared.ErrNotFound {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	periodStart := config.PeriodStart(closing)
+	if statement != nil {
+		periodStart = statement.PeriodStart
+	}
+
+	transactions, err := s.transactionRepo.GetTransactionsByDateRange(ctx, config.UserID, &config.AccountID, periodStart, time.Now())
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	var previous *domain.Statement
+	if statement == nil {
+		previous, err = s.repo.GetStatementByPeriod(ctx, config.AccountID, config.PreviousClosingDate(closing))
+		if err != nil && err != shared.ErrNotFound {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+
+		var previousDue *time.Time
+		if previous != nil {
+			previousDue = &previous.GraceEndDate
+		}
+		activity := summarizeActivity(transactions, closing, previousDue)
+
+		statement = domain.BuildStatement(config, closing, domain.OwedFromAccountBalance(account.CurrentBalance), activity, previous, account.CreditLimit)
+		statement.Currency = string(account.Currency)
+
+		created, err := s.repo.CreateStatement(ctx, statement)
+		if err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+		if !created {
+			// Generated concurrently; continue with the stored row
+			if statement, err = s.repo.GetStatementByPeriod(ctx, config.AccountID, closing); err != nil {
+				return nil, shared.ErrInternal.WithError(err)
+			}
+		} else {
+			s.logger.Info("Card statement generated",
+				zap.String("account_id", config.AccountID.String()),
+				zap.Time("period_end", closing),
+				zap.Float64("statement_balance", statement.StatementBalance),
+				zap.Float64("minimum_due", statement.MinimumDue),
+			)
+		}
+
+		config.LastStatementDate = &closing
+		if err := s.repo.UpdateConfig(ctx, config); err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+	}
+
+	if !statement.Status.IsSettled() {
+		activity := summarizeActivity(transactions, closing, nil)
+		statement.RefreshStatus(activity.PaymentsAfterClose, asOf)
+		if err := s.repo.UpdateStatement(ctx, statement); err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+	}
+
+	s.syncDebt(ctx, config, account, statement)
+
+	return statement, nil
+}
+
+// syncDebt mirrors the statement onto the linked debt: remaining balance, minimum payment and next due date
+func (s *creditCardService) syncDebt(ctx context.Context, config *domain.StatementConfig, account *accountDomain.Account, statement *domain.Statement) {
+	if config.DebtID == nil {
+		return
+	}
+
+	debt, err := s.debtRepo.FindByID(ctx, *config.DebtID)
+	if err != nil {
+		s.logger.Warn("Linked card debt not found",
+			zap.String("account_id", config.AccountID.String()),
+			zap.String("debt_id", config.DebtID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	remaining := statement.RemainingDue()
+	nextDue := statement.DueDate
+	if statement.Status.IsSettled() {
+		nextDue = config.DueDateFor(config.NextClosingDate(statement.PeriodEnd))
+	}
+
+	debt.CurrentBalance = remaining
+	debt.MinimumPayment = statement.MinimumDue
+	debt.NextPaymentDate = &nextDue
+	debt.InterestRate = config.InterestRate
+	if account.CreditLimit != nil && *account.CreditLimit > debt.PrincipalAmount {
+		debt.PrincipalAmount = *account.CreditLimit
+	}
+	if debt.PrincipalAmount < remaining {
+		debt.PrincipalAmount = remaining
+	}
+	if remaining > 0 && debt.Status == debtDomain.DebtStatusPaidOff {
+		// Revolving debt: a new statement reopens it
+		debt.Status = debtDomain.DebtStatusActive
+		debt.PaidOffDate = nil
+	}
+	debt.UpdateCalculatedFields()
+
+	if err := s.debtRepo.Update(ctx, debt); err != nil {
+		s.logger.Error("Failed to update card debt from statement",
+			zap.String("debt_id", debt.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// summarizeActivity splits card transactions around the closing date.
+// Payments up to previousDue are tracked separately to decide whether the previous balance was carried.
+func summarizeActivity(transactions []*transactionDomain.Transaction, closing time.Time, previousDue *time.Time) domain.CycleActivity {
+	var activity domain.CycleActivity
+	for _, txn := range transactions {
+		amount := float64(txn.Amount)
+		booked := time.Date(txn.BookingDate.Year(), txn.BookingDate.Month(), txn.BookingDate.Day(), 0, 0, 0, 0, time.UTC)
+		afterClose := booked.After(closing)
+
+		if txn.Direction == transactionDomain.DirectionDebit {
+			if afterClose {
+				activity.ChargesAfterClose += amount
+			} else {
+				activity.Purchases += amount
+			}
+			continue
+		}
+
+		if afterClose {
+			activity.PaymentsAfterClose += amount
+			continue
+		}
+		activity.Payments += amount
+		if previousDue != nil && !booked.After(*previousDue) {
+			activity.PaymentsByPreviousDue += amount
+		}
+	}
+	return activity
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/credit_card/domain"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetUtilization reports utilization per card and across all cards.
+// Totals are converted to the base currency so cards in different currencies can be combined.
+func (s *creditCardService) GetUtilization(ctx context.Context, userID uuid.UUID) (*domain.UtilizationReport, error) {
+	cardType := accountDomain.AccountTypeCreditCard
+	accounts, err := s.accountRepo.ListByUserID(ctx, userID.String(), accountDomain.ListAccountsFilter{AccountType: &cardType})
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	configs, err := s.repo.ListConfigsByUserID(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	configByAccount := make(map[uuid.UUID]*domain.StatementConfig, len(configs))
+	for i := range configs {
+		configByAccount[configs[i].AccountID] = &configs[i]
+	}
+
+	rates := s.rates.Rates(ctx)
+	report := &domain.UtilizationReport{
+		Cards:    make([]domain.CardUtilization, 0, len(accounts)),
+		Currency: exchangerate.BaseCurrency,
+	}
+
+	limitedOwed := 0.0 // owed on cards that have a credit limit
+	for _, account := range accounts {
+		owed := domain.OwedFromAccountBalance(account.CurrentBalance)
+		card := domain.CardUtilization{
+			AccountID:   account.ID,
+			AccountName: account.AccountName,
+			Currency:    string(account.Currency),
+			CreditLimit: account.CreditLimit,
+			Owed:        owed,
+			Utilization: domain.Utilization(owed, account.CreditLimit),
+		}
+		if account.CreditLimit != nil {
+			available := *account.CreditLimit - owed
+			if available < 0 {
+				available = 0
+			}
+			card.Available = &available
+		}
+
+		if config, ok := configByAccount[account.ID]; ok {
+			latest, err := s.repo.GetLatestStatement(ctx, account.ID)
+			if err != nil && err != shared.ErrNotFound {
+				return nil, shared.ErrInternal.WithError(err)
+			}
+			card.LatestStatement = latest
+
+			nextDue := config.DueDateFor(config.NextClosingDate(time.Now()))
+			if latest != nil && !latest.Status.IsSettled() {
+				nextDue = latest.DueDate
+			}
+			card.NextDueDate = &nextDue
+		}
+
+		report.Cards = append(report.Cards, card)
+
+		convertedOwed, err := rates.Convert(owed, card.Currency, report.Currency)
+		if err != nil {
+			s.logger.Warn("Skipping card with unsupported currency in utilization totals",
+				zap.String("account_id", account.ID.String()),
+				zap.String("currency", card.Currency),
+			)
+			continue
+		}
+		report.TotalOwed += convertedOwed
+
+		if account.CreditLimit != nil && *account.CreditLimit > 0 {
+			convertedLimit, _ := rates.Convert(*account.CreditLimit, card.Currency, report.Currency)
+			report.TotalCreditLimit += convertedLimit
+			limitedOwed += convertedOwed
+		}
+	}
+
+	limit := report.TotalCreditLimit
+	report.Utilization = domain.Utilization(limitedOwed, &limit)
+
+	return report, nil
+}
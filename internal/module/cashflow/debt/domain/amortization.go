@@ -0,0 +1,358 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxSchedulePeriods bounds a schedule, e.g. one derived from a payment that barely covers interest
+const MaxSchedulePeriods = 1200
+
+// DebtScheduleEntry is one scheduled payment in a debt's amortization table
+type DebtScheduleEntry struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	DebtID uuid.UUID `gorm:"type:uuid;not null;index:idx_debt_schedule_period,priority:1;column:debt_id" json:"debt_id"`
+	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+
+	Period  int       `gorm:"not null;index:idx_debt_schedule_period,priority:2;column:period" json:"period"` // 1-based payment number
+	DueDate time.Time `gorm:"type:date;not null;column:due_date" json:"due_date"`
+
+	Payment          float64 `gorm:"type:decimal(15,2);not null;column:payment" json:"payment"`
+	Principal        float64 `gorm:"type:decimal(15,2);not null;column:principal" json:"principal"`
+	Interest         float64 `gorm:"type:decimal(15,2);not null;column:interest" json:"interest"`
+	RemainingBalance float64 `gorm:"type:decimal(15,2);not null;column:remaining_balance" json:"remaining_balance"`
+	InterestRate     float64 `gorm:"type:decimal(5,2);not null;column:interest_rate" json:"interest_rate"` // Annual rate (%) the entry was generated with
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+}
+
+// TableName specifies the table name for DebtScheduleEntry
+func (DebtScheduleEntry) TableName() string {
+	return "debt_schedule_entries"
+}
+
+// ScheduleReconciliation compares what was actually repaid with the amortization schedule
+type ScheduleReconciliation struct {
+	AsOf             time.Time          `json:"as_of"`
+	PeriodsDue       int                `json:"periods_due"`       // Scheduled payments due by AsOf
+	ScheduledBalance float64            `json:"scheduled_balance"` // Balance the schedule expects at AsOf
+	ActualBalance    float64            `json:"actual_balance"`
+	Difference       float64            `json:"difference"`     // ScheduledBalance - ActualBalance; positive when ahead
+	ScheduledPaid    float64            `json:"scheduled_paid"` // Sum of the payments due by AsOf
+	ActualPaid       float64            `json:"actual_paid"`
+	Status           ScheduleStatus     `json:"status"`
+	NextEntry        *DebtScheduleEntry `json:"next_entry,omitempty"`
+}
+
+// HasSchedule reports whether the debt is repaid on an amortization schedule
+func (d *Debt) HasSchedule() bool {
+	return d.Behavior == DebtBehaviorInstallment || d.Behavior == DebtBehaviorInterestOnly
+}
+
+// Method returns the installment split, defaulting to annuity
+func (d *Debt) Method() AmortizationMethod {
+	if d.AmortizationMethod == nil {
+		return AmortizationAnnuity
+	}
+	return *d.AmortizationMethod
+}
+
+// PeriodsPerYear returns how many scheduled payments fall in a year; monthly when no frequency is set
+func (d *Debt) PeriodsPerYear() int {
+	if d.PaymentFrequency == nil {
+		return 12
+	}
+	switch *d.PaymentFrequency {
+	case FrequencyDaily:
+		return 365
+	case FrequencyWeekly:
+		return 52
+	case FrequencyBiweekly:
+		return 26
+	case FrequencyQuarterly:
+		return 4
+	case FrequencyYearly:
+		return 1
+	default:
+		return 12
+	}
+}
+
+// PeriodicRate returns the interest rate applied per scheduled payment
+func (d *Debt) PeriodicRate() float64 {
+	return d.InterestRate / 100 / float64(d.PeriodsPerYear())
+}
+
+// PeriodRate returns the annual rate (%) charged over the given 1-based payment period, which is the
+// rate in force when the period starts
+func (d *Debt) PeriodRate(period int) float64 {
+	return d.RateOn(d.ScheduleDueDate(period - 1))
+}
+
+// ScheduleDueDate returns the due date of the given 1-based payment, counted from the start date.
+// Monthly steps keep the start day, clamped to the end of shorter months.
+func (d *Debt) ScheduleDueDate(period int) time.Time {
+	if d.PaymentFrequency != nil {
+		switch *d.PaymentFrequency {
+		case FrequencyDaily:
+			return d.StartDate.AddDate(0, 0, period)
+		case FrequencyWeekly:
+			return d.StartDate.AddDate(0, 0, 7*period)
+		case FrequencyBiweekly:
+			return d.StartDate.AddDate(0, 0, 14*period)
+		case FrequencyQuarterly:
+			return addMonthsClamped(d.StartDate, 3*period)
+		case FrequencyYearly:
+			return addMonthsClamped(d.StartDate, 12*period)
+		}
+	}
+	return addMonthsClamped(d.StartDate, period)
+}
+
+// ScheduleTerm returns the number of scheduled payments: TermPeriods when set, otherwise the
+// payments that fall due by DueDate. Zero means the term is unknown.
+func (d *Debt) ScheduleTerm() int {
+	if d.TermPeriods != nil && *d.TermPeriods > 0 {
+		return *d.TermPeriods
+	}
+	if d.DueDate == nil {
+		return 0
+	}
+
+	term := 0
+	for term < MaxSchedulePeriods && !d.ScheduleDueDate(term+1).After(*d.DueDate) {
+		term++
+	}
+	if term == 0 {
+		term = 1
+	}
+	return term
+}
+
+// GenerateSchedule builds the full amortization table from the principal amount and start date
+func (d *Debt) GenerateSchedule() ([]DebtScheduleEntry, error) {
+	return d.buildSchedule(d.PrincipalAmount, 1, d.ScheduleTerm())
+}
+
+// RegenerateSchedule rebuilds the schedule from the current balance, e.g. after the rate changed or
+// an extra payment was made. Entries due before settledBefore are kept as they were; the returned
+// entries replace every entry from the returned period on.
+func (d *Debt) RegenerateSchedule(existing []DebtScheduleEntry, settledBefore time.Time) ([]DebtScheduleEntry, int, error) {
+	cutoff := truncateDate(settledBefore)
+	kept := 0
+	for _, entry := range existing {
+		if !entry.DueDate.Before(cutoff) {
+			break
+		}
+		kept++
+	}
+
+	remaining := 0
+	if term := d.ScheduleTerm(); term > 0 {
+		remaining = term - kept
+		if remaining < 1 {
+			remaining = 1
+		}
+	}
+
+	entries, err := d.buildSchedule(d.CurrentBalance, kept+1, remaining)
+	return entries, kept + 1, err
+}
+
+// ScheduleIsStale reports whether the stored schedule no longer matches the debt's rate or term
+func (d *Debt) ScheduleIsStale(entries []DebtScheduleEntry) bool {
+	if len(entries) == 0 {
+		return true
+	}
+	last := entries[len(entries)-1]
+	if last.InterestRate != d.PeriodRate(last.Period) {
+		return true
+	}
+	term := d.ScheduleTerm()
+	return term > 0 && last.Period != term
+}
+
+// UpcomingEntry returns the first scheduled payment due on or after asOf
+func UpcomingEntry(entries []DebtScheduleEntry, asOf time.Time) *DebtScheduleEntry {
+	day := truncateDate(asOf)
+	for i := range entries {
+		if !entries[i].DueDate.Before(day) {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+// IsExtraPayment reports whether the amount pays more than the upcoming scheduled payment
+func IsExtraPayment(entries []DebtScheduleEntry, amount float64, asOf time.Time) bool {
+	entry := UpcomingEntry(entries, asOf)
+	if entry == nil {
+		return false
+	}
+	return amount > entry.Payment+scheduleTolerance(entry.Payment)
+}
+
+// ReconcileSchedule compares the current balance with the balance the schedule expects at asOf
+func (d *Debt) ReconcileSchedule(entries []DebtScheduleEntry, asOf time.Time) *ScheduleReconciliation {
+	day := truncateDate(asOf)
+	rec := &ScheduleReconciliation{
+		AsOf:             day,
+		ScheduledBalance: d.PrincipalAmount,
+		ActualBalance:    d.CurrentBalance,
+		ActualPaid:       d.TotalPaid,
+	}
+
+	reference := 0.0
+	for i := range entries {
+		entry := &entries[i]
+		if entry.DueDate.After(day) {
+			if rec.NextEntry == nil {
+				rec.NextEntry = entry
+			}
+			continue
+		}
+		rec.PeriodsDue++
+		rec.ScheduledBalance = entry.RemainingBalance
+		rec.ScheduledPaid += entry.Payment
+		reference = entry.Payment
+	}
+	if rec.NextEntry != nil {
+		reference = rec.NextEntry.Payment
+	}
+
+	rec.ScheduledPaid = roundMoney(rec.ScheduledPaid)
+	rec.Difference = roundMoney(rec.ScheduledBalance - rec.ActualBalance)
+	tolerance := scheduleTolerance(reference)
+	switch {
+	case rec.Difference > tolerance:
+		rec.Status = ScheduleStatusAhead
+	case rec.Difference < -tolerance:
+		rec.Status = ScheduleStatusBehind
+	default:
+		rec.Status = ScheduleStatusOnTrack
+	}
+	return rec
+}
+
+// buildSchedule lays out periods payments of balance starting at firstPeriod. Annuity debts
+// without a known term are scheduled until the regular payment clears the balance. When the rate
+// schedule changes the rate, annuity debts with a known term re-amortize the remaining balance.
+func (d *Debt) buildSchedule(balance float64, firstPeriod, periods int) ([]DebtScheduleEntry, error) {
+	if !d.HasSchedule() {
+		return nil, errors.New("only installment and interest-only debts have an amortization schedule")
+	}
+	if balance <= 0 {
+		return []DebtScheduleEntry{}, nil
+	}
+
+	perYear := float64(d.PeriodsPerYear())
+	annualRate := d.PeriodRate(firstPeriod)
+	rate := annualRate / 100 / perYear
+	fixedTerm := periods > 0
+	interestOnly := d.Behavior == DebtBehaviorInterestOnly
+	method := d.Method()
+	if !interestOnly && !method.IsValid() {
+		return nil, errors.New("invalid amortization method")
+	}
+
+	payment := 0.0
+	switch {
+	case periods > 0 && !interestOnly && method == AmortizationAnnuity:
+		payment = annuityPayment(balance, rate, periods)
+	case periods == 0 && !interestOnly && method == AmortizationAnnuity:
+		payment = d.PaymentAmount
+		if payment <= 0 {
+			payment = d.MinimumPayment
+		}
+		if payment <= 0 {
+			return nil, errors.New("a term, due date or payment amount is required to build the schedule")
+		}
+		if payment <= roundMoney(balance*rate) {
+			return nil, errors.New("payment amount does not cover the interest")
+		}
+		periods = MaxSchedulePeriods
+	case periods == 0:
+		return nil, errors.New("a term or due date is required to build the schedule")
+	}
+
+	principalStep := roundMoney(balance / float64(periods))
+	entries := make([]DebtScheduleEntry, 0)
+	for i := 0; i < periods && balance > 0; i++ {
+		period := firstPeriod + i
+		if periodRate := d.PeriodRate(period); periodRate != annualRate {
+			annualRate = periodRate
+			rate = annualRate / 100 / perYear
+			if fixedTerm && !interestOnly && method == AmortizationAnnuity {
+				payment = annuityPayment(balance, rate, periods-i)
+			}
+		}
+
+		interest := roundMoney(balance * rate)
+		var principal float64
+		switch {
+		case interestOnly:
+			// Interest every period, the whole principal as a balloon at the end
+			if i == periods-1 {
+				principal = balance
+			}
+		case method == AmortizationEqualPrincipal:
+			principal = principalStep
+		default:
+			// A rate rise can leave a set payment short of the interest; at least the interest is due
+			principal = math.Max(roundMoney(payment-interest), 0)
+		}
+		if i == periods-1 || principal > balance {
+			principal = balance
+		}
+
+		balance = roundMoney(balance - principal)
+		entries = append(entries, DebtScheduleEntry{
+			DebtID:           d.ID,
+			UserID:           d.UserID,
+			Period:           period,
+			DueDate:          truncateDate(d.ScheduleDueDate(period)),
+			Payment:          roundMoney(principal + interest),
+			Principal:        principal,
+			Interest:         interest,
+			RemainingBalance: balance,
+			InterestRate:     annualRate,
+		})
+	}
+
+	return entries, nil
+}
+
+// annuityPayment is the level payment that clears balance over periods at the periodic rate
+func annuityPayment(balance, rate float64, periods int) float64 {
+	if rate == 0 {
+		return roundMoney(balance / float64(periods))
+	}
+	return roundMoney(balance * rate / (1 - math.Pow(1+rate, -float64(periods))))
+}
+
+// scheduleTolerance absorbs rounding when comparing amounts with a scheduled payment
+func scheduleTolerance(payment float64) float64 {
+	return math.Max(1, payment*0.01)
+}
+
+func roundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// addMonthsClamped adds months keeping the day of month, clamped to the last day of the target month
+func addMonthsClamped(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()).AddDate(0, months, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
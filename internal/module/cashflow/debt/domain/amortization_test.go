@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLoan(behavior DebtBehavior, method AmortizationMethod) *Debt {
+	term := 12
+	return &Debt{
+		Behavior:           behavior,
+		AmortizationMethod: &method,
+		PrincipalAmount:    12000000,
+		CurrentBalance:     12000000,
+		InterestRate:       12,
+		Currency:           "VND",
+		StartDate:          time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		TermPeriods:        &term,
+	}
+}
+
+func sumPrincipal(entries []DebtScheduleEntry) float64 {
+	total := 0.0
+	for _, entry := range entries {
+		total += entry.Principal
+	}
+	return roundMoney(total)
+}
+
+func TestDebt_GenerateSchedule_Annuity(t *testing.T) {
+	debt := newLoan(DebtBehaviorInstallment, AmortizationAnnuity)
+
+	entries, err := debt.GenerateSchedule()
+
+	require.NoError(t, err)
+	require.Len(t, entries, 12)
+	assert.Equal(t, 1066185.46, entries[0].Payment)
+	assert.Equal(t, 120000.0, entries[0].Interest)
+	assert.Equal(t, entries[0].Payment, entries[5].Payment)
+	assert.Greater(t, entries[11].Principal, entries[0].Principal)
+	assert.Equal(t, 0.0, entries[11].RemainingBalance)
+	assert.Equal(t, 12000000.0, sumPrincipal(entries))
+
+	// Monthly due dates keep the start day, clamped to shorter months
+	assert.Equal(t, time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC), entries[0].DueDate)
+	assert.Equal(t, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), entries[1].DueDate)
+}
+
+func TestDebt_GenerateSchedule_EqualPrincipal(t *testing.T) {
+	debt := newLoan(DebtBehaviorInstallment, AmortizationEqualPrincipal)
+
+	entries, err := debt.GenerateSchedule()
+
+	require.NoError(t, err)
+	require.Len(t, entries, 12)
+	assert.Equal(t, 1000000.0, entries[0].Principal)
+	assert.Equal(t, 1120000.0, entries[0].Payment)
+	assert.Equal(t, 110000.0, entries[1].Interest)
+	assert.Equal(t, 1010000.0, entries[11].Payment)
+	assert.Equal(t, 0.0, entries[11].RemainingBalance)
+}
+
+func TestDebt_GenerateSchedule_InterestOnlyBalloon(t *testing.T) {
+	debt := newLoan(DebtBehaviorInterestOnly, AmortizationAnnuity)
+
+	entries, err := debt.GenerateSchedule()
+
+	require.NoError(t, err)
+	require.Len(t, entries, 12)
+	assert.Equal(t, 0.0, entries[0].Principal)
+	assert.Equal(t, 120000.0, entries[10].Payment)
+	assert.Equal(t, 12000000.0, entries[11].Principal)
+	assert.Equal(t, 12120000.0, entries[11].Payment)
+}
+
+func TestDebt_GenerateSchedule_Term(t *testing.T) {
+	t.Run("derived from due date", func(t *testing.T) {
+		debt := newLoan(DebtBehaviorInstallment, AmortizationAnnuity)
+		debt.TermPeriods = nil
+		dueDate := time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+		debt.DueDate = &dueDate
+
+		assert.Equal(t, 12, debt.ScheduleTerm())
+	})
+
+	t.Run("derived from payment amount", func(t *testing.T) {
+		debt := newLoan(DebtBehaviorInstallment, AmortizationAnnuity)
+		debt.TermPeriods = nil
+		debt.PaymentAmount = 2000000
+
+		entries, err := debt.GenerateSchedule()
+
+		require.NoError(t, err)
+		assert.Len(t, entries, 7)
+		assert.Equal(t, 12000000.0, sumPrincipal(entries))
+	})
+
+	t.Run("payment below interest", func(t *testing.T) {
+		debt := newLoan(DebtBehaviorInstallment, AmortizationAnnuity)
+		debt.TermPeriods = nil
+		debt.PaymentAmount = 100000
+
+		_, err := debt.GenerateSchedule()
+		assert.Error(t, err)
+	})
+
+	t.Run("revolving debts have no schedule", func(t *testing.T) {
+		debt := newLoan(DebtBehaviorRevolving, AmortizationAnnuity)
+
+		_, err := debt.GenerateSchedule()
+		assert.Error(t, err)
+	})
+}
+
+func TestDebt_RegenerateSchedule(t *testing.T) {
+	debt := newLoan(DebtBehaviorInstallment, AmortizationAnnuity)
+	entries, err := debt.GenerateSchedule()
+	require.NoError(t, err)
+
+	// Three installments paid, then the rate goes up
+	debt.CurrentBalance = entries[2].RemainingBalance
+	debt.InterestRate = 18
+	assert.True(t, debt.ScheduleIsStale(entries))
+
+	regenerated, fromPeriod, err := debt.RegenerateSchedule(entries, entries[3].DueDate)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, fromPeriod)
+	require.Len(t, regenerated, 9)
+	assert.Equal(t, 4, regenerated[0].Period)
+	assert.Equal(t, entries[3].DueDate, regenerated[0].DueDate)
+	assert.Equal(t, 18.0, regenerated[0].InterestRate)
+	assert.Greater(t, regenerated[0].Payment, entries[3].Payment)
+	assert.Equal(t, 0.0, regenerated[8].RemainingBalance)
+	assert.False(t, debt.ScheduleIsStale(append(entries[:3:3], regenerated...)))
+}
+
+func TestDebt_ReconcileSchedule(t *testing.T) {
+	debt := newLoan(DebtBehaviorInstallment, AmortizationEqualPrincipal)
+	entries, err := debt.GenerateSchedule()
+	require.NoError(t, err)
+	asOf := entries[2].DueDate.AddDate(0, 0, 1)
+
+	debt.CurrentBalance = 9000000
+	rec := debt.ReconcileSchedule(entries, asOf)
+	assert.Equal(t, ScheduleStatusOnTrack, rec.Status)
+	assert.Equal(t, 3, rec.PeriodsDue)
+	assert.Equal(t, 4, rec.NextEntry.Period)
+
+	debt.CurrentBalance = 8000000
+	rec = debt.ReconcileSchedule(entries, asOf)
+	assert.Equal(t, ScheduleStatusAhead, rec.Status)
+	assert.Equal(t, 1000000.0, rec.Difference)
+
+	debt.CurrentBalance = 10000000
+	assert.Equal(t, ScheduleStatusBehind, debt.ReconcileSchedule(entries, asOf).Status)
+}
+
+func TestIsExtraPayment(t *testing.T) {
+	debt := newLoan(DebtBehaviorInstallment, AmortizationEqualPrincipal)
+	entries, err := debt.GenerateSchedule()
+	require.NoError(t, err)
+	asOf := entries[0].DueDate.AddDate(0, 0, -5)
+
+	assert.False(t, IsExtraPayment(entries, 1120000, asOf))
+	assert.True(t, IsExtraPayment(entries, 3000000, asOf))
+}
@@ -13,11 +13,12 @@ type Debt struct {
 	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
 
 	// Debt Details
-	Name        string       `gorm:"type:varchar(255);not null;column:name" json:"name"`
-	Description *string      `gorm:"type:text;column:description" json:"description,omitempty"`
-	Type        DebtType     `gorm:"type:varchar(50);not null;column:type" json:"type"`
-	Behavior    DebtBehavior `gorm:"type:varchar(20);not null;default:'installment';column:behavior" json:"behavior"`
-	Status      DebtStatus   `gorm:"type:varchar(20);default:'active';column:status" json:"status"`
+	Name        string        `gorm:"type:varchar(255);not null;column:name" json:"name"`
+	Description *string       `gorm:"type:text;column:description" json:"description,omitempty"`
+	Type        DebtType      `gorm:"type:varchar(50);not null;column:type" json:"type"`
+	Behavior    DebtBehavior  `gorm:"type:varchar(20);not null;default:'installment';column:behavior" json:"behavior"`
+	Status      DebtStatus    `gorm:"type:varchar(20);default:'active';column:status" json:"status"`
+	Direction   DebtDirection `gorm:"type:varchar(10);not null;default:'borrowed';index;column:direction" json:"direction"` // "borrowed" or "lent" (receivable)
 
 	// Financial Details
 	PrincipalAmount float64 `gorm:"type:decimal(15,2);not null;column:principal_amount" json:"principal_amount"` // Original debt amount
@@ -27,12 +28,38 @@ type Debt struct {
 	PaymentAmount   float64 `gorm:"type:decimal(15,2);default:0;column:payment_amount" json:"payment_amount"`    // Actual payment amount
 	Currency        string  `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
 
+	// Interest Accrual
+	InterestAccrual        *InterestAccrual `gorm:"type:varchar(10);column:interest_accrual" json:"interest_accrual,omitempty"`          // Convention between payments; nil means monthly
+	AccruedInterest        float64          `gorm:"type:decimal(15,2);default:0;column:accrued_interest" json:"accrued_interest"`        // Interest accrued and not yet paid
+	OutstandingFees        float64          `gorm:"type:decimal(15,2);default:0;column:outstanding_fees" json:"outstanding_fees"`        // Fees charged and not yet paid
+	InterestAccruedThrough *time.Time       `gorm:"type:date;column:interest_accrued_through" json:"interest_accrued_through,omitempty"` // Date interest has been accrued up to
+
 	// Payment Information
 	PaymentFrequency  *PaymentFrequency `gorm:"type:varchar(20);column:payment_frequency" json:"payment_frequency,omitempty"`
 	NextPaymentDate   *time.Time        `gorm:"type:date;column:next_payment_date" json:"next_payment_date,omitempty"`
 	LastPaymentDate   *time.Time        `gorm:"type:date;column:last_payment_date" json:"last_payment_date,omitempty"`
 	LastPaymentAmount *float64          `gorm:"type:decimal(15,2);column:last_payment_amount" json:"last_payment_amount,omitempty"`
 
+	// Late Payment Settings
+	GracePeriodDays      int     `gorm:"default:0;column:grace_period_days" json:"grace_period_days"`                    // Days after the payment date before a payment counts as missed
+	LateFee              float64 `gorm:"type:decimal(15,2);default:0;column:late_fee" json:"late_fee"`                   // Flat fee charged per missed payment
+	LateFeeRate          float64 `gorm:"type:decimal(5,2);default:0;column:late_fee_rate" json:"late_fee_rate"`          // Percentage of the installment charged per missed payment
+	DelinquencyThreshold int     `gorm:"default:3;column:delinquency_threshold" json:"delinquency_threshold"`            // Missed payments before the debt is delinquent
+	LateFeesCharged      float64 `gorm:"type:decimal(15,2);default:0;column:late_fees_charged" json:"late_fees_charged"` // Total late fees added to OutstandingFees
+
+	// Payment State (kept current by the daily status check and by payments)
+	PaymentState          PaymentState `gorm:"type:varchar(20);index;column:payment_state" json:"payment_state,omitempty"` // Empty while the debt has no payment date to track
+	MissedPayments        int          `gorm:"default:0;column:missed_payments" json:"missed_payments"`                    // Payment dates past their grace period and not yet paid
+	OverdueSince          *time.Time   `gorm:"type:date;column:overdue_since" json:"overdue_since,omitempty"`              // Oldest missed payment date
+	PaymentStateChangedAt *time.Time   `gorm:"column:payment_state_changed_at" json:"payment_state_changed_at,omitempty"`
+
+	// Amortization (installment and interest-only debts)
+	AmortizationMethod *AmortizationMethod `gorm:"type:varchar(20);column:amortization_method" json:"amortization_method,omitempty"` // Installment split; nil means annuity
+	TermPeriods        *int                `gorm:"column:term_periods" json:"term_periods,omitempty"`                                // Number of scheduled payments; derived from DueDate when nil
+
+	// Rates is the debt's rate schedule when it has one, loaded by the service; nil means InterestRate applies throughout
+	Rates *RateSchedule `gorm:"-" json:"-"`
+
 	// Timeline
 	StartDate   time.Time  `gorm:"type:date;not null;column:start_date" json:"start_date"`
 	DueDate     *time.Time `gorm:"type:date;column:due_date" json:"due_date,omitempty"`
@@ -43,12 +70,17 @@ type Debt struct {
 	RemainingAmount   float64 `gorm:"type:decimal(15,2);default:0;column:remaining_amount" json:"remaining_amount"`       // Remaining to pay
 	PercentagePaid    float64 `gorm:"type:decimal(5,2);default:0;column:percentage_paid" json:"percentage_paid"`          // Percentage paid off
 	TotalInterestPaid float64 `gorm:"type:decimal(15,2);default:0;column:total_interest_paid" json:"total_interest_paid"` // Total interest paid
+	TotalFeesPaid     float64 `gorm:"type:decimal(15,2);default:0;column:total_fees_paid" json:"total_fees_paid"`         // Total fees paid
 
 	// Linked Resources
 	CreditorName    *string    `gorm:"type:varchar(255);column:creditor_name" json:"creditor_name,omitempty"`       // Name of creditor
 	AccountNumber   *string    `gorm:"type:varchar(100);column:account_number" json:"account_number,omitempty"`     // Account number with creditor
 	LinkedAccountID *uuid.UUID `gorm:"type:uuid;index;column:linked_account_id" json:"linked_account_id,omitempty"` // Account used for payments
 
+	// Counterparty (the person on the other side of an informal loan or receivable)
+	CounterpartyName    *string `gorm:"type:varchar(255);column:counterparty_name" json:"counterparty_name,omitempty"`
+	CounterpartyContact *string `gorm:"type:varchar(255);column:counterparty_contact" json:"counterparty_contact,omitempty"` // Phone or email
+
 	// Notifications
 	EnableReminders    bool       `gorm:"default:true;column:enable_reminders" json:"enable_reminders"`
 	ReminderFrequency  *string    `gorm:"type:varchar(20);column:reminder_frequency" json:"reminder_frequency,omitempty"` // "weekly", "monthly"
@@ -68,6 +100,11 @@ func (Debt) TableName() string {
 	return "debts"
 }
 
+// IsReceivable reports whether the debt is money the user lent, owed back to the user
+func (d *Debt) IsReceivable() bool {
+	return d.Direction == DebtDirectionLent
+}
+
 // IsPaidOff checks if the debt has been paid off
 func (d *Debt) IsPaidOff() bool {
 	return d.CurrentBalance <= 0 || d.Status == DebtStatusPaidOff
@@ -109,48 +146,22 @@ func (d *Debt) UpdateCalculatedFields() {
 		d.PercentagePaid = 0
 	}
 
-	// Update status
-	if d.IsPaidOff() && d.Status != DebtStatusPaidOff {
+	// Update status; a settled debt stays settled
+	if d.IsPaidOff() && d.Status != DebtStatusPaidOff && d.Status != DebtStatusSettled {
 		d.Status = DebtStatusPaidOff
 		now := time.Now()
 		d.PaidOffDate = &now
-	} else if d.IsOverdue() && d.Status == DebtStatusActive {
+	} else if d.PaymentState == PaymentStateDelinquent && d.Status == DebtStatusActive {
 		d.Status = DebtStatusDefaulted
 	}
 }
 
-// AddPayment adds a payment to the debt
+// AddPayment adds a payment made now to the debt
 func (d *Debt) AddPayment(amount float64) {
 	if amount <= 0 {
 		return
 	}
-
-	// Calculate interest portion if applicable
-	interestPortion := 0.0
-	if d.InterestRate > 0 && d.CurrentBalance > 0 {
-		// Simple interest calculation for the period
-		// This is a simplified calculation - actual interest depends on payment frequency
-		monthlyInterestRate := d.InterestRate / 12 / 100
-		interestPortion = d.CurrentBalance * monthlyInterestRate
-		if interestPortion > amount {
-			interestPortion = amount
-		}
-	}
-
-	principalPortion := amount - interestPortion
-	d.CurrentBalance -= principalPortion
-	if d.CurrentBalance < 0 {
-		d.CurrentBalance = 0
-	}
-
-	d.TotalPaid += amount
-	d.TotalInterestPaid += interestPortion
-
-	now := time.Now()
-	d.LastPaymentDate = &now
-	d.LastPaymentAmount = &amount
-
-	d.UpdateCalculatedFields()
+	d.ApplyPayment(amount, time.Now())
 }
 
 // CalculateNextPaymentDate calculates the next payment date based on frequency
@@ -166,23 +177,30 @@ func (d *Debt) CalculateNextPaymentDate() *time.Time {
 		baseDate = *d.NextPaymentDate
 	}
 
-	var nextDate time.Time
-	switch *d.PaymentFrequency {
+	nextDate, ok := addPaymentPeriods(baseDate, *d.PaymentFrequency, 1)
+	if !ok {
+		return nil
+	}
+
+	return &nextDate
+}
+
+// addPaymentPeriods moves a date forward by n payment periods of the given frequency. It reports
+// false for frequencies without a recurring period.
+func addPaymentPeriods(date time.Time, frequency PaymentFrequency, n int) (time.Time, bool) {
+	switch frequency {
 	case FrequencyDaily:
-		nextDate = baseDate.AddDate(0, 0, 1)
+		return date.AddDate(0, 0, n), true
 	case FrequencyWeekly:
-		nextDate = baseDate.AddDate(0, 0, 7)
+		return date.AddDate(0, 0, 7*n), true
 	case FrequencyBiweekly:
-		nextDate = baseDate.AddDate(0, 0, 14)
+		return date.AddDate(0, 0, 14*n), true
 	case FrequencyMonthly:
-		nextDate = baseDate.AddDate(0, 1, 0)
+		return addMonthsClamped(date, n), true
 	case FrequencyQuarterly:
-		nextDate = baseDate.AddDate(0, 3, 0)
+		return addMonthsClamped(date, 3*n), true
 	case FrequencyYearly:
-		nextDate = baseDate.AddDate(1, 0, 0)
-	default:
-		return nil
+		return date.AddDate(n, 0, 0), true
 	}
-
-	return &nextDate
+	return date, false
 }
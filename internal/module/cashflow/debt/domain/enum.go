@@ -7,13 +7,31 @@ const (
 	DebtTypeCreditCard   DebtType = "credit_card"   // Credit card debt
 	DebtTypePersonalLoan DebtType = "personal_loan" // Personal loan
 	DebtTypeMortgage     DebtType = "mortgage"      // Mortgage
+	DebtTypeInformal     DebtType = "informal"      // Loan between people (family, friends), often interest-free
 	DebtTypeOther        DebtType = "other"         // Other debt
 )
 
 // IsValid checks if the debt type is valid
 func (dt DebtType) IsValid() bool {
 	switch dt {
-	case DebtTypeCreditCard, DebtTypePersonalLoan, DebtTypeMortgage, DebtTypeOther:
+	case DebtTypeCreditCard, DebtTypePersonalLoan, DebtTypeMortgage, DebtTypeInformal, DebtTypeOther:
+		return true
+	}
+	return false
+}
+
+// DebtDirection represents who owes whom
+type DebtDirection string
+
+const (
+	DebtDirectionBorrowed DebtDirection = "borrowed" // The user owes the counterparty (a liability)
+	DebtDirectionLent     DebtDirection = "lent"     // The counterparty owes the user (a receivable)
+)
+
+// IsValid checks if the debt direction is valid
+func (dd DebtDirection) IsValid() bool {
+	switch dd {
+	case DebtDirectionBorrowed, DebtDirectionLent:
 		return true
 	}
 	return false
@@ -80,3 +98,95 @@ func (pf PaymentFrequency) IsValid() bool {
 	}
 	return false
 }
+
+// AmortizationMethod represents how an installment debt's payments are split
+type AmortizationMethod string
+
+const (
+	AmortizationAnnuity        AmortizationMethod = "annuity"         // Equal payments, interest share shrinks over time
+	AmortizationEqualPrincipal AmortizationMethod = "equal_principal" // Equal principal on the declining balance (dư nợ giảm dần)
+)
+
+// IsValid checks if the amortization method is valid
+func (am AmortizationMethod) IsValid() bool {
+	switch am {
+	case AmortizationAnnuity, AmortizationEqualPrincipal:
+		return true
+	}
+	return false
+}
+
+// ScheduleStatus represents how actual repayments compare with the amortization schedule
+type ScheduleStatus string
+
+const (
+	ScheduleStatusAhead   ScheduleStatus = "ahead"    // Balance is below the scheduled balance
+	ScheduleStatusOnTrack ScheduleStatus = "on_track" // Balance matches the schedule
+	ScheduleStatusBehind  ScheduleStatus = "behind"   // Balance is above the scheduled balance
+)
+
+// InterestAccrual represents the convention used to accrue interest between payments
+type InterestAccrual string
+
+const (
+	InterestAccrualDaily   InterestAccrual = "daily"   // Actual days elapsed at rate/365
+	InterestAccrualMonthly InterestAccrual = "monthly" // Whole months elapsed at rate/12
+)
+
+// IsValid checks if the interest accrual convention is valid
+func (ia InterestAccrual) IsValid() bool {
+	switch ia {
+	case InterestAccrualDaily, InterestAccrualMonthly:
+		return true
+	}
+	return false
+}
+
+// RateType represents how the rate of a rate schedule period is set
+type RateType string
+
+const (
+	RateTypeFixed    RateType = "fixed"    // A set rate, e.g. a promotional teaser rate
+	RateTypeFloating RateType = "floating" // A reference index plus a margin
+)
+
+// IsValid checks if the rate type is valid
+func (rt RateType) IsValid() bool {
+	switch rt {
+	case RateTypeFixed, RateTypeFloating:
+		return true
+	}
+	return false
+}
+
+// PaymentState represents where a debt stands against its next payment date
+type PaymentState string
+
+const (
+	PaymentStateUpcoming   PaymentState = "upcoming"   // Next payment date not reached yet
+	PaymentStateDueToday   PaymentState = "due_today"  // Payment date reached; stays here through the grace period
+	PaymentStateOverdue    PaymentState = "overdue"    // Grace period passed without the payment
+	PaymentStateDelinquent PaymentState = "delinquent" // Missed payments reached the debt's delinquency threshold
+)
+
+// IsValid checks if the payment state is valid
+func (ps PaymentState) IsValid() bool {
+	switch ps {
+	case PaymentStateUpcoming, PaymentStateDueToday, PaymentStateOverdue, PaymentStateDelinquent:
+		return true
+	}
+	return false
+}
+
+// IsLate reports whether at least one payment has been missed
+func (ps PaymentState) IsLate() bool {
+	return ps == PaymentStateOverdue || ps == PaymentStateDelinquent
+}
+
+// StatusTrigger represents what caused a debt's payment state to change
+type StatusTrigger string
+
+const (
+	StatusTriggerSchedule StatusTrigger = "schedule" // The daily status check
+	StatusTriggerPayment  StatusTrigger = "payment"  // A payment toward the debt
+)
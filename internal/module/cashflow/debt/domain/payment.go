@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DebtPayment is one payment made toward a debt and how it was split
+type DebtPayment struct {
+	ID            uuid.UUID  `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	DebtID        uuid.UUID  `gorm:"type:uuid;not null;index;column:debt_id" json:"debt_id"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index:idx_debt_payment_user_date,priority:1;column:user_id" json:"user_id"`
+	TransactionID *uuid.UUID `gorm:"type:uuid;index;column:transaction_id" json:"transaction_id,omitempty"` // Transaction the payment was made with
+
+	PaymentDate time.Time `gorm:"type:date;not null;index:idx_debt_payment_user_date,priority:2;column:payment_date" json:"payment_date"`
+	Amount      float64   `gorm:"type:decimal(15,2);not null;column:amount" json:"amount"`
+
+	// Split applied in order: fees, then interest, then principal
+	FeePortion       float64 `gorm:"type:decimal(15,2);default:0;column:fee_portion" json:"fee_portion"`
+	InterestPortion  float64 `gorm:"type:decimal(15,2);default:0;column:interest_portion" json:"interest_portion"`
+	PrincipalPortion float64 `gorm:"type:decimal(15,2);default:0;column:principal_portion" json:"principal_portion"`
+	BalanceAfter     float64 `gorm:"type:decimal(15,2);not null;column:balance_after" json:"balance_after"` // Principal balance after the payment
+
+	Description *string `gorm:"type:text;column:description" json:"description,omitempty"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+}
+
+// TableName specifies the table name for DebtPayment
+func (DebtPayment) TableName() string {
+	return "debt_payments"
+}
+
+// Accrual returns the interest accrual convention, defaulting to monthly
+func (d *Debt) Accrual() InterestAccrual {
+	if d.InterestAccrual == nil {
+		return InterestAccrualMonthly
+	}
+	return *d.InterestAccrual
+}
+
+// StartInterestAccrual sets where interest starts accruing on a new debt. The balance entered
+// already includes what was owed before today, so accrual starts today, or on the start date when
+// that is later. A debt already accruing is left alone.
+func (d *Debt) StartInterestAccrual(now time.Time) {
+	if d.InterestAccruedThrough != nil {
+		return
+	}
+	start := truncateDate(now)
+	if d.StartDate.After(start) {
+		start = truncateDate(d.StartDate)
+	}
+	d.InterestAccruedThrough = &start
+}
+
+// AccrueInterest adds the interest accrued on the balance since the last accrual up to asOf and
+// returns the amount added. Monthly accrual only counts whole months; the rest carries over.
+func (d *Debt) AccrueInterest(asOf time.Time) float64 {
+	asOfDate := truncateDate(asOf)
+	start := d.accrualStart()
+	if start == nil || !asOfDate.After(*start) {
+		return 0
+	}
+	if d.InterestRate <= 0 || d.CurrentBalance <= 0 {
+		d.InterestAccruedThrough = &asOfDate
+		return 0
+	}
+
+	var interest float64
+	through := asOfDate
+	switch d.Accrual() {
+	case InterestAccrualDaily:
+		days := math.Round(asOfDate.Sub(*start).Hours() / 24)
+		interest = d.CurrentBalance * d.InterestRate / 100 / 365 * days
+	default:
+		months := 0
+		for !addMonthsClamped(*start, months+1).After(asOfDate) {
+			months++
+		}
+		if months == 0 {
+			return 0
+		}
+		through = addMonthsClamped(*start, months)
+		interest = d.CurrentBalance * d.InterestRate / 100 / 12 * float64(months)
+	}
+
+	interest = roundMoney(interest)
+	d.AccruedInterest = roundMoney(d.AccruedInterest + interest)
+	d.InterestAccruedThrough = &through
+	return interest
+}
+
+// InterestDue returns the unpaid interest as of asOf, including what has accrued since the last
+// accrual, without changing the debt
+func (d *Debt) InterestDue(asOf time.Time) float64 {
+	preview := *d
+	preview.AccrueInterest(asOf)
+	return preview.AccruedInterest
+}
+
+// PayoffAmount returns what it takes to clear the debt on asOf: the balance plus the interest due
+// and the outstanding fees
+func (d *Debt) PayoffAmount(asOf time.Time) float64 {
+	return roundMoney(math.Max(d.CurrentBalance, 0) + d.InterestDue(asOf) + math.Max(d.OutstandingFees, 0))
+}
+
+// ApplyPayment accrues interest up to paidAt and applies the amount to outstanding fees, then
+// accrued interest, then principal. It returns the payment record to store.
+func (d *Debt) ApplyPayment(amount float64, paidAt time.Time) *DebtPayment {
+	d.AccrueInterest(paidAt)
+
+	remaining := amount
+	fee := math.Min(remaining, math.Max(d.OutstandingFees, 0))
+	remaining -= fee
+	interest := math.Min(remaining, math.Max(d.AccruedInterest, 0))
+	remaining -= interest
+	principal := math.Min(remaining, math.Max(d.CurrentBalance, 0))
+
+	d.OutstandingFees = roundMoney(d.OutstandingFees - fee)
+	d.AccruedInterest = roundMoney(d.AccruedInterest - interest)
+	d.CurrentBalance = roundMoney(d.CurrentBalance - principal)
+
+	d.TotalPaid += amount
+	d.TotalFeesPaid += fee
+	d.TotalInterestPaid += interest
+
+	// A back-dated payment does not move the last payment back
+	if d.LastPaymentDate == nil || !paidAt.Before(*d.LastPaymentDate) {
+		d.LastPaymentDate = &paidAt
+		d.LastPaymentAmount = &amount
+	}
+
+	d.UpdateCalculatedFields()
+
+	return &DebtPayment{
+		DebtID:           d.ID,
+		UserID:           d.UserID,
+		PaymentDate:      truncateDate(paidAt),
+		Amount:           amount,
+		FeePortion:       roundMoney(fee),
+		InterestPortion:  roundMoney(interest),
+		PrincipalPortion: roundMoney(principal),
+		BalanceAfter:     d.CurrentBalance,
+	}
+}
+
+// accrualStart is the date interest accrues from: the last accrual, else the last payment, else the start date
+func (d *Debt) accrualStart() *time.Time {
+	var start time.Time
+	switch {
+	case d.InterestAccruedThrough != nil:
+		start = *d.InterestAccruedThrough
+	case d.LastPaymentDate != nil:
+		start = *d.LastPaymentDate
+	case !d.StartDate.IsZero():
+		start = d.StartDate
+	default:
+		return nil
+	}
+	start = truncateDate(start)
+	return &start
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAccruingDebt(accrual InterestAccrual) *Debt {
+	return &Debt{
+		PrincipalAmount: 12000000,
+		CurrentBalance:  12000000,
+		InterestRate:    12,
+		InterestAccrual: &accrual,
+		StartDate:       time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestDebt_AccrueInterest(t *testing.T) {
+	t.Run("monthly counts whole months", func(t *testing.T) {
+		debt := newAccruingDebt(InterestAccrualMonthly)
+
+		assert.Equal(t, 0.0, debt.AccrueInterest(time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, 240000.0, debt.AccrueInterest(time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), *debt.InterestAccruedThrough)
+		assert.Equal(t, 240000.0, debt.AccruedInterest)
+	})
+
+	t.Run("daily counts actual days", func(t *testing.T) {
+		debt := newAccruingDebt(InterestAccrualDaily)
+
+		interest := debt.AccrueInterest(time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 118356.16, interest) // 12,000,000 * 12% / 365 * 30
+		assert.Equal(t, 118356.16, debt.AccruedInterest)
+		assert.Equal(t, 0.0, debt.AccrueInterest(time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("interest due does not change the debt", func(t *testing.T) {
+		debt := newAccruingDebt(InterestAccrualMonthly)
+
+		assert.Equal(t, 120000.0, debt.InterestDue(time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, 0.0, debt.AccruedInterest)
+		assert.Nil(t, debt.InterestAccruedThrough)
+	})
+}
+
+func TestDebt_ApplyPayment(t *testing.T) {
+	debt := newAccruingDebt(InterestAccrualMonthly)
+	debt.OutstandingFees = 50000
+
+	payment := debt.ApplyPayment(1000000, time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, 50000.0, payment.FeePortion)
+	assert.Equal(t, 120000.0, payment.InterestPortion)
+	assert.Equal(t, 830000.0, payment.PrincipalPortion)
+	assert.Equal(t, 11170000.0, payment.BalanceAfter)
+	assert.Equal(t, 11170000.0, debt.CurrentBalance)
+	assert.Equal(t, 0.0, debt.OutstandingFees)
+	assert.Equal(t, 0.0, debt.AccruedInterest)
+	assert.Equal(t, 120000.0, debt.TotalInterestPaid)
+	assert.Equal(t, 50000.0, debt.TotalFeesPaid)
+	assert.Equal(t, 1000000.0, debt.TotalPaid)
+
+	// A payment smaller than the interest leaves the rest accrued
+	small := debt.ApplyPayment(50000, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, 50000.0, small.InterestPortion)
+	assert.Equal(t, 0.0, small.PrincipalPortion)
+	assert.Equal(t, 61700.0, debt.AccruedInterest)
+	assert.Equal(t, 11170000.0, debt.CurrentBalance)
+}
+
+func TestDebt_ApplyPayment_BackDated(t *testing.T) {
+	debt := newAccruingDebt(InterestAccrualMonthly)
+	debt.ApplyPayment(1000000, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	debt.ApplyPayment(200000, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), *debt.LastPaymentDate)
+	assert.Equal(t, 1000000.0, *debt.LastPaymentAmount)
+	assert.Equal(t, 1200000.0, debt.TotalPaid)
+}
+
+func TestDebt_StartInterestAccrual(t *testing.T) {
+	now := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+
+	t.Run("old start date accrues from today", func(t *testing.T) {
+		debt := newAccruingDebt(InterestAccrualMonthly)
+		debt.StartDate = time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		debt.StartInterestAccrual(now)
+
+		assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), *debt.InterestAccruedThrough)
+		assert.Equal(t, 120000.0, debt.InterestDue(time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)), "one month, not years")
+	})
+
+	t.Run("future start date accrues from the start", func(t *testing.T) {
+		debt := newAccruingDebt(InterestAccrualMonthly)
+		debt.StartDate = time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		debt.StartInterestAccrual(now)
+
+		assert.Equal(t, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), *debt.InterestAccruedThrough)
+	})
+
+	t.Run("accruing debt is left alone", func(t *testing.T) {
+		debt := newAccruingDebt(InterestAccrualMonthly)
+		through := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+		debt.InterestAccruedThrough = &through
+
+		debt.StartInterestAccrual(now)
+
+		assert.Equal(t, through, *debt.InterestAccruedThrough)
+	})
+}
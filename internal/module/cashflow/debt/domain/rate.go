@@ -0,0 +1,289 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DebtRatePeriod is one stretch of a debt's rate schedule, running from StartDate until the next
+// period starts. A fixed period charges FixedRate; a floating period charges the reference index
+// plus Margin, re-fixed every ResetMonths and kept within RateFloor and RateCap.
+type DebtRatePeriod struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	DebtID uuid.UUID `gorm:"type:uuid;not null;index;column:debt_id" json:"debt_id"`
+	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+
+	StartDate time.Time `gorm:"type:date;not null;column:start_date" json:"start_date"`
+	RateType  RateType  `gorm:"type:varchar(20);not null;column:rate_type" json:"rate_type"`
+
+	FixedRate      *float64 `gorm:"type:decimal(5,2);column:fixed_rate" json:"fixed_rate,omitempty"`          // Annual rate (%) of a fixed period
+	ReferenceIndex *string  `gorm:"type:varchar(50);column:reference_index" json:"reference_index,omitempty"` // Index a floating period follows, e.g. "VCB_12M_DEPOSIT"
+	Margin         float64  `gorm:"type:decimal(5,2);default:0;column:margin" json:"margin"`                  // Percentage points over the index
+	ResetMonths    int      `gorm:"default:0;column:reset_months" json:"reset_months"`                        // Months between repricings; 0 follows every index change
+	RateFloor      *float64 `gorm:"type:decimal(5,2);column:rate_floor" json:"rate_floor,omitempty"`          // Lowest annual rate (%) a floating period charges
+	RateCap        *float64 `gorm:"type:decimal(5,2);column:rate_cap" json:"rate_cap,omitempty"`              // Highest annual rate (%) a floating period charges
+	Description    *string  `gorm:"type:varchar(255);column:description" json:"description,omitempty"`        // e.g. "Ưu đãi 24 tháng đầu"
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+}
+
+// TableName specifies the table name for DebtRatePeriod
+func (DebtRatePeriod) TableName() string {
+	return "debt_rate_periods"
+}
+
+// ReferenceRate is a published value of a reference index, effective from EffectiveDate until the
+// next value. Users record the values their lenders price against.
+type ReferenceRate struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_reference_rate_user_index_date,priority:1;column:user_id" json:"user_id"`
+
+	Index         string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_reference_rate_user_index_date,priority:2;column:index_name" json:"index"`
+	EffectiveDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_reference_rate_user_index_date,priority:3;column:effective_date" json:"effective_date"`
+	Rate          float64   `gorm:"type:decimal(5,2);not null;column:rate" json:"rate"` // Annual rate (%)
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for ReferenceRate
+func (ReferenceRate) TableName() string {
+	return "reference_rates"
+}
+
+// RateSchedule resolves a debt's annual rate on any date from its rate periods and the reference
+// index series they follow. Values past the last published index value stay flat.
+type RateSchedule struct {
+	Periods    []DebtRatePeriod
+	References map[string][]ReferenceRate
+	Shock      float64 // Percentage points added to every reference value, for stress tests
+}
+
+// NewRateSchedule orders the periods by start date and groups the reference values by index
+func NewRateSchedule(periods []DebtRatePeriod, references []ReferenceRate) *RateSchedule {
+	s := &RateSchedule{
+		Periods:    append([]DebtRatePeriod(nil), periods...),
+		References: make(map[string][]ReferenceRate),
+	}
+	sort.SliceStable(s.Periods, func(i, j int) bool {
+		return s.Periods[i].StartDate.Before(s.Periods[j].StartDate)
+	})
+
+	for _, ref := range references {
+		key := NormalizeIndex(ref.Index)
+		s.References[key] = append(s.References[key], ref)
+	}
+	for key := range s.References {
+		series := s.References[key]
+		sort.SliceStable(series, func(i, j int) bool {
+			return series[i].EffectiveDate.Before(series[j].EffectiveDate)
+		})
+	}
+	return s
+}
+
+// WithShock returns a copy of the schedule whose reference values are raised by points
+func (s *RateSchedule) WithShock(points float64) *RateSchedule {
+	shocked := *s
+	shocked.Shock = s.Shock + points
+	return &shocked
+}
+
+// Indexes lists the reference indexes followed by the floating periods
+func (s *RateSchedule) Indexes() []string {
+	seen := make(map[string]bool)
+	indexes := make([]string, 0)
+	for _, p := range s.Periods {
+		if p.RateType != RateTypeFloating || p.ReferenceIndex == nil {
+			continue
+		}
+		key := NormalizeIndex(*p.ReferenceIndex)
+		if !seen[key] {
+			seen[key] = true
+			indexes = append(indexes, key)
+		}
+	}
+	return indexes
+}
+
+// HasFloating reports whether any period floats with a reference index
+func (s *RateSchedule) HasFloating() bool {
+	return len(s.Indexes()) > 0
+}
+
+// PeriodAt returns the period in force on date, or nil before the first period starts
+func (s *RateSchedule) PeriodAt(date time.Time) *DebtRatePeriod {
+	day := truncateDate(date)
+	var current *DebtRatePeriod
+	for i := range s.Periods {
+		if truncateDate(s.Periods[i].StartDate).After(day) {
+			break
+		}
+		current = &s.Periods[i]
+	}
+	return current
+}
+
+// ReferenceAt returns the value of the index in effect on date
+func (s *RateSchedule) ReferenceAt(index string, date time.Time) (float64, bool) {
+	day := truncateDate(date)
+	series := s.References[NormalizeIndex(index)]
+	value, found := 0.0, false
+	for _, ref := range series {
+		if truncateDate(ref.EffectiveDate).After(day) {
+			break
+		}
+		value, found = ref.Rate, true
+	}
+	return value, found
+}
+
+// RateAt returns the annual rate (%) charged on date. Dates before the first period, and floating
+// periods whose index has no value yet, are charged fallback.
+func (s *RateSchedule) RateAt(date time.Time, fallback float64) float64 {
+	period := s.PeriodAt(date)
+	if period == nil {
+		return fallback
+	}
+
+	if period.RateType == RateTypeFixed {
+		if period.FixedRate == nil {
+			return fallback
+		}
+		return *period.FixedRate
+	}
+
+	rate := fallback
+	if period.ReferenceIndex != nil {
+		if ref, ok := s.ReferenceAt(*period.ReferenceIndex, period.repricedOn(date)); ok {
+			rate = ref + period.Margin
+		}
+	}
+	rate += s.Shock
+	if period.RateFloor != nil {
+		rate = math.Max(rate, *period.RateFloor)
+	}
+	if period.RateCap != nil {
+		rate = math.Min(rate, *period.RateCap)
+	}
+	return math.Max(roundMoney(rate), 0)
+}
+
+// RateStep is the rate a schedule charges from a month onwards, months counted from 1
+type RateStep struct {
+	FromMonth int
+	Rate      float64 // Annual rate (%)
+	Floating  bool
+	RateFloor *float64
+	RateCap   *float64
+}
+
+// Steps walks the schedule month by month for months months from start and returns a step
+// wherever the rate charged or the period in force changes
+func (s *RateSchedule) Steps(start time.Time, months int, fallback float64) []RateStep {
+	steps := make([]RateStep, 0)
+	var previous *DebtRatePeriod
+	for month := 1; month <= months; month++ {
+		date := addMonthsClamped(start, month-1)
+		period := s.PeriodAt(date)
+		rate := s.RateAt(date, fallback)
+		if len(steps) > 0 && period == previous && rate == steps[len(steps)-1].Rate {
+			continue
+		}
+		previous = period
+
+		step := RateStep{FromMonth: month, Rate: rate}
+		if period != nil && period.RateType == RateTypeFloating {
+			step.Floating = true
+			step.RateFloor = period.RateFloor
+			step.RateCap = period.RateCap
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// repricedOn returns the date the floating rate charged on date was last re-fixed
+func (p *DebtRatePeriod) repricedOn(date time.Time) time.Time {
+	if p.ResetMonths <= 0 {
+		return date
+	}
+	day := truncateDate(date)
+	resets := 0
+	for !addMonthsClamped(p.StartDate, (resets+1)*p.ResetMonths).After(day) {
+		resets++
+	}
+	return addMonthsClamped(p.StartDate, resets*p.ResetMonths)
+}
+
+// ValidateRatePeriods checks a rate schedule before it replaces a debt's current one
+func ValidateRatePeriods(periods []DebtRatePeriod) error {
+	if len(periods) == 0 {
+		return errors.New("at least one rate period is required")
+	}
+
+	starts := make(map[time.Time]bool)
+	for _, p := range periods {
+		if p.StartDate.IsZero() {
+			return errors.New("rate period start date is required")
+		}
+		day := truncateDate(p.StartDate)
+		if starts[day] {
+			return errors.New("rate periods must start on different dates")
+		}
+		starts[day] = true
+
+		switch p.RateType {
+		case RateTypeFixed:
+			if p.FixedRate == nil || *p.FixedRate < 0 || *p.FixedRate > 100 {
+				return errors.New("fixed rate periods need a rate between 0 and 100")
+			}
+		case RateTypeFloating:
+			if p.ReferenceIndex == nil || strings.TrimSpace(*p.ReferenceIndex) == "" {
+				return errors.New("floating rate periods need a reference index")
+			}
+			if p.ResetMonths < 0 {
+				return errors.New("reset months cannot be negative")
+			}
+			if p.RateFloor != nil && p.RateCap != nil && *p.RateFloor > *p.RateCap {
+				return errors.New("rate floor cannot exceed the rate cap")
+			}
+		default:
+			return errors.New("invalid rate type")
+		}
+	}
+	return nil
+}
+
+// NormalizeIndex returns the canonical form of a reference index name
+func NormalizeIndex(index string) string {
+	return strings.ToUpper(strings.TrimSpace(index))
+}
+
+// RateOn returns the annual rate (%) the debt charges on date, following its rate schedule if it has one
+func (d *Debt) RateOn(date time.Time) float64 {
+	if d.Rates == nil {
+		return d.InterestRate
+	}
+	return d.Rates.RateAt(date, d.InterestRate)
+}
+
+// ApplyCurrentRate moves InterestRate to the rate the schedule charges at now, accruing interest at
+// the old rate first. It reports whether the rate changed.
+func (d *Debt) ApplyCurrentRate(now time.Time) bool {
+	if d.Rates == nil {
+		return false
+	}
+	rate := d.RateOn(now)
+	if rate == d.InterestRate {
+		return false
+	}
+	d.AccrueInterest(now)
+	d.InterestRate = rate
+	return true
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// teaserMortgage is fixed at 7% for six months, then floats at the index plus 3.5 repriced quarterly
+func teaserMortgage() *RateSchedule {
+	periods := []DebtRatePeriod{
+		{StartDate: day(2026, 7, 15), RateType: RateTypeFloating, ReferenceIndex: ptr("vcb_12m"), Margin: 3.5, ResetMonths: 3, RateCap: ptr(12.0)},
+		{StartDate: day(2026, 1, 15), RateType: RateTypeFixed, FixedRate: ptr(7.0)},
+	}
+	references := []ReferenceRate{
+		{Index: "VCB_12M", EffectiveDate: day(2026, 8, 1), Rate: 5.5},
+		{Index: "VCB_12M", EffectiveDate: day(2026, 1, 1), Rate: 5.0},
+	}
+	return NewRateSchedule(periods, references)
+}
+
+func TestRateSchedule_RateAt(t *testing.T) {
+	schedule := teaserMortgage()
+
+	assert.Equal(t, 10.0, schedule.RateAt(day(2026, 1, 1), 10), "before the first period")
+	assert.Equal(t, 7.0, schedule.RateAt(day(2026, 3, 1), 10))
+	assert.Equal(t, 8.5, schedule.RateAt(day(2026, 7, 15), 10))
+	// The August index change waits for the October repricing
+	assert.Equal(t, 8.5, schedule.RateAt(day(2026, 9, 30), 10))
+	assert.Equal(t, 9.0, schedule.RateAt(day(2026, 10, 15), 10))
+	assert.Equal(t, []string{"VCB_12M"}, schedule.Indexes())
+}
+
+func TestRateSchedule_WithShock(t *testing.T) {
+	shocked := teaserMortgage().WithShock(2)
+
+	assert.Equal(t, 7.0, shocked.RateAt(day(2026, 3, 1), 10), "fixed periods are not shocked")
+	assert.Equal(t, 10.5, shocked.RateAt(day(2026, 7, 15), 10))
+	assert.Equal(t, 11.0, shocked.RateAt(day(2026, 10, 15), 10))
+	assert.Equal(t, 12.0, shocked.WithShock(2).RateAt(day(2026, 10, 15), 10), "capped")
+}
+
+func TestRateSchedule_Steps(t *testing.T) {
+	steps := teaserMortgage().Steps(day(2026, 1, 15), 12, 10)
+
+	assert.Equal(t, []RateStep{
+		{FromMonth: 1, Rate: 7},
+		{FromMonth: 7, Rate: 8.5, Floating: true, RateCap: ptr(12.0)},
+		{FromMonth: 10, Rate: 9, Floating: true, RateCap: ptr(12.0)},
+	}, steps)
+}
+
+func TestValidateRatePeriods(t *testing.T) {
+	assert.NoError(t, ValidateRatePeriods(teaserMortgage().Periods))
+	assert.Error(t, ValidateRatePeriods(nil))
+	assert.Error(t, ValidateRatePeriods([]DebtRatePeriod{{StartDate: day(2026, 1, 1), RateType: RateTypeFixed}}))
+	assert.Error(t, ValidateRatePeriods([]DebtRatePeriod{{StartDate: day(2026, 1, 1), RateType: RateTypeFloating}}))
+	assert.Error(t, ValidateRatePeriods([]DebtRatePeriod{{
+		StartDate: day(2026, 1, 1), RateType: RateTypeFloating, ReferenceIndex: ptr("X"), RateFloor: ptr(9.0), RateCap: ptr(8.0),
+	}}))
+}
+
+func TestDebt_GenerateSchedule_RateSchedule(t *testing.T) {
+	debt := newLoan(DebtBehaviorInstallment, AmortizationAnnuity)
+	debt.StartDate = day(2026, 1, 15)
+	debt.Rates = NewRateSchedule([]DebtRatePeriod{
+		{StartDate: day(2026, 1, 15), RateType: RateTypeFixed, FixedRate: ptr(12.0)},
+		{StartDate: day(2026, 7, 15), RateType: RateTypeFixed, FixedRate: ptr(24.0)},
+	}, nil)
+
+	entries, err := debt.GenerateSchedule()
+
+	require.NoError(t, err)
+	require.Len(t, entries, 12)
+	assert.Equal(t, 12.0, entries[5].InterestRate)
+	assert.Equal(t, 24.0, entries[6].InterestRate)
+	assert.Equal(t, 1066185.46, entries[0].Payment)
+	// The remaining balance is re-amortized over the last six payments at the new rate
+	assert.Greater(t, entries[6].Payment, entries[5].Payment)
+	assert.Equal(t, entries[6].Payment, entries[10].Payment)
+	assert.Equal(t, 0.0, entries[11].RemainingBalance)
+	assert.Equal(t, 12000000.0, sumPrincipal(entries))
+
+	assert.False(t, debt.ScheduleIsStale(entries))
+	debt.Rates = nil
+	assert.True(t, debt.ScheduleIsStale(entries))
+}
+
+func TestDebt_ApplyCurrentRate(t *testing.T) {
+	debt := newLoan(DebtBehaviorInstallment, AmortizationAnnuity)
+	debt.StartDate = day(2026, 1, 15)
+	debt.Rates = teaserMortgage()
+
+	assert.True(t, debt.ApplyCurrentRate(day(2026, 2, 15)))
+	assert.Equal(t, 7.0, debt.InterestRate)
+	assert.Equal(t, 120000.0, debt.AccruedInterest, "a month accrued at the old 12%")
+	assert.False(t, debt.ApplyCurrentRate(day(2026, 3, 15)))
+}
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DebtRefinance records a refinance or consolidation applied to a user's debts: the new loan that
+// paid them off and what the switch was expected to save, so the decision can be reviewed later
+type DebtRefinance struct {
+	ID               uuid.UUID  `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID           uuid.UUID  `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	NewDebtID        uuid.UUID  `gorm:"type:uuid;not null;index;column:new_debt_id" json:"new_debt_id"`
+	FundingAccountID *uuid.UUID `gorm:"type:uuid;column:funding_account_id" json:"funding_account_id,omitempty"` // Account the loan proceeds passed through
+	Currency         string     `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+
+	// Terms of the new loan
+	NewRate        float64 `gorm:"type:decimal(5,2);not null;column:new_rate" json:"new_rate"` // Annual rate (%)
+	TermMonths     int     `gorm:"not null;column:term_months" json:"term_months"`
+	OriginationFee float64 `gorm:"type:decimal(15,2);default:0;column:origination_fee" json:"origination_fee"` // Added to the new loan's principal
+	MonthlyFee     float64 `gorm:"type:decimal(15,2);default:0;column:monthly_fee" json:"monthly_fee"`
+
+	// Expectations when the refinance was applied
+	PaidOffBalance      float64 `gorm:"type:decimal(15,2);not null;column:paid_off_balance" json:"paid_off_balance"`           // Balance, interest and fees paid off
+	OldWeightedRate     float64 `gorm:"type:decimal(5,2);not null;column:old_weighted_rate" json:"old_weighted_rate"`          // Balance-weighted rate of the old debts (%)
+	OldMonthlyPayment   float64 `gorm:"type:decimal(15,2);not null;column:old_monthly_payment" json:"old_monthly_payment"`     // Combined monthly payment of the old debts
+	NewMonthlyPayment   float64 `gorm:"type:decimal(15,2);not null;column:new_monthly_payment" json:"new_monthly_payment"`     // First scheduled payment of the new loan
+	ExpectedOldInterest float64 `gorm:"type:decimal(15,2);not null;column:expected_old_interest" json:"expected_old_interest"` // Interest the old debts would have charged
+	ExpectedNewInterest float64 `gorm:"type:decimal(15,2);not null;column:expected_new_interest" json:"expected_new_interest"` // Interest scheduled on the new loan
+	ExpectedSavings     float64 `gorm:"type:decimal(15,2);not null;column:expected_savings" json:"expected_savings"`           // Old interest - new interest - fees
+
+	AppliedAt time.Time           `gorm:"type:date;not null;column:applied_at" json:"applied_at"`
+	Items     []DebtRefinanceItem `gorm:"foreignKey:RefinanceID" json:"items"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+}
+
+// TableName specifies the table name for DebtRefinance
+func (DebtRefinance) TableName() string {
+	return "debt_refinances"
+}
+
+// DebtRefinanceItem is one old debt paid off by a refinance, as it stood when it was paid off
+type DebtRefinanceItem struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	RefinanceID uuid.UUID `gorm:"type:uuid;not null;index;column:refinance_id" json:"refinance_id"`
+	DebtID      uuid.UUID `gorm:"type:uuid;not null;index;column:debt_id" json:"debt_id"`
+	DebtName    string    `gorm:"type:varchar(255);not null;column:debt_name" json:"debt_name"`
+
+	PayoffAmount      float64 `gorm:"type:decimal(15,2);not null;column:payoff_amount" json:"payoff_amount"` // Balance plus accrued interest and fees
+	InterestRate      float64 `gorm:"type:decimal(5,2);not null;column:interest_rate" json:"interest_rate"`
+	MonthlyPayment    float64 `gorm:"type:decimal(15,2);not null;column:monthly_payment" json:"monthly_payment"`
+	ProjectedInterest float64 `gorm:"type:decimal(15,2);not null;column:projected_interest" json:"projected_interest"` // Interest still to come without the refinance
+
+	PayoffTransactionID *uuid.UUID `gorm:"type:uuid;column:payoff_transaction_id" json:"payoff_transaction_id,omitempty"`
+}
+
+// TableName specifies the table name for DebtRefinanceItem
+func (DebtRefinanceItem) TableName() string {
+	return "debt_refinance_items"
+}
+
+// AddPaidOffDebt adds a debt the refinance pays off as of AppliedAt: what it takes to pay off, the
+// payment it is assumed to keep up over the new term and the interest it would still charge
+func (r *DebtRefinance) AddPaidOffDebt(debt *Debt) *DebtRefinanceItem {
+	payoff := debt.PayoffAmount(r.AppliedAt)
+	payment := debt.BaselinePayment(payoff, r.TermMonths)
+	interest, _ := ProjectInterest(payoff, debt.InterestRate, payment, 0)
+	r.Items = append(r.Items, DebtRefinanceItem{
+		RefinanceID:       r.ID,
+		DebtID:            debt.ID,
+		DebtName:          debt.Name,
+		PayoffAmount:      payoff,
+		InterestRate:      debt.InterestRate,
+		MonthlyPayment:    payment,
+		ProjectedInterest: interest,
+	})
+
+	r.PaidOffBalance += payoff
+	r.OldMonthlyPayment += payment
+	r.ExpectedOldInterest += interest
+
+	weightedRate := 0.0
+	for _, item := range r.Items {
+		weightedRate += item.PayoffAmount * item.InterestRate
+	}
+	r.OldWeightedRate = 0
+	if r.PaidOffBalance > 0 {
+		r.OldWeightedRate = roundMoney(weightedRate / r.PaidOffBalance)
+	}
+	return &r.Items[len(r.Items)-1]
+}
+
+// LoanPrincipal returns the principal of the new loan: the payoffs plus the origination fee
+func (r *DebtRefinance) LoanPrincipal() float64 {
+	return roundMoney(r.PaidOffBalance + r.OriginationFee)
+}
+
+// LoanStartDate returns the date the new loan starts on
+func (r *DebtRefinance) LoanStartDate() time.Time {
+	return truncateDate(r.AppliedAt)
+}
+
+// SetNewLoan records the new loan and the payments and interest its schedule expects, and from
+// them the expected savings
+func (r *DebtRefinance) SetNewLoan(loan *Debt, schedule []DebtScheduleEntry) {
+	totalInterest := 0.0
+	for _, entry := range schedule {
+		totalInterest += entry.Interest
+	}
+
+	r.NewDebtID = loan.ID
+	r.NewMonthlyPayment = loan.PaymentAmount
+	r.ExpectedNewInterest = roundMoney(totalInterest)
+	r.ExpectedSavings = roundMoney(r.ExpectedOldInterest - r.ExpectedNewInterest -
+		r.OriginationFee - r.MonthlyFee*float64(len(schedule)))
+}
+
+// RefinanceReview compares the savings a refinance was expected to bring by now with what it realized
+type RefinanceReview struct {
+	AsOf          time.Time `json:"as_of"`
+	MonthsElapsed int       `json:"months_elapsed"`
+
+	ExpectedSavings       float64 `json:"expected_savings"`         // Over the life of the loans
+	ExpectedSavingsToDate float64 `json:"expected_savings_to_date"` // Old interest projected to date - new interest scheduled to date - fees to date
+
+	BaselineInterestToDate float64 `json:"baseline_interest_to_date"` // Interest the old debts would have charged by now
+	InterestPaid           float64 `json:"interest_paid"`             // Interest actually paid on the new loan
+	FeesPaid               float64 `json:"fees_paid"`                 // Origination fee plus fees paid on the new loan
+	RealizedSavingsToDate  float64 `json:"realized_savings_to_date"`  // Baseline interest - interest paid - fees paid
+
+	Variance float64 `json:"variance"` // Realized - expected to date; negative when behind
+	OnTrack  bool    `json:"on_track"`
+}
+
+// Review compares the expected and realized savings of the refinance as of asOf. The old debts'
+// interest is projected to date as if they had been kept; against it, the plan counts the new loan's
+// scheduled interest and fees, and the realized side what was actually paid on the new loan.
+func (r *DebtRefinance) Review(newDebt *Debt, schedule []DebtScheduleEntry, asOf time.Time) *RefinanceReview {
+	months := monthsBetween(r.AppliedAt, asOf)
+
+	baseline := 0.0
+	if months > 0 {
+		for _, item := range r.Items {
+			interest, _ := ProjectInterest(item.PayoffAmount, item.InterestRate, item.MonthlyPayment, months)
+			baseline += interest
+		}
+	}
+	scheduledInterest := 0.0
+	asOfDate := truncateDate(asOf)
+	for _, entry := range schedule {
+		if !entry.DueDate.After(asOfDate) {
+			scheduledInterest += entry.Interest
+		}
+	}
+	expectedToDate := baseline - scheduledInterest - r.OriginationFee - r.MonthlyFee*float64(months)
+
+	review := &RefinanceReview{
+		AsOf:                   asOf,
+		MonthsElapsed:          months,
+		ExpectedSavings:        r.ExpectedSavings,
+		ExpectedSavingsToDate:  roundMoney(expectedToDate),
+		BaselineInterestToDate: roundMoney(baseline),
+		FeesPaid:               r.OriginationFee,
+	}
+	if newDebt != nil {
+		review.InterestPaid = roundMoney(newDebt.TotalInterestPaid)
+		review.FeesPaid = roundMoney(review.FeesPaid + newDebt.TotalFeesPaid)
+	}
+	review.RealizedSavingsToDate = roundMoney(baseline - review.InterestPaid - review.FeesPaid)
+	review.Variance = roundMoney(review.RealizedSavingsToDate - review.ExpectedSavingsToDate)
+	review.OnTrack = review.Variance >= -scheduleTolerance(r.NewMonthlyPayment)
+	return review
+}
+
+// BaselinePayment is the monthly payment a debt being refinanced is assumed to keep up without the
+// refinance: its payment amount, else its minimum payment, converted to a monthly amount, else the
+// level payment that clears the payoff over the given months at its rate
+func (d *Debt) BaselinePayment(payoff float64, months int) float64 {
+	payment := d.PaymentAmount
+	if payment <= 0 {
+		payment = d.MinimumPayment
+	}
+	if payment > 0 {
+		return roundMoney(payment * float64(d.PeriodsPerYear()) / 12)
+	}
+	if months <= 0 {
+		months = 1
+	}
+	return annuityPayment(payoff, d.InterestRate/100/12, months)
+}
+
+// ProjectInterest projects the interest a balance charges at an annual rate (%) while repaid by a
+// fixed monthly payment, over the given number of months or, when months is 0, until it is paid
+// off. It returns the interest and the months the projection ran. A payment that does not cover
+// the interest runs up to MaxSchedulePeriods.
+func ProjectInterest(balance, annualRate, payment float64, months int) (float64, int) {
+	limit := months
+	if limit <= 0 || limit > MaxSchedulePeriods {
+		limit = MaxSchedulePeriods
+	}
+
+	monthlyRate := annualRate / 100 / 12
+	total := 0.0
+	month := 0
+	for ; month < limit && balance > 0.005; month++ {
+		interest := balance * monthlyRate
+		total += interest
+		balance = balance + interest - math.Min(payment, balance+interest)
+	}
+	return roundMoney(total), month
+}
+
+// monthsBetween counts the whole months from start to end
+func monthsBetween(start, end time.Time) int {
+	start, end = truncateDate(start), truncateDate(end)
+	months := 0
+	for !addMonthsClamped(start, months+1).After(end) {
+		months++
+	}
+	return months
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectInterest(t *testing.T) {
+	interest, months := ProjectInterest(1000, 12, 1010, 0)
+	assert.Equal(t, 10.0, interest)
+	assert.Equal(t, 1, months)
+
+	interest, months = ProjectInterest(1000, 0, 100, 0)
+	assert.Equal(t, 0.0, interest)
+	assert.Equal(t, 10, months, "interest-free balance runs until paid off")
+
+	interest, months = ProjectInterest(1000, 24, 100, 3)
+	assert.Equal(t, 55.17, interest)
+	assert.Equal(t, 3, months, "stops after the requested months")
+}
+
+func TestDebt_PayoffAmount(t *testing.T) {
+	debt := &Debt{
+		CurrentBalance:  1000,
+		InterestRate:    12,
+		OutstandingFees: 5,
+		StartDate:       day(2026, 1, 1),
+	}
+
+	assert.Equal(t, 1025.0, debt.PayoffAmount(day(2026, 3, 1)))
+	assert.Equal(t, 0.0, debt.AccruedInterest, "payoff is a preview")
+}
+
+func TestDebt_BaselinePayment(t *testing.T) {
+	weekly := FrequencyWeekly
+
+	assert.Equal(t, 300.0, (&Debt{PaymentAmount: 300, MinimumPayment: 100}).BaselinePayment(1200, 12))
+	assert.Equal(t, 100.0, (&Debt{MinimumPayment: 100}).BaselinePayment(1200, 12))
+	assert.Equal(t, 433.33, (&Debt{PaymentAmount: 100, PaymentFrequency: &weekly}).BaselinePayment(1200, 12))
+	assert.Equal(t, 100.0, (&Debt{}).BaselinePayment(1200, 12), "interest-free payoff spread over the term")
+}
+
+func TestDebt_UpdateCalculatedFields_KeepsSettled(t *testing.T) {
+	debt := &Debt{PrincipalAmount: 1000, CurrentBalance: 0, Status: DebtStatusSettled}
+
+	debt.UpdateCalculatedFields()
+
+	assert.Equal(t, DebtStatusSettled, debt.Status)
+	assert.Equal(t, 100.0, debt.PercentagePaid)
+}
+
+func TestDebtRefinance_AddPaidOffDebtAndSetNewLoan(t *testing.T) {
+	appliedAt := day(2026, 3, 10)
+	refinance := &DebtRefinance{TermMonths: 12, OriginationFee: 30, MonthlyFee: 1, AppliedAt: appliedAt}
+
+	refinance.AddPaidOffDebt(&Debt{Name: "Card A", CurrentBalance: 1000, InterestRate: 24, MinimumPayment: 100, InterestAccruedThrough: &appliedAt})
+	item := refinance.AddPaidOffDebt(&Debt{Name: "Card B", CurrentBalance: 500, InterestRate: 30, MinimumPayment: 50, InterestAccruedThrough: &appliedAt})
+
+	assert.Equal(t, "Card B", item.DebtName)
+	assert.Len(t, refinance.Items, 2)
+	assert.Equal(t, 1500.0, refinance.PaidOffBalance)
+	assert.Equal(t, 150.0, refinance.OldMonthlyPayment)
+	assert.Equal(t, 26.0, refinance.OldWeightedRate)
+	assert.Equal(t, 1530.0, refinance.LoanPrincipal())
+	assert.Equal(t, appliedAt, refinance.LoanStartDate())
+
+	loan := &Debt{PaymentAmount: 136}
+	refinance.SetNewLoan(loan, []DebtScheduleEntry{{Interest: 15.3}, {Interest: 10.2}})
+	assert.Equal(t, 136.0, refinance.NewMonthlyPayment)
+	assert.Equal(t, 25.5, refinance.ExpectedNewInterest)
+	assert.InDelta(t, refinance.ExpectedOldInterest-25.5-30-2, refinance.ExpectedSavings, 0.01)
+}
+
+func TestDebtRefinance_Review(t *testing.T) {
+	refinance := &DebtRefinance{
+		NewRate:           9.6,
+		OriginationFee:    10,
+		NewMonthlyPayment: 100,
+		ExpectedSavings:   120,
+		AppliedAt:         day(2026, 1, 1),
+		Items: []DebtRefinanceItem{
+			{PayoffAmount: 1000, InterestRate: 24, MonthlyPayment: 100},
+		},
+	}
+	schedule := []DebtScheduleEntry{
+		{Period: 1, DueDate: day(2026, 2, 1), Interest: 8},
+		{Period: 2, DueDate: day(2026, 3, 1), Interest: 7},
+		{Period: 3, DueDate: day(2026, 4, 1), Interest: 6},
+		{Period: 4, DueDate: day(2026, 5, 1), Interest: 5},
+	}
+
+	t.Run("paid as planned", func(t *testing.T) {
+		review := refinance.Review(&Debt{TotalInterestPaid: 21}, schedule, day(2026, 4, 1))
+
+		assert.Equal(t, 3, review.MonthsElapsed)
+		assert.Equal(t, 55.17, review.BaselineInterestToDate)
+		assert.Equal(t, 24.17, review.ExpectedSavingsToDate)
+		assert.Equal(t, 10.0, review.FeesPaid)
+		assert.Equal(t, 24.17, review.RealizedSavingsToDate)
+		assert.Equal(t, 0.0, review.Variance)
+		assert.True(t, review.OnTrack)
+		assert.Equal(t, 120.0, review.ExpectedSavings)
+	})
+
+	t.Run("more interest than planned", func(t *testing.T) {
+		review := refinance.Review(&Debt{TotalInterestPaid: 40, TotalFeesPaid: 2}, schedule, day(2026, 4, 1))
+
+		assert.Equal(t, 12.0, review.FeesPaid)
+		assert.Equal(t, 3.17, review.RealizedSavingsToDate)
+		assert.Equal(t, -21.0, review.Variance)
+		assert.False(t, review.OnTrack)
+	})
+
+	t.Run("before the first month", func(t *testing.T) {
+		review := refinance.Review(&Debt{}, schedule, day(2026, 1, 20))
+
+		assert.Equal(t, 0, review.MonthsElapsed)
+		assert.Equal(t, 0.0, review.BaselineInterestToDate)
+		assert.Equal(t, -10.0, review.ExpectedSavingsToDate, "only the origination fee so far")
+	})
+}
@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultDelinquencyThreshold is the number of missed payments after which a debt is delinquent
+	// when the debt does not set its own threshold
+	DefaultDelinquencyThreshold = 3
+
+	// maxTrackedMissedPayments bounds the missed payment count of debts left unpaid for years
+	maxTrackedMissedPayments = 1000
+)
+
+// DebtStatusEvent records one change of a debt's payment state or status: a payment date reached,
+// missed or caught up, a late fee charged, or the debt moving into or out of default
+type DebtStatusEvent struct {
+	ID      uuid.UUID     `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	DebtID  uuid.UUID     `gorm:"type:uuid;not null;index;column:debt_id" json:"debt_id"`
+	UserID  uuid.UUID     `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	Trigger StatusTrigger `gorm:"type:varchar(20);not null;column:trigger" json:"trigger"`
+
+	FromState  PaymentState `gorm:"type:varchar(20);column:from_state" json:"from_state,omitempty"`
+	ToState    PaymentState `gorm:"type:varchar(20);column:to_state" json:"to_state,omitempty"`
+	FromStatus DebtStatus   `gorm:"type:varchar(20);column:from_status" json:"from_status"`
+	ToStatus   DebtStatus   `gorm:"type:varchar(20);column:to_status" json:"to_status"`
+
+	MissedPayments  int        `gorm:"default:0;column:missed_payments" json:"missed_payments"`
+	LateFee         float64    `gorm:"type:decimal(15,2);default:0;column:late_fee" json:"late_fee"` // Late fee charged by this change
+	NextPaymentDate *time.Time `gorm:"type:date;column:next_payment_date" json:"next_payment_date,omitempty"`
+
+	OccurredAt time.Time `gorm:"not null;index;column:occurred_at" json:"occurred_at"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+}
+
+// TableName specifies the table name for DebtStatusEvent
+func (DebtStatusEvent) TableName() string {
+	return "debt_status_history"
+}
+
+// StateChanged reports whether the event moved the debt to another payment state
+func (e *DebtStatusEvent) StateChanged() bool {
+	return e.FromState != e.ToState
+}
+
+// Subject returns a one-line description of the event for notifications
+func (e *DebtStatusEvent) Subject(debtName string) string {
+	switch {
+	case e.FromStatus != DebtStatusDefaulted && e.ToStatus == DebtStatusDefaulted:
+		return fmt.Sprintf("%s is in default after %d missed payments", debtName, e.MissedPayments)
+	case e.FromStatus == DebtStatusDefaulted && e.ToStatus == DebtStatusActive:
+		return fmt.Sprintf("%s is no longer in default", debtName)
+	case !e.StateChanged() && e.LateFee > 0:
+		return fmt.Sprintf("Late fee charged on %s", debtName)
+	case !e.StateChanged():
+		return fmt.Sprintf("%s now has %d missed payments", debtName, e.MissedPayments)
+	}
+
+	switch e.ToState {
+	case PaymentStateUpcoming:
+		if e.FromState.IsLate() {
+			return fmt.Sprintf("%s is back on track", debtName)
+		}
+		return fmt.Sprintf("Next payment on %s is scheduled", debtName)
+	case PaymentStateDueToday:
+		return fmt.Sprintf("Payment on %s is due", debtName)
+	case PaymentStateOverdue:
+		return fmt.Sprintf("Payment on %s is overdue", debtName)
+	case PaymentStateDelinquent:
+		return fmt.Sprintf("%s is delinquent after %d missed payments", debtName, e.MissedPayments)
+	}
+	return fmt.Sprintf("%s no longer has a payment due", debtName)
+}
+
+// EvaluatePaymentState moves the debt to its payment state as of asOf. Every payment date whose
+// grace period has passed counts as missed, and each newly missed payment is charged the debt's
+// late fee. A debt reaching its delinquency threshold goes into default and comes back out once
+// enough payments are caught up. It returns the event to record, or nil when nothing changed.
+//
+// Payments already missed when a debt is first tracked are counted but not charged, so turning on
+// late fee settings does not back-charge old payments.
+func (d *Debt) EvaluatePaymentState(asOf time.Time, trigger StatusTrigger) *DebtStatusEvent {
+	fromState, fromStatus, fromMissed := d.PaymentState, d.Status, d.MissedPayments
+
+	state, missed := d.paymentStateAt(asOf)
+
+	lateFee := 0.0
+	if fromState != "" && missed > fromMissed {
+		lateFee = roundMoney(float64(missed-fromMissed) * d.LateFeePerPayment())
+		d.OutstandingFees = roundMoney(d.OutstandingFees + lateFee)
+		d.LateFeesCharged = roundMoney(d.LateFeesCharged + lateFee)
+	}
+
+	d.MissedPayments = missed
+	d.OverdueSince = nil
+	if missed > 0 {
+		since := dateOnly(*d.NextPaymentDate)
+		d.OverdueSince = &since
+	}
+
+	switch {
+	case state == PaymentStateDelinquent && d.Status == DebtStatusActive:
+		d.Status = DebtStatusDefaulted
+	case state != PaymentStateDelinquent && fromState == PaymentStateDelinquent && d.Status == DebtStatusDefaulted:
+		d.Status = DebtStatusActive
+	}
+
+	if state == fromState && d.Status == fromStatus && missed == fromMissed {
+		return nil
+	}
+
+	if state != fromState {
+		d.PaymentState = state
+		changedAt := asOf
+		d.PaymentStateChangedAt = &changedAt
+	}
+
+	return &DebtStatusEvent{
+		DebtID:          d.ID,
+		UserID:          d.UserID,
+		Trigger:         trigger,
+		FromState:       fromState,
+		ToState:         state,
+		FromStatus:      fromStatus,
+		ToStatus:        d.Status,
+		MissedPayments:  missed,
+		LateFee:         lateFee,
+		NextPaymentDate: d.NextPaymentDate,
+		OccurredAt:      asOf,
+	}
+}
+
+// AdvanceDueDate moves NextPaymentDate past the payment dates a payment of amount covers: one
+// per full installment, up to the missed payments plus the one coming due. A partial payment
+// leaves the date where it is; anything beyond the covered dates is a prepayment. It returns the
+// number of payment dates covered.
+func (d *Debt) AdvanceDueDate(amount float64, paidAt time.Time) int {
+	if d.PaymentFrequency == nil {
+		return 0
+	}
+	if d.NextPaymentDate == nil {
+		d.NextPaymentDate = d.CalculateNextPaymentDate()
+		return 0
+	}
+
+	periods := 1
+	if installment := d.InstallmentAmount(); installment > 0 {
+		periods = int(math.Floor(amount/installment + 1e-9))
+	}
+	_, missed := d.paymentStateAt(paidAt)
+	if periods > missed+1 {
+		periods = missed + 1
+	}
+	if periods <= 0 {
+		return 0
+	}
+
+	next, recurring := addPaymentPeriods(dateOnly(*d.NextPaymentDate), *d.PaymentFrequency, periods)
+	if !recurring {
+		d.NextPaymentDate = nil
+		return 1
+	}
+	d.NextPaymentDate = &next
+	return periods
+}
+
+// InstallmentAmount returns the payment expected on each payment date: the minimum payment, else
+// the planned payment amount
+func (d *Debt) InstallmentAmount() float64 {
+	if d.MinimumPayment > 0 {
+		return d.MinimumPayment
+	}
+	return math.Max(d.PaymentAmount, 0)
+}
+
+// LateFeePerPayment returns the late fee charged for each missed payment: the flat fee plus the
+// late fee rate applied to the installment
+func (d *Debt) LateFeePerPayment() float64 {
+	return roundMoney(math.Max(d.LateFee, 0) + math.Max(d.LateFeeRate, 0)/100*d.InstallmentAmount())
+}
+
+// EffectiveDelinquencyThreshold returns the missed payments after which the debt is delinquent
+func (d *Debt) EffectiveDelinquencyThreshold() int {
+	if d.DelinquencyThreshold <= 0 {
+		return DefaultDelinquencyThreshold
+	}
+	return d.DelinquencyThreshold
+}
+
+// paymentStateAt returns the debt's payment state as of asOf and how many payment dates have
+// passed their grace period unpaid. Debts without a payment date, paid off or no longer being
+// repaid have no state.
+func (d *Debt) paymentStateAt(asOf time.Time) (PaymentState, int) {
+	if d.NextPaymentDate == nil || d.IsPaidOff() {
+		return "", 0
+	}
+	if d.Status != DebtStatusActive && d.Status != DebtStatusDefaulted {
+		return "", 0
+	}
+
+	today := dateOnly(asOf)
+	due := dateOnly(*d.NextPaymentDate)
+	if today.Before(due) {
+		return PaymentStateUpcoming, 0
+	}
+
+	grace := d.GracePeriodDays
+	if grace < 0 {
+		grace = 0
+	}
+
+	missed := 0
+	for missed < maxTrackedMissedPayments {
+		date := due
+		if missed > 0 {
+			next, recurring := addPaymentPeriods(due, d.frequency(), missed)
+			if !recurring {
+				break
+			}
+			date = next
+		}
+		if !today.After(date.AddDate(0, 0, grace)) {
+			break
+		}
+		missed++
+	}
+
+	switch {
+	case missed == 0:
+		return PaymentStateDueToday, 0
+	case missed >= d.EffectiveDelinquencyThreshold():
+		return PaymentStateDelinquent, missed
+	}
+	return PaymentStateOverdue, missed
+}
+
+// frequency returns the payment frequency, treating a debt without one as a one-time payment
+func (d *Debt) frequency() PaymentFrequency {
+	if d.PaymentFrequency == nil {
+		return FrequencyOneTime
+	}
+	return *d.PaymentFrequency
+}
+
+// dateOnly returns the calendar date of t as midnight UTC, the way date columns are loaded
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statusTestDebt() *Debt {
+	frequency := FrequencyMonthly
+	due := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	return &Debt{
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		Name:             "Car loan",
+		Status:           DebtStatusActive,
+		PrincipalAmount:  10000000,
+		CurrentBalance:   8000000,
+		MinimumPayment:   1000000,
+		PaymentFrequency: &frequency,
+		NextPaymentDate:  &due,
+		GracePeriodDays:  5,
+		LateFee:          50000,
+		LateFeeRate:      2,
+		PaymentState:     PaymentStateUpcoming,
+	}
+}
+
+func TestDebt_EvaluatePaymentState(t *testing.T) {
+	tests := []struct {
+		name   string
+		asOf   time.Time
+		state  PaymentState
+		missed int
+		status DebtStatus
+	}{
+		{"before the payment date", time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC), PaymentStateUpcoming, 0, DebtStatusActive},
+		{"on the payment date", time.Date(2026, 1, 10, 8, 0, 0, 0, time.UTC), PaymentStateDueToday, 0, DebtStatusActive},
+		{"last day of the grace period", time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), PaymentStateDueToday, 0, DebtStatusActive},
+		{"grace period passed", time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC), PaymentStateOverdue, 1, DebtStatusActive},
+		{"two payments missed", time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC), PaymentStateOverdue, 2, DebtStatusActive},
+		{"threshold reached", time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC), PaymentStateDelinquent, 3, DebtStatusDefaulted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			debt := statusTestDebt()
+			debt.EvaluatePaymentState(tt.asOf, StatusTriggerSchedule)
+
+			assert.Equal(t, tt.state, debt.PaymentState)
+			assert.Equal(t, tt.missed, debt.MissedPayments)
+			assert.Equal(t, tt.status, debt.Status)
+		})
+	}
+}
+
+func TestDebt_EvaluatePaymentState_LateFees(t *testing.T) {
+	debt := statusTestDebt()
+
+	event := debt.EvaluatePaymentState(time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), StatusTriggerSchedule)
+	require.NotNil(t, event)
+	assert.Equal(t, PaymentStateUpcoming, event.FromState)
+	assert.Equal(t, PaymentStateDueToday, event.ToState)
+	assert.Equal(t, 0.0, event.LateFee)
+
+	assert.Nil(t, debt.EvaluatePaymentState(time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC), StatusTriggerSchedule), "nothing changed")
+
+	// 50,000 flat plus 2% of the 1,000,000 installment per missed payment
+	event = debt.EvaluatePaymentState(time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC), StatusTriggerSchedule)
+	require.NotNil(t, event)
+	assert.Equal(t, 70000.0, event.LateFee)
+	assert.Equal(t, 70000.0, debt.OutstandingFees)
+	assert.Equal(t, time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), *debt.OverdueSince)
+
+	// A second missed payment keeps the state and charges another fee
+	event = debt.EvaluatePaymentState(time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC), StatusTriggerSchedule)
+	require.NotNil(t, event)
+	assert.False(t, event.StateChanged())
+	assert.Equal(t, 70000.0, event.LateFee)
+	assert.Equal(t, 140000.0, debt.LateFeesCharged)
+	assert.Equal(t, "Late fee charged on Car loan", event.Subject(debt.Name))
+}
+
+func TestDebt_EvaluatePaymentState_NoBackCharge(t *testing.T) {
+	debt := statusTestDebt()
+	debt.PaymentState = ""
+
+	debt.EvaluatePaymentState(time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC), StatusTriggerSchedule)
+
+	assert.Equal(t, PaymentStateOverdue, debt.PaymentState)
+	assert.Equal(t, 2, debt.MissedPayments)
+	assert.Equal(t, 0.0, debt.OutstandingFees, "payments missed before tracking are not charged")
+}
+
+func TestDebt_EvaluatePaymentState_CuredDefault(t *testing.T) {
+	debt := statusTestDebt()
+	debt.DelinquencyThreshold = 2
+	asOf := time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC)
+	debt.EvaluatePaymentState(asOf, StatusTriggerSchedule)
+	require.Equal(t, DebtStatusDefaulted, debt.Status)
+
+	// Catching up both missed installments moves the date past today's
+	assert.Equal(t, 2, debt.AdvanceDueDate(2000000, asOf))
+	event := debt.EvaluatePaymentState(asOf, StatusTriggerPayment)
+
+	require.NotNil(t, event)
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), *debt.NextPaymentDate)
+	assert.Equal(t, PaymentStateUpcoming, debt.PaymentState)
+	assert.Equal(t, DebtStatusActive, debt.Status)
+	assert.Equal(t, 0, debt.MissedPayments)
+	assert.Nil(t, debt.OverdueSince)
+	assert.Equal(t, "Car loan is no longer in default", event.Subject(debt.Name))
+}
+
+func TestDebt_EvaluatePaymentState_Untracked(t *testing.T) {
+	debt := statusTestDebt()
+	debt.CurrentBalance = 0
+
+	event := debt.EvaluatePaymentState(time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC), StatusTriggerPayment)
+
+	require.NotNil(t, event)
+	assert.Equal(t, PaymentState(""), debt.PaymentState)
+	assert.Nil(t, debt.EvaluatePaymentState(time.Date(2026, 1, 21, 0, 0, 0, 0, time.UTC), StatusTriggerSchedule))
+}
+
+func TestDebt_AdvanceDueDate(t *testing.T) {
+	asOf := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	t.Run("partial payment", func(t *testing.T) {
+		debt := statusTestDebt()
+		assert.Equal(t, 0, debt.AdvanceDueDate(400000, asOf))
+		assert.Equal(t, time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), *debt.NextPaymentDate)
+	})
+
+	t.Run("prepayment covers only the coming payment", func(t *testing.T) {
+		debt := statusTestDebt()
+		assert.Equal(t, 1, debt.AdvanceDueDate(5000000, asOf))
+		assert.Equal(t, time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), *debt.NextPaymentDate)
+	})
+
+	t.Run("one-time payment", func(t *testing.T) {
+		debt := statusTestDebt()
+		frequency := FrequencyOneTime
+		debt.PaymentFrequency = &frequency
+		assert.Equal(t, 1, debt.AdvanceDueDate(1000000, asOf))
+		assert.Nil(t, debt.NextPaymentDate)
+	})
+
+	t.Run("no installment amount", func(t *testing.T) {
+		debt := statusTestDebt()
+		debt.MinimumPayment = 0
+		assert.Equal(t, 1, debt.AdvanceDueDate(10000, asOf))
+	})
+}
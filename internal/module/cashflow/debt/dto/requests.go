@@ -2,6 +2,7 @@ package dto
 
 import (
 	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"personalfinancedss/internal/module/cashflow/debt/service"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,11 +10,12 @@ import (
 
 // CreateDebtRequest represents a request to create a new debt
 type CreateDebtRequest struct {
-	Name        string              `json:"name" binding:"required"`
-	Description *string             `json:"description"`
-	Type        domain.DebtType     `json:"type" binding:"required"`
-	Behavior    domain.DebtBehavior `json:"behavior" binding:"required"`
-	Status      *domain.DebtStatus  `json:"status"`
+	Name        string               `json:"name" binding:"required"`
+	Description *string              `json:"description"`
+	Type        domain.DebtType      `json:"type" binding:"required"`
+	Behavior    domain.DebtBehavior  `json:"behavior" binding:"required"`
+	Status      *domain.DebtStatus   `json:"status"`
+	Direction   domain.DebtDirection `json:"direction"` // "borrowed" (default) or "lent"
 
 	PrincipalAmount float64 `json:"principal_amount" binding:"required,gt=0"`
 	CurrentBalance  float64 `json:"current_balance" binding:"required,gt=0"`
@@ -22,9 +24,20 @@ type CreateDebtRequest struct {
 	PaymentAmount   float64 `json:"payment_amount" binding:"gte=0"`
 	Currency        string  `json:"currency" binding:"required,len=3"`
 
+	InterestAccrual *domain.InterestAccrual `json:"interest_accrual"`
+	OutstandingFees float64                 `json:"outstanding_fees" binding:"gte=0"`
+
 	PaymentFrequency *domain.PaymentFrequency `json:"payment_frequency"`
 	NextPaymentDate  *time.Time               `json:"next_payment_date"`
 
+	GracePeriodDays      int     `json:"grace_period_days" binding:"gte=0,lte=90"`
+	LateFee              float64 `json:"late_fee" binding:"gte=0"`
+	LateFeeRate          float64 `json:"late_fee_rate" binding:"gte=0,lte=100"` // Percentage of the installment
+	DelinquencyThreshold int     `json:"delinquency_threshold" binding:"gte=0"` // Missed payments before delinquency; 0 uses the default of 3
+
+	AmortizationMethod *domain.AmortizationMethod `json:"amortization_method"`
+	TermPeriods        *int                       `json:"term_periods" binding:"omitempty,gte=1"`
+
 	StartDate time.Time  `json:"start_date" binding:"required"`
 	DueDate   *time.Time `json:"due_date"`
 
@@ -32,6 +45,9 @@ type CreateDebtRequest struct {
 	AccountNumber   *string    `json:"account_number"`
 	LinkedAccountID *uuid.UUID `json:"linked_account_id"`
 
+	CounterpartyName    *string `json:"counterparty_name"`
+	CounterpartyContact *string `json:"counterparty_contact"`
+
 	EnableReminders   bool    `json:"enable_reminders"`
 	ReminderFrequency *string `json:"reminder_frequency"`
 
@@ -54,10 +70,21 @@ type UpdateDebtRequest struct {
 	PaymentAmount   *float64 `json:"payment_amount" binding:"omitempty,gte=0"`
 	Currency        *string  `json:"currency" binding:"omitempty,len=3"`
 
+	InterestAccrual *domain.InterestAccrual `json:"interest_accrual"`
+	OutstandingFees *float64                `json:"outstanding_fees" binding:"omitempty,gte=0"`
+
 	PaymentFrequency *domain.PaymentFrequency `json:"payment_frequency"`
 	NextPaymentDate  *time.Time               `json:"next_payment_date"`
 	LastPaymentDate  *time.Time               `json:"last_payment_date"`
 
+	GracePeriodDays      *int     `json:"grace_period_days" binding:"omitempty,gte=0,lte=90"`
+	LateFee              *float64 `json:"late_fee" binding:"omitempty,gte=0"`
+	LateFeeRate          *float64 `json:"late_fee_rate" binding:"omitempty,gte=0,lte=100"`
+	DelinquencyThreshold *int     `json:"delinquency_threshold" binding:"omitempty,gte=1"`
+
+	AmortizationMethod *domain.AmortizationMethod `json:"amortization_method"`
+	TermPeriods        *int                       `json:"term_periods" binding:"omitempty,gte=1"`
+
 	StartDate *time.Time `json:"start_date"`
 	DueDate   *time.Time `json:"due_date"`
 
@@ -65,6 +92,9 @@ type UpdateDebtRequest struct {
 	AccountNumber   *string    `json:"account_number"`
 	LinkedAccountID *uuid.UUID `json:"linked_account_id"`
 
+	CounterpartyName    *string `json:"counterparty_name"`
+	CounterpartyContact *string `json:"counterparty_contact"`
+
 	EnableReminders   *bool   `json:"enable_reminders"`
 	ReminderFrequency *string `json:"reminder_frequency"`
 
@@ -78,3 +108,104 @@ type AddPaymentRequest struct {
 	Description *string    `json:"description"`
 	Date        *time.Time `json:"date"`
 }
+
+// RatePeriodRequest represents one period of a debt's rate schedule
+type RatePeriodRequest struct {
+	StartDate      time.Time       `json:"start_date" binding:"required"`
+	RateType       domain.RateType `json:"rate_type" binding:"required"`
+	FixedRate      *float64        `json:"fixed_rate"`
+	ReferenceIndex *string         `json:"reference_index"`
+	Margin         float64         `json:"margin"`
+	ResetMonths    int             `json:"reset_months" binding:"omitempty,min=0"`
+	RateFloor      *float64        `json:"rate_floor"`
+	RateCap        *float64        `json:"rate_cap"`
+	Description    *string         `json:"description"`
+}
+
+// SetRateScheduleRequest represents a request to replace a debt's rate schedule
+type SetRateScheduleRequest struct {
+	Periods []RatePeriodRequest `json:"periods" binding:"required,min=1,dive"`
+}
+
+// ToDomain converts the requested periods to domain rate periods
+func (r *SetRateScheduleRequest) ToDomain() []domain.DebtRatePeriod {
+	return toRatePeriods(r.Periods)
+}
+
+// toRatePeriods converts requested rate periods to domain rate periods
+func toRatePeriods(requested []RatePeriodRequest) []domain.DebtRatePeriod {
+	periods := make([]domain.DebtRatePeriod, len(requested))
+	for i, p := range requested {
+		periods[i] = domain.DebtRatePeriod{
+			StartDate:      p.StartDate,
+			RateType:       p.RateType,
+			FixedRate:      p.FixedRate,
+			ReferenceIndex: p.ReferenceIndex,
+			Margin:         p.Margin,
+			ResetMonths:    p.ResetMonths,
+			RateFloor:      p.RateFloor,
+			RateCap:        p.RateCap,
+			Description:    p.Description,
+		}
+	}
+	return periods
+}
+
+// RecordReferenceRateRequest represents a request to record a value of a reference index
+type RecordReferenceRateRequest struct {
+	Index         string    `json:"index" binding:"required"`
+	EffectiveDate time.Time `json:"effective_date" binding:"required"`
+	Rate          float64   `json:"rate" binding:"gte=0,lte=100"`
+}
+
+// ApplyRefinanceRequest represents a request to pay off debts with a new loan
+type ApplyRefinanceRequest struct {
+	DebtIDs            []string                   `json:"debt_ids" binding:"required,min=1,dive,uuid"`
+	Name               *string                    `json:"name"` // Name of the new loan
+	CreditorName       *string                    `json:"creditor_name"`
+	NewRate            float64                    `json:"new_rate" binding:"gte=0,lte=100"`
+	TermMonths         int                        `json:"term_months" binding:"required,min=1"`
+	OriginationFee     float64                    `json:"origination_fee" binding:"gte=0"`
+	MonthlyFee         float64                    `json:"monthly_fee" binding:"gte=0"`
+	AmortizationMethod *domain.AmortizationMethod `json:"amortization_method"`
+	RatePeriods        []RatePeriodRequest        `json:"rate_periods" binding:"omitempty,dive"`
+	FundingAccountID   *string                    `json:"funding_account_id" binding:"omitempty,uuid"` // Defaults to the debts' shared linked account
+	AppliedAt          *time.Time                 `json:"applied_at"`
+}
+
+// ToPlan converts the request to a refinance plan
+func (r *ApplyRefinanceRequest) ToPlan() (*service.RefinancePlan, error) {
+	plan := &service.RefinancePlan{
+		DebtIDs:            make([]uuid.UUID, len(r.DebtIDs)),
+		CreditorName:       r.CreditorName,
+		NewRate:            r.NewRate,
+		TermMonths:         r.TermMonths,
+		OriginationFee:     r.OriginationFee,
+		MonthlyFee:         r.MonthlyFee,
+		AmortizationMethod: r.AmortizationMethod,
+	}
+	for i, id := range r.DebtIDs {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		plan.DebtIDs[i] = parsed
+	}
+	if r.Name != nil {
+		plan.Name = *r.Name
+	}
+	if len(r.RatePeriods) > 0 {
+		plan.RatePeriods = toRatePeriods(r.RatePeriods)
+	}
+	if r.FundingAccountID != nil {
+		accountID, err := uuid.Parse(*r.FundingAccountID)
+		if err != nil {
+			return nil, err
+		}
+		plan.FundingAccountID = &accountID
+	}
+	if r.AppliedAt != nil {
+		plan.AppliedAt = *r.AppliedAt
+	}
+	return plan, nil
+}
@@ -13,11 +13,12 @@ type DebtResponse struct {
 	ID     uuid.UUID `json:"id"`
 	UserID uuid.UUID `json:"user_id"`
 
-	Name        string              `json:"name"`
-	Description *string             `json:"description,omitempty"`
-	Type        domain.DebtType     `json:"type"`
-	Behavior    domain.DebtBehavior `json:"behavior"` // "revolving", "installment", "interest_only"
-	Status      domain.DebtStatus   `json:"status"`
+	Name        string               `json:"name"`
+	Description *string              `json:"description,omitempty"`
+	Type        domain.DebtType      `json:"type"`
+	Behavior    domain.DebtBehavior  `json:"behavior"` // "revolving", "installment", "interest_only"
+	Status      domain.DebtStatus    `json:"status"`
+	Direction   domain.DebtDirection `json:"direction"` // "borrowed" or "lent"
 
 	PrincipalAmount float64 `json:"principal_amount"`
 	CurrentBalance  float64 `json:"current_balance"`
@@ -26,11 +27,30 @@ type DebtResponse struct {
 	PaymentAmount   float64 `json:"payment_amount"`
 	Currency        string  `json:"currency"`
 
+	InterestAccrual        domain.InterestAccrual `json:"interest_accrual"`
+	AccruedInterest        float64                `json:"accrued_interest"`
+	InterestDue            float64                `json:"interest_due"` // Accrued interest including what accrued since the last accrual
+	OutstandingFees        float64                `json:"outstanding_fees"`
+	InterestAccruedThrough *time.Time             `json:"interest_accrued_through,omitempty"`
+
 	PaymentFrequency  *domain.PaymentFrequency `json:"payment_frequency,omitempty"`
 	NextPaymentDate   *time.Time               `json:"next_payment_date,omitempty"`
 	LastPaymentDate   *time.Time               `json:"last_payment_date,omitempty"`
 	LastPaymentAmount *float64                 `json:"last_payment_amount,omitempty"`
 
+	GracePeriodDays       int                 `json:"grace_period_days"`
+	LateFee               float64             `json:"late_fee"`
+	LateFeeRate           float64             `json:"late_fee_rate"`
+	DelinquencyThreshold  int                 `json:"delinquency_threshold"`
+	LateFeesCharged       float64             `json:"late_fees_charged"`
+	PaymentState          domain.PaymentState `json:"payment_state,omitempty"`
+	MissedPayments        int                 `json:"missed_payments"`
+	OverdueSince          *time.Time          `json:"overdue_since,omitempty"`
+	PaymentStateChangedAt *time.Time          `json:"payment_state_changed_at,omitempty"`
+
+	AmortizationMethod *domain.AmortizationMethod `json:"amortization_method,omitempty"`
+	TermPeriods        *int                       `json:"term_periods,omitempty"`
+
 	StartDate   time.Time  `json:"start_date"`
 	DueDate     *time.Time `json:"due_date,omitempty"`
 	PaidOffDate *time.Time `json:"paid_off_date,omitempty"`
@@ -39,11 +59,15 @@ type DebtResponse struct {
 	RemainingAmount   float64 `json:"remaining_amount"`
 	PercentagePaid    float64 `json:"percentage_paid"`
 	TotalInterestPaid float64 `json:"total_interest_paid"`
+	TotalFeesPaid     float64 `json:"total_fees_paid"`
 
 	CreditorName    *string    `json:"creditor_name,omitempty"`
 	AccountNumber   *string    `json:"account_number,omitempty"`
 	LinkedAccountID *uuid.UUID `json:"linked_account_id,omitempty"`
 
+	CounterpartyName    *string `json:"counterparty_name,omitempty"`
+	CounterpartyContact *string `json:"counterparty_contact,omitempty"`
+
 	EnableReminders    bool       `json:"enable_reminders"`
 	ReminderFrequency  *string    `json:"reminder_frequency,omitempty"`
 	LastReminderSentAt *time.Time `json:"last_reminder_sent_at,omitempty"`
@@ -72,6 +96,170 @@ type DebtSummaryResponse struct {
 	AverageProgress      float64                         `json:"average_progress"`
 	DebtsByType          map[string]*service.DebtTypeSum `json:"debts_by_type"`
 	DebtsByStatus        map[string]int                  `json:"debts_by_status"`
+	Receivables          service.ReceivableSummary       `json:"receivables"` // Money lent to others, not included above
+}
+
+// DebtPaymentResponse represents a debt payment and its split in API responses
+type DebtPaymentResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	DebtID           uuid.UUID  `json:"debt_id"`
+	TransactionID    *uuid.UUID `json:"transaction_id,omitempty"`
+	PaymentDate      time.Time  `json:"payment_date"`
+	Amount           float64    `json:"amount"`
+	FeePortion       float64    `json:"fee_portion"`
+	InterestPortion  float64    `json:"interest_portion"`
+	PrincipalPortion float64    `json:"principal_portion"`
+	BalanceAfter     float64    `json:"balance_after"`
+	Description      *string    `json:"description,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// StatusEventResponse represents one change of a debt's payment state or status in API responses
+type StatusEventResponse struct {
+	ID              uuid.UUID            `json:"id"`
+	DebtID          uuid.UUID            `json:"debt_id"`
+	Trigger         domain.StatusTrigger `json:"trigger"`
+	FromState       domain.PaymentState  `json:"from_state,omitempty"`
+	ToState         domain.PaymentState  `json:"to_state,omitempty"`
+	FromStatus      domain.DebtStatus    `json:"from_status"`
+	ToStatus        domain.DebtStatus    `json:"to_status"`
+	MissedPayments  int                  `json:"missed_payments"`
+	LateFee         float64              `json:"late_fee"`
+	NextPaymentDate *time.Time           `json:"next_payment_date,omitempty"`
+	OccurredAt      time.Time            `json:"occurred_at"`
+}
+
+// ScheduleEntryResponse represents one scheduled payment in API responses
+type ScheduleEntryResponse struct {
+	Period           int       `json:"period"`
+	DueDate          time.Time `json:"due_date"`
+	Payment          float64   `json:"payment"`
+	Principal        float64   `json:"principal"`
+	Interest         float64   `json:"interest"`
+	RemainingBalance float64   `json:"remaining_balance"`
+	InterestRate     float64   `json:"interest_rate"`
+}
+
+// ScheduleReconciliationResponse compares actual repayments with the schedule in API responses
+type ScheduleReconciliationResponse struct {
+	AsOf             time.Time              `json:"as_of"`
+	Status           domain.ScheduleStatus  `json:"status"` // "ahead", "on_track", "behind"
+	PeriodsDue       int                    `json:"periods_due"`
+	ScheduledBalance float64                `json:"scheduled_balance"`
+	ActualBalance    float64                `json:"actual_balance"`
+	Difference       float64                `json:"difference"` // Positive when ahead of schedule
+	ScheduledPaid    float64                `json:"scheduled_paid"`
+	ActualPaid       float64                `json:"actual_paid"`
+	NextPayment      *ScheduleEntryResponse `json:"next_payment,omitempty"`
+}
+
+// AmortizationScheduleResponse represents a debt's amortization table in API responses
+type AmortizationScheduleResponse struct {
+	DebtID         uuid.UUID                       `json:"debt_id"`
+	Behavior       domain.DebtBehavior             `json:"behavior"`
+	Method         domain.AmortizationMethod       `json:"method"` // Split used by installment debts
+	InterestRate   float64                         `json:"interest_rate"`
+	Currency       string                          `json:"currency"`
+	TotalPayment   float64                         `json:"total_payment"`
+	TotalInterest  float64                         `json:"total_interest"`
+	Entries        []ScheduleEntryResponse         `json:"entries"`
+	Reconciliation *ScheduleReconciliationResponse `json:"reconciliation"`
+}
+
+// RatePeriodResponse represents one period of a debt's rate schedule in API responses
+type RatePeriodResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	StartDate      time.Time       `json:"start_date"`
+	RateType       domain.RateType `json:"rate_type"`
+	FixedRate      *float64        `json:"fixed_rate,omitempty"`
+	ReferenceIndex *string         `json:"reference_index,omitempty"`
+	Margin         float64         `json:"margin"`
+	ResetMonths    int             `json:"reset_months"`
+	RateFloor      *float64        `json:"rate_floor,omitempty"`
+	RateCap        *float64        `json:"rate_cap,omitempty"`
+	Description    *string         `json:"description,omitempty"`
+}
+
+// ReferenceRateResponse represents a recorded value of a reference index in API responses
+type ReferenceRateResponse struct {
+	Index         string    `json:"index"`
+	EffectiveDate time.Time `json:"effective_date"`
+	Rate          float64   `json:"rate"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// RateStressTestResponse compares a debt's remaining payments before and after a reference-rate shock
+type RateStressTestResponse struct {
+	DebtID              uuid.UUID               `json:"debt_id"`
+	Currency            string                  `json:"currency"`
+	Shock               float64                 `json:"shock"` // Percentage points added to the reference rates
+	CurrentRate         float64                 `json:"current_rate"`
+	StressedRate        float64                 `json:"stressed_rate"`
+	BaselineInterest    float64                 `json:"baseline_interest"`
+	StressedInterest    float64                 `json:"stressed_interest"`
+	InterestImpact      float64                 `json:"interest_impact"`
+	BaselinePeakPayment float64                 `json:"baseline_peak_payment"`
+	StressedPeakPayment float64                 `json:"stressed_peak_payment"`
+	StressedPeakDate    *time.Time              `json:"stressed_peak_date,omitempty"`
+	PeakPaymentImpact   float64                 `json:"peak_payment_impact"`
+	Baseline            []ScheduleEntryResponse `json:"baseline"`
+	Stressed            []ScheduleEntryResponse `json:"stressed"`
+}
+
+// RefinanceItemResponse represents a debt paid off by a refinance in API responses
+type RefinanceItemResponse struct {
+	DebtID              uuid.UUID  `json:"debt_id"`
+	DebtName            string     `json:"debt_name"`
+	PayoffAmount        float64    `json:"payoff_amount"`
+	InterestRate        float64    `json:"interest_rate"`
+	MonthlyPayment      float64    `json:"monthly_payment"`
+	ProjectedInterest   float64    `json:"projected_interest"` // Interest still to come had the debt been kept
+	PayoffTransactionID *uuid.UUID `json:"payoff_transaction_id,omitempty"`
+}
+
+// RefinanceResponse represents an applied refinance in API responses
+type RefinanceResponse struct {
+	ID                  uuid.UUID               `json:"id"`
+	NewDebtID           uuid.UUID               `json:"new_debt_id"`
+	FundingAccountID    *uuid.UUID              `json:"funding_account_id,omitempty"`
+	Currency            string                  `json:"currency"`
+	NewRate             float64                 `json:"new_rate"`
+	TermMonths          int                     `json:"term_months"`
+	OriginationFee      float64                 `json:"origination_fee"`
+	MonthlyFee          float64                 `json:"monthly_fee"`
+	PaidOffBalance      float64                 `json:"paid_off_balance"`
+	OldWeightedRate     float64                 `json:"old_weighted_rate"`
+	OldMonthlyPayment   float64                 `json:"old_monthly_payment"`
+	NewMonthlyPayment   float64                 `json:"new_monthly_payment"`
+	ExpectedOldInterest float64                 `json:"expected_old_interest"`
+	ExpectedNewInterest float64                 `json:"expected_new_interest"`
+	ExpectedSavings     float64                 `json:"expected_savings"`
+	AppliedAt           time.Time               `json:"applied_at"`
+	Items               []RefinanceItemResponse `json:"items"`
+	CreatedAt           time.Time               `json:"created_at"`
+}
+
+// ApplyRefinanceResponse represents the outcome of applying a refinance in API responses
+type ApplyRefinanceResponse struct {
+	Refinance    *RefinanceResponse `json:"refinance"`
+	NewDebt      *DebtResponse      `json:"new_debt"`
+	SettledDebts []*DebtResponse    `json:"settled_debts"`
+}
+
+// RefinanceReviewResponse compares a refinance's expected and realized savings in API responses
+type RefinanceReviewResponse struct {
+	Refinance              *RefinanceResponse `json:"refinance"`
+	NewDebt                *DebtResponse      `json:"new_debt"`
+	AsOf                   time.Time          `json:"as_of"`
+	MonthsElapsed          int                `json:"months_elapsed"`
+	ExpectedSavings        float64            `json:"expected_savings"`
+	ExpectedSavingsToDate  float64            `json:"expected_savings_to_date"`
+	BaselineInterestToDate float64            `json:"baseline_interest_to_date"` // Interest the old debts would have charged by now
+	InterestPaid           float64            `json:"interest_paid"`
+	FeesPaid               float64            `json:"fees_paid"`
+	RealizedSavingsToDate  float64            `json:"realized_savings_to_date"`
+	Variance               float64            `json:"variance"` // Realized - expected to date; negative when behind
+	OnTrack                bool               `json:"on_track"`
 }
 
 // ToDebtResponse converts a domain debt to response DTO
@@ -81,41 +269,61 @@ func ToDebtResponse(debt *domain.Debt) *DebtResponse {
 	}
 
 	return &DebtResponse{
-		ID:                   debt.ID,
-		UserID:               debt.UserID,
-		Name:                 debt.Name,
-		Description:          debt.Description,
-		Type:                 debt.Type,
-		Behavior:             debt.Behavior,
-		Status:               debt.Status,
-		PrincipalAmount:      debt.PrincipalAmount,
-		CurrentBalance:       debt.CurrentBalance,
-		InterestRate:         debt.InterestRate,
-		MinimumPayment:       debt.MinimumPayment,
-		PaymentAmount:        debt.PaymentAmount,
-		Currency:             debt.Currency,
-		PaymentFrequency:     debt.PaymentFrequency,
-		NextPaymentDate:      debt.NextPaymentDate,
-		LastPaymentDate:      debt.LastPaymentDate,
-		LastPaymentAmount:    debt.LastPaymentAmount,
-		StartDate:            debt.StartDate,
-		DueDate:              debt.DueDate,
-		PaidOffDate:          debt.PaidOffDate,
-		TotalPaid:            debt.TotalPaid,
-		RemainingAmount:      debt.RemainingAmount,
-		PercentagePaid:       debt.PercentagePaid,
-		TotalInterestPaid:    debt.TotalInterestPaid,
-		CreditorName:         debt.CreditorName,
-		AccountNumber:        debt.AccountNumber,
-		LinkedAccountID:      debt.LinkedAccountID,
-		EnableReminders:      debt.EnableReminders,
-		ReminderFrequency:    debt.ReminderFrequency,
-		LastReminderSentAt:   debt.LastReminderSentAt,
-		Notes:                debt.Notes,
-		Tags:                 debt.Tags,
-		DaysUntilNextPayment: debt.DaysUntilNextPayment(),
-		CreatedAt:            debt.CreatedAt,
-		UpdatedAt:            debt.UpdatedAt,
+		ID:                     debt.ID,
+		UserID:                 debt.UserID,
+		Name:                   debt.Name,
+		Description:            debt.Description,
+		Type:                   debt.Type,
+		Behavior:               debt.Behavior,
+		Status:                 debt.Status,
+		Direction:              debt.Direction,
+		PrincipalAmount:        debt.PrincipalAmount,
+		CurrentBalance:         debt.CurrentBalance,
+		InterestRate:           debt.InterestRate,
+		MinimumPayment:         debt.MinimumPayment,
+		PaymentAmount:          debt.PaymentAmount,
+		Currency:               debt.Currency,
+		InterestAccrual:        debt.Accrual(),
+		AccruedInterest:        debt.AccruedInterest,
+		InterestDue:            debt.InterestDue(time.Now()),
+		OutstandingFees:        debt.OutstandingFees,
+		InterestAccruedThrough: debt.InterestAccruedThrough,
+		PaymentFrequency:       debt.PaymentFrequency,
+		NextPaymentDate:        debt.NextPaymentDate,
+		LastPaymentDate:        debt.LastPaymentDate,
+		LastPaymentAmount:      debt.LastPaymentAmount,
+		GracePeriodDays:        debt.GracePeriodDays,
+		LateFee:                debt.LateFee,
+		LateFeeRate:            debt.LateFeeRate,
+		DelinquencyThreshold:   debt.EffectiveDelinquencyThreshold(),
+		LateFeesCharged:        debt.LateFeesCharged,
+		PaymentState:           debt.PaymentState,
+		MissedPayments:         debt.MissedPayments,
+		OverdueSince:           debt.OverdueSince,
+		PaymentStateChangedAt:  debt.PaymentStateChangedAt,
+		AmortizationMethod:     debt.AmortizationMethod,
+		TermPeriods:            debt.TermPeriods,
+		StartDate:              debt.StartDate,
+		DueDate:                debt.DueDate,
+		PaidOffDate:            debt.PaidOffDate,
+		TotalPaid:              debt.TotalPaid,
+		RemainingAmount:        debt.RemainingAmount,
+		PercentagePaid:         debt.PercentagePaid,
+		TotalInterestPaid:      debt.TotalInterestPaid,
+		TotalFeesPaid:          debt.TotalFeesPaid,
+		CreditorName:           debt.CreditorName,
+		CounterpartyName:       debt.CounterpartyName,
+		CounterpartyContact:    debt.CounterpartyContact,
+		AccountNumber:          debt.AccountNumber,
+		LinkedAccountID:        debt.LinkedAccountID,
+		EnableReminders:        debt.EnableReminders,
+		ReminderFrequency:      debt.ReminderFrequency,
+		LastReminderSentAt:     debt.LastReminderSentAt,
+		Notes:                  debt.Notes,
+		Tags:                   debt.Tags,
+		DaysUntilNextPayment:   debt.DaysUntilNextPayment(),
+		CreatedAt:              debt.CreatedAt,
+		UpdatedAt:              debt.UpdatedAt,
 	}
 }
 
@@ -147,5 +355,264 @@ func ToDebtSummaryResponse(summary *service.DebtSummary) *DebtSummaryResponse {
 		AverageProgress:      summary.AverageProgress,
 		DebtsByType:          summary.DebtsByType,
 		DebtsByStatus:        summary.DebtsByStatus,
+		Receivables:          summary.Receivables,
+	}
+}
+
+// ToScheduleEntryResponse converts a schedule entry to response DTO
+func ToScheduleEntryResponse(entry *domain.DebtScheduleEntry) *ScheduleEntryResponse {
+	if entry == nil {
+		return nil
+	}
+
+	return &ScheduleEntryResponse{
+		Period:           entry.Period,
+		DueDate:          entry.DueDate,
+		Payment:          entry.Payment,
+		Principal:        entry.Principal,
+		Interest:         entry.Interest,
+		RemainingBalance: entry.RemainingBalance,
+		InterestRate:     entry.InterestRate,
+	}
+}
+
+// ToAmortizationScheduleResponse converts a service amortization schedule to response DTO
+func ToAmortizationScheduleResponse(schedule *service.AmortizationSchedule) *AmortizationScheduleResponse {
+	if schedule == nil {
+		return nil
+	}
+
+	entries := make([]ScheduleEntryResponse, len(schedule.Entries))
+	for i := range schedule.Entries {
+		entries[i] = *ToScheduleEntryResponse(&schedule.Entries[i])
+	}
+
+	response := &AmortizationScheduleResponse{
+		DebtID:        schedule.Debt.ID,
+		Behavior:      schedule.Debt.Behavior,
+		Method:        schedule.Debt.Method(),
+		InterestRate:  schedule.Debt.InterestRate,
+		Currency:      schedule.Debt.Currency,
+		TotalPayment:  schedule.TotalPayment,
+		TotalInterest: schedule.TotalInterest,
+		Entries:       entries,
+	}
+
+	if rec := schedule.Reconciliation; rec != nil {
+		response.Reconciliation = &ScheduleReconciliationResponse{
+			AsOf:             rec.AsOf,
+			Status:           rec.Status,
+			PeriodsDue:       rec.PeriodsDue,
+			ScheduledBalance: rec.ScheduledBalance,
+			ActualBalance:    rec.ActualBalance,
+			Difference:       rec.Difference,
+			ScheduledPaid:    rec.ScheduledPaid,
+			ActualPaid:       rec.ActualPaid,
+			NextPayment:      ToScheduleEntryResponse(rec.NextEntry),
+		}
+	}
+
+	return response
+}
+
+// ToDebtPaymentResponseList converts debt payments to response DTOs
+func ToDebtPaymentResponseList(payments []domain.DebtPayment) []DebtPaymentResponse {
+	responses := make([]DebtPaymentResponse, len(payments))
+	for i, payment := range payments {
+		responses[i] = DebtPaymentResponse{
+			ID:               payment.ID,
+			DebtID:           payment.DebtID,
+			TransactionID:    payment.TransactionID,
+			PaymentDate:      payment.PaymentDate,
+			Amount:           payment.Amount,
+			FeePortion:       payment.FeePortion,
+			InterestPortion:  payment.InterestPortion,
+			PrincipalPortion: payment.PrincipalPortion,
+			BalanceAfter:     payment.BalanceAfter,
+			Description:      payment.Description,
+			CreatedAt:        payment.CreatedAt,
+		}
+	}
+	return responses
+}
+
+// ToStatusEventResponseList converts debt status events to response DTOs
+func ToStatusEventResponseList(events []domain.DebtStatusEvent) []StatusEventResponse {
+	responses := make([]StatusEventResponse, len(events))
+	for i, event := range events {
+		responses[i] = StatusEventResponse{
+			ID:              event.ID,
+			DebtID:          event.DebtID,
+			Trigger:         event.Trigger,
+			FromState:       event.FromState,
+			ToState:         event.ToState,
+			FromStatus:      event.FromStatus,
+			ToStatus:        event.ToStatus,
+			MissedPayments:  event.MissedPayments,
+			LateFee:         event.LateFee,
+			NextPaymentDate: event.NextPaymentDate,
+			OccurredAt:      event.OccurredAt,
+		}
+	}
+	return responses
+}
+
+// ToRatePeriodResponseList converts rate periods to response DTOs
+func ToRatePeriodResponseList(periods []domain.DebtRatePeriod) []RatePeriodResponse {
+	responses := make([]RatePeriodResponse, len(periods))
+	for i, period := range periods {
+		responses[i] = RatePeriodResponse{
+			ID:             period.ID,
+			StartDate:      period.StartDate,
+			RateType:       period.RateType,
+			FixedRate:      period.FixedRate,
+			ReferenceIndex: period.ReferenceIndex,
+			Margin:         period.Margin,
+			ResetMonths:    period.ResetMonths,
+			RateFloor:      period.RateFloor,
+			RateCap:        period.RateCap,
+			Description:    period.Description,
+		}
 	}
+	return responses
+}
+
+// ToReferenceRateResponseList converts reference rates to response DTOs
+func ToReferenceRateResponseList(rates []domain.ReferenceRate) []ReferenceRateResponse {
+	responses := make([]ReferenceRateResponse, len(rates))
+	for i, rate := range rates {
+		responses[i] = ReferenceRateResponse{
+			Index:         rate.Index,
+			EffectiveDate: rate.EffectiveDate,
+			Rate:          rate.Rate,
+			UpdatedAt:     rate.UpdatedAt,
+		}
+	}
+	return responses
+}
+
+// ToRateStressTestResponse converts a service rate stress test to response DTO
+func ToRateStressTestResponse(result *service.RateStressTest) *RateStressTestResponse {
+	if result == nil {
+		return nil
+	}
+
+	response := &RateStressTestResponse{
+		Shock:               result.Shock,
+		CurrentRate:         result.CurrentRate,
+		StressedRate:        result.StressedRate,
+		BaselineInterest:    result.BaselineInterest,
+		StressedInterest:    result.StressedInterest,
+		InterestImpact:      result.InterestImpact,
+		BaselinePeakPayment: result.BaselinePeakPayment,
+		StressedPeakPayment: result.StressedPeakPayment,
+		PeakPaymentImpact:   result.PeakPaymentImpact,
+		Baseline:            make([]ScheduleEntryResponse, 0, len(result.Baseline)),
+		Stressed:            make([]ScheduleEntryResponse, 0, len(result.Stressed)),
+	}
+	if result.Debt != nil {
+		response.DebtID = result.Debt.ID
+		response.Currency = result.Debt.Currency
+	}
+	if !result.StressedPeakDate.IsZero() {
+		peak := result.StressedPeakDate
+		response.StressedPeakDate = &peak
+	}
+	for i := range result.Baseline {
+		response.Baseline = append(response.Baseline, *ToScheduleEntryResponse(&result.Baseline[i]))
+	}
+	for i := range result.Stressed {
+		response.Stressed = append(response.Stressed, *ToScheduleEntryResponse(&result.Stressed[i]))
+	}
+
+	return response
+}
+
+// ToRefinanceResponse converts an applied refinance to response DTO
+func ToRefinanceResponse(refinance *domain.DebtRefinance) *RefinanceResponse {
+	if refinance == nil {
+		return nil
+	}
+
+	items := make([]RefinanceItemResponse, len(refinance.Items))
+	for i, item := range refinance.Items {
+		items[i] = RefinanceItemResponse{
+			DebtID:              item.DebtID,
+			DebtName:            item.DebtName,
+			PayoffAmount:        item.PayoffAmount,
+			InterestRate:        item.InterestRate,
+			MonthlyPayment:      item.MonthlyPayment,
+			ProjectedInterest:   item.ProjectedInterest,
+			PayoffTransactionID: item.PayoffTransactionID,
+		}
+	}
+
+	return &RefinanceResponse{
+		ID:                  refinance.ID,
+		NewDebtID:           refinance.NewDebtID,
+		FundingAccountID:    refinance.FundingAccountID,
+		Currency:            refinance.Currency,
+		NewRate:             refinance.NewRate,
+		TermMonths:          refinance.TermMonths,
+		OriginationFee:      refinance.OriginationFee,
+		MonthlyFee:          refinance.MonthlyFee,
+		PaidOffBalance:      refinance.PaidOffBalance,
+		OldWeightedRate:     refinance.OldWeightedRate,
+		OldMonthlyPayment:   refinance.OldMonthlyPayment,
+		NewMonthlyPayment:   refinance.NewMonthlyPayment,
+		ExpectedOldInterest: refinance.ExpectedOldInterest,
+		ExpectedNewInterest: refinance.ExpectedNewInterest,
+		ExpectedSavings:     refinance.ExpectedSavings,
+		AppliedAt:           refinance.AppliedAt,
+		Items:               items,
+		CreatedAt:           refinance.CreatedAt,
+	}
+}
+
+// ToRefinanceResponseList converts applied refinances to response DTOs
+func ToRefinanceResponseList(refinances []domain.DebtRefinance) []*RefinanceResponse {
+	responses := make([]*RefinanceResponse, len(refinances))
+	for i := range refinances {
+		responses[i] = ToRefinanceResponse(&refinances[i])
+	}
+	return responses
+}
+
+// ToApplyRefinanceResponse converts a service refinance result to response DTO
+func ToApplyRefinanceResponse(result *service.RefinanceResult) *ApplyRefinanceResponse {
+	if result == nil {
+		return nil
+	}
+
+	return &ApplyRefinanceResponse{
+		Refinance:    ToRefinanceResponse(result.Refinance),
+		NewDebt:      ToDebtResponse(result.NewDebt),
+		SettledDebts: ToDebtResponseList(result.SettledDebts),
+	}
+}
+
+// ToRefinanceReviewResponse converts a service refinance report to response DTO
+func ToRefinanceReviewResponse(report *service.RefinanceReport) *RefinanceReviewResponse {
+	if report == nil {
+		return nil
+	}
+
+	response := &RefinanceReviewResponse{
+		Refinance: ToRefinanceResponse(report.Refinance),
+		NewDebt:   ToDebtResponse(report.NewDebt),
+	}
+	if review := report.Review; review != nil {
+		response.AsOf = review.AsOf
+		response.MonthsElapsed = review.MonthsElapsed
+		response.ExpectedSavings = review.ExpectedSavings
+		response.ExpectedSavingsToDate = review.ExpectedSavingsToDate
+		response.BaselineInterestToDate = review.BaselineInterestToDate
+		response.InterestPaid = review.InterestPaid
+		response.FeesPaid = review.FeesPaid
+		response.RealizedSavingsToDate = review.RealizedSavingsToDate
+		response.Variance = review.Variance
+		response.OnTrack = review.OnTrack
+	}
+
+	return response
 }
@@ -10,7 +10,8 @@ import (
 	"go.uber.org/fx"
 )
 
-// Module provides debt module dependencies
+// Module provides debt module dependencies. The payment state job runs on the notification
+// scheduler through the scheduled_jobs group.
 var Module = fx.Module("debt",
 	fx.Provide(
 		// Repository - provide as interface
@@ -25,6 +26,12 @@ var Module = fx.Module("debt",
 			fx.As(new(service.Service)),
 		),
 
+		// Scheduled job
+		fx.Annotate(
+			service.NewStatusJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
+
 		// Handler
 		handler.NewHandler,
 	),
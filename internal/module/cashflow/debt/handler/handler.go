@@ -7,6 +7,8 @@ import (
 	"personalfinancedss/internal/module/cashflow/debt/dto"
 	"personalfinancedss/internal/module/cashflow/debt/service"
 	"personalfinancedss/internal/shared"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -35,12 +37,25 @@ func (h *Handler) RegisterRoutes(router *gin.Engine, authMiddleware *middleware.
 		debts.GET("", h.GetUserDebts)
 		debts.GET("/active", h.GetActiveDebts)
 		debts.GET("/paid-off", h.GetPaidOffDebts)
+		debts.GET("/receivables", h.GetReceivables)
 		debts.GET("/summary", h.GetDebtSummary)
 		debts.GET("/:id", h.GetDebtByID)
 		debts.PUT("/:id", h.UpdateDebt)
 		debts.DELETE("/:id", h.DeleteDebt)
 		debts.POST("/:id/payment", h.AddPayment)
+		debts.GET("/:id/payments", h.GetDebtPayments)
+		debts.GET("/:id/history", h.GetStatusHistory)
 		debts.POST("/:id/paid-off", h.MarkAsPaidOff)
+		debts.GET("/:id/schedule", h.GetAmortizationSchedule)
+		debts.GET("/:id/rate-schedule", h.GetRateSchedule)
+		debts.PUT("/:id/rate-schedule", h.SetRateSchedule)
+		debts.DELETE("/:id/rate-schedule", h.ClearRateSchedule)
+		debts.GET("/:id/rate-stress", h.StressTestRates)
+		debts.GET("/reference-rates", h.GetReferenceRates)
+		debts.POST("/reference-rates", h.RecordReferenceRate)
+		debts.POST("/refinances", h.ApplyRefinance)
+		debts.GET("/refinances", h.GetRefinances)
+		debts.GET("/refinances/:refinanceId/review", h.ReviewRefinance)
 	}
 }
 
@@ -74,29 +89,38 @@ func (h *Handler) CreateDebt(c *gin.Context) {
 	}
 
 	debt := &domain.Debt{
-		UserID:            userID.(uuid.UUID),
-		Name:              req.Name,
-		Description:       req.Description,
-		Type:              req.Type,
-		Behavior:          req.Behavior,
-		Status:            status,
-		PrincipalAmount:   req.PrincipalAmount,
-		CurrentBalance:    req.CurrentBalance,
-		InterestRate:      req.InterestRate,
-		MinimumPayment:    req.MinimumPayment,
-		PaymentAmount:     req.PaymentAmount,
-		Currency:          req.Currency,
-		PaymentFrequency:  req.PaymentFrequency,
-		NextPaymentDate:   req.NextPaymentDate,
-		StartDate:         req.StartDate,
-		DueDate:           req.DueDate,
-		CreditorName:      req.CreditorName,
-		AccountNumber:     req.AccountNumber,
-		LinkedAccountID:   req.LinkedAccountID,
-		EnableReminders:   req.EnableReminders,
-		ReminderFrequency: req.ReminderFrequency,
-		Notes:             req.Notes,
-		Tags:              req.Tags,
+		UserID:               userID.(uuid.UUID),
+		Name:                 req.Name,
+		Description:          req.Description,
+		Type:                 req.Type,
+		Behavior:             req.Behavior,
+		Status:               status,
+		Direction:            req.Direction,
+		PrincipalAmount:      req.PrincipalAmount,
+		CurrentBalance:       req.CurrentBalance,
+		InterestRate:         req.InterestRate,
+		MinimumPayment:       req.MinimumPayment,
+		PaymentAmount:        req.PaymentAmount,
+		Currency:             req.Currency,
+		PaymentFrequency:     req.PaymentFrequency,
+		NextPaymentDate:      req.NextPaymentDate,
+		GracePeriodDays:      req.GracePeriodDays,
+		LateFee:              req.LateFee,
+		LateFeeRate:          req.LateFeeRate,
+		DelinquencyThreshold: req.DelinquencyThreshold,
+		AmortizationMethod:   req.AmortizationMethod,
+		TermPeriods:          req.TermPeriods,
+		StartDate:            req.StartDate,
+		DueDate:              req.DueDate,
+		CreditorName:         req.CreditorName,
+		CounterpartyName:     req.CounterpartyName,
+		CounterpartyContact:  req.CounterpartyContact,
+		AccountNumber:        req.AccountNumber,
+		LinkedAccountID:      req.LinkedAccountID,
+		EnableReminders:      req.EnableReminders,
+		ReminderFrequency:    req.ReminderFrequency,
+		Notes:                req.Notes,
+		Tags:                 req.Tags,
 	}
 
 	if err := h.service.CreateDebt(c.Request.Context(), debt); err != nil {
@@ -183,6 +207,31 @@ func (h *Handler) GetPaidOffDebts(c *gin.Context) {
 	shared.RespondWithSuccess(c, http.StatusOK, "Paid off debts retrieved successfully", dto.ToDebtResponseList(debts))
 }
 
+// GetReceivables godoc
+// @Summary Get receivables
+// @Description Get the money the authenticated user has lent to others, with what is still owed back
+// @Tags debts
+// @Produce json
+// @Success 200 {array} dto.DebtResponse
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/receivables [get]
+func (h *Handler) GetReceivables(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	debts, err := h.service.GetReceivables(c.Request.Context(), userID.(uuid.UUID))
+	if err != nil {
+		h.logger.Error("Failed to get receivables", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Receivables retrieved successfully", dto.ToDebtResponseList(debts))
+}
+
 // GetDebtByID godoc
 // @Summary Get debt by ID
 // @Description Get a specific debt by its ID
@@ -266,6 +315,8 @@ func (h *Handler) UpdateDebt(c *gin.Context) {
 		debt.CurrentBalance = *req.CurrentBalance
 	}
 	if req.InterestRate != nil {
+		// Interest up to today accrues at the old rate
+		debt.AccrueInterest(time.Now())
 		debt.InterestRate = *req.InterestRate
 	}
 	if req.MinimumPayment != nil {
@@ -277,6 +328,12 @@ func (h *Handler) UpdateDebt(c *gin.Context) {
 	if req.Currency != nil {
 		debt.Currency = *req.Currency
 	}
+	if req.InterestAccrual != nil {
+		debt.InterestAccrual = req.InterestAccrual
+	}
+	if req.OutstandingFees != nil {
+		debt.OutstandingFees = *req.OutstandingFees
+	}
 	if req.PaymentFrequency != nil {
 		debt.PaymentFrequency = req.PaymentFrequency
 	}
@@ -286,6 +343,24 @@ func (h *Handler) UpdateDebt(c *gin.Context) {
 	if req.LastPaymentDate != nil {
 		debt.LastPaymentDate = req.LastPaymentDate
 	}
+	if req.GracePeriodDays != nil {
+		debt.GracePeriodDays = *req.GracePeriodDays
+	}
+	if req.LateFee != nil {
+		debt.LateFee = *req.LateFee
+	}
+	if req.LateFeeRate != nil {
+		debt.LateFeeRate = *req.LateFeeRate
+	}
+	if req.DelinquencyThreshold != nil {
+		debt.DelinquencyThreshold = *req.DelinquencyThreshold
+	}
+	if req.AmortizationMethod != nil {
+		debt.AmortizationMethod = req.AmortizationMethod
+	}
+	if req.TermPeriods != nil {
+		debt.TermPeriods = req.TermPeriods
+	}
 	if req.StartDate != nil {
 		debt.StartDate = *req.StartDate
 	}
@@ -298,6 +373,12 @@ func (h *Handler) UpdateDebt(c *gin.Context) {
 	if req.AccountNumber != nil {
 		debt.AccountNumber = req.AccountNumber
 	}
+	if req.CounterpartyName != nil {
+		debt.CounterpartyName = req.CounterpartyName
+	}
+	if req.CounterpartyContact != nil {
+		debt.CounterpartyContact = req.CounterpartyContact
+	}
 	if req.LinkedAccountID != nil {
 		debt.LinkedAccountID = req.LinkedAccountID
 	}
@@ -350,7 +431,7 @@ func (h *Handler) DeleteDebt(c *gin.Context) {
 }
 
 // AddPayment godoc
-// @Summary Add payment to debt
+// @Description Add a payment to a debt; it pays outstanding fees first, then accrued interest, then principal
 // @Description Add a payment amount to a debt
 // @Tags debts
 // @Accept json
@@ -375,7 +456,15 @@ func (h *Handler) AddPayment(c *gin.Context) {
 		return
 	}
 
-	debt, err := h.service.AddPayment(c.Request.Context(), id, req.Amount)
+	payment := &domain.DebtPayment{
+		Amount:      req.Amount,
+		Description: req.Description,
+	}
+	if req.Date != nil {
+		payment.PaymentDate = *req.Date
+	}
+
+	debt, err := h.service.RecordPayment(c.Request.Context(), id, payment)
 	if err != nil {
 		h.logger.Error("Failed to add payment", zap.Error(err))
 		shared.HandleError(c, err)
@@ -441,3 +530,383 @@ func (h *Handler) GetDebtSummary(c *gin.Context) {
 
 	shared.RespondWithSuccess(c, http.StatusOK, "Debt summary retrieved successfully", dto.ToDebtSummaryResponse(summary))
 }
+
+// GetAmortizationSchedule godoc
+// @Summary Get debt amortization schedule
+// @Description Get the payment schedule of an installment or interest-only debt, reconciled with the payments made so far
+// @Tags debts
+// @Produce json
+// @Param id path string true "Debt ID"
+// @Success 200 {object} dto.AmortizationScheduleResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/{id}/schedule [get]
+func (h *Handler) GetAmortizationSchedule(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid debt ID"})
+		return
+	}
+
+	schedule, err := h.service.GetAmortizationSchedule(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get amortization schedule", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Amortization schedule retrieved successfully", dto.ToAmortizationScheduleResponse(schedule))
+}
+
+// GetDebtPayments godoc
+// @Summary Get debt payments
+// @Description Get the payments made toward a debt with their fee, interest and principal split, newest first
+// @Tags debts
+// @Produce json
+// @Param id path string true "Debt ID"
+// @Success 200 {array} dto.DebtPaymentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/{id}/payments [get]
+func (h *Handler) GetDebtPayments(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid debt ID"})
+		return
+	}
+
+	payments, err := h.service.GetDebtPayments(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get debt payments", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Debt payments retrieved successfully", dto.ToDebtPaymentResponseList(payments))
+}
+
+// GetStatusHistory godoc
+// @Summary Get debt status history
+// @Description Get the payment state and status changes of a debt, including late fees charged, newest first
+// @Tags debts
+// @Produce json
+// @Param id path string true "Debt ID"
+// @Success 200 {array} dto.StatusEventResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/{id}/history [get]
+func (h *Handler) GetStatusHistory(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid debt ID"})
+		return
+	}
+
+	events, err := h.service.GetStatusHistory(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get debt status history", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Debt status history retrieved successfully", dto.ToStatusEventResponseList(events))
+}
+
+// GetRateSchedule godoc
+// @Summary Get debt rate schedule
+// @Description Get the fixed and floating rate periods of a debt, ordered by start date
+// @Tags debts
+// @Produce json
+// @Param id path string true "Debt ID"
+// @Success 200 {array} dto.RatePeriodResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/{id}/rate-schedule [get]
+func (h *Handler) GetRateSchedule(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid debt ID"})
+		return
+	}
+
+	periods, err := h.service.GetRateSchedule(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get rate schedule", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Rate schedule retrieved successfully", dto.ToRatePeriodResponseList(periods))
+}
+
+// SetRateSchedule godoc
+// @Summary Set debt rate schedule
+// @Description Replace the rate periods of a debt (e.g. a promotional fixed rate followed by a reference index plus margin) and re-plan the remaining payments
+// @Tags debts
+// @Accept json
+// @Produce json
+// @Param id path string true "Debt ID"
+// @Param schedule body dto.SetRateScheduleRequest true "Rate periods"
+// @Success 200 {array} dto.RatePeriodResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/{id}/rate-schedule [put]
+func (h *Handler) SetRateSchedule(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid debt ID"})
+		return
+	}
+
+	var req dto.SetRateScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	periods, err := h.service.SetRateSchedule(c.Request.Context(), id, req.ToDomain())
+	if err != nil {
+		h.logger.Error("Failed to set rate schedule", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Rate schedule updated successfully", dto.ToRatePeriodResponseList(periods))
+}
+
+// ClearRateSchedule godoc
+// @Summary Clear debt rate schedule
+// @Description Remove the rate periods of a debt so its interest rate applies throughout
+// @Tags debts
+// @Param id path string true "Debt ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/{id}/rate-schedule [delete]
+func (h *Handler) ClearRateSchedule(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid debt ID"})
+		return
+	}
+
+	if err := h.service.ClearRateSchedule(c.Request.Context(), id); err != nil {
+		h.logger.Error("Failed to clear rate schedule", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Rate schedule cleared successfully")
+}
+
+// StressTestRates godoc
+// @Summary Stress-test a floating-rate debt
+// @Description Compare the remaining payments of a debt with the same payments after its reference rates rise by the shock
+// @Tags debts
+// @Produce json
+// @Param id path string true "Debt ID"
+// @Param shock query number false "Reference-rate shock in percentage points" default(2)
+// @Success 200 {object} dto.RateStressTestResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/{id}/rate-stress [get]
+func (h *Handler) StressTestRates(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid debt ID"})
+		return
+	}
+
+	shock, err := strconv.ParseFloat(c.DefaultQuery("shock", "2"), 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid shock"})
+		return
+	}
+
+	result, err := h.service.StressTestRates(c.Request.Context(), id, shock)
+	if err != nil {
+		h.logger.Error("Failed to stress-test debt rates", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Rate stress test completed successfully", dto.ToRateStressTestResponse(result))
+}
+
+// GetReferenceRates godoc
+// @Summary Get reference rates
+// @Description Get the recorded values of the reference indexes the user's loans float on
+// @Tags debts
+// @Produce json
+// @Param index query string false "Reference index, e.g. VCB_12M_DEPOSIT"
+// @Success 200 {array} dto.ReferenceRateResponse
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/reference-rates [get]
+func (h *Handler) GetReferenceRates(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	rates, err := h.service.GetReferenceRates(c.Request.Context(), userID.(uuid.UUID), c.Query("index"))
+	if err != nil {
+		h.logger.Error("Failed to get reference rates", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Reference rates retrieved successfully", dto.ToReferenceRateResponseList(rates))
+}
+
+// RecordReferenceRate godoc
+// @Summary Record a reference rate
+// @Description Record a value of a reference index and reprice the user's debts that float on it
+// @Tags debts
+// @Accept json
+// @Produce json
+// @Param rate body dto.RecordReferenceRateRequest true "Reference rate"
+// @Success 201 {object} dto.ReferenceRateResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/reference-rates [post]
+func (h *Handler) RecordReferenceRate(c *gin.Context) {
+	var req dto.RecordReferenceRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	rate := &domain.ReferenceRate{
+		UserID:        userID.(uuid.UUID),
+		Index:         req.Index,
+		EffectiveDate: req.EffectiveDate,
+		Rate:          req.Rate,
+	}
+	if err := h.service.RecordReferenceRate(c.Request.Context(), rate); err != nil {
+		h.logger.Error("Failed to record reference rate", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Reference rate recorded successfully", dto.ToReferenceRateResponseList([]domain.ReferenceRate{*rate})[0])
+}
+
+// ApplyRefinance godoc
+// @Summary Apply a refinance or consolidation
+// @Description Take out a new loan that pays off the given debts: the loan is created with its schedule, each debt is paid off from the funding account and settled, and the expected savings are recorded for later review
+// @Tags debts
+// @Accept json
+// @Produce json
+// @Param refinance body dto.ApplyRefinanceRequest true "Refinance plan"
+// @Success 201 {object} dto.ApplyRefinanceResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/refinances [post]
+func (h *Handler) ApplyRefinance(c *gin.Context) {
+	var req dto.ApplyRefinanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	plan, err := req.ToPlan()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid ID"})
+		return
+	}
+
+	result, err := h.service.ApplyRefinance(c.Request.Context(), userID.(uuid.UUID), plan)
+	if err != nil {
+		h.logger.Error("Failed to apply refinance", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Refinance applied successfully", dto.ToApplyRefinanceResponse(result))
+}
+
+// GetRefinances godoc
+// @Summary Get applied refinances
+// @Description Get the refinances and consolidations the authenticated user has applied, newest first
+// @Tags debts
+// @Produce json
+// @Success 200 {array} dto.RefinanceResponse
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/refinances [get]
+func (h *Handler) GetRefinances(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	refinances, err := h.service.GetRefinances(c.Request.Context(), userID.(uuid.UUID))
+	if err != nil {
+		h.logger.Error("Failed to get refinances", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Refinances retrieved successfully", dto.ToRefinanceResponseList(refinances))
+}
+
+// ReviewRefinance godoc
+// @Summary Review a refinance
+// @Description Compare the savings a refinance was expected to bring so far with what the repayments of the new loan have realized
+// @Tags debts
+// @Produce json
+// @Param refinanceId path string true "Refinance ID"
+// @Success 200 {object} dto.RefinanceReviewResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/debts/refinances/{refinanceId}/review [get]
+func (h *Handler) ReviewRefinance(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("refinanceId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid refinance ID"})
+		return
+	}
+
+	userID, exists := c.Get("user_id")
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not authenticated")
+		return
+	}
+
+	report, err := h.service.ReviewRefinance(c.Request.Context(), userID.(uuid.UUID), id)
+	if err != nil {
+		h.logger.Error("Failed to review refinance", zap.Error(err))
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Refinance reviewed successfully", dto.ToRefinanceReviewResponse(report))
+}
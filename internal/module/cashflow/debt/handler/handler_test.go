@@ -45,6 +45,9 @@ func (m *MockService) GetDebtsByType(ctx context.Context, userID uuid.UUID, debt
 func (m *MockService) GetPaidOffDebts(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error) {
 	return nil, nil
 }
+func (m *MockService) GetReceivables(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error) {
+	return nil, nil
+}
 func (m *MockService) GetDebtSummary(ctx context.Context, userID uuid.UUID) (*service.DebtSummary, error) {
 	return nil, nil
 }
@@ -66,6 +69,48 @@ func (m *MockService) AddPayment(ctx context.Context, debtID uuid.UUID, amount f
 func (m *MockService) MarkAsPaidOff(ctx context.Context, debtID uuid.UUID) error {
 	return nil
 }
+func (m *MockService) RecordPayment(ctx context.Context, debtID uuid.UUID, payment *domain.DebtPayment) (*domain.Debt, error) {
+	return nil, nil
+}
+func (m *MockService) GetDebtPayments(ctx context.Context, debtID uuid.UUID) ([]domain.DebtPayment, error) {
+	return nil, nil
+}
+func (m *MockService) GetAmortizationSchedule(ctx context.Context, debtID uuid.UUID) (*service.AmortizationSchedule, error) {
+	return nil, nil
+}
+func (m *MockService) GetRateSchedule(ctx context.Context, debtID uuid.UUID) ([]domain.DebtRatePeriod, error) {
+	return nil, nil
+}
+func (m *MockService) SetRateSchedule(ctx context.Context, debtID uuid.UUID, periods []domain.DebtRatePeriod) ([]domain.DebtRatePeriod, error) {
+	return nil, nil
+}
+func (m *MockService) ClearRateSchedule(ctx context.Context, debtID uuid.UUID) error {
+	return nil
+}
+func (m *MockService) RecordReferenceRate(ctx context.Context, rate *domain.ReferenceRate) error {
+	return nil
+}
+func (m *MockService) GetReferenceRates(ctx context.Context, userID uuid.UUID, index string) ([]domain.ReferenceRate, error) {
+	return nil, nil
+}
+func (m *MockService) StressTestRates(ctx context.Context, debtID uuid.UUID, shock float64) (*service.RateStressTest, error) {
+	return nil, nil
+}
+func (m *MockService) ApplyRefinance(ctx context.Context, userID uuid.UUID, plan *service.RefinancePlan) (*service.RefinanceResult, error) {
+	return nil, nil
+}
+func (m *MockService) GetRefinances(ctx context.Context, userID uuid.UUID) ([]domain.DebtRefinance, error) {
+	return nil, nil
+}
+func (m *MockService) ReviewRefinance(ctx context.Context, userID, refinanceID uuid.UUID) (*service.RefinanceReport, error) {
+	return nil, nil
+}
+func (m *MockService) ProcessPaymentStates(ctx context.Context, asOf time.Time) (int, error) {
+	return 0, nil
+}
+func (m *MockService) GetStatusHistory(ctx context.Context, debtID uuid.UUID) ([]domain.DebtStatusEvent, error) {
+	return nil, nil
+}
 
 func TestHandler_CreateDebt(t *testing.T) {
 	gin.SetMode(gin.TestMode)
@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // Repository defines the interface for debt data access
@@ -12,15 +14,24 @@ type Repository interface {
 	// Create creates a new debt
 	Create(ctx context.Context, debt *domain.Debt) error
 
+	// CreateWithTx creates a new debt within an existing database transaction
+	CreateWithTx(tx *gorm.DB, debt *domain.Debt) error
+
 	// FindByID retrieves a debt by its ID
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Debt, error)
 
+	// FindByIDWithTx retrieves and locks a debt within an existing database transaction
+	FindByIDWithTx(tx *gorm.DB, id uuid.UUID) (*domain.Debt, error)
+
 	// FindByUserID retrieves all debts for a user
 	FindByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error)
 
-	// FindActiveByUserID retrieves all active debts for a user
+	// FindActiveByUserID retrieves all active debts the user owes (money lent to others is left out)
 	FindActiveByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error)
 
+	// FindReceivablesByUserID retrieves the money a user has lent to others
+	FindReceivablesByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error)
+
 	// FindByType retrieves debts of a specific type
 	FindByType(ctx context.Context, userID uuid.UUID, debtType domain.DebtType) ([]domain.Debt, error)
 
@@ -36,9 +47,81 @@ type Repository interface {
 	// Update updates an existing debt
 	Update(ctx context.Context, debt *domain.Debt) error
 
+	// UpdateWithTx updates an existing debt within an existing database transaction
+	UpdateWithTx(tx *gorm.DB, debt *domain.Debt) error
+
 	// Delete soft deletes a debt
 	Delete(ctx context.Context, id uuid.UUID) error
 
 	// AddPayment adds a payment amount to a debt
 	AddPayment(ctx context.Context, id uuid.UUID, amount float64) error
+
+	// FindReminderCandidates retrieves debts still being repaid that have reminders enabled
+	FindReminderCandidates(ctx context.Context) ([]domain.Debt, error)
+
+	// MarkReminderSent records when a debt's reminder was last sent
+	MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+
+	// FindStatusTrackedDebts retrieves debts still being repaid that have a next payment date
+	FindStatusTrackedDebts(ctx context.Context) ([]domain.Debt, error)
+
+	// CreateStatusEvent stores a change of a debt's payment state or status
+	CreateStatusEvent(ctx context.Context, event *domain.DebtStatusEvent) error
+
+	// CreateStatusEventWithTx stores a status change within an existing database transaction
+	CreateStatusEventWithTx(tx *gorm.DB, event *domain.DebtStatusEvent) error
+
+	// FindStatusEventsByDebtID retrieves a debt's status history, newest first
+	FindStatusEventsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtStatusEvent, error)
+
+	// FindScheduleByDebtID retrieves a debt's amortization schedule ordered by period
+	FindScheduleByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtScheduleEntry, error)
+
+	// ReplaceSchedule replaces a debt's schedule entries from the given period on
+	ReplaceSchedule(ctx context.Context, debtID uuid.UUID, fromPeriod int, entries []domain.DebtScheduleEntry) error
+
+	// ReplaceScheduleWithTx replaces a debt's schedule entries within an existing database transaction
+	ReplaceScheduleWithTx(tx *gorm.DB, debtID uuid.UUID, fromPeriod int, entries []domain.DebtScheduleEntry) error
+
+	// CreatePayment stores a payment made toward a debt
+	CreatePayment(ctx context.Context, payment *domain.DebtPayment) error
+
+	// CreatePaymentWithTx stores a payment within an existing database transaction
+	CreatePaymentWithTx(tx *gorm.DB, payment *domain.DebtPayment) error
+
+	// FindPaymentsByDebtID retrieves a debt's payments, newest first
+	FindPaymentsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtPayment, error)
+
+	// FindPaymentsByUserInRange retrieves a user's debt payments dated within [from, to]
+	FindPaymentsByUserInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.DebtPayment, error)
+
+	// FindRatePeriodsByDebtID retrieves a debt's rate schedule ordered by start date
+	FindRatePeriodsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtRatePeriod, error)
+
+	// ReplaceRatePeriods replaces a debt's rate schedule
+	ReplaceRatePeriods(ctx context.Context, debtID uuid.UUID, periods []domain.DebtRatePeriod) error
+
+	// ReplaceRatePeriodsWithTx replaces a debt's rate schedule within an existing database transaction
+	ReplaceRatePeriodsWithTx(tx *gorm.DB, debtID uuid.UUID, periods []domain.DebtRatePeriod) error
+
+	// FindDebtIDsByReferenceIndex retrieves the user's debts with a rate period floating on the index
+	FindDebtIDsByReferenceIndex(ctx context.Context, userID uuid.UUID, index string) ([]uuid.UUID, error)
+
+	// UpsertReferenceRate creates or updates the value of a reference index on its effective date
+	UpsertReferenceRate(ctx context.Context, rate *domain.ReferenceRate) error
+
+	// FindReferenceRates retrieves the user's values of the given indexes, or of all indexes when none are given
+	FindReferenceRates(ctx context.Context, userID uuid.UUID, indexes []string) ([]domain.ReferenceRate, error)
+
+	// CreateRefinance stores an applied refinance with its items
+	CreateRefinance(ctx context.Context, refinance *domain.DebtRefinance) error
+
+	// CreateRefinanceWithTx stores an applied refinance within an existing database transaction
+	CreateRefinanceWithTx(tx *gorm.DB, refinance *domain.DebtRefinance) error
+
+	// FindRefinancesByUserID retrieves a user's refinances with their items, newest first
+	FindRefinancesByUserID(ctx context.Context, userID uuid.UUID) ([]domain.DebtRefinance, error)
+
+	// FindRefinanceByID retrieves a refinance with its items
+	FindRefinanceByID(ctx context.Context, id uuid.UUID) (*domain.DebtRefinance, error)
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"personalfinancedss/internal/shared"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -20,7 +22,11 @@ func New(db *gorm.DB) Repository {
 }
 
 func (r *repository) Create(ctx context.Context, debt *domain.Debt) error {
-	return r.db.WithContext(ctx).Create(debt).Error
+	return r.CreateWithTx(r.db.WithContext(ctx), debt)
+}
+
+func (r *repository) CreateWithTx(tx *gorm.DB, debt *domain.Debt) error {
+	return tx.Create(debt).Error
 }
 
 func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Debt, error) {
@@ -35,6 +41,18 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Debt,
 	return &debt, nil
 }
 
+func (r *repository) FindByIDWithTx(tx *gorm.DB, id uuid.UUID) (*domain.Debt, error) {
+	var debt domain.Debt
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&debt).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("debt not found")
+		}
+		return nil, err
+	}
+	return &debt, nil
+}
+
 func (r *repository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error) {
 	var debts []domain.Debt
 	err := r.db.WithContext(ctx).
@@ -47,12 +65,21 @@ func (r *repository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]doma
 func (r *repository) FindActiveByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error) {
 	var debts []domain.Debt
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND status = ?", userID, domain.DebtStatusActive).
+		Where("user_id = ? AND status = ? AND direction = ?", userID, domain.DebtStatusActive, domain.DebtDirectionBorrowed).
 		Order("next_payment_date ASC").
 		Find(&debts).Error
 	return debts, err
 }
 
+func (r *repository) FindReceivablesByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error) {
+	var debts []domain.Debt
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND direction = ?", userID, domain.DebtDirectionLent).
+		Order("status ASC, next_payment_date ASC, created_at DESC").
+		Find(&debts).Error
+	return debts, err
+}
+
 func (r *repository) FindByType(ctx context.Context, userID uuid.UUID, debtType domain.DebtType) ([]domain.Debt, error) {
 	var debts []domain.Debt
 	err := r.db.WithContext(ctx).
@@ -95,7 +122,11 @@ func (r *repository) FindOverdueDebts(ctx context.Context, userID uuid.UUID) ([]
 }
 
 func (r *repository) Update(ctx context.Context, debt *domain.Debt) error {
-	return r.db.WithContext(ctx).Save(debt).Error
+	return r.UpdateWithTx(r.db.WithContext(ctx), debt)
+}
+
+func (r *repository) UpdateWithTx(tx *gorm.DB, debt *domain.Debt) error {
+	return tx.Save(debt).Error
 }
 
 func (r *repository) Delete(ctx context.Context, id uuid.UUID) error {
@@ -111,3 +142,187 @@ func (r *repository) AddPayment(ctx context.Context, id uuid.UUID, amount float6
 			"total_paid":      gorm.Expr("total_paid + ?", amount),
 		}).Error
 }
+
+func (r *repository) FindReminderCandidates(ctx context.Context) ([]domain.Debt, error) {
+	var debts []domain.Debt
+	err := r.db.WithContext(ctx).
+		Where("enable_reminders = ? AND status IN (?)", true, []string{
+			string(domain.DebtStatusActive),
+			string(domain.DebtStatusDefaulted),
+		}).
+		Order("user_id ASC").
+		Find(&debts).Error
+	return debts, err
+}
+
+func (r *repository) MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.Debt{}).
+		Where("id = ?", id).
+		Update("last_reminder_sent_at", sentAt).Error
+}
+
+func (r *repository) FindStatusTrackedDebts(ctx context.Context) ([]domain.Debt, error) {
+	var debts []domain.Debt
+	err := r.db.WithContext(ctx).
+		Where("status IN (?) AND next_payment_date IS NOT NULL AND current_balance > 0", []string{
+			string(domain.DebtStatusActive),
+			string(domain.DebtStatusDefaulted),
+		}).
+		Order("user_id ASC").
+		Find(&debts).Error
+	return debts, err
+}
+
+func (r *repository) CreateStatusEvent(ctx context.Context, event *domain.DebtStatusEvent) error {
+	return r.CreateStatusEventWithTx(r.db.WithContext(ctx), event)
+}
+
+func (r *repository) CreateStatusEventWithTx(tx *gorm.DB, event *domain.DebtStatusEvent) error {
+	return tx.Create(event).Error
+}
+
+func (r *repository) FindStatusEventsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtStatusEvent, error) {
+	var events []domain.DebtStatusEvent
+	err := r.db.WithContext(ctx).
+		Where("debt_id = ?", debtID).
+		Order("occurred_at DESC, created_at DESC").
+		Find(&events).Error
+	return events, err
+}
+
+func (r *repository) FindScheduleByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtScheduleEntry, error) {
+	var entries []domain.DebtScheduleEntry
+	err := r.db.WithContext(ctx).
+		Where("debt_id = ?", debtID).
+		Order("period ASC").
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *repository) ReplaceSchedule(ctx context.Context, debtID uuid.UUID, fromPeriod int, entries []domain.DebtScheduleEntry) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return r.ReplaceScheduleWithTx(tx, debtID, fromPeriod, entries)
+	})
+}
+
+func (r *repository) ReplaceScheduleWithTx(tx *gorm.DB, debtID uuid.UUID, fromPeriod int, entries []domain.DebtScheduleEntry) error {
+	if err := tx.Where("debt_id = ? AND period >= ?", debtID, fromPeriod).
+		Delete(&domain.DebtScheduleEntry{}).Error; err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return tx.Create(&entries).Error
+}
+
+func (r *repository) CreatePayment(ctx context.Context, payment *domain.DebtPayment) error {
+	return r.CreatePaymentWithTx(r.db.WithContext(ctx), payment)
+}
+
+func (r *repository) CreatePaymentWithTx(tx *gorm.DB, payment *domain.DebtPayment) error {
+	return tx.Create(payment).Error
+}
+
+func (r *repository) FindPaymentsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtPayment, error) {
+	var payments []domain.DebtPayment
+	err := r.db.WithContext(ctx).
+		Where("debt_id = ?", debtID).
+		Order("payment_date DESC, created_at DESC").
+		Find(&payments).Error
+	return payments, err
+}
+
+func (r *repository) FindPaymentsByUserInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.DebtPayment, error) {
+	var payments []domain.DebtPayment
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND payment_date >= ? AND payment_date <= ?", userID, from, to).
+		Order("payment_date ASC").
+		Find(&payments).Error
+	return payments, err
+}
+
+func (r *repository) FindRatePeriodsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtRatePeriod, error) {
+	var periods []domain.DebtRatePeriod
+	err := r.db.WithContext(ctx).
+		Where("debt_id = ?", debtID).
+		Order("start_date ASC").
+		Find(&periods).Error
+	return periods, err
+}
+
+func (r *repository) ReplaceRatePeriods(ctx context.Context, debtID uuid.UUID, periods []domain.DebtRatePeriod) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return r.ReplaceRatePeriodsWithTx(tx, debtID, periods)
+	})
+}
+
+func (r *repository) ReplaceRatePeriodsWithTx(tx *gorm.DB, debtID uuid.UUID, periods []domain.DebtRatePeriod) error {
+	if err := tx.Where("debt_id = ?", debtID).Delete(&domain.DebtRatePeriod{}).Error; err != nil {
+		return err
+	}
+	if len(periods) == 0 {
+		return nil
+	}
+	return tx.Create(&periods).Error
+}
+
+func (r *repository) FindDebtIDsByReferenceIndex(ctx context.Context, userID uuid.UUID, index string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&domain.DebtRatePeriod{}).
+		Distinct("debt_id").
+		Where("user_id = ? AND rate_type = ? AND UPPER(reference_index) = ?", userID, domain.RateTypeFloating, domain.NormalizeIndex(index)).
+		Pluck("debt_id", &ids).Error
+	return ids, err
+}
+
+func (r *repository) UpsertReferenceRate(ctx context.Context, rate *domain.ReferenceRate) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "index_name"}, {Name: "effective_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+		}).
+		Create(rate).Error
+}
+
+func (r *repository) FindReferenceRates(ctx context.Context, userID uuid.UUID, indexes []string) ([]domain.ReferenceRate, error) {
+	var rates []domain.ReferenceRate
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if len(indexes) > 0 {
+		query = query.Where("index_name IN ?", indexes)
+	}
+	err := query.Order("index_name ASC, effective_date ASC").Find(&rates).Error
+	return rates, err
+}
+
+func (r *repository) CreateRefinance(ctx context.Context, refinance *domain.DebtRefinance) error {
+	return r.CreateRefinanceWithTx(r.db.WithContext(ctx), refinance)
+}
+
+func (r *repository) CreateRefinanceWithTx(tx *gorm.DB, refinance *domain.DebtRefinance) error {
+	return tx.Create(refinance).Error
+}
+
+func (r *repository) FindRefinancesByUserID(ctx context.Context, userID uuid.UUID) ([]domain.DebtRefinance, error) {
+	var refinances []domain.DebtRefinance
+	err := r.db.WithContext(ctx).
+		Preload("Items").
+		Where("user_id = ?", userID).
+		Order("applied_at DESC, created_at DESC").
+		Find(&refinances).Error
+	return refinances, err
+}
+
+func (r *repository) FindRefinanceByID(ctx context.Context, id uuid.UUID) (*domain.DebtRefinance, error) {
+	var refinance domain.DebtRefinance
+	err := r.db.WithContext(ctx).Preload("Items").Where("id = ?", id).First(&refinance).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &refinance, nil
+}
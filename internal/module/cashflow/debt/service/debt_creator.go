@@ -3,24 +3,17 @@ package service
 import (
 	"context"
 	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"time"
 
 	"go.uber.org/zap"
 )
 
 // CreateDebt creates a new debt for a user
 func (s *debtService) CreateDebt(ctx context.Context, debt *domain.Debt) error {
-	if err := s.validateDebt(debt); err != nil {
+	if err := s.prepareNewDebt(debt); err != nil {
 		return err
 	}
 
-	debt.UpdateCalculatedFields()
-
-	// Calculate next payment date if frequency is provided
-	if debt.PaymentFrequency != nil {
-		nextDate := debt.CalculateNextPaymentDate()
-		debt.NextPaymentDate = nextDate
-	}
-
 	if err := s.repo.Create(ctx, debt); err != nil {
 		s.logger.Error("Failed to create debt",
 			zap.String("debt_name", debt.Name),
@@ -30,11 +23,40 @@ func (s *debtService) CreateDebt(ctx context.Context, debt *domain.Debt) error {
 		return err
 	}
 
+	if debt.HasSchedule() {
+		s.syncSchedule(ctx, debt, nil, false)
+	}
+
 	s.logger.Info("Debt created successfully",
 		zap.String("debt_id", debt.ID.String()),
 		zap.String("debt_name", debt.Name),
 		zap.String("user_id", debt.UserID.String()),
+		zap.String("direction", string(debt.Direction)),
 	)
 
 	return nil
 }
+
+// prepareNewDebt validates a debt about to be created and fills in its payment date, interest
+// accrual and payment state
+func (s *debtService) prepareNewDebt(debt *domain.Debt) error {
+	if debt.Direction == "" {
+		debt.Direction = domain.DebtDirectionBorrowed
+	}
+	if err := s.validateDebt(debt); err != nil {
+		return err
+	}
+
+	// Calculate next payment date if frequency is provided and no date was given
+	if debt.PaymentFrequency != nil && debt.NextPaymentDate == nil {
+		debt.NextPaymentDate = debt.CalculateNextPaymentDate()
+	}
+
+	// The balance entered already includes the interest owed so far
+	debt.StartInterestAccrual(time.Now())
+
+	// Start from the current payment state; history records the changes from here on
+	debt.EvaluatePaymentState(time.Now(), domain.StatusTriggerSchedule)
+	debt.UpdateCalculatedFields()
+	return nil
+}
@@ -8,46 +8,82 @@ import (
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
-// AddPayment adds a payment to a debt and updates balances
+// AddPayment adds a payment made now to a debt and updates balances
 func (s *debtService) AddPayment(ctx context.Context, debtID uuid.UUID, amount float64) (*domain.Debt, error) {
+	return s.RecordPayment(ctx, debtID, &domain.DebtPayment{Amount: amount, PaymentDate: time.Now()})
+}
+
+// RecordPayment applies a payment to a debt, split into fees, accrued interest and principal, and
+// stores it. Amount, PaymentDate and optionally TransactionID and Description are read from the
+// payment; the split is filled in.
+func (s *debtService) RecordPayment(ctx context.Context, debtID uuid.UUID, payment *domain.DebtPayment) (*domain.Debt, error) {
+	amount := payment.Amount
 	if amount <= 0 {
 		return nil, errors.New("payment amount must be greater than 0")
 	}
-
-	debt, err := s.repo.FindByID(ctx, debtID)
-	if err != nil {
-		s.logger.Error("Failed to find debt for payment",
-			zap.String("debt_id", debtID.String()),
-			zap.Error(err),
-		)
-		return nil, err
+	paidAt := payment.PaymentDate
+	if paidAt.IsZero() {
+		paidAt = time.Now()
 	}
 
-	// Record original balance for logging
-	originalBalance := debt.CurrentBalance
+	// The debt is read locked so the split works from its current balance and a concurrent payment
+	// waits; the new balance, the payment and the status change commit together
+	var (
+		debt            *domain.Debt
+		originalBalance float64
+		schedule        []domain.DebtScheduleEntry
+		scheduleErr     error
+		extraPayment    bool
+		event           *domain.DebtStatusEvent
+	)
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		debt, err = s.repo.FindByIDWithTx(tx, debtID)
+		if err != nil {
+			return err
+		}
+		originalBalance = debt.CurrentBalance
 
-	// Add payment using domain logic
-	debt.AddPayment(amount)
+		// A rate schedule may have moved the rate since the last payment
+		if err := s.loadRates(ctx, debt); err != nil {
+			return err
+		}
+		debt.ApplyCurrentRate(paidAt)
 
-	// Update next payment date if frequency is set
-	if debt.PaymentFrequency != nil {
-		nextDate := debt.CalculateNextPaymentDate()
-		debt.NextPaymentDate = nextDate
-	}
+		// Paying more than the scheduled installment re-plans the rest of the schedule
+		if debt.HasSchedule() {
+			schedule, scheduleErr = s.repo.FindScheduleByDebtID(ctx, debtID)
+			extraPayment = domain.IsExtraPayment(schedule, amount, paidAt)
+		}
 
-	if err := s.repo.Update(ctx, debt); err != nil {
-		s.logger.Error("Failed to update debt after payment",
+		event = applyPayment(debt, payment, paidAt)
+		return s.savePaymentWithTx(tx, debt, payment, event)
+	}); err != nil {
+		s.logger.Error("Failed to record debt payment",
 			zap.String("debt_id", debtID.String()),
+			zap.Float64("amount", amount),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
+	if debt.HasSchedule() && scheduleErr == nil {
+		s.syncSchedule(ctx, debt, schedule, extraPayment)
+	}
+
+	if event != nil {
+		s.notifyStatusEvent(ctx, debt, event)
+	}
+
 	s.logger.Info("Payment added to debt",
 		zap.String("debt_id", debtID.String()),
 		zap.Float64("amount", amount),
+		zap.Float64("fees", payment.FeePortion),
+		zap.Float64("interest", payment.InterestPortion),
+		zap.Float64("principal", payment.PrincipalPortion),
 		zap.Float64("original_balance", originalBalance),
 		zap.Float64("new_balance", debt.CurrentBalance),
 		zap.Float64("total_paid", debt.TotalPaid),
@@ -56,6 +92,60 @@ func (s *debtService) AddPayment(ctx context.Context, debtID uuid.UUID, amount f
 	return debt, nil
 }
 
+// applyPayment accrues interest on the debt, splits the payment into fees, interest and principal
+// and moves the next payment date past the payment dates it covers, which may catch up missed
+// payments. It returns the status change the payment caused, if any.
+func applyPayment(debt *domain.Debt, payment *domain.DebtPayment, paidAt time.Time) *domain.DebtStatusEvent {
+	applied := debt.ApplyPayment(payment.Amount, paidAt)
+	payment.DebtID = applied.DebtID
+	payment.UserID = applied.UserID
+	payment.PaymentDate = applied.PaymentDate
+	payment.FeePortion = applied.FeePortion
+	payment.InterestPortion = applied.InterestPortion
+	payment.PrincipalPortion = applied.PrincipalPortion
+	payment.BalanceAfter = applied.BalanceAfter
+
+	debt.AdvanceDueDate(payment.Amount, paidAt)
+	event := debt.EvaluatePaymentState(paidAt, domain.StatusTriggerPayment)
+	debt.UpdateCalculatedFields()
+	return event
+}
+
+// savePaymentWithTx stores a debt after a payment was applied to it, the payment and the status
+// change the payment caused, if any, within an existing database transaction
+func (s *debtService) savePaymentWithTx(tx *gorm.DB, debt *domain.Debt, payment *domain.DebtPayment, event *domain.DebtStatusEvent) error {
+	if err := s.repo.UpdateWithTx(tx, debt); err != nil {
+		return err
+	}
+	if err := s.repo.CreatePaymentWithTx(tx, payment); err != nil {
+		return err
+	}
+	if event != nil {
+		if err := s.repo.CreateStatusEventWithTx(tx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDebtPayments returns the payments made toward a debt, newest first
+func (s *debtService) GetDebtPayments(ctx context.Context, debtID uuid.UUID) ([]domain.DebtPayment, error) {
+	if _, err := s.repo.FindByID(ctx, debtID); err != nil {
+		return nil, err
+	}
+
+	payments, err := s.repo.FindPaymentsByDebtID(ctx, debtID)
+	if err != nil {
+		s.logger.Error("Failed to get debt payments",
+			zap.String("debt_id", debtID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return payments, nil
+}
+
 // MarkAsPaidOff marks a debt as completely paid off
 func (s *debtService) MarkAsPaidOff(ctx context.Context, debtID uuid.UUID) error {
 	debt, err := s.repo.FindByID(ctx, debtID)
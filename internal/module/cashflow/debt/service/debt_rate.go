@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"personalfinancedss/internal/shared"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetRateSchedule returns the debt's rate periods ordered by start date
+func (s *debtService) GetRateSchedule(ctx context.Context, debtID uuid.UUID) ([]domain.DebtRatePeriod, error) {
+	if _, err := s.repo.FindByID(ctx, debtID); err != nil {
+		return nil, err
+	}
+
+	periods, err := s.repo.FindRatePeriodsByDebtID(ctx, debtID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return periods, nil
+}
+
+// SetRateSchedule replaces the debt's rate periods, moves the debt to the rate now in force and
+// re-plans the payments still to come
+func (s *debtService) SetRateSchedule(ctx context.Context, debtID uuid.UUID, periods []domain.DebtRatePeriod) ([]domain.DebtRatePeriod, error) {
+	if err := domain.ValidateRatePeriods(periods); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("rate_periods", err.Error())
+	}
+
+	debt, err := s.repo.FindByID(ctx, debtID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignRatePeriods(debt, periods)
+
+	if err := s.repo.ReplaceRatePeriods(ctx, debt.ID, periods); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	if err := s.repriceDebt(ctx, debt); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Debt rate schedule updated",
+		zap.String("debt_id", debt.ID.String()),
+		zap.Int("periods", len(periods)),
+		zap.Float64("current_rate", debt.InterestRate),
+	)
+
+	return s.repo.FindRatePeriodsByDebtID(ctx, debt.ID)
+}
+
+// ClearRateSchedule removes the debt's rate periods; InterestRate then applies throughout
+func (s *debtService) ClearRateSchedule(ctx context.Context, debtID uuid.UUID) error {
+	debt, err := s.repo.FindByID(ctx, debtID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.ReplaceRatePeriods(ctx, debt.ID, nil); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	return s.repriceDebt(ctx, debt)
+}
+
+// RecordReferenceRate stores a value of a reference index and reprices the user's debts that float on it
+func (s *debtService) RecordReferenceRate(ctx context.Context, rate *domain.ReferenceRate) error {
+	rate.Index = domain.NormalizeIndex(rate.Index)
+	if rate.Index == "" {
+		return shared.ErrBadRequest.WithDetails("index", "reference index is required")
+	}
+	if rate.Rate < 0 || rate.Rate > 100 {
+		return shared.ErrBadRequest.WithDetails("rate", "rate must be between 0 and 100")
+	}
+	if rate.EffectiveDate.IsZero() {
+		return shared.ErrBadRequest.WithDetails("effective_date", "effective date is required")
+	}
+
+	if err := s.repo.UpsertReferenceRate(ctx, rate); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	debtIDs, err := s.repo.FindDebtIDsByReferenceIndex(ctx, rate.UserID, rate.Index)
+	if err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	for _, id := range debtIDs {
+		debt, err := s.repo.FindByID(ctx, id)
+		if err != nil {
+			continue
+		}
+		if err := s.repriceDebt(ctx, debt); err != nil {
+			s.logger.Warn("Failed to reprice debt after reference rate change",
+				zap.String("debt_id", id.String()),
+				zap.String("index", rate.Index),
+				zap.Error(err),
+			)
+		}
+	}
+
+	s.logger.Info("Reference rate recorded",
+		zap.String("user_id", rate.UserID.String()),
+		zap.String("index", rate.Index),
+		zap.Float64("rate", rate.Rate),
+		zap.Int("debts_repriced", len(debtIDs)),
+	)
+
+	return nil
+}
+
+// GetReferenceRates returns the user's values of an index, or of every index when index is empty
+func (s *debtService) GetReferenceRates(ctx context.Context, userID uuid.UUID, index string) ([]domain.ReferenceRate, error) {
+	var indexes []string
+	if index = domain.NormalizeIndex(index); index != "" {
+		indexes = []string{index}
+	}
+
+	rates, err := s.repo.FindReferenceRates(ctx, userID, indexes)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return rates, nil
+}
+
+// StressTestRates compares the payments still to come with the same payments after the reference
+// rates of the debt's floating periods rise by shock percentage points
+func (s *debtService) StressTestRates(ctx context.Context, debtID uuid.UUID, shock float64) (*RateStressTest, error) {
+	debt, err := s.repo.FindByID(ctx, debtID)
+	if err != nil {
+		return nil, err
+	}
+	if !debt.HasSchedule() {
+		return nil, shared.ErrBadRequest.WithDetails("behavior", "only installment and interest-only debts have an amortization schedule")
+	}
+	if err := s.loadRates(ctx, debt); err != nil {
+		return nil, err
+	}
+	if debt.Rates == nil || !debt.Rates.HasFloating() {
+		return nil, shared.ErrBadRequest.WithDetails("rate_periods", "the debt has no floating rate period to stress")
+	}
+
+	entries, err := s.repo.FindScheduleByDebtID(ctx, debtID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	now := time.Now()
+	settledBefore := now
+	if debt.NextPaymentDate != nil {
+		settledBefore = *debt.NextPaymentDate
+	}
+
+	baseline, _, err := debt.RegenerateSchedule(entries, settledBefore)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("schedule", err.Error())
+	}
+
+	rates := debt.Rates
+	debt.Rates = rates.WithShock(shock)
+	stressed, _, err := debt.RegenerateSchedule(entries, settledBefore)
+	stressedRate := debt.RateOn(now)
+	debt.Rates = rates
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("schedule", err.Error())
+	}
+
+	result := &RateStressTest{
+		Debt:         debt,
+		Shock:        shock,
+		CurrentRate:  debt.RateOn(now),
+		StressedRate: stressedRate,
+		Baseline:     baseline,
+		Stressed:     stressed,
+	}
+	for _, entry := range baseline {
+		result.BaselineInterest += entry.Interest
+		if entry.Payment > result.BaselinePeakPayment {
+			result.BaselinePeakPayment = entry.Payment
+		}
+	}
+	for _, entry := range stressed {
+		result.StressedInterest += entry.Interest
+		if entry.Payment > result.StressedPeakPayment {
+			result.StressedPeakPayment = entry.Payment
+			result.StressedPeakDate = entry.DueDate
+		}
+	}
+	result.InterestImpact = result.StressedInterest - result.BaselineInterest
+	result.PeakPaymentImpact = result.StressedPeakPayment - result.BaselinePeakPayment
+
+	return result, nil
+}
+
+// assignRatePeriods makes the periods new periods of the debt, with their reference indexes normalized
+func assignRatePeriods(debt *domain.Debt, periods []domain.DebtRatePeriod) {
+	for i := range periods {
+		periods[i].ID = uuid.Nil
+		periods[i].DebtID = debt.ID
+		periods[i].UserID = debt.UserID
+		if periods[i].ReferenceIndex != nil {
+			index := domain.NormalizeIndex(*periods[i].ReferenceIndex)
+			periods[i].ReferenceIndex = &index
+		}
+	}
+}
+
+// loadRates attaches the debt's rate schedule, with the reference values it follows, to the debt
+func (s *debtService) loadRates(ctx context.Context, debt *domain.Debt) error {
+	periods, err := s.repo.FindRatePeriodsByDebtID(ctx, debt.ID)
+	if err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	debt.Rates, err = s.rateSchedule(ctx, debt.UserID, periods)
+	return err
+}
+
+// rateSchedule builds a rate schedule from the periods and the user's values of the reference
+// indexes they follow; no periods means no schedule
+func (s *debtService) rateSchedule(ctx context.Context, userID uuid.UUID, periods []domain.DebtRatePeriod) (*domain.RateSchedule, error) {
+	if len(periods) == 0 {
+		return nil, nil
+	}
+
+	rates := domain.NewRateSchedule(periods, nil)
+	var references []domain.ReferenceRate
+	if indexes := rates.Indexes(); len(indexes) > 0 {
+		var err error
+		references, err = s.repo.FindReferenceRates(ctx, userID, indexes)
+		if err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+	}
+
+	return domain.NewRateSchedule(periods, references), nil
+}
+
+// repriceDebt reloads the debt's rate schedule, moves the debt to the rate now in force and
+// rebuilds the payments still to come
+func (s *debtService) repriceDebt(ctx context.Context, debt *domain.Debt) error {
+	if err := s.loadRates(ctx, debt); err != nil {
+		return err
+	}
+
+	if debt.ApplyCurrentRate(time.Now()) {
+		if err := s.repo.Update(ctx, debt); err != nil {
+			return shared.ErrInternal.WithError(err)
+		}
+	}
+
+	if debt.HasSchedule() {
+		if entries, err := s.repo.FindScheduleByDebtID(ctx, debt.ID); err == nil {
+			s.syncSchedule(ctx, debt, entries, true)
+		}
+	}
+	return nil
+}
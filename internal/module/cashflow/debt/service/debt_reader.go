@@ -74,6 +74,19 @@ func (s *debtService) GetPaidOffDebts(ctx context.Context, userID uuid.UUID) ([]
 	return debts, nil
 }
 
+// GetReceivables retrieves the money the user has lent to others
+func (s *debtService) GetReceivables(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error) {
+	debts, err := s.repo.FindReceivablesByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get receivables",
+			zap.String("user_id", userID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return debts, nil
+}
+
 // GetDebtSummary calculates and returns a summary of all debts for a user
 func (s *debtService) GetDebtSummary(ctx context.Context, userID uuid.UUID) (*DebtSummary, error) {
 	debts, err := s.repo.FindByUserID(ctx, userID)
@@ -93,6 +106,12 @@ func (s *debtService) GetDebtSummary(ctx context.Context, userID uuid.UUID) (*De
 	var totalProgress float64
 
 	for _, debt := range debts {
+		// Money lent to others is owed to the user, so it is summarized apart from what the user owes
+		if debt.IsReceivable() {
+			summary.Receivables.add(&debt)
+			continue
+		}
+
 		summary.TotalDebts++
 		summary.TotalPrincipalAmount += debt.PrincipalAmount
 		summary.TotalCurrentBalance += debt.CurrentBalance
@@ -0,0 +1,476 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/debt/domain"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ApplyRefinance carries out a refinance or consolidation. A new installment loan is created for
+// what the debts take to pay off plus the origination fee; its proceeds land on the funding account
+// and pay off each debt with a payoff transaction, after which the debts are settled. What the
+// switch is expected to save is recorded with it for later review. Everything is stored in one
+// database transaction, so a failure part way leaves the debts and the account untouched.
+func (s *debtService) ApplyRefinance(ctx context.Context, userID uuid.UUID, plan *RefinancePlan) (*RefinanceResult, error) {
+	if err := validateRefinancePlan(plan); err != nil {
+		return nil, err
+	}
+	appliedAt := plan.AppliedAt
+	if appliedAt.IsZero() {
+		appliedAt = time.Now()
+	}
+
+	debts, err := s.loadRefinancedDebts(ctx, userID, plan.DebtIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	refinance := &domain.DebtRefinance{
+		ID:             uuid.New(),
+		UserID:         userID,
+		Currency:       debts[0].Currency,
+		NewRate:        plan.NewRate,
+		TermMonths:     plan.TermMonths,
+		OriginationFee: plan.OriginationFee,
+		MonthlyFee:     plan.MonthlyFee,
+		AppliedAt:      appliedAt,
+		Items:          make([]domain.DebtRefinanceItem, 0, len(debts)),
+	}
+
+	// What the new loan pays off, and what keeping the debts would have cost
+	for i := range debts {
+		debt := &debts[i]
+		if err := s.loadRates(ctx, debt); err != nil {
+			return nil, err
+		}
+		debt.ApplyCurrentRate(appliedAt)
+		refinance.AddPaidOffDebt(debt)
+	}
+	if refinance.PaidOffBalance <= 0 {
+		return nil, shared.ErrBadRequest.WithDetails("debt_ids", "nothing is left to pay off on these debts")
+	}
+
+	account, err := s.fundingAccount(ctx, userID, plan, debts)
+	if err != nil {
+		return nil, err
+	}
+	refinance.FundingAccountID = &account.ID
+
+	loan, periods, schedule, err := s.newRefinanceLoan(ctx, userID, plan, debts, refinance, account)
+	if err != nil {
+		return nil, err
+	}
+	refinance.SetNewLoan(loan, schedule)
+
+	events := make([]*domain.DebtStatusEvent, len(debts))
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.CreateWithTx(tx, loan); err != nil {
+			return err
+		}
+		if len(periods) > 0 {
+			if err := s.repo.ReplaceRatePeriodsWithTx(tx, loan.ID, periods); err != nil {
+				return err
+			}
+		}
+		if err := s.repo.ReplaceScheduleWithTx(tx, loan.ID, 1, schedule); err != nil {
+			return err
+		}
+
+		// The lender withholds the origination fee, so the proceeds cover exactly the payoffs
+		if _, err := s.bookRefinanceTransactionWithTx(tx, account, userID, loan.ID, transactionDomain.DirectionCredit,
+			refinance.PaidOffBalance, appliedAt, "debt_refinance:"+refinance.ID.String()+":proceeds",
+			"Proceeds of "+loan.Name); err != nil {
+			return err
+		}
+
+		for i := range debts {
+			event, err := s.payOffRefinancedDebtWithTx(tx, refinance, &refinance.Items[i], &debts[i], account, loan)
+			if err != nil {
+				return err
+			}
+			events[i] = event
+		}
+
+		return s.repo.CreateRefinanceWithTx(tx, refinance)
+	}); err != nil {
+		s.logger.Error("Failed to apply debt refinance",
+			zap.String("refinance_id", refinance.ID.String()),
+			zap.Error(err),
+		)
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	for i, event := range events {
+		if event != nil {
+			s.notifyStatusEvent(ctx, &debts[i], event)
+		}
+	}
+
+	s.logger.Info("Debt refinance applied",
+		zap.String("refinance_id", refinance.ID.String()),
+		zap.String("new_debt_id", loan.ID.String()),
+		zap.Int("debts_paid_off", len(debts)),
+		zap.Float64("paid_off_balance", refinance.PaidOffBalance),
+		zap.Float64("expected_savings", refinance.ExpectedSavings),
+	)
+
+	return &RefinanceResult{
+		Refinance:    refinance,
+		NewDebt:      loan,
+		SettledDebts: debts,
+	}, nil
+}
+
+// GetRefinances returns the refinances a user has applied, newest first
+func (s *debtService) GetRefinances(ctx context.Context, userID uuid.UUID) ([]domain.DebtRefinance, error) {
+	refinances, err := s.repo.FindRefinancesByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get debt refinances",
+			zap.String("user_id", userID.String()),
+			zap.Error(err),
+		)
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return refinances, nil
+}
+
+// ReviewRefinance compares the savings a refinance was expected to bring so far with what the
+// repayments of the new loan have realized
+func (s *debtService) ReviewRefinance(ctx context.Context, userID, refinanceID uuid.UUID) (*RefinanceReport, error) {
+	refinance, err := s.repo.FindRefinanceByID(ctx, refinanceID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if refinance.UserID != userID {
+		return nil, shared.ErrNotFound
+	}
+
+	newDebt, err := s.repo.FindByID(ctx, refinance.NewDebtID)
+	if err != nil {
+		return nil, shared.ErrNotFound.WithDetails("new_debt_id", "the refinanced loan no longer exists")
+	}
+	schedule, err := s.repo.FindScheduleByDebtID(ctx, newDebt.ID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	return &RefinanceReport{
+		Refinance: refinance,
+		NewDebt:   newDebt,
+		Review:    refinance.Review(newDebt, schedule, time.Now()),
+	}, nil
+}
+
+// loadRefinancedDebts loads the debts a refinance pays off and checks they are the user's own
+// open borrowings in one currency
+func (s *debtService) loadRefinancedDebts(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) ([]domain.Debt, error) {
+	debts := make([]domain.Debt, 0, len(ids))
+	for _, id := range ids {
+		debt, err := s.repo.FindByID(ctx, id)
+		if err != nil || debt.UserID != userID {
+			return nil, shared.ErrBadRequest.WithDetails("debt_ids", "debt not found: "+id.String())
+		}
+		switch {
+		case debt.IsReceivable():
+			return nil, shared.ErrBadRequest.WithDetails("debt_ids", "money lent cannot be refinanced: "+debt.Name)
+		case debt.IsPaidOff() || debt.Status == domain.DebtStatusSettled || debt.Status == domain.DebtStatusInactive:
+			return nil, shared.ErrBadRequest.WithDetails("debt_ids", "debt is already closed: "+debt.Name)
+		case len(debts) > 0 && debt.Currency != debts[0].Currency:
+			return nil, shared.ErrBadRequest.WithDetails("debt_ids", "debts must share one currency")
+		}
+		debts = append(debts, *debt)
+	}
+	return debts, nil
+}
+
+// fundingAccount returns the account the proceeds pass through: the requested one, else the
+// account all the debts are paid from
+func (s *debtService) fundingAccount(ctx context.Context, userID uuid.UUID, plan *RefinancePlan, debts []domain.Debt) (*accountDomain.Account, error) {
+	accountID := plan.FundingAccountID
+	if accountID == nil {
+		accountID = debts[0].LinkedAccountID
+		for _, debt := range debts[1:] {
+			if debt.LinkedAccountID == nil || accountID == nil || *debt.LinkedAccountID != *accountID {
+				accountID = nil
+				break
+			}
+		}
+	}
+	if accountID == nil {
+		return nil, shared.ErrBadRequest.WithDetails("funding_account_id", "required when the debts are not paid from one linked account")
+	}
+
+	account, err := s.accountRepo.GetEditableByIDAndUserID(ctx, accountID.String(), userID.String())
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, shared.ErrBadRequest.WithDetails("funding_account_id", "account not found")
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if string(account.Currency) != debts[0].Currency {
+		return nil, shared.ErrBadRequest.WithDetails("funding_account_id", "account currency must match the debts")
+	}
+	return account, nil
+}
+
+// newRefinanceLoan builds the new loan, carrying over the debts' tags, linked account and
+// reminders, along with its rate periods and schedule. Nothing is stored yet.
+func (s *debtService) newRefinanceLoan(
+	ctx context.Context,
+	userID uuid.UUID,
+	plan *RefinancePlan,
+	debts []domain.Debt,
+	refinance *domain.DebtRefinance,
+	account *accountDomain.Account,
+) (*domain.Debt, []domain.DebtRatePeriod, []domain.DebtScheduleEntry, error) {
+	monthly := domain.FrequencyMonthly
+	term := plan.TermMonths
+	principal := refinance.LoanPrincipal()
+	names := make([]string, len(debts))
+	for i, debt := range debts {
+		names[i] = debt.Name
+	}
+	description := "Refinances " + strings.Join(names, ", ")
+
+	loan := &domain.Debt{
+		ID:                 uuid.New(),
+		UserID:             userID,
+		Name:               refinanceLoanName(plan, debts),
+		Description:        &description,
+		Type:               refinanceLoanType(debts),
+		Behavior:           domain.DebtBehaviorInstallment,
+		Status:             domain.DebtStatusActive,
+		Direction:          domain.DebtDirectionBorrowed,
+		PrincipalAmount:    principal,
+		CurrentBalance:     principal,
+		InterestRate:       plan.NewRate,
+		Currency:           refinance.Currency,
+		PaymentFrequency:   &monthly,
+		AmortizationMethod: plan.AmortizationMethod,
+		TermPeriods:        &term,
+		StartDate:          refinance.LoanStartDate(),
+		CreditorName:       plan.CreditorName,
+		LinkedAccountID:    &account.ID,
+		Tags:               mergeTags(debts),
+	}
+	// Interest on the new loan runs from the day it pays the debts off
+	accruedThrough := loan.StartDate
+	loan.InterestAccruedThrough = &accruedThrough
+	if loan.CreditorName == nil && len(debts) == 1 {
+		loan.CreditorName = debts[0].CreditorName
+	}
+	for _, debt := range debts {
+		loan.EnableReminders = loan.EnableReminders || debt.EnableReminders
+		if loan.ReminderFrequency == nil {
+			loan.ReminderFrequency = debt.ReminderFrequency
+		}
+	}
+
+	if err := s.prepareNewDebt(loan); err != nil {
+		return nil, nil, nil, shared.ErrBadRequest.WithDetails("new_loan", err.Error())
+	}
+
+	periods := append([]domain.DebtRatePeriod(nil), plan.RatePeriods...)
+	assignRatePeriods(loan, periods)
+	rates, err := s.rateSchedule(ctx, userID, periods)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	loan.Rates = rates
+	loan.ApplyCurrentRate(refinance.AppliedAt)
+
+	schedule, err := loan.GenerateSchedule()
+	if err != nil {
+		return nil, nil, nil, shared.ErrBadRequest.WithDetails("schedule", err.Error())
+	}
+	if len(schedule) > 0 {
+		loan.MinimumPayment = schedule[0].Payment
+		loan.PaymentAmount = schedule[0].Payment
+	}
+	return loan, periods, schedule, nil
+}
+
+// payOffRefinancedDebtWithTx books the payoff of one debt from the funding account, applies it to
+// the debt and settles the debt within an existing database transaction. It returns the status
+// change the payoff caused, if any.
+func (s *debtService) payOffRefinancedDebtWithTx(
+	tx *gorm.DB,
+	refinance *domain.DebtRefinance,
+	item *domain.DebtRefinanceItem,
+	debt *domain.Debt,
+	account *accountDomain.Account,
+	newDebt *domain.Debt,
+) (*domain.DebtStatusEvent, error) {
+	txnID, err := s.bookRefinanceTransactionWithTx(tx, account, refinance.UserID, item.DebtID, transactionDomain.DirectionDebit,
+		item.PayoffAmount, refinance.AppliedAt, "debt_refinance:"+refinance.ID.String()+":"+item.DebtID.String(),
+		fmt.Sprintf("Payoff of %s by %s", item.DebtName, newDebt.Name))
+	if err != nil {
+		return nil, err
+	}
+	item.PayoffTransactionID = &txnID
+
+	description := "Paid off by refinance into " + newDebt.Name
+	payment := &domain.DebtPayment{
+		Amount:        item.PayoffAmount,
+		PaymentDate:   refinance.AppliedAt,
+		TransactionID: &txnID,
+		Description:   &description,
+	}
+	event := applyPayment(debt, payment, refinance.AppliedAt)
+
+	debt.Status = domain.DebtStatusSettled
+	settledAt := refinance.AppliedAt
+	debt.PaidOffDate = &settledAt
+	debt.NextPaymentDate = nil
+	debt.UpdateCalculatedFields()
+
+	if err := s.savePaymentWithTx(tx, debt, payment, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// bookRefinanceTransactionWithTx creates a transaction on the funding account linked to a debt and
+// applies it to the account balance within an existing database transaction
+func (s *debtService) bookRefinanceTransactionWithTx(
+	tx *gorm.DB,
+	account *accountDomain.Account,
+	userID uuid.UUID,
+	debtID uuid.UUID,
+	direction transactionDomain.Direction,
+	amount float64,
+	date time.Time,
+	externalID string,
+	description string,
+) (uuid.UUID, error) {
+	rounded := int64(math.Round(amount))
+	links := transactionDomain.TransactionLinks{{Type: transactionDomain.LinkDebt, ID: debtID.String()}}
+	txn := &transactionDomain.Transaction{
+		ID:          uuid.New(),
+		UserID:      userID,
+		AccountID:   account.ID,
+		Source:      transactionDomain.SourceManual,
+		ExternalID:  externalID,
+		Direction:   direction,
+		Channel:     transactionDomain.ChannelUnknown,
+		Instrument:  transactionDomain.InstrumentBankAccount,
+		BookingDate: date,
+		ValueDate:   date,
+		Amount:      rounded,
+		Currency:    string(account.Currency),
+		Description: description,
+		Links:       &links,
+	}
+	if err := s.transactionRepo.CreateWithTx(tx, txn); err != nil {
+		return uuid.Nil, err
+	}
+
+	delta := float64(rounded)
+	if direction == transactionDomain.DirectionDebit {
+		delta = -delta
+	}
+	if err := s.accountRepo.UpdateBalanceWithTx(tx, account.ID.String(), delta); err != nil {
+		return uuid.Nil, err
+	}
+	return txn.ID, nil
+}
+
+// validateRefinancePlan checks a refinance plan before anything is changed
+func validateRefinancePlan(plan *RefinancePlan) error {
+	if len(plan.DebtIDs) == 0 {
+		return shared.ErrBadRequest.WithDetails("debt_ids", "at least one debt is required")
+	}
+	seen := make(map[uuid.UUID]bool, len(plan.DebtIDs))
+	for _, id := range plan.DebtIDs {
+		if seen[id] {
+			return shared.ErrBadRequest.WithDetails("debt_ids", "debt listed more than once: "+id.String())
+		}
+		seen[id] = true
+	}
+	if plan.NewRate < 0 || plan.NewRate > 100 {
+		return shared.ErrBadRequest.WithDetails("new_rate", "must be between 0 and 100")
+	}
+	if plan.TermMonths < 1 || plan.TermMonths > domain.MaxSchedulePeriods {
+		return shared.ErrBadRequest.WithDetails("term_months", fmt.Sprintf("must be between 1 and %d", domain.MaxSchedulePeriods))
+	}
+	if plan.OriginationFee < 0 || plan.MonthlyFee < 0 {
+		return shared.ErrBadRequest.WithDetails("fees", "cannot be negative")
+	}
+	if plan.AmortizationMethod != nil && !plan.AmortizationMethod.IsValid() {
+		return shared.ErrBadRequest.WithDetails("amortization_method", "invalid amortization method")
+	}
+	if len(plan.RatePeriods) > 0 {
+		if err := domain.ValidateRatePeriods(plan.RatePeriods); err != nil {
+			return shared.ErrBadRequest.WithDetails("rate_periods", err.Error())
+		}
+	}
+	return nil
+}
+
+// refinanceLoanName names the new loan after the debt it refinances, or as a consolidation
+func refinanceLoanName(plan *RefinancePlan, debts []domain.Debt) string {
+	if name := strings.TrimSpace(plan.Name); name != "" {
+		return name
+	}
+	if len(debts) == 1 {
+		return debts[0].Name + " (refinanced)"
+	}
+	return "Consolidation loan"
+}
+
+// refinanceLoanType keeps the debts' type when they share one; a consolidation of mixed debts is a personal loan
+func refinanceLoanType(debts []domain.Debt) domain.DebtType {
+	for _, debt := range debts[1:] {
+		if debt.Type != debts[0].Type {
+			return domain.DebtTypePersonalLoan
+		}
+	}
+	if debts[0].Type == domain.DebtTypeCreditCard {
+		return domain.DebtTypePersonalLoan
+	}
+	return debts[0].Type
+}
+
+// mergeTags combines the debts' JSON tag arrays, keeping the first occurrence of each tag
+func mergeTags(debts []domain.Debt) *string {
+	var merged []string
+	seen := make(map[string]bool)
+	for _, debt := range debts {
+		if debt.Tags == nil {
+			continue
+		}
+		var tags []string
+		if err := json.Unmarshal([]byte(*debt.Tags), &tags); err != nil {
+			continue
+		}
+		for _, tag := range tags {
+			if !seen[tag] {
+				seen[tag] = true
+				merged = append(merged, tag)
+			}
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	encoded, err := json.Marshal(merged)
+	if err != nil {
+		return nil
+	}
+	tags := string(encoded)
+	return &tags
+}
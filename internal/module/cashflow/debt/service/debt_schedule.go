@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"personalfinancedss/internal/shared"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetAmortizationSchedule returns the debt's amortization table, generating or refreshing it when
+// it is missing or no longer matches the debt, reconciled against the payments made so far
+func (s *debtService) GetAmortizationSchedule(ctx context.Context, debtID uuid.UUID) (*AmortizationSchedule, error) {
+	debt, err := s.repo.FindByID(ctx, debtID)
+	if err != nil {
+		return nil, err
+	}
+	if !debt.HasSchedule() {
+		return nil, shared.ErrBadRequest.WithDetails("behavior", "only installment and interest-only debts have an amortization schedule")
+	}
+	if err := s.loadRates(ctx, debt); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repo.FindScheduleByDebtID(ctx, debtID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if debt.ScheduleIsStale(entries) {
+		entries, err = s.refreshSchedule(ctx, debt, entries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	schedule := &AmortizationSchedule{
+		Debt:           debt,
+		Entries:        entries,
+		Reconciliation: debt.ReconcileSchedule(entries, time.Now()),
+	}
+	for _, entry := range entries {
+		schedule.TotalPayment += entry.Payment
+		schedule.TotalInterest += entry.Interest
+	}
+
+	return schedule, nil
+}
+
+// refreshSchedule generates the schedule of a debt that has none, or rebuilds the entries not yet
+// settled from the current balance, and returns the full schedule
+func (s *debtService) refreshSchedule(ctx context.Context, debt *domain.Debt, entries []domain.DebtScheduleEntry) ([]domain.DebtScheduleEntry, error) {
+	if len(entries) == 0 {
+		generated, err := debt.GenerateSchedule()
+		if err != nil {
+			return nil, shared.ErrBadRequest.WithDetails("schedule", err.Error())
+		}
+		if err := s.repo.ReplaceSchedule(ctx, debt.ID, 1, generated); err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+		return generated, nil
+	}
+
+	settledBefore := time.Now()
+	if debt.NextPaymentDate != nil {
+		settledBefore = *debt.NextPaymentDate
+	}
+
+	regenerated, fromPeriod, err := debt.RegenerateSchedule(entries, settledBefore)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("schedule", err.Error())
+	}
+	if err := s.repo.ReplaceSchedule(ctx, debt.ID, fromPeriod, regenerated); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Debt schedule regenerated",
+		zap.String("debt_id", debt.ID.String()),
+		zap.Int("from_period", fromPeriod),
+		zap.Float64("interest_rate", debt.InterestRate),
+		zap.Float64("balance", debt.CurrentBalance),
+	)
+
+	return append(entries[:fromPeriod-1:fromPeriod-1], regenerated...), nil
+}
+
+// syncSchedule keeps a stored schedule in line with the debt after it changed. The schedule is
+// derived data, so failures are logged rather than failing the change itself.
+func (s *debtService) syncSchedule(ctx context.Context, debt *domain.Debt, entries []domain.DebtScheduleEntry, force bool) {
+	if !force && !debt.ScheduleIsStale(entries) {
+		return
+	}
+	if _, err := s.refreshSchedule(ctx, debt, entries); err != nil {
+		s.logger.Warn("Failed to refresh debt schedule",
+			zap.String("debt_id", debt.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"personalfinancedss/internal/module/cashflow/debt/domain"
+	notificationDomain "personalfinancedss/internal/module/notification/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ProcessPaymentStates moves every debt still being repaid to its payment state as of asOf,
+// charging late fees for newly missed payments. It returns the number of debts that changed.
+func (s *debtService) ProcessPaymentStates(ctx context.Context, asOf time.Time) (int, error) {
+	debts, err := s.repo.FindStatusTrackedDebts(ctx)
+	if err != nil {
+		s.logger.Error("Failed to find debts for payment state check", zap.Error(err))
+		return 0, err
+	}
+
+	changed := 0
+	for i := range debts {
+		// Only debts whose state moves on the listed row are re-read and saved
+		if debts[i].EvaluatePaymentState(asOf, domain.StatusTriggerSchedule) == nil {
+			continue
+		}
+
+		debt, event, err := s.processPaymentState(ctx, debts[i].ID, asOf)
+		if err != nil {
+			s.logger.Error("Failed to update debt payment state",
+				zap.String("debt_id", debts[i].ID.String()),
+				zap.Error(err),
+			)
+			// Continue processing other debts even if one fails
+			continue
+		}
+		if event == nil {
+			continue
+		}
+
+		s.notifyStatusEvent(ctx, debt, event)
+		changed++
+	}
+
+	if changed > 0 {
+		s.logger.Info("Debt payment states updated", zap.Int("count", changed))
+	}
+	return changed, nil
+}
+
+// GetStatusHistory returns the payment state and status changes of a debt, newest first
+func (s *debtService) GetStatusHistory(ctx context.Context, debtID uuid.UUID) ([]domain.DebtStatusEvent, error) {
+	if _, err := s.repo.FindByID(ctx, debtID); err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.FindStatusEventsByDebtID(ctx, debtID)
+	if err != nil {
+		s.logger.Error("Failed to get debt status history",
+			zap.String("debt_id", debtID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// processPaymentState re-reads a debt locked, so a payment recorded since it was listed is not
+// overwritten, and saves its payment state as of asOf together with the status change, if any
+func (s *debtService) processPaymentState(ctx context.Context, debtID uuid.UUID, asOf time.Time) (*domain.Debt, *domain.DebtStatusEvent, error) {
+	var debt *domain.Debt
+	var event *domain.DebtStatusEvent
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		debt, err = s.repo.FindByIDWithTx(tx, debtID)
+		if err != nil {
+			return err
+		}
+		event = debt.EvaluatePaymentState(asOf, domain.StatusTriggerSchedule)
+		if event == nil {
+			return nil
+		}
+		debt.UpdateCalculatedFields()
+
+		if err := s.repo.UpdateWithTx(tx, debt); err != nil {
+			return err
+		}
+		return s.repo.CreateStatusEventWithTx(tx, event)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return debt, event, nil
+}
+
+// recordStatusEvent writes a status change to the debt's history and notifies the user. Failures
+// are logged: the debt itself has already been saved.
+func (s *debtService) recordStatusEvent(ctx context.Context, debt *domain.Debt, event *domain.DebtStatusEvent) {
+	if err := s.repo.CreateStatusEvent(ctx, event); err != nil {
+		s.logger.Error("Failed to record debt status change",
+			zap.String("debt_id", debt.ID.String()),
+			zap.String("to_state", string(event.ToState)),
+			zap.Error(err),
+		)
+	}
+	s.notifyStatusEvent(ctx, debt, event)
+}
+
+// notifyStatusEvent tells the user about a change of a debt's payment state or status
+func (s *debtService) notifyStatusEvent(ctx context.Context, debt *domain.Debt, event *domain.DebtStatusEvent) {
+	if s.notifier == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"debt_id":         debt.ID.String(),
+		"debt_name":       debt.Name,
+		"direction":       string(debt.Direction),
+		"from_state":      string(event.FromState),
+		"to_state":        string(event.ToState),
+		"status":          string(event.ToStatus),
+		"missed_payments": event.MissedPayments,
+		"late_fee":        event.LateFee,
+		"trigger":         string(event.Trigger),
+	}
+	if event.NextPaymentDate != nil {
+		data["next_payment_date"] = event.NextPaymentDate.Format("2006-01-02")
+	}
+
+	if err := s.notifier.NotifyInApp(ctx, debt.UserID, notificationDomain.NotificationTypeDebtStatus, event.Subject(debt.Name), data); err != nil {
+		s.logger.Warn("Failed to send debt status notification",
+			zap.String("debt_id", debt.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
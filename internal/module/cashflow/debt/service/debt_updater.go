@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -14,16 +15,21 @@ func (s *debtService) UpdateDebt(ctx context.Context, debt *domain.Debt) error {
 		return err
 	}
 
-	debt.UpdateCalculatedFields()
+	// A rate schedule, when the debt has one, sets the rate rather than InterestRate
+	if err := s.loadRates(ctx, debt); err != nil {
+		return err
+	}
+	debt.ApplyCurrentRate(time.Now())
 
-	// Recalculate next payment date if needed
-	if debt.PaymentFrequency != nil {
-		nextDate := debt.CalculateNextPaymentDate()
-		if debt.NextPaymentDate == nil || (nextDate != nil && nextDate.After(*debt.NextPaymentDate)) {
-			debt.NextPaymentDate = nextDate
-		}
+	// Fill in the next payment date if needed; once set it only moves with payments
+	if debt.PaymentFrequency != nil && debt.NextPaymentDate == nil {
+		debt.NextPaymentDate = debt.CalculateNextPaymentDate()
 	}
 
+	// A changed payment date or grace period can move the payment state
+	event := debt.EvaluatePaymentState(time.Now(), domain.StatusTriggerSchedule)
+	debt.UpdateCalculatedFields()
+
 	if err := s.repo.Update(ctx, debt); err != nil {
 		s.logger.Error("Failed to update debt",
 			zap.String("debt_id", debt.ID.String()),
@@ -32,6 +38,17 @@ func (s *debtService) UpdateDebt(ctx context.Context, debt *domain.Debt) error {
 		return err
 	}
 
+	if event != nil {
+		s.recordStatusEvent(ctx, debt, event)
+	}
+
+	// A rate or term change re-plans the payments still to come
+	if debt.HasSchedule() {
+		if entries, err := s.repo.FindScheduleByDebtID(ctx, debt.ID); err == nil {
+			s.syncSchedule(ctx, debt, entries, false)
+		}
+	}
+
 	s.logger.Info("Debt updated successfully",
 		zap.String("debt_id", debt.ID.String()),
 		zap.String("debt_name", debt.Name),
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"strings"
 )
 
 // validateDebt validates debt fields before create or update
@@ -32,6 +33,19 @@ func (s *debtService) validateDebt(debt *domain.Debt) error {
 		return fmt.Errorf("invalid debt status: %s", debt.Status)
 	}
 
+	if debt.Direction != "" && !debt.Direction.IsValid() {
+		return fmt.Errorf("invalid debt direction: %s", debt.Direction)
+	}
+
+	if debt.IsReceivable() {
+		if debt.Type == domain.DebtTypeCreditCard {
+			return errors.New("a credit card debt cannot be a receivable")
+		}
+		if debt.CounterpartyName == nil || strings.TrimSpace(*debt.CounterpartyName) == "" {
+			return errors.New("counterparty name is required for money lent")
+		}
+	}
+
 	if debt.InterestRate < 0 || debt.InterestRate > 100 {
 		return errors.New("interest rate must be between 0 and 100")
 	}
@@ -44,5 +58,21 @@ func (s *debtService) validateDebt(debt *domain.Debt) error {
 		return fmt.Errorf("invalid payment frequency: %s", *debt.PaymentFrequency)
 	}
 
+	if debt.InterestAccrual != nil && !debt.InterestAccrual.IsValid() {
+		return fmt.Errorf("invalid interest accrual: %s", *debt.InterestAccrual)
+	}
+
+	if debt.OutstandingFees < 0 {
+		return errors.New("outstanding fees cannot be negative")
+	}
+
+	if debt.AmortizationMethod != nil && !debt.AmortizationMethod.IsValid() {
+		return fmt.Errorf("invalid amortization method: %s", *debt.AmortizationMethod)
+	}
+
+	if debt.TermPeriods != nil && (*debt.TermPeriods < 1 || *debt.TermPeriods > domain.MaxSchedulePeriods) {
+		return fmt.Errorf("term must be between 1 and %d payments", domain.MaxSchedulePeriods)
+	}
+
 	return nil
 }
@@ -2,11 +2,17 @@ package service
 
 import (
 	"context"
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
 	"personalfinancedss/internal/module/cashflow/debt/domain"
 	"personalfinancedss/internal/module/cashflow/debt/repository"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+	notificationService "personalfinancedss/internal/module/notification/service"
+
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // DebtCreator defines debt creation operations
@@ -21,6 +27,7 @@ type DebtReader interface {
 	GetActiveDebts(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error)
 	GetDebtsByType(ctx context.Context, userID uuid.UUID, debtType domain.DebtType) ([]domain.Debt, error)
 	GetPaidOffDebts(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error)
+	GetReceivables(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error)
 	GetDebtSummary(ctx context.Context, userID uuid.UUID) (*DebtSummary, error)
 }
 
@@ -39,9 +46,39 @@ type DebtDeleter interface {
 // DebtPaymentManager defines payment-related operations
 type DebtPaymentManager interface {
 	AddPayment(ctx context.Context, debtID uuid.UUID, amount float64) (*domain.Debt, error)
+	RecordPayment(ctx context.Context, debtID uuid.UUID, payment *domain.DebtPayment) (*domain.Debt, error)
+	GetDebtPayments(ctx context.Context, debtID uuid.UUID) ([]domain.DebtPayment, error)
 	MarkAsPaidOff(ctx context.Context, debtID uuid.UUID) error
 }
 
+// DebtScheduleManager defines amortization schedule operations
+type DebtScheduleManager interface {
+	GetAmortizationSchedule(ctx context.Context, debtID uuid.UUID) (*AmortizationSchedule, error)
+}
+
+// DebtRateManager defines rate schedule and reference rate operations
+type DebtRateManager interface {
+	GetRateSchedule(ctx context.Context, debtID uuid.UUID) ([]domain.DebtRatePeriod, error)
+	SetRateSchedule(ctx context.Context, debtID uuid.UUID, periods []domain.DebtRatePeriod) ([]domain.DebtRatePeriod, error)
+	ClearRateSchedule(ctx context.Context, debtID uuid.UUID) error
+	RecordReferenceRate(ctx context.Context, rate *domain.ReferenceRate) error
+	GetReferenceRates(ctx context.Context, userID uuid.UUID, index string) ([]domain.ReferenceRate, error)
+	StressTestRates(ctx context.Context, debtID uuid.UUID, shock float64) (*RateStressTest, error)
+}
+
+// DebtRefinancer defines refinance and consolidation operations
+type DebtRefinancer interface {
+	ApplyRefinance(ctx context.Context, userID uuid.UUID, plan *RefinancePlan) (*RefinanceResult, error)
+	GetRefinances(ctx context.Context, userID uuid.UUID) ([]domain.DebtRefinance, error)
+	ReviewRefinance(ctx context.Context, userID, refinanceID uuid.UUID) (*RefinanceReport, error)
+}
+
+// DebtStatusTracker defines payment state tracking operations
+type DebtStatusTracker interface {
+	ProcessPaymentStates(ctx context.Context, asOf time.Time) (int, error)
+	GetStatusHistory(ctx context.Context, debtID uuid.UUID) ([]domain.DebtStatusEvent, error)
+}
+
 // Service is the composite interface for all debt operations
 type Service interface {
 	DebtCreator
@@ -49,19 +86,38 @@ type Service interface {
 	DebtUpdater
 	DebtDeleter
 	DebtPaymentManager
+	DebtScheduleManager
+	DebtRateManager
+	DebtRefinancer
+	DebtStatusTracker
 }
 
 // debtService implements all debt use cases
 type debtService struct {
-	repo   repository.Repository
-	logger *zap.Logger
+	repo            repository.Repository
+	accountRepo     accountRepo.Repository
+	transactionRepo transactionRepo.Repository
+	notifier        notificationService.InAppNotifier
+	db              *gorm.DB
+	logger          *zap.Logger
 }
 
 // NewService creates a new debt service
-func NewService(repo repository.Repository, logger *zap.Logger) Service {
+func NewService(
+	repo repository.Repository,
+	accountRepo accountRepo.Repository,
+	transactionRepo transactionRepo.Repository,
+	notifier notificationService.InAppNotifier,
+	db *gorm.DB,
+	logger *zap.Logger,
+) Service {
 	return &debtService{
-		repo:   repo,
-		logger: logger,
+		repo:            repo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		notifier:        notifier,
+		db:              db,
+		logger:          logger,
 	}
 }
 
@@ -79,6 +135,31 @@ type DebtSummary struct {
 	AverageProgress      float64                 `json:"average_progress"`
 	DebtsByType          map[string]*DebtTypeSum `json:"debts_by_type"`
 	DebtsByStatus        map[string]int          `json:"debts_by_status"`
+	Receivables          ReceivableSummary       `json:"receivables"` // Money lent to others, not included above
+}
+
+// ReceivableSummary represents a summary of the money a user has lent to others
+type ReceivableSummary struct {
+	Count          int     `json:"count"`
+	OpenCount      int     `json:"open_count"`
+	OverdueCount   int     `json:"overdue_count"`
+	TotalLent      float64 `json:"total_lent"`
+	TotalRepaid    float64 `json:"total_repaid"`
+	TotalRemaining float64 `json:"total_remaining"`
+}
+
+func (r *ReceivableSummary) add(debt *domain.Debt) {
+	r.Count++
+	r.TotalLent += debt.PrincipalAmount
+	r.TotalRepaid += debt.TotalPaid
+	if debt.IsPaidOff() {
+		return
+	}
+	r.OpenCount++
+	r.TotalRemaining += debt.CurrentBalance
+	if debt.Status == domain.DebtStatusDefaulted || debt.IsOverdue() {
+		r.OverdueCount++
+	}
 }
 
 // DebtTypeSum represents summary for a debt type
@@ -89,3 +170,59 @@ type DebtTypeSum struct {
 	TotalPaid       float64 `json:"total_paid"`
 	Progress        float64 `json:"progress"`
 }
+
+// AmortizationSchedule represents a debt's payment schedule reconciled with actual repayments
+type AmortizationSchedule struct {
+	Debt           *domain.Debt                   `json:"debt"`
+	Entries        []domain.DebtScheduleEntry     `json:"entries"`
+	TotalPayment   float64                        `json:"total_payment"`
+	TotalInterest  float64                        `json:"total_interest"`
+	Reconciliation *domain.ScheduleReconciliation `json:"reconciliation"`
+}
+
+// RateStressTest compares the payments still to come with the same payments under a reference-rate shock
+type RateStressTest struct {
+	Debt                *domain.Debt               `json:"debt"`
+	Shock               float64                    `json:"shock"` // Percentage points added to the reference rates
+	CurrentRate         float64                    `json:"current_rate"`
+	StressedRate        float64                    `json:"stressed_rate"`
+	Baseline            []domain.DebtScheduleEntry `json:"baseline"`
+	Stressed            []domain.DebtScheduleEntry `json:"stressed"`
+	BaselineInterest    float64                    `json:"baseline_interest"`
+	StressedInterest    float64                    `json:"stressed_interest"`
+	InterestImpact      float64                    `json:"interest_impact"`
+	BaselinePeakPayment float64                    `json:"baseline_peak_payment"`
+	StressedPeakPayment float64                    `json:"stressed_peak_payment"`
+	StressedPeakDate    time.Time                  `json:"stressed_peak_date"`
+	PeakPaymentImpact   float64                    `json:"peak_payment_impact"`
+}
+
+// RefinancePlan describes a refinance or consolidation to apply: the debts the new loan pays off
+// and the new loan's terms
+type RefinancePlan struct {
+	DebtIDs            []uuid.UUID
+	Name               string // Name of the new loan; derived from the debts paid off when empty
+	CreditorName       *string
+	NewRate            float64 // Annual rate (%)
+	TermMonths         int
+	OriginationFee     float64 // Withheld from the proceeds and added to the new loan's principal
+	MonthlyFee         float64
+	AmortizationMethod *domain.AmortizationMethod
+	RatePeriods        []domain.DebtRatePeriod // Promotional or floating schedule of the new loan
+	FundingAccountID   *uuid.UUID              // Account the proceeds pass through; defaults to the debts' shared linked account
+	AppliedAt          time.Time               // Defaults to now
+}
+
+// RefinanceResult is the outcome of applying a refinance
+type RefinanceResult struct {
+	Refinance    *domain.DebtRefinance `json:"refinance"`
+	NewDebt      *domain.Debt          `json:"new_debt"`
+	SettledDebts []domain.Debt         `json:"settled_debts"`
+}
+
+// RefinanceReport is an applied refinance with its savings reviewed against the new loan's repayments
+type RefinanceReport struct {
+	Refinance *domain.DebtRefinance   `json:"refinance"`
+	NewDebt   *domain.Debt            `json:"new_debt"`
+	Review    *domain.RefinanceReview `json:"review"`
+}
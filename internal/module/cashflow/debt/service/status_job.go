@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// statusJobSpec runs shortly after midnight so the day's payment dates and grace periods apply
+const statusJobSpec = "0 15 0 * * *"
+
+// statusJob runs the daily payment state check on the notification scheduler
+type statusJob struct {
+	service Service
+}
+
+// NewStatusJob creates the scheduled job that moves debts through their payment states
+func NewStatusJob(service Service) notificationService.ScheduledJob {
+	return &statusJob{service: service}
+}
+
+func (j *statusJob) Name() string {
+	return "debt_payment_states"
+}
+
+func (j *statusJob) Spec() string {
+	return statusJobSpec
+}
+
+func (j *statusJob) Run(ctx context.Context) error {
+	_, err := j.service.ProcessPaymentStates(ctx, time.Now())
+	return err
+}
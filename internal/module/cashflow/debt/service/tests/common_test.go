@@ -4,11 +4,29 @@ import (
 	"personalfinancedss/internal/module/cashflow/debt/service"
 
 	"go.uber.org/zap"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
 )
 
 func setupService() (service.Service, *MockRepository) {
 	mockRepo := new(MockRepository)
 	logger := zap.NewNop()
-	svc := service.NewService(mockRepo, logger)
+	svc := service.NewService(mockRepo, nil, nil, nil, newTestDB(), logger)
 	return svc, mockRepo
 }
+
+// newTestDB opens an in-memory database the service can run its transactions on; the mocked
+// repositories do the actual reads and writes. A single connection keeps every query on the same
+// in-memory database.
+func newTestDB() *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		panic(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	return db
+}
@@ -78,3 +78,66 @@ func TestDebtCreator_CreateDebt_RepoError(t *testing.T) {
 	assert.Contains(t, err.Error(), "db error")
 	mockRepo.AssertExpectations(t)
 }
+
+func TestDebtCreator_CreateDebt_Receivable(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+
+	newLoan := func() *domain.Debt {
+		return &domain.Debt{
+			Name:            "Loan to Minh",
+			Type:            domain.DebtTypeInformal,
+			Behavior:        domain.DebtBehaviorInstallment,
+			Direction:       domain.DebtDirectionLent,
+			PrincipalAmount: 5000000,
+			CurrentBalance:  5000000,
+			Currency:        "VND",
+			Status:          domain.DebtStatusActive,
+			UserID:          uuid.New(),
+			StartDate:       time.Now(),
+		}
+	}
+
+	// The counterparty is required for money lent
+	err := svc.CreateDebt(ctx, newLoan())
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "Create")
+
+	loan := newLoan()
+	name := "Minh"
+	loan.CounterpartyName = &name
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(d *domain.Debt) bool {
+		return d.IsReceivable() && d.InterestRate == 0
+	})).Return(nil)
+
+	assert.NoError(t, svc.CreateDebt(ctx, loan))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDebtCreator_CreateDebt_OldStartDateAccruesFromToday(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+
+	debt := &domain.Debt{
+		Name:            "Mortgage",
+		Type:            domain.DebtTypeMortgage,
+		Behavior:        domain.DebtBehaviorRevolving,
+		PrincipalAmount: 900000000,
+		CurrentBalance:  600000000,
+		InterestRate:    9,
+		Currency:        "VND",
+		Status:          domain.DebtStatusActive,
+		UserID:          uuid.New(),
+		StartDate:       time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+	mockRepo.On("Create", ctx, mock.Anything).Return(nil)
+
+	err := svc.CreateDebt(ctx, debt)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, debt.InterestAccruedThrough) {
+		now := time.Now()
+		assert.Equal(t, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()), *debt.InterestAccruedThrough)
+	}
+	assert.Equal(t, 0.0, debt.InterestDue(time.Now()), "years before the debt was entered are not charged")
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"personalfinancedss/internal/module/cashflow/debt/domain"
@@ -23,10 +24,14 @@ func TestDebtPayment_AddPayment_Success(t *testing.T) {
 		PrincipalAmount: 10000,
 	}
 
-	mockRepo.On("FindByID", ctx, id).Return(debt, nil)
-	mockRepo.On("Update", ctx, mock.MatchedBy(func(d *domain.Debt) bool {
+	mockRepo.On("FindByIDWithTx", mock.Anything, id).Return(debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, id).Return([]domain.DebtRatePeriod{}, nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, mock.MatchedBy(func(d *domain.Debt) bool {
 		return d.CurrentBalance == 4000 // 5000 - 1000
 	})).Return(nil)
+	mockRepo.On("CreatePaymentWithTx", mock.Anything, mock.MatchedBy(func(p *domain.DebtPayment) bool {
+		return p.Amount == 1000 && p.PrincipalPortion == 1000 && p.BalanceAfter == 4000
+	})).Return(nil)
 
 	updatedDebt, err := svc.AddPayment(ctx, id, amount)
 
@@ -46,8 +51,8 @@ func TestDebtPayment_AddPayment_InvalidAmount(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "payment amount must be greater than 0")
-	mockRepo.AssertNotCalled(t, "FindByID")
-	mockRepo.AssertNotCalled(t, "Update")
+	mockRepo.AssertNotCalled(t, "FindByIDWithTx", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateWithTx")
 }
 
 func TestDebtPayment_MarkAsPaidOff_Success(t *testing.T) {
@@ -71,3 +76,29 @@ func TestDebtPayment_MarkAsPaidOff_Success(t *testing.T) {
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestDebtPayment_RecordPayment_FailedInsertReturnsError(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	id := uuid.New()
+
+	debt := &domain.Debt{
+		ID:              id,
+		CurrentBalance:  5000,
+		PrincipalAmount: 10000,
+	}
+
+	mockRepo.On("FindByIDWithTx", mock.Anything, id).Return(debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, id).Return([]domain.DebtRatePeriod{}, nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("CreatePaymentWithTx", mock.Anything, mock.Anything).Return(errors.New("insert failed"))
+
+	_, err := svc.AddPayment(ctx, id, 1000)
+
+	assert.Error(t, err)
+	// Both writes ran on the same transaction, which the failed insert rolled back
+	updateTx := mockRepo.Calls[2].Arguments.Get(0)
+	insertTx := mockRepo.Calls[3].Arguments.Get(0)
+	assert.Same(t, updateTx, insertTx)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
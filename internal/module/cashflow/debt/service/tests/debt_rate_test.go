@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/debt/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+// teaserPeriods fixes the rate at 12% for the first six months, then floats on VCB_12M plus 3.5
+func teaserPeriods(debt *domain.Debt) []domain.DebtRatePeriod {
+	index := "vcb_12m"
+	return []domain.DebtRatePeriod{
+		{StartDate: debt.StartDate, RateType: domain.RateTypeFixed, FixedRate: floatPtr(12)},
+		{StartDate: debt.StartDate.AddDate(0, 6, 0), RateType: domain.RateTypeFloating, ReferenceIndex: &index, Margin: 3.5},
+	}
+}
+
+func vcbReferences(debt *domain.Debt) []domain.ReferenceRate {
+	return []domain.ReferenceRate{{UserID: debt.UserID, Index: "VCB_12M", EffectiveDate: debt.StartDate, Rate: 9.5}}
+}
+
+func TestDebtRate_SetRateSchedule_RegeneratesSchedule(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := newInstallmentDebt()
+	entries, err := debt.GenerateSchedule()
+	assert.NoError(t, err)
+	periods := teaserPeriods(debt)
+
+	mockRepo.On("FindByID", ctx, debt.ID).Return(debt, nil)
+	mockRepo.On("ReplaceRatePeriods", ctx, debt.ID, mock.MatchedBy(func(saved []domain.DebtRatePeriod) bool {
+		return len(saved) == 2 && saved[0].DebtID == debt.ID && *saved[1].ReferenceIndex == "VCB_12M"
+	})).Return(nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return(periods, nil)
+	mockRepo.On("FindReferenceRates", ctx, debt.UserID, []string{"VCB_12M"}).Return(vcbReferences(debt), nil)
+	mockRepo.On("FindScheduleByDebtID", ctx, debt.ID).Return(entries, nil)
+	mockRepo.On("ReplaceSchedule", ctx, debt.ID, 1, mock.MatchedBy(func(regenerated []domain.DebtScheduleEntry) bool {
+		return len(regenerated) == 12 && regenerated[5].InterestRate == 12 && regenerated[6].InterestRate == 13
+	})).Return(nil)
+
+	_, err = svc.SetRateSchedule(ctx, debt.ID, periods)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12.0, debt.InterestRate, "the teaser rate is in force today")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDebtRate_SetRateSchedule_Invalid(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+
+	_, err := svc.SetRateSchedule(ctx, uuid.New(), []domain.DebtRatePeriod{{StartDate: time.Now(), RateType: domain.RateTypeFloating}})
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "ReplaceRatePeriods", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDebtRate_StressTestRates(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := newInstallmentDebt()
+	entries, err := debt.GenerateSchedule()
+	assert.NoError(t, err)
+
+	mockRepo.On("FindByID", ctx, debt.ID).Return(debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return(teaserPeriods(debt), nil)
+	mockRepo.On("FindReferenceRates", ctx, debt.UserID, []string{"VCB_12M"}).Return(vcbReferences(debt), nil)
+	mockRepo.On("FindScheduleByDebtID", ctx, debt.ID).Return(entries, nil)
+
+	result, err := svc.StressTestRates(ctx, debt.ID, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12.0, result.CurrentRate)
+	assert.Equal(t, 12.0, result.StressedRate, "the fixed period is not shocked")
+	assert.Equal(t, 13.0, result.Baseline[6].InterestRate)
+	assert.Equal(t, 15.0, result.Stressed[6].InterestRate)
+	assert.Equal(t, result.Baseline[5].Interest, result.Stressed[5].Interest)
+	assert.Greater(t, result.InterestImpact, 0.0)
+	assert.Greater(t, result.StressedPeakPayment, 0.0)
+	mockRepo.AssertNotCalled(t, "ReplaceSchedule", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDebtRate_StressTestRates_NoFloatingPeriod(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := newInstallmentDebt()
+
+	mockRepo.On("FindByID", ctx, debt.ID).Return(debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return([]domain.DebtRatePeriod{}, nil)
+
+	_, err := svc.StressTestRates(ctx, debt.ID, 2)
+
+	assert.Error(t, err)
+}
+
+func TestDebtRate_RecordReferenceRate_RepricesFloatingDebts(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := newInstallmentDebt()
+	debt.StartDate = time.Now().AddDate(0, -7, 0)
+	debt.NextPaymentDate = nil
+	entries, err := debt.GenerateSchedule()
+	assert.NoError(t, err)
+	rate := &domain.ReferenceRate{UserID: debt.UserID, Index: " vcb_12m ", EffectiveDate: debt.StartDate, Rate: 9.5}
+
+	mockRepo.On("UpsertReferenceRate", ctx, rate).Return(nil)
+	mockRepo.On("FindDebtIDsByReferenceIndex", ctx, debt.UserID, "VCB_12M").Return([]uuid.UUID{debt.ID}, nil)
+	mockRepo.On("FindByID", ctx, debt.ID).Return(debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return(teaserPeriods(debt), nil)
+	mockRepo.On("FindReferenceRates", ctx, debt.UserID, []string{"VCB_12M"}).Return(vcbReferences(debt), nil)
+	mockRepo.On("Update", ctx, mock.MatchedBy(func(d *domain.Debt) bool {
+		return d.InterestRate == 13
+	})).Return(nil)
+	mockRepo.On("FindScheduleByDebtID", ctx, debt.ID).Return(entries, nil)
+	mockRepo.On("ReplaceSchedule", ctx, debt.ID, mock.Anything, mock.Anything).Return(nil)
+
+	err = svc.RecordReferenceRate(ctx, rate)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "VCB_12M", rate.Index)
+	assert.Greater(t, debt.AccruedInterest, 0.0, "interest up to the repricing accrues at the old rate")
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,267 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"personalfinancedss/internal/module/cashflow/debt/service"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// mockAccountRepository mocks the account lookups a refinance makes; balance changes are written to
+// the test database within the refinance's transaction
+type mockAccountRepository struct {
+	accountRepo.Repository
+	mock.Mock
+}
+
+func (m *mockAccountRepository) GetEditableByIDAndUserID(ctx context.Context, id, userID string) (*accountDomain.Account, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*accountDomain.Account), args.Error(1)
+}
+
+func (m *mockAccountRepository) UpdateBalanceWithTx(tx *gorm.DB, accountID string, balanceDelta float64) error {
+	return tx.Exec("INSERT INTO balance_changes (account_id, delta) VALUES (?, ?)", accountID, balanceDelta).Error
+}
+
+// mockTransactionRepository records the transactions a refinance books and writes them to the test
+// database within the refinance's transaction
+type mockTransactionRepository struct {
+	transactionRepo.Repository
+	booked []*transactionDomain.Transaction
+}
+
+func (m *mockTransactionRepository) CreateWithTx(tx *gorm.DB, transaction *transactionDomain.Transaction) error {
+	m.booked = append(m.booked, transaction)
+	return tx.Exec("INSERT INTO booked_transactions (id, amount) VALUES (?, ?)", transaction.ID.String(), transaction.Amount).Error
+}
+
+func setupRefinanceService() (service.Service, *MockRepository, *mockAccountRepository, *mockTransactionRepository, *gorm.DB) {
+	mockRepo := new(MockRepository)
+	accounts := new(mockAccountRepository)
+	transactions := new(mockTransactionRepository)
+	db := newTestDB()
+	db.Exec("CREATE TABLE balance_changes (account_id TEXT, delta REAL)")
+	db.Exec("CREATE TABLE booked_transactions (id TEXT, amount INTEGER)")
+	svc := service.NewService(mockRepo, accounts, transactions, nil, db, zap.NewNop())
+	return svc, mockRepo, accounts, transactions, db
+}
+
+// balanceChanges returns the balance changes committed to the test database, in order
+func balanceChanges(t *testing.T, db *gorm.DB) []float64 {
+	var deltas []float64
+	require.NoError(t, db.Raw("SELECT delta FROM balance_changes ORDER BY rowid").Scan(&deltas).Error)
+	return deltas
+}
+
+func newCardDebt(userID, accountID uuid.UUID, name string, balance, rate, minimum float64, tags string, appliedAt time.Time) *domain.Debt {
+	return &domain.Debt{
+		ID:                     uuid.New(),
+		UserID:                 userID,
+		Name:                   name,
+		Type:                   domain.DebtTypeCreditCard,
+		Behavior:               domain.DebtBehaviorRevolving,
+		Status:                 domain.DebtStatusActive,
+		Direction:              domain.DebtDirectionBorrowed,
+		PrincipalAmount:        balance,
+		CurrentBalance:         balance,
+		InterestRate:           rate,
+		MinimumPayment:         minimum,
+		Currency:               "VND",
+		StartDate:              appliedAt.AddDate(-1, 0, 0),
+		InterestAccruedThrough: &appliedAt,
+		LinkedAccountID:        &accountID,
+		EnableReminders:        true,
+		Tags:                   &tags,
+	}
+}
+
+func TestDebtRefinance_ApplyRefinance(t *testing.T) {
+	svc, mockRepo, accounts, transactions, db := setupRefinanceService()
+	ctx := context.Background()
+	userID, accountID := uuid.New(), uuid.New()
+	appliedAt := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	cardA := newCardDebt(userID, accountID, "Card A", 1000, 24, 100, `["cards"]`, appliedAt)
+	cardB := newCardDebt(userID, accountID, "Card B", 500, 30, 50, `["cards","travel"]`, appliedAt)
+	account := &accountDomain.Account{ID: accountID, UserID: userID, Currency: accountDomain.CurrencyVND}
+
+	mockRepo.On("FindByID", ctx, cardA.ID).Return(cardA, nil)
+	mockRepo.On("FindByID", ctx, cardB.ID).Return(cardB, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, mock.Anything).Return([]domain.DebtRatePeriod{}, nil)
+	mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*domain.Debt")).Return(nil)
+	mockRepo.On("ReplaceScheduleWithTx", mock.Anything, mock.Anything, 1, mock.Anything).Return(nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, mock.AnythingOfType("*domain.Debt")).Return(nil)
+	mockRepo.On("CreatePaymentWithTx", mock.Anything, mock.AnythingOfType("*domain.DebtPayment")).Return(nil)
+	mockRepo.On("CreateRefinanceWithTx", mock.Anything, mock.AnythingOfType("*domain.DebtRefinance")).Return(nil)
+	accounts.On("GetEditableByIDAndUserID", ctx, accountID.String(), userID.String()).Return(account, nil)
+
+	result, err := svc.ApplyRefinance(ctx, userID, &service.RefinancePlan{
+		DebtIDs:        []uuid.UUID{cardA.ID, cardB.ID},
+		NewRate:        12,
+		TermMonths:     12,
+		OriginationFee: 30,
+		AppliedAt:      appliedAt,
+	})
+
+	require.NoError(t, err)
+	refinance := result.Refinance
+	assert.Equal(t, 1500.0, refinance.PaidOffBalance)
+	assert.Equal(t, 150.0, refinance.OldMonthlyPayment)
+	assert.Equal(t, 26.0, refinance.OldWeightedRate)
+	assert.Equal(t, &accountID, refinance.FundingAccountID)
+	assert.Greater(t, refinance.ExpectedOldInterest, refinance.ExpectedNewInterest)
+	assert.InDelta(t, refinance.ExpectedOldInterest-refinance.ExpectedNewInterest-30, refinance.ExpectedSavings, 0.01)
+
+	// The new loan carries the fee, the linked account, reminders and tags
+	assert.Equal(t, result.NewDebt.ID, refinance.NewDebtID)
+	assert.Equal(t, appliedAt, *result.NewDebt.InterestAccruedThrough)
+	assert.Equal(t, "Consolidation loan", result.NewDebt.Name)
+	assert.Equal(t, domain.DebtTypePersonalLoan, result.NewDebt.Type)
+	assert.Equal(t, 1530.0, result.NewDebt.PrincipalAmount)
+	assert.Equal(t, &accountID, result.NewDebt.LinkedAccountID)
+	assert.True(t, result.NewDebt.EnableReminders)
+	assert.Equal(t, `["cards","travel"]`, *result.NewDebt.Tags)
+	assert.Greater(t, result.NewDebt.MinimumPayment, 0.0)
+	assert.Equal(t, result.NewDebt.MinimumPayment, refinance.NewMonthlyPayment)
+
+	// Proceeds in, one payoff out per debt, each debt settled
+	require.Len(t, transactions.booked, 3)
+	assert.Equal(t, transactionDomain.DirectionCredit, transactions.booked[0].Direction)
+	assert.Equal(t, int64(1500), transactions.booked[0].Amount)
+	assert.Equal(t, int64(1000), transactions.booked[1].Amount)
+	assert.Equal(t, int64(500), transactions.booked[2].Amount)
+	assert.Equal(t, []float64{1500, -1000, -500}, balanceChanges(t, db))
+
+	require.Len(t, result.SettledDebts, 2)
+	for i, settled := range result.SettledDebts {
+		assert.Equal(t, domain.DebtStatusSettled, settled.Status)
+		assert.Equal(t, 0.0, settled.CurrentBalance)
+		assert.Equal(t, &transactions.booked[i+1].ID, refinance.Items[i].PayoffTransactionID)
+	}
+	mockRepo.AssertCalled(t, "CreateRefinanceWithTx", mock.Anything, refinance)
+	mockRepo.AssertCalled(t, "ReplaceScheduleWithTx", mock.Anything, result.NewDebt.ID, 1, mock.Anything)
+}
+
+func TestDebtRefinance_ApplyRefinance_FailureRollsBack(t *testing.T) {
+	svc, mockRepo, accounts, transactions, db := setupRefinanceService()
+	ctx := context.Background()
+	userID, accountID := uuid.New(), uuid.New()
+	appliedAt := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	cardA := newCardDebt(userID, accountID, "Card A", 1000, 24, 100, `[]`, appliedAt)
+	cardB := newCardDebt(userID, accountID, "Card B", 500, 30, 50, `[]`, appliedAt)
+	account := &accountDomain.Account{ID: accountID, UserID: userID, Currency: accountDomain.CurrencyVND}
+
+	mockRepo.On("FindByID", ctx, cardA.ID).Return(cardA, nil)
+	mockRepo.On("FindByID", ctx, cardB.ID).Return(cardB, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, mock.Anything).Return([]domain.DebtRatePeriod{}, nil)
+	mockRepo.On("CreateWithTx", mock.Anything, mock.AnythingOfType("*domain.Debt")).Return(nil)
+	mockRepo.On("ReplaceScheduleWithTx", mock.Anything, mock.Anything, 1, mock.Anything).Return(nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, mock.AnythingOfType("*domain.Debt")).Return(nil)
+	mockRepo.On("CreatePaymentWithTx", mock.Anything, mock.MatchedBy(func(p *domain.DebtPayment) bool {
+		return p.DebtID == cardA.ID
+	})).Return(nil)
+	mockRepo.On("CreatePaymentWithTx", mock.Anything, mock.MatchedBy(func(p *domain.DebtPayment) bool {
+		return p.DebtID == cardB.ID
+	})).Return(errors.New("connection reset"))
+	accounts.On("GetEditableByIDAndUserID", ctx, accountID.String(), userID.String()).Return(account, nil)
+
+	_, err := svc.ApplyRefinance(ctx, userID, &service.RefinancePlan{
+		DebtIDs:    []uuid.UUID{cardA.ID, cardB.ID},
+		NewRate:    12,
+		TermMonths: 12,
+		AppliedAt:  appliedAt,
+	})
+
+	assert.ErrorIs(t, err, shared.ErrInternal)
+	// The proceeds and the first payoff were booked before the second payoff failed; none of it stays
+	assert.Len(t, transactions.booked, 3)
+	assert.Empty(t, balanceChanges(t, db))
+	var booked int64
+	require.NoError(t, db.Raw("SELECT COUNT(*) FROM booked_transactions").Scan(&booked).Error)
+	assert.Zero(t, booked)
+	mockRepo.AssertNotCalled(t, "CreateRefinanceWithTx", mock.Anything, mock.Anything)
+}
+
+func TestDebtRefinance_ApplyRefinance_RequiresFundingAccount(t *testing.T) {
+	svc, mockRepo, _, _, _ := setupRefinanceService()
+	ctx := context.Background()
+	userID := uuid.New()
+	appliedAt := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	cardA := newCardDebt(userID, uuid.New(), "Card A", 1000, 24, 100, `[]`, appliedAt)
+	cardB := newCardDebt(userID, uuid.New(), "Card B", 500, 30, 50, `[]`, appliedAt)
+	mockRepo.On("FindByID", ctx, cardA.ID).Return(cardA, nil)
+	mockRepo.On("FindByID", ctx, cardB.ID).Return(cardB, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, mock.Anything).Return([]domain.DebtRatePeriod{}, nil)
+
+	_, err := svc.ApplyRefinance(ctx, userID, &service.RefinancePlan{
+		DebtIDs:    []uuid.UUID{cardA.ID, cardB.ID},
+		NewRate:    12,
+		TermMonths: 12,
+		AppliedAt:  appliedAt,
+	})
+
+	assert.ErrorIs(t, err, shared.ErrBadRequest)
+	mockRepo.AssertNotCalled(t, "CreateWithTx", mock.Anything, mock.Anything)
+}
+
+func TestDebtRefinance_ApplyRefinance_InvalidDebts(t *testing.T) {
+	svc, mockRepo, _, _, _ := setupRefinanceService()
+	ctx := context.Background()
+	userID := uuid.New()
+	appliedAt := time.Now()
+
+	lent := newCardDebt(userID, uuid.New(), "Loan to Minh", 1000, 0, 0, `[]`, appliedAt)
+	lent.Type = domain.DebtTypeInformal
+	lent.Direction = domain.DebtDirectionLent
+	other := newCardDebt(uuid.New(), uuid.New(), "Someone else's card", 1000, 24, 100, `[]`, appliedAt)
+	mockRepo.On("FindByID", ctx, lent.ID).Return(lent, nil)
+	mockRepo.On("FindByID", ctx, other.ID).Return(other, nil)
+
+	tests := []struct {
+		name string
+		plan *service.RefinancePlan
+	}{
+		{"no debts", &service.RefinancePlan{NewRate: 12, TermMonths: 12}},
+		{"duplicate debt", &service.RefinancePlan{DebtIDs: []uuid.UUID{lent.ID, lent.ID}, NewRate: 12, TermMonths: 12}},
+		{"missing term", &service.RefinancePlan{DebtIDs: []uuid.UUID{lent.ID}, NewRate: 12}},
+		{"money lent", &service.RefinancePlan{DebtIDs: []uuid.UUID{lent.ID}, NewRate: 12, TermMonths: 12}},
+		{"another user's debt", &service.RefinancePlan{DebtIDs: []uuid.UUID{other.ID}, NewRate: 12, TermMonths: 12}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ApplyRefinance(ctx, userID, tt.plan)
+			assert.ErrorIs(t, err, shared.ErrBadRequest)
+		})
+	}
+}
+
+func TestDebtRefinance_ReviewRefinance_OtherUser(t *testing.T) {
+	svc, mockRepo, _, _, _ := setupRefinanceService()
+	ctx := context.Background()
+	refinance := &domain.DebtRefinance{ID: uuid.New(), UserID: uuid.New()}
+	mockRepo.On("FindRefinanceByID", ctx, refinance.ID).Return(refinance, nil)
+
+	_, err := svc.ReviewRefinance(ctx, uuid.New(), refinance.ID)
+
+	assert.ErrorIs(t, err, shared.ErrNotFound)
+}
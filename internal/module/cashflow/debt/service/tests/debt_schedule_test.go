@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/debt/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newInstallmentDebt() *domain.Debt {
+	method := domain.AmortizationEqualPrincipal
+	term := 12
+	return &domain.Debt{
+		ID:                 uuid.New(),
+		UserID:             uuid.New(),
+		Name:               "Home loan",
+		Type:               domain.DebtTypeMortgage,
+		Behavior:           domain.DebtBehaviorInstallment,
+		Status:             domain.DebtStatusActive,
+		AmortizationMethod: &method,
+		TermPeriods:        &term,
+		PrincipalAmount:    12000000,
+		CurrentBalance:     12000000,
+		InterestRate:       12,
+		Currency:           "VND",
+		StartDate:          time.Now().AddDate(0, 0, -10),
+	}
+}
+
+func TestDebtSchedule_GetAmortizationSchedule_GeneratesMissing(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := newInstallmentDebt()
+
+	mockRepo.On("FindByID", ctx, debt.ID).Return(debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return([]domain.DebtRatePeriod{}, nil)
+	mockRepo.On("FindScheduleByDebtID", ctx, debt.ID).Return([]domain.DebtScheduleEntry{}, nil)
+	mockRepo.On("ReplaceSchedule", ctx, debt.ID, 1, mock.MatchedBy(func(entries []domain.DebtScheduleEntry) bool {
+		return len(entries) == 12
+	})).Return(nil)
+
+	schedule, err := svc.GetAmortizationSchedule(ctx, debt.ID)
+
+	assert.NoError(t, err)
+	assert.Len(t, schedule.Entries, 12)
+	assert.Equal(t, 780000.0, schedule.TotalInterest)
+	assert.Equal(t, domain.ScheduleStatusOnTrack, schedule.Reconciliation.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDebtSchedule_GetAmortizationSchedule_Revolving(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := newInstallmentDebt()
+	debt.Behavior = domain.DebtBehaviorRevolving
+
+	mockRepo.On("FindByID", ctx, debt.ID).Return(debt, nil)
+
+	_, err := svc.GetAmortizationSchedule(ctx, debt.ID)
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "FindScheduleByDebtID", mock.Anything, mock.Anything)
+}
+
+func TestDebtSchedule_AddPayment_ExtraPaymentRegenerates(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := newInstallmentDebt()
+	entries, err := debt.GenerateSchedule()
+	assert.NoError(t, err)
+
+	mockRepo.On("FindByIDWithTx", mock.Anything, debt.ID).Return(debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return([]domain.DebtRatePeriod{}, nil)
+	mockRepo.On("FindScheduleByDebtID", ctx, debt.ID).Return(entries, nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, debt).Return(nil)
+	mockRepo.On("CreatePaymentWithTx", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("ReplaceSchedule", ctx, debt.ID, 1, mock.MatchedBy(func(regenerated []domain.DebtScheduleEntry) bool {
+		return len(regenerated) == 12 && regenerated[0].Principal < entries[0].Principal
+	})).Return(nil)
+
+	_, err = svc.AddPayment(ctx, debt.ID, 3000000)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDebtSchedule_AddPayment_RegularPaymentKeepsSchedule(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := newInstallmentDebt()
+	entries, err := debt.GenerateSchedule()
+	assert.NoError(t, err)
+
+	mockRepo.On("FindByIDWithTx", mock.Anything, debt.ID).Return(debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return([]domain.DebtRatePeriod{}, nil)
+	mockRepo.On("FindScheduleByDebtID", ctx, debt.ID).Return(entries, nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, debt).Return(nil)
+	mockRepo.On("CreatePaymentWithTx", mock.Anything, mock.Anything).Return(nil)
+
+	_, err = svc.AddPayment(ctx, debt.ID, entries[0].Payment)
+
+	assert.NoError(t, err)
+	mockRepo.AssertNotCalled(t, "ReplaceSchedule", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"personalfinancedss/internal/module/cashflow/debt/service"
+	notificationDomain "personalfinancedss/internal/module/notification/domain"
+	notificationService "personalfinancedss/internal/module/notification/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// mockNotifier records the in-app notifications the status check sends
+type mockNotifier struct {
+	notificationService.InAppNotifier
+	subjects []string
+	types    []notificationDomain.NotificationType
+}
+
+func (m *mockNotifier) NotifyInApp(ctx context.Context, userID uuid.UUID, notifType notificationDomain.NotificationType, subject string, data map[string]interface{}) error {
+	m.types = append(m.types, notifType)
+	m.subjects = append(m.subjects, subject)
+	return nil
+}
+
+func statusTrackedDebt(name string, due time.Time) domain.Debt {
+	frequency := domain.FrequencyMonthly
+	return domain.Debt{
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		Name:             name,
+		Status:           domain.DebtStatusActive,
+		PrincipalAmount:  5000,
+		CurrentBalance:   4000,
+		MinimumPayment:   500,
+		PaymentFrequency: &frequency,
+		NextPaymentDate:  &due,
+		LateFee:          25,
+		PaymentState:     domain.PaymentStateDueToday,
+	}
+}
+
+func TestDebtStatus_ProcessPaymentStates(t *testing.T) {
+	mockRepo := new(MockRepository)
+	notifier := &mockNotifier{}
+	svc := service.NewService(mockRepo, nil, nil, notifier, newTestDB(), zap.NewNop())
+	ctx := context.Background()
+	asOf := time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)
+
+	overdue := statusTrackedDebt("Card", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	upcoming := statusTrackedDebt("Loan", time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC))
+	upcoming.PaymentState = domain.PaymentStateUpcoming
+
+	locked := overdue
+	mockRepo.On("FindStatusTrackedDebts", ctx).Return([]domain.Debt{overdue, upcoming}, nil)
+	mockRepo.On("FindByIDWithTx", mock.Anything, overdue.ID).Return(&locked, nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, mock.MatchedBy(func(d *domain.Debt) bool {
+		return d.ID == overdue.ID && d.PaymentState == domain.PaymentStateOverdue && d.OutstandingFees == 25
+	})).Return(nil)
+	mockRepo.On("CreateStatusEventWithTx", mock.Anything, mock.MatchedBy(func(e *domain.DebtStatusEvent) bool {
+		return e.DebtID == overdue.ID && e.ToState == domain.PaymentStateOverdue && e.LateFee == 25
+	})).Return(nil)
+
+	changed, err := svc.ProcessPaymentStates(ctx, asOf)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, []string{"Payment on Card is overdue"}, notifier.subjects)
+	assert.Equal(t, []notificationDomain.NotificationType{notificationDomain.NotificationTypeDebtStatus}, notifier.types)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDebtStatus_ProcessPaymentStates_KeepsPaymentMadeSinceListing(t *testing.T) {
+	mockRepo := new(MockRepository)
+	notifier := &mockNotifier{}
+	svc := service.NewService(mockRepo, nil, nil, notifier, newTestDB(), zap.NewNop())
+	ctx := context.Background()
+	asOf := time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)
+
+	// The listed row is still due on the 10th; a payment moved the stored debt to April meanwhile
+	listed := statusTrackedDebt("Card", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	paid := listed
+	nextDue := time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)
+	paid.NextPaymentDate = &nextDue
+	paid.CurrentBalance = 3500
+	paid.PaymentState = domain.PaymentStateUpcoming
+
+	mockRepo.On("FindStatusTrackedDebts", ctx).Return([]domain.Debt{listed}, nil)
+	mockRepo.On("FindByIDWithTx", mock.Anything, listed.ID).Return(&paid, nil)
+
+	changed, err := svc.ProcessPaymentStates(ctx, asOf)
+
+	require.NoError(t, err)
+	assert.Zero(t, changed)
+	assert.Empty(t, notifier.subjects)
+	mockRepo.AssertNotCalled(t, "UpdateWithTx", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateStatusEventWithTx", mock.Anything, mock.Anything)
+}
+
+func TestDebtStatus_RecordPayment_AdvancesDueDate(t *testing.T) {
+	svc, mockRepo := setupService()
+	ctx := context.Background()
+	debt := statusTrackedDebt("Card", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	debt.PaymentState = domain.PaymentStateOverdue
+	debt.MissedPayments = 1
+
+	mockRepo.On("FindByIDWithTx", mock.Anything, debt.ID).Return(&debt, nil)
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return([]domain.DebtRatePeriod{}, nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, mock.AnythingOfType("*domain.Debt")).Return(nil)
+	mockRepo.On("CreatePaymentWithTx", mock.Anything, mock.AnythingOfType("*domain.DebtPayment")).Return(nil)
+	mockRepo.On("CreateStatusEventWithTx", mock.Anything, mock.MatchedBy(func(e *domain.DebtStatusEvent) bool {
+		return e.Trigger == domain.StatusTriggerPayment && e.FromState == domain.PaymentStateOverdue && e.ToState == domain.PaymentStateUpcoming
+	})).Return(nil)
+
+	updated, err := svc.RecordPayment(ctx, debt.ID, &domain.DebtPayment{
+		Amount:      500,
+		PaymentDate: time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC), *updated.NextPaymentDate)
+	assert.Equal(t, 0, updated.MissedPayments)
+	mockRepo.AssertExpectations(t)
+}
@@ -28,6 +28,7 @@ func TestDebtUpdater_UpdateDebt_Success(t *testing.T) {
 		StartDate:       time.Now(),
 	}
 
+	mockRepo.On("FindRatePeriodsByDebtID", ctx, debt.ID).Return([]domain.DebtRatePeriod{}, nil)
 	mockRepo.On("Update", ctx, mock.MatchedBy(func(d *domain.Debt) bool {
 		return d.Name == "Updated Debt"
 	})).Return(nil)
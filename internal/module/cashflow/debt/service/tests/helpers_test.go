@@ -3,9 +3,11 @@ package tests
 import (
 	"context"
 	"personalfinancedss/internal/module/cashflow/debt/domain"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 type MockRepository struct {
@@ -17,6 +19,11 @@ func (m *MockRepository) Create(ctx context.Context, debt *domain.Debt) error {
 	return args.Error(0)
 }
 
+func (m *MockRepository) CreateWithTx(tx *gorm.DB, debt *domain.Debt) error {
+	args := m.Called(tx, debt)
+	return args.Error(0)
+}
+
 func (m *MockRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Debt, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -25,6 +32,14 @@ func (m *MockRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.De
 	return args.Get(0).(*domain.Debt), args.Error(1)
 }
 
+func (m *MockRepository) FindByIDWithTx(tx *gorm.DB, id uuid.UUID) (*domain.Debt, error) {
+	args := m.Called(tx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Debt), args.Error(1)
+}
+
 func (m *MockRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error) {
 	args := m.Called(ctx, userID)
 	if args.Get(0) == nil {
@@ -41,6 +56,14 @@ func (m *MockRepository) FindActiveByUserID(ctx context.Context, userID uuid.UUI
 	return args.Get(0).([]domain.Debt), args.Error(1)
 }
 
+func (m *MockRepository) FindReceivablesByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Debt, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Debt), args.Error(1)
+}
+
 func (m *MockRepository) FindByType(ctx context.Context, userID uuid.UUID, debtType domain.DebtType) ([]domain.Debt, error) {
 	args := m.Called(ctx, userID, debtType)
 	if args.Get(0) == nil {
@@ -87,3 +110,150 @@ func (m *MockRepository) AddPayment(ctx context.Context, id uuid.UUID, amount fl
 	args := m.Called(ctx, id, amount)
 	return args.Error(0)
 }
+
+func (m *MockRepository) FindReminderCandidates(ctx context.Context) ([]domain.Debt, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Debt), args.Error(1)
+}
+
+func (m *MockRepository) MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	args := m.Called(ctx, id, sentAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) UpdateWithTx(tx *gorm.DB, debt *domain.Debt) error {
+	args := m.Called(tx, debt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreatePaymentWithTx(tx *gorm.DB, payment *domain.DebtPayment) error {
+	args := m.Called(tx, payment)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreateStatusEventWithTx(tx *gorm.DB, event *domain.DebtStatusEvent) error {
+	args := m.Called(tx, event)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindStatusTrackedDebts(ctx context.Context) ([]domain.Debt, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Debt), args.Error(1)
+}
+
+func (m *MockRepository) CreateStatusEvent(ctx context.Context, event *domain.DebtStatusEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindStatusEventsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtStatusEvent, error) {
+	args := m.Called(ctx, debtID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.DebtStatusEvent), args.Error(1)
+}
+
+func (m *MockRepository) FindScheduleByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtScheduleEntry, error) {
+	args := m.Called(ctx, debtID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.DebtScheduleEntry), args.Error(1)
+}
+
+func (m *MockRepository) ReplaceSchedule(ctx context.Context, debtID uuid.UUID, fromPeriod int, entries []domain.DebtScheduleEntry) error {
+	args := m.Called(ctx, debtID, fromPeriod, entries)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ReplaceScheduleWithTx(tx *gorm.DB, debtID uuid.UUID, fromPeriod int, entries []domain.DebtScheduleEntry) error {
+	args := m.Called(tx, debtID, fromPeriod, entries)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreatePayment(ctx context.Context, payment *domain.DebtPayment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindPaymentsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtPayment, error) {
+	args := m.Called(ctx, debtID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.DebtPayment), args.Error(1)
+}
+
+func (m *MockRepository) FindPaymentsByUserInRange(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.DebtPayment, error) {
+	args := m.Called(ctx, userID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.DebtPayment), args.Error(1)
+}
+
+func (m *MockRepository) FindRatePeriodsByDebtID(ctx context.Context, debtID uuid.UUID) ([]domain.DebtRatePeriod, error) {
+	args := m.Called(ctx, debtID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.DebtRatePeriod), args.Error(1)
+}
+
+func (m *MockRepository) ReplaceRatePeriods(ctx context.Context, debtID uuid.UUID, periods []domain.DebtRatePeriod) error {
+	args := m.Called(ctx, debtID, periods)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ReplaceRatePeriodsWithTx(tx *gorm.DB, debtID uuid.UUID, periods []domain.DebtRatePeriod) error {
+	args := m.Called(tx, debtID, periods)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindDebtIDsByReferenceIndex(ctx context.Context, userID uuid.UUID, index string) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userID, index)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) UpsertReferenceRate(ctx context.Context, rate *domain.ReferenceRate) error {
+	args := m.Called(ctx, rate)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindReferenceRates(ctx context.Context, userID uuid.UUID, indexes []string) ([]domain.ReferenceRate, error) {
+	args := m.Called(ctx, userID, indexes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ReferenceRate), args.Error(1)
+}
+
+func (m *MockRepository) CreateRefinance(ctx context.Context, refinance *domain.DebtRefinance) error {
+	args := m.Called(ctx, refinance)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreateRefinanceWithTx(tx *gorm.DB, refinance *domain.DebtRefinance) error {
+	args := m.Called(tx, refinance)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindRefinancesByUserID(ctx context.Context, userID uuid.UUID) ([]domain.DebtRefinance, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.DebtRefinance), args.Error(1)
+}
+
+func (m *MockRepository) FindRefinanceByID(ctx context.Context, id uuid.UUID) (*domain.DebtRefinance, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DebtRefinance), args.Error(1)
+}
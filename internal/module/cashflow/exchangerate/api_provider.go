@@ -0,0 +1,133 @@
+package exchangerate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// apiBaseURL is the ExchangeRate-API endpoint; the key and base currency complete the path
+	apiBaseURL = "https://v6.exchangerate-api.com/v6"
+
+	// cacheTTL is how long fetched rates are served before they are refreshed
+	cacheTTL = time.Hour
+
+	// retryInterval is how long to wait before trying the API again after a failed fetch
+	retryInterval = 5 * time.Minute
+)
+
+// apiProvider serves live rates from ExchangeRate-API, cached for an hour. Currencies the API
+// does not quote use the fallback table, as do all currencies until a fetch succeeds.
+type apiProvider struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+	fallback   Rates
+	logger     *zap.Logger
+
+	mu        sync.Mutex
+	rates     Rates
+	nextFetch time.Time
+}
+
+// latestResponse is the body of the API's latest rates endpoint
+type latestResponse struct {
+	Result          string             `json:"result"`
+	ErrorType       string             `json:"error-type"`
+	ConversionRates map[string]float64 `json:"conversion_rates"`
+}
+
+// NewAPIProvider creates a provider fetching live rates with the given ExchangeRate-API key
+func NewAPIProvider(apiKey string, fallback Rates, logger *zap.Logger) Provider {
+	return newAPIProvider(apiBaseURL, apiKey, fallback, logger)
+}
+
+func newAPIProvider(baseURL, apiKey string, fallback Rates, logger *zap.Logger) *apiProvider {
+	return &apiProvider{
+		baseURL:    baseURL,
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		fallback:   fallback,
+		logger:     logger.Named("exchangerate.api"),
+	}
+}
+
+// Rates returns the cached rates, refreshing them once they are older than cacheTTL. A failed
+// refresh keeps serving the last rates fetched, or the fallback table if none were.
+func (p *apiProvider) Rates(ctx context.Context) Rates {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if time.Now().Before(p.nextFetch) {
+		return p.current()
+	}
+
+	live, err := p.fetch(ctx)
+	if err != nil {
+		p.logger.Warn("Failed to fetch exchange rates, serving previous rates", zap.Error(err))
+		p.nextFetch = time.Now().Add(retryInterval)
+		return p.current()
+	}
+
+	rates := make(Rates, len(p.fallback)+len(live))
+	for currency, rate := range p.fallback {
+		rates[currency] = rate
+	}
+	for currency, rate := range live {
+		rates[currency] = rate
+	}
+	p.rates = rates
+	p.nextFetch = time.Now().Add(cacheTTL)
+	return p.rates
+}
+
+// current returns the last fetched rates, or the fallback table if no fetch succeeded yet
+func (p *apiProvider) current() Rates {
+	if p.rates != nil {
+		return p.rates
+	}
+	return p.fallback
+}
+
+// fetch retrieves the latest rates and turns them into the value of one unit in BaseCurrency
+func (p *apiProvider) fetch(ctx context.Context) (Rates, error) {
+	url := fmt.Sprintf("%s/%s/latest/%s", p.baseURL, p.apiKey, BaseCurrency)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var body latestResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("decode exchange rates: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || body.Result != "success" {
+		return nil, fmt.Errorf("exchange rate API returned %d: %s", resp.StatusCode, body.ErrorType)
+	}
+
+	// The API quotes how much of each currency one unit of BaseCurrency buys
+	rates := make(Rates, len(body.ConversionRates))
+	for currency, perBase := range body.ConversionRates {
+		if perBase > 0 {
+			rates[currency] = 1 / perBase
+		}
+	}
+	if len(rates) == 0 {
+		return nil, errors.New("exchange rate API returned no rates")
+	}
+	rates[BaseCurrency] = 1
+	return rates, nil
+}
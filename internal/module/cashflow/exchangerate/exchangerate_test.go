@@ -0,0 +1,68 @@
+package exchangerate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRates_Convert(t *testing.T) {
+	rates := DefaultRates()
+
+	vnd, err := rates.Convert(10, "USD", "VND")
+	require.NoError(t, err)
+	assert.Equal(t, 254000.0, vnd)
+
+	usd, err := rates.Convert(254000, "vnd", "USD")
+	require.NoError(t, err)
+	assert.InDelta(t, 10.0, usd, 0.0001)
+
+	same, err := rates.Convert(42, "", "VND")
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, same)
+
+	_, err = rates.Convert(1, "XYZ", "VND")
+	assert.Error(t, err)
+}
+
+func TestAPIProvider_Rates(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/test-key/latest/VND", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"result":"success","conversion_rates":{"VND":1,"USD":0.00004,"GBP":0.00003125}}`))
+	}))
+	defer server.Close()
+
+	provider := newAPIProvider(server.URL, "test-key", DefaultRates(), zap.NewNop())
+	rates := provider.Rates(context.Background())
+
+	assert.InDelta(t, 25000.0, rates["USD"], 0.0001)
+	assert.InDelta(t, 32000.0, rates["GBP"], 0.0001)
+	assert.Equal(t, 168.0, rates["JPY"], "currencies the API omits keep the fallback rate")
+
+	provider.Rates(context.Background())
+	assert.Equal(t, 1, calls, "rates are cached between calls")
+}
+
+func TestAPIProvider_FallsBackWhenUnavailable(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"result":"error","error-type":"invalid-key"}`))
+	}))
+	defer server.Close()
+
+	provider := newAPIProvider(server.URL, "bad-key", DefaultRates(), zap.NewNop())
+
+	assert.Equal(t, DefaultRates(), provider.Rates(context.Background()))
+	assert.Equal(t, DefaultRates(), provider.Rates(context.Background()))
+	assert.Equal(t, 1, calls, "a failed fetch is not retried on every call")
+}
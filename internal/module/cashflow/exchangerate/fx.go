@@ -0,0 +1,23 @@
+package exchangerate
+
+import (
+	"personalfinancedss/internal/config"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Module provides the exchange rate provider the cashflow modules convert currencies with
+var Module = fx.Module("exchangerate",
+	fx.Provide(NewProvider),
+)
+
+// NewProvider serves live rates when an ExchangeRate-API key is configured, with the reference
+// table as the fallback, and the reference table alone otherwise
+func NewProvider(cfg *config.Config, logger *zap.Logger) Provider {
+	if cfg.ExternalAPIs.ExchangeRateAPIKey == "" {
+		logger.Warn("No exchange rate API key configured, using reference exchange rates")
+		return NewStaticProvider(DefaultRates())
+	}
+	return NewAPIProvider(cfg.ExternalAPIs.ExchangeRateAPIKey, DefaultRates(), logger)
+}
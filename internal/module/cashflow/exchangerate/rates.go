@@ -0,0 +1,78 @@
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BaseCurrency is the currency all exchange rates are quoted against
+const BaseCurrency = "VND"
+
+// Rates maps a currency code to its value in BaseCurrency (1 unit = rate VND)
+type Rates map[string]float64
+
+// Provider supplies the exchange rates currency conversions use
+type Provider interface {
+	// Rates returns the current rates. A provider that cannot reach its source falls back to
+	// DefaultRates rather than fail, so the result is always usable.
+	Rates(ctx context.Context) Rates
+}
+
+// DefaultRates are reference rates used when no live rate provider is configured or reachable
+func DefaultRates() Rates {
+	return Rates{
+		"VND": 1,
+		"USD": 25400,
+		"EUR": 27500,
+		"JPY": 168,
+	}
+}
+
+// Convert converts an amount between two currencies via BaseCurrency
+func (r Rates) Convert(amount float64, from, to string) (float64, error) {
+	from = normalizeCurrency(from)
+	to = normalizeCurrency(to)
+	if from == to {
+		return amount, nil
+	}
+
+	fromRate, ok := r[from]
+	if !ok || fromRate <= 0 {
+		return 0, fmt.Errorf("no exchange rate for currency: %s", from)
+	}
+	toRate, ok := r[to]
+	if !ok || toRate <= 0 {
+		return 0, fmt.Errorf("no exchange rate for currency: %s", to)
+	}
+
+	return amount * fromRate / toRate, nil
+}
+
+// Supports reports whether a rate is known for the currency
+func (r Rates) Supports(currency string) bool {
+	_, ok := r[normalizeCurrency(currency)]
+	return ok
+}
+
+// staticProvider always serves the same rates
+type staticProvider struct {
+	rates Rates
+}
+
+// NewStaticProvider creates a provider serving a fixed rate table
+func NewStaticProvider(rates Rates) Provider {
+	return &staticProvider{rates: rates}
+}
+
+func (p *staticProvider) Rates(ctx context.Context) Rates {
+	return p.rates
+}
+
+func normalizeCurrency(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return BaseCurrency
+	}
+	return currency
+}
@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AutoContributeShortfall decides what happens when the source account cannot cover
+// the full auto-contribution without going below its floor
+type AutoContributeShortfall string
+
+const (
+	// ShortfallReduce contributes whatever is available above the floor
+	ShortfallReduce AutoContributeShortfall = "reduce"
+	// ShortfallSkip skips the period entirely
+	ShortfallSkip AutoContributeShortfall = "skip"
+)
+
+// IsValid checks if the shortfall policy is valid
+func (s AutoContributeShortfall) IsValid() bool {
+	switch s {
+	case ShortfallReduce, ShortfallSkip:
+		return true
+	}
+	return false
+}
+
+// AutoContributionStatus is the outcome of one scheduled auto-contribution
+type AutoContributionStatus string
+
+const (
+	AutoContributionCompleted AutoContributionStatus = "completed"
+	AutoContributionReduced   AutoContributionStatus = "reduced"
+	AutoContributionSkipped   AutoContributionStatus = "skipped"
+	AutoContributionFailed    AutoContributionStatus = "failed"
+)
+
+// AutoContributionRun records the outcome of a goal's auto-contribution for one due date.
+// The (goal, due date) pair is unique, so a due date is never executed twice.
+type AutoContributionRun struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	GoalID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_goal_auto_run_due;column:goal_id" json:"goal_id"`
+	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+
+	DueDate time.Time              `gorm:"type:date;not null;uniqueIndex:idx_goal_auto_run_due;column:due_date" json:"due_date"`
+	Status  AutoContributionStatus `gorm:"type:varchar(20);not null;column:status" json:"status"`
+
+	RequestedAmount float64    `gorm:"type:decimal(15,2);not null;column:requested_amount" json:"requested_amount"`
+	Amount          float64    `gorm:"type:decimal(15,2);default:0;column:amount" json:"amount"` // Amount actually contributed
+	SourceAccountID uuid.UUID  `gorm:"type:uuid;not null;column:source_account_id" json:"source_account_id"`
+	ContributionID  *uuid.UUID `gorm:"type:uuid;column:contribution_id" json:"contribution_id,omitempty"`
+
+	Reason   *string `gorm:"type:text;column:reason" json:"reason,omitempty"` // Why the run was reduced, skipped or failed
+	Attempts int     `gorm:"default:0;column:attempts" json:"attempts"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (AutoContributionRun) TableName() string {
+	return "goal_auto_contribution_runs"
+}
+
+// AutoContributionPlan is the amount an auto-contribution will move given the source balance
+type AutoContributionPlan struct {
+	Status AutoContributionStatus
+	Amount float64
+	Reason string
+}
+
+// SourceAccountID returns the account auto-contributions are drawn from
+func (g *Goal) SourceAccountID() uuid.UUID {
+	if g.AutoContributeAccountID != nil {
+		return *g.AutoContributeAccountID
+	}
+	return g.AccountID
+}
+
+// SyncAutoContributeSchedule sets the first due date when auto-contribute is turned on
+// and clears it when turned off
+func (g *Goal) SyncAutoContributeSchedule(now time.Time) {
+	if !g.AutoContribute {
+		g.NextAutoContributeAt = nil
+		return
+	}
+	if g.NextAutoContributeAt != nil {
+		return
+	}
+
+	today := TruncateToDate(now)
+	next := TruncateToDate(g.StartDate)
+	if next.Before(today) {
+		next = today
+	}
+	g.NextAutoContributeAt = &next
+}
+
+// AutoContributeDue reports whether an auto-contribution is due on or before asOf
+func (g *Goal) AutoContributeDue(asOf time.Time) bool {
+	return g.AutoContribute &&
+		g.Status == GoalStatusActive &&
+		g.NextAutoContributeAt != nil &&
+		!g.NextAutoContributeAt.After(asOf)
+}
+
+// AdvanceAutoContribute moves the schedule one period past the current due date.
+// One-time goals stop auto-contributing after their single run.
+func (g *Goal) AdvanceAutoContribute() {
+	if g.NextAutoContributeAt == nil {
+		return
+	}
+	if g.ContributionFrequency == nil {
+		g.NextAutoContributeAt = nil
+		return
+	}
+
+	next, ok := g.ContributionFrequency.nextOnDay(*g.NextAutoContributeAt, g.autoContributeDay())
+	if !ok {
+		g.AutoContribute = false
+		g.NextAutoContributeAt = nil
+		return
+	}
+	g.NextAutoContributeAt = &next
+}
+
+// PlanAutoContribution works out how much of the configured amount can move from a source
+// account holding sourceBalance without breaching the goal's floor. The amount is capped
+// at what the goal still needs.
+func (g *Goal) PlanAutoContribution(sourceBalance float64) AutoContributionPlan {
+	requested := 0.0
+	if g.AutoContributeAmount != nil {
+		requested = *g.AutoContributeAmount
+	}
+	if remaining := g.TargetAmount - g.FundedAmount(); remaining < requested {
+		requested = math.Max(remaining, 0)
+	}
+	if requested <= 0 {
+		return AutoContributionPlan{Status: AutoContributionSkipped, Reason: "nothing left to contribute"}
+	}
+
+	floor := 0.0
+	if g.AutoContributeMinBalance != nil {
+		floor = *g.AutoContributeMinBalance
+	}
+	available := math.Floor(sourceBalance - floor)
+	if available >= requested {
+		return AutoContributionPlan{Status: AutoContributionCompleted, Amount: requested}
+	}
+
+	reason := fmt.Sprintf("source balance %.0f leaves only %.0f above the %.0f floor", sourceBalance, math.Max(available, 0), floor)
+	if available <= 0 || (g.AutoContributeShortfall != nil && *g.AutoContributeShortfall == ShortfallSkip) {
+		return AutoContributionPlan{Status: AutoContributionSkipped, Reason: reason}
+	}
+	return AutoContributionPlan{Status: AutoContributionReduced, Amount: available, Reason: reason}
+}
+
+// autoContributeDay returns the day of month the schedule falls on. A due date moved to the end
+// of a short month goes back to the start date's day, so Jan 31 is followed by Feb 28 and Mar 31.
+func (g *Goal) autoContributeDay() int {
+	current := *g.NextAutoContributeAt
+	if day := g.StartDate.Day(); day > current.Day() && current.Day() == daysInMonth(current) {
+		return day
+	}
+	return current.Day()
+}
+
+// Next returns the due date one period after from; false for one-time contributions. Monthly,
+// quarterly and yearly dates keep from's day of month, clamped to the end of shorter months.
+func (cf ContributionFrequency) Next(from time.Time) (time.Time, bool) {
+	return cf.nextOnDay(from, from.Day())
+}
+
+// nextOnDay returns the due date one period after from, putting monthly, quarterly and yearly
+// dates on the given day of month
+func (cf ContributionFrequency) nextOnDay(from time.Time, day int) (time.Time, bool) {
+	switch cf {
+	case FrequencyDaily:
+		return from.AddDate(0, 0, 1), true
+	case FrequencyWeekly:
+		return from.AddDate(0, 0, 7), true
+	case FrequencyBiweekly:
+		return from.AddDate(0, 0, 14), true
+	case FrequencyMonthly:
+		return addMonthsOnDay(from, 1, day), true
+	case FrequencyQuarterly:
+		return addMonthsOnDay(from, 3, day), true
+	case FrequencyYearly:
+		return addMonthsOnDay(from, 12, day), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// addMonthsOnDay adds months to t and moves it to the given day of month, clamped to the last
+// day of the target month
+func addMonthsOnDay(t time.Time, months, day int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()).AddDate(0, months, 0)
+	if last := daysInMonth(first); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// daysInMonth returns the number of days in t's month
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+// TruncateToDate drops the time of day, keeping the location
+func TruncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
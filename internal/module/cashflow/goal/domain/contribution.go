@@ -40,11 +40,14 @@ type GoalContribution struct {
 
 	// Optional metadata
 	Note   *string `gorm:"type:text;column:note" json:"note,omitempty"`
-	Source string  `gorm:"type:varchar(20);default:'manual';column:source" json:"source"` // manual, auto, import
+	Source string  `gorm:"type:varchar(20);default:'manual';column:source" json:"source"` // manual, auto, import, transaction
 
-	// For withdrawals - optional reference to the contribution being reversed
+	// Optional reference to the contribution being reversed
 	ReversingContributionID *uuid.UUID `gorm:"type:uuid;column:reversing_contribution_id" json:"reversing_contribution_id,omitempty"`
 
+	// Cash transaction that produced this contribution (GOAL transaction links)
+	TransactionID *uuid.UUID `gorm:"type:uuid;index;column:transaction_id" json:"transaction_id,omitempty"`
+
 	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
 }
 
@@ -61,6 +64,13 @@ func (gc *GoalContribution) NetAmount() float64 {
 	return gc.Amount
 }
 
+const (
+	// ContributionSourceTransaction marks contributions recorded from linked cash transactions
+	ContributionSourceTransaction = "transaction"
+	// ContributionSourceAuto marks contributions made by the auto-contribution schedule
+	ContributionSourceAuto = "auto"
+)
+
 // NewDeposit creates a new deposit contribution
 func NewDeposit(goalID, accountID, userID uuid.UUID, amount float64, note *string) *GoalContribution {
 	return &GoalContribution{
@@ -11,6 +11,8 @@ import (
 type Goal struct {
 	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
 	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	// WorkspaceID is the household the goal is shared into; nil keeps it personal
+	WorkspaceID *uuid.UUID `gorm:"type:uuid;index;column:workspace_id" json:"workspace_id,omitempty"`
 
 	// Goal Details
 	Name        string       `gorm:"type:varchar(255);not null;column:name" json:"name"`
@@ -22,7 +24,9 @@ type Goal struct {
 	// Financial Details
 	TargetAmount  float64 `gorm:"type:decimal(15,2);not null;column:target_amount" json:"target_amount"`
 	CurrentAmount float64 `gorm:"type:decimal(15,2);default:0;column:current_amount" json:"current_amount"`
-	Currency      string  `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+	// Value of manual assets (e.g. gold) earmarked for the goal, refreshed as they are revalued
+	AssetFundedAmount float64 `gorm:"type:decimal(15,2);default:0;column:asset_funded_amount" json:"asset_funded_amount"`
+	Currency          string  `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
 
 	// Timeline
 	StartDate   time.Time  `gorm:"type:date;not null;column:start_date" json:"start_date"`
@@ -40,6 +44,28 @@ type Goal struct {
 	AutoContribute          bool                   `gorm:"default:false;column:auto_contribute" json:"auto_contribute"`
 	AutoContributeAmount    *float64               `gorm:"type:decimal(15,2);column:auto_contribute_amount" json:"auto_contribute_amount,omitempty"`
 	AutoContributeAccountID *uuid.UUID             `gorm:"type:uuid;column:auto_contribute_account_id" json:"auto_contribute_account_id,omitempty"`
+	// Lowest balance an auto-contribution may leave in the source account; nil means 0
+	AutoContributeMinBalance *float64 `gorm:"type:decimal(15,2);column:auto_contribute_min_balance" json:"auto_contribute_min_balance,omitempty"`
+	// What to do when the full amount would breach the floor (defaults to reduce)
+	AutoContributeShortfall *AutoContributeShortfall `gorm:"type:varchar(10);column:auto_contribute_shortfall" json:"auto_contribute_shortfall,omitempty"`
+	NextAutoContributeAt    *time.Time               `gorm:"type:date;index;column:next_auto_contribute_at" json:"next_auto_contribute_at,omitempty"`
+
+	// Sinking Fund - a recurring goal saving for an expense due every year; TargetAmount is the
+	// yearly amount and TargetDate the next due date
+	SinkingFund bool `gorm:"default:false;column:sinking_fund" json:"sinking_fund"`
+	// Calendar event the due date follows (e.g. Tết or an insurance renewal); nil repeats TargetDate yearly
+	DueEventID *uuid.UUID `gorm:"type:uuid;column:due_event_id" json:"due_event_id,omitempty"`
+	LastPaidAt *time.Time `gorm:"column:last_paid_at" json:"last_paid_at,omitempty"`
+
+	// Wishlist - a willing goal for a planned purchase that must wait out a cooling-off period before
+	// it can become a budget; CoolingOffUntil is only set on wishlist items
+	CoolingOffDays       int               `gorm:"default:0;column:cooling_off_days" json:"cooling_off_days"`
+	CoolingOffUntil      *time.Time        `gorm:"type:date;index;column:cooling_off_until" json:"cooling_off_until,omitempty"`
+	CoolingOffNotifiedAt *time.Time        `gorm:"column:cooling_off_notified_at" json:"cooling_off_notified_at,omitempty"`
+	WishlistDecision     *WishlistDecision `gorm:"type:varchar(20);column:wishlist_decision" json:"wishlist_decision,omitempty"`
+	DecidedAt            *time.Time        `gorm:"column:decided_at" json:"decided_at,omitempty"`
+	// Transaction the item was finally bought with, once converted
+	PurchaseTransactionID *uuid.UUID `gorm:"type:uuid;column:purchase_transaction_id" json:"purchase_transaction_id,omitempty"`
 
 	// Linked Resources - AccountID is required, must be cash/bank/savings account
 	AccountID         uuid.UUID  `gorm:"type:uuid;not null;index;column:account_id" json:"account_id"`
@@ -51,6 +77,7 @@ type Goal struct {
 	LastReminderSentAt *time.Time `gorm:"column:last_reminder_sent_at" json:"last_reminder_sent_at,omitempty"`
 
 	// Milestones
+	// Deprecated: legacy JSON; the migrator moves it into GoalMilestone records and clears it
 	Milestones *string `gorm:"type:jsonb;column:milestones" json:"milestones,omitempty"` // JSON array of milestone objects
 
 	// Metadata
@@ -67,9 +94,14 @@ func (Goal) TableName() string {
 	return "goals"
 }
 
+// FundedAmount returns contributions plus the value of assets earmarked for the goal
+func (g *Goal) FundedAmount() float64 {
+	return g.CurrentAmount + g.AssetFundedAmount
+}
+
 // IsCompleted checks if the goal has been completed
 func (g *Goal) IsCompleted() bool {
-	return g.CurrentAmount >= g.TargetAmount || g.Status == GoalStatusCompleted
+	return g.FundedAmount() >= g.TargetAmount || g.Status == GoalStatusCompleted
 }
 
 // IsOverdue checks if the goal is past its target date and not completed
@@ -89,15 +121,32 @@ func (g *Goal) DaysRemaining() int {
 	return int(duration.Hours() / 24)
 }
 
+// AmountBehindSchedule returns how far the funded amount trails an even pace from the start date
+// to the target date; 0 without a target date or when on or ahead of pace
+func (g *Goal) AmountBehindSchedule(now time.Time) float64 {
+	if g.TargetDate == nil || !g.TargetDate.After(g.StartDate) || !now.After(g.StartDate) {
+		return 0
+	}
+	progress := float64(now.Sub(g.StartDate)) / float64(g.TargetDate.Sub(g.StartDate))
+	if progress > 1 {
+		progress = 1
+	}
+	behind := g.TargetAmount*progress - g.FundedAmount()
+	if behind < 0 {
+		return 0
+	}
+	return behind
+}
+
 // UpdateCalculatedFields updates progress, remaining amount, and status
 func (g *Goal) UpdateCalculatedFields() {
-	g.RemainingAmount = g.TargetAmount - g.CurrentAmount
+	g.RemainingAmount = g.TargetAmount - g.FundedAmount()
 	if g.RemainingAmount < 0 {
 		g.RemainingAmount = 0
 	}
 
 	if g.TargetAmount > 0 {
-		g.PercentageComplete = (g.CurrentAmount / g.TargetAmount) * 100
+		g.PercentageComplete = (g.FundedAmount() / g.TargetAmount) * 100
 		if g.PercentageComplete > 100 {
 			g.PercentageComplete = 100
 		}
@@ -163,6 +212,8 @@ func (g *Goal) AddContribution(amount float64) {
 }
 
 // Milestone represents a milestone in goal progress
+//
+// Deprecated: use GoalMilestone
 type Milestone struct {
 	Percentage  float64    `json:"percentage"`
 	Amount      float64    `json:"amount"`
@@ -30,6 +30,18 @@ func TestGoal_UpdateCalculatedFields(t *testing.T) {
 			expectedPercentage: 50.0,
 			expectedStatus:     GoalStatusActive,
 		},
+		{
+			name: "earmarked assets count toward progress",
+			goal: &Goal{
+				TargetAmount:      10000000,
+				CurrentAmount:     4000000,
+				AssetFundedAmount: 3500000,
+				Status:            GoalStatusActive,
+			},
+			expectedRemaining:  2500000,
+			expectedPercentage: 75.0,
+			expectedStatus:     GoalStatusActive,
+		},
 		{
 			name: "goal completed",
 			goal: &Goal{
@@ -466,3 +478,500 @@ func TestGoal_NewGoal(t *testing.T) {
 	assert.Equal(t, 50000000.0, goal.TargetAmount)
 	assert.Equal(t, GoalStatusActive, goal.Status)
 }
+
+func TestGoal_PlanAutoContribution(t *testing.T) {
+	amount := 1000000.0
+	floor := 500000.0
+	skip := ShortfallSkip
+
+	tests := []struct {
+		name      string
+		balance   float64
+		shortfall *AutoContributeShortfall
+		current   float64
+		status    AutoContributionStatus
+		amount    float64
+	}{
+		{"full amount above floor", 2000000, nil, 0, AutoContributionCompleted, 1000000},
+		{"reduced to what is above floor", 1200000, nil, 0, AutoContributionReduced, 700000},
+		{"skipped by policy", 1200000, &skip, 0, AutoContributionSkipped, 0},
+		{"skipped at floor", 500000, nil, 0, AutoContributionSkipped, 0},
+		{"capped at remaining target", 5000000, nil, 9600000, AutoContributionCompleted, 400000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goal := &Goal{
+				TargetAmount:             10000000,
+				CurrentAmount:            tt.current,
+				AutoContributeAmount:     &amount,
+				AutoContributeMinBalance: &floor,
+				AutoContributeShortfall:  tt.shortfall,
+			}
+
+			plan := goal.PlanAutoContribution(tt.balance)
+			assert.Equal(t, tt.status, plan.Status)
+			assert.Equal(t, tt.amount, plan.Amount)
+		})
+	}
+}
+
+func TestGoal_AutoContributeSchedule(t *testing.T) {
+	monthly := FrequencyMonthly
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	goal := &Goal{
+		Status:                GoalStatusActive,
+		StartDate:             time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		ContributionFrequency: &monthly,
+		AutoContribute:        true,
+	}
+
+	goal.SyncAutoContributeSchedule(now)
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), *goal.NextAutoContributeAt)
+	assert.True(t, goal.AutoContributeDue(now))
+
+	goal.AdvanceAutoContribute()
+	assert.Equal(t, time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC), *goal.NextAutoContributeAt)
+	assert.False(t, goal.AutoContributeDue(now))
+
+	oneTime := FrequencyOneTime
+	goal.ContributionFrequency = &oneTime
+	goal.AdvanceAutoContribute()
+	assert.Nil(t, goal.NextAutoContributeAt)
+	assert.False(t, goal.AutoContribute)
+
+	goal.SyncAutoContributeSchedule(now)
+	assert.Nil(t, goal.NextAutoContributeAt)
+}
+
+func TestGoal_AdvanceAutoContribute_ClampsToMonthEnd(t *testing.T) {
+	monthly := FrequencyMonthly
+	start := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	goal := &Goal{
+		Status:                GoalStatusActive,
+		StartDate:             start,
+		ContributionFrequency: &monthly,
+		AutoContribute:        true,
+		NextAutoContributeAt:  &start,
+	}
+
+	want := []time.Time{
+		time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC),
+	}
+	for _, date := range want {
+		goal.AdvanceAutoContribute()
+		assert.Equal(t, date, *goal.NextAutoContributeAt)
+	}
+
+	quarterly := FrequencyQuarterly
+	next, ok := quarterly.Next(time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2027, 2, 28, 0, 0, 0, 0, time.UTC), next)
+
+	yearly := FrequencyYearly
+	next, ok = yearly.Next(time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2029, 2, 28, 0, 0, 0, 0, time.UTC), next)
+}
+
+func TestGoal_LegacyMilestones(t *testing.T) {
+	updatedAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	achievedAt := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+	legacy := `[
+		{"percentage": 25, "amount": 0, "description": "First quarter", "achieved": true, "achieved_at": "2025-03-15T00:00:00Z"},
+		{"percentage": 0, "amount": 6000000, "description": "", "achieved": true},
+		{"percentage": 0, "amount": 0, "description": "No target"},
+		{"percentage": 150, "amount": 0, "description": "Past the goal"}
+	]`
+	goal := &Goal{
+		ID:           uuid.New(),
+		UserID:       uuid.New(),
+		TargetAmount: 10000000,
+		Currency:     "VND",
+		Milestones:   &legacy,
+		UpdatedAt:    updatedAt,
+	}
+
+	milestones, err := goal.LegacyMilestones()
+
+	assert.NoError(t, err)
+	assert.Len(t, milestones, 2)
+
+	assert.Equal(t, "First quarter", milestones[0].Label)
+	assert.Equal(t, 25.0, *milestones[0].TargetPercentage)
+	assert.Nil(t, milestones[0].TargetAmount)
+	assert.Equal(t, achievedAt, *milestones[0].AchievedAt)
+	assert.Equal(t, goal.ID, milestones[0].GoalID)
+	assert.Equal(t, goal.UserID, milestones[0].UserID)
+
+	assert.Equal(t, "6000000 VND saved", milestones[1].Label)
+	assert.Equal(t, 6000000.0, *milestones[1].TargetAmount)
+	assert.Equal(t, updatedAt, *milestones[1].AchievedAt)
+
+	invalid := "not json"
+	goal.Milestones = &invalid
+	_, err = goal.LegacyMilestones()
+	assert.Error(t, err)
+
+	goal.Milestones = nil
+	milestones, err = goal.LegacyMilestones()
+	assert.NoError(t, err)
+	assert.Empty(t, milestones)
+}
+
+func TestGoal_SyncMilestones(t *testing.T) {
+	amount := 3000000.0
+	half := 50.0
+	now := time.Date(2026, 5, 10, 9, 0, 0, 0, time.UTC)
+	earlier := now.AddDate(0, 0, -5)
+
+	goal := &Goal{TargetAmount: 10000000, CurrentAmount: 4000000}
+	milestones := []GoalMilestone{
+		{Label: "3M", TargetAmount: &amount},
+		{Label: "Half way", TargetPercentage: &half},
+	}
+
+	crossings := goal.SyncMilestones(milestones, now)
+	assert.Len(t, crossings, 1)
+	assert.Equal(t, "3M", crossings[0].Milestone.Label)
+	assert.True(t, crossings[0].Reached)
+	assert.Equal(t, now, *milestones[0].AchievedAt)
+	assert.Nil(t, milestones[1].AchievedAt)
+
+	// Nothing changes while the amount stays between the two milestones
+	assert.Empty(t, goal.SyncMilestones(milestones, now))
+
+	goal.AssetFundedAmount = 1000000
+	crossings = goal.SyncMilestones(milestones, now)
+	assert.Len(t, crossings, 1)
+	assert.Equal(t, "Half way", crossings[0].Milestone.Label)
+
+	// A withdrawal below both milestones un-achieves them
+	milestones[0].AchievedAt = &earlier
+	goal.CurrentAmount = 1000000
+	goal.AssetFundedAmount = 0
+	crossings = goal.SyncMilestones(milestones, now)
+	assert.Len(t, crossings, 2)
+	for _, c := range crossings {
+		assert.False(t, c.Reached)
+		assert.Nil(t, c.Milestone.AchievedAt)
+	}
+}
+
+func TestGoalMilestone_Validate(t *testing.T) {
+	goalTarget := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	goal := &Goal{TargetAmount: 1000, TargetDate: &goalTarget}
+	amount := 500.0
+	tooMuch := 2000.0
+	pct := 25.0
+	late := goalTarget.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name      string
+		milestone GoalMilestone
+		wantErr   bool
+	}{
+		{name: "amount", milestone: GoalMilestone{Label: "a", TargetAmount: &amount}},
+		{name: "percentage", milestone: GoalMilestone{Label: "p", TargetPercentage: &pct}},
+		{name: "missing label", milestone: GoalMilestone{TargetAmount: &amount}, wantErr: true},
+		{name: "neither threshold", milestone: GoalMilestone{Label: "n"}, wantErr: true},
+		{name: "both thresholds", milestone: GoalMilestone{Label: "b", TargetAmount: &amount, TargetPercentage: &pct}, wantErr: true},
+		{name: "amount above target", milestone: GoalMilestone{Label: "x", TargetAmount: &tooMuch}, wantErr: true},
+		{name: "date after goal", milestone: GoalMilestone{Label: "d", TargetAmount: &amount, TargetDate: &late}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.milestone.Validate(goal)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGoalMilestone_Assess(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	start := now.AddDate(0, -6, 0)
+	goal := &Goal{TargetAmount: 12000000, CurrentAmount: 3000000, StartDate: start}
+
+	// 3M over the last 90 days -> 1M per 30 days
+	contributions := []GoalContribution{
+		{Type: ContributionTypeDeposit, Amount: 2000000, CreatedAt: now.AddDate(0, 0, -60)},
+		{Type: ContributionTypeDeposit, Amount: 1500000, CreatedAt: now.AddDate(0, 0, -20)},
+		{Type: ContributionTypeWithdrawal, Amount: 500000, CreatedAt: now.AddDate(0, 0, -10)},
+		{Type: ContributionTypeDeposit, Amount: 9000000, CreatedAt: now.AddDate(0, 0, -120)}, // outside the window
+	}
+	pace := goal.ContributionPace(contributions, now)
+	assert.InDelta(t, 1000000, pace, 0.01)
+
+	amount := 5000000.0
+	onTrackDate := now.AddDate(0, 3, 0)
+	behindDate := now.AddDate(0, 1, 0)
+	pastDate := now.AddDate(0, 0, -1)
+	reached := 2000000.0
+
+	t.Run("on track", func(t *testing.T) {
+		a := (&GoalMilestone{TargetAmount: &amount, TargetDate: &onTrackDate}).Assess(goal, pace, now)
+		assert.Equal(t, MilestoneStatusOnTrack, a.Status)
+		assert.Equal(t, 2000000.0, a.RemainingAmount)
+		assert.Equal(t, time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC), *a.ProjectedDate)
+	})
+
+	t.Run("behind", func(t *testing.T) {
+		a := (&GoalMilestone{TargetAmount: &amount, TargetDate: &behindDate}).Assess(goal, pace, now)
+		assert.Equal(t, MilestoneStatusBehind, a.Status)
+		assert.NotNil(t, a.RequiredPace)
+		assert.Greater(t, *a.RequiredPace, pace)
+	})
+
+	t.Run("target date passed", func(t *testing.T) {
+		a := (&GoalMilestone{TargetAmount: &amount, TargetDate: &pastDate}).Assess(goal, pace, now)
+		assert.Equal(t, MilestoneStatusBehind, a.Status)
+		assert.Nil(t, a.RequiredPace)
+	})
+
+	t.Run("no pace", func(t *testing.T) {
+		a := (&GoalMilestone{TargetAmount: &amount, TargetDate: &onTrackDate}).Assess(goal, 0, now)
+		assert.Equal(t, MilestoneStatusBehind, a.Status)
+		assert.Nil(t, a.ProjectedDate)
+	})
+
+	t.Run("unscheduled", func(t *testing.T) {
+		a := (&GoalMilestone{TargetAmount: &amount}).Assess(goal, pace, now)
+		assert.Equal(t, MilestoneStatusUnscheduled, a.Status)
+		assert.NotNil(t, a.ProjectedDate)
+	})
+
+	t.Run("achieved", func(t *testing.T) {
+		a := (&GoalMilestone{TargetAmount: &reached, TargetDate: &pastDate}).Assess(goal, pace, now)
+		assert.Equal(t, MilestoneStatusAchieved, a.Status)
+		assert.Zero(t, a.RemainingAmount)
+	})
+}
+
+func TestSimulateCompletionMonths(t *testing.T) {
+	cfg := ForecastConfig{Simulations: 200, HorizonMonths: 60, Seed: 42}
+
+	t.Run("no uncertainty completes on schedule", func(t *testing.T) {
+		months := SimulateCompletionMonths(0, 1000, ForecastAssumptions{MonthlyContribution: 100}, cfg)
+		assert.Len(t, months, 200)
+		assert.Equal(t, 10, months[0])
+		assert.Equal(t, 10, months[199])
+		assert.Equal(t, 1.0, CompletionProbability(months, 10))
+		assert.Equal(t, 0.0, CompletionProbability(months, 9))
+	})
+
+	t.Run("already funded", func(t *testing.T) {
+		months := SimulateCompletionMonths(1000, 1000, ForecastAssumptions{}, cfg)
+		month, ok := CompletionPercentile(months, 90, cfg.HorizonMonths)
+		assert.True(t, ok)
+		assert.Equal(t, 0, month)
+	})
+
+	t.Run("no contributions never completes", func(t *testing.T) {
+		months := SimulateCompletionMonths(0, 1000, ForecastAssumptions{}, cfg)
+		_, ok := CompletionPercentile(months, 10, cfg.HorizonMonths)
+		assert.False(t, ok)
+		assert.Equal(t, 0.0, CompletionProbability(months, cfg.HorizonMonths))
+	})
+
+	t.Run("uncertainty spreads completion dates", func(t *testing.T) {
+		a := ForecastAssumptions{MonthlyContribution: 100, ContributionCV: 0.3, IncomeVolatility: 0.15}
+		months := SimulateCompletionMonths(0, 1000, a, cfg)
+		p10, _ := CompletionPercentile(months, 10, cfg.HorizonMonths)
+		p50, _ := CompletionPercentile(months, 50, cfg.HorizonMonths)
+		p90, _ := CompletionPercentile(months, 90, cfg.HorizonMonths)
+		assert.Less(t, p10, p90)
+		assert.LessOrEqual(t, p10, p50)
+		assert.LessOrEqual(t, p50, p90)
+
+		// Same seed, same paths
+		assert.Equal(t, months, SimulateCompletionMonths(0, 1000, a, cfg))
+	})
+
+	t.Run("positive returns complete sooner", func(t *testing.T) {
+		base := SimulateCompletionMonths(500, 5000, ForecastAssumptions{MonthlyContribution: 100}, cfg)
+		invested := SimulateCompletionMonths(500, 5000, ForecastAssumptions{MonthlyContribution: 100, MonthlyReturn: 0.01}, cfg)
+		assert.Less(t, invested[100], base[100])
+	})
+}
+
+func TestRequiredMonthlyContribution(t *testing.T) {
+	cfg := ForecastConfig{Simulations: 300, Seed: 7}
+
+	exact := RequiredMonthlyContribution(0, 1200, ForecastAssumptions{}, 12, 0.9, cfg)
+	assert.InDelta(t, 100, exact, 0.01)
+
+	a := ForecastAssumptions{ContributionCV: 0.3, IncomeVolatility: 0.2}
+	p80 := RequiredMonthlyContribution(0, 1200, a, 12, 0.8, cfg)
+	p90 := RequiredMonthlyContribution(0, 1200, a, 12, 0.9, cfg)
+	assert.Greater(t, p80, 100.0)
+	assert.GreaterOrEqual(t, p90, p80)
+
+	a.MonthlyContribution = p90
+	months := SimulateCompletionMonths(0, 1200, a, ForecastConfig{Simulations: 300, HorizonMonths: 12, Seed: 7})
+	assert.GreaterOrEqual(t, CompletionProbability(months, 12), 0.9)
+
+	assert.Zero(t, RequiredMonthlyContribution(1500, 1200, a, 12, 0.9, cfg))
+
+	// The result is the smallest contribution reaching the confidence on the same paths
+	invested := ForecastAssumptions{ContributionCV: 0.3, IncomeVolatility: 0.2, MonthlyReturn: 0.006, MonthlyReturnStdDev: 0.035}
+	required := RequiredMonthlyContribution(2000, 20000, invested, 36, 0.9, cfg)
+	simCfg := ForecastConfig{Simulations: 300, HorizonMonths: 36, Seed: 7}
+	invested.MonthlyContribution = required
+	assert.GreaterOrEqual(t, CompletionProbability(SimulateCompletionMonths(2000, 20000, invested, simCfg), 36), 0.9)
+	invested.MonthlyContribution = required * 0.99
+	assert.Less(t, CompletionProbability(SimulateCompletionMonths(2000, 20000, invested, simCfg), 36), 0.9)
+}
+
+func TestGoal_MonthlyContributionStats(t *testing.T) {
+	now := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	goal := &Goal{StartDate: now.AddDate(0, 0, -90)}
+
+	contributions := []GoalContribution{
+		{Type: ContributionTypeDeposit, Amount: 300, CreatedAt: now.AddDate(0, 0, -80)},
+		{Type: ContributionTypeDeposit, Amount: 200, CreatedAt: now.AddDate(0, 0, -40)},
+		{Type: ContributionTypeWithdrawal, Amount: 50, CreatedAt: now.AddDate(0, 0, -35)},
+		{Type: ContributionTypeDeposit, Amount: 150, CreatedAt: now.AddDate(0, 0, -5)},
+	}
+
+	mean, stdDev, months := goal.MonthlyContributionStats(contributions, now)
+	assert.Equal(t, 3, months)
+	assert.InDelta(t, 200, mean, 0.001)
+	assert.InDelta(t, 86.60, stdDev, 0.01)
+}
+
+func TestGoal_MonthsUntilTarget(t *testing.T) {
+	now := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	_, ok := (&Goal{}).MonthsUntilTarget(now)
+	assert.False(t, ok)
+
+	target := time.Date(2027, 3, 15, 0, 0, 0, 0, time.UTC)
+	months, ok := (&Goal{TargetDate: &target}).MonthsUntilTarget(now)
+	assert.True(t, ok)
+	assert.Equal(t, 12, months)
+
+	target = time.Date(2027, 3, 14, 0, 0, 0, 0, time.UTC)
+	months, _ = (&Goal{TargetDate: &target}).MonthsUntilTarget(now)
+	assert.Equal(t, 11, months)
+
+	past := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	months, _ = (&Goal{TargetDate: &past}).MonthsUntilTarget(now)
+	assert.Zero(t, months)
+}
+
+func TestGoal_MonthlySetAside(t *testing.T) {
+	now := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+	due := time.Date(2027, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	goal := &Goal{Behavior: GoalBehaviorRecurring, SinkingFund: true, TargetAmount: 12000000, CurrentAmount: 2000000, TargetDate: &due}
+	assert.InDelta(t, 1000000, goal.MonthlySetAside(now), 0.01)
+
+	soon := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	goal.TargetDate = &soon
+	assert.InDelta(t, 10000000, goal.MonthlySetAside(now), 0.01)
+
+	goal.CurrentAmount = 12000000
+	assert.Zero(t, goal.MonthlySetAside(now))
+
+	flexible := &Goal{Behavior: GoalBehaviorFlexible, SinkingFund: true, TargetAmount: 1000, TargetDate: &due}
+	assert.Zero(t, flexible.MonthlySetAside(now))
+	assert.Error(t, flexible.ValidateSinkingFund())
+}
+
+func TestGoal_ResetSinkingFund(t *testing.T) {
+	due := time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC)
+	completedAt := due.AddDate(0, 0, -10)
+	goal := &Goal{
+		Behavior:      GoalBehaviorRecurring,
+		SinkingFund:   true,
+		TargetAmount:  1000,
+		CurrentAmount: 100,
+		TargetDate:    &due,
+		Status:        GoalStatusCompleted,
+		CompletedAt:   &completedAt,
+	}
+
+	paidAt := due.AddDate(0, 0, -1)
+	nextDue := time.Date(2027, 2, 6, 0, 0, 0, 0, time.UTC)
+	goal.ResetSinkingFund(paidAt, nextDue)
+
+	assert.Equal(t, GoalStatusActive, goal.Status)
+	assert.Nil(t, goal.CompletedAt)
+	assert.Equal(t, nextDue, *goal.TargetDate)
+	assert.Equal(t, paidAt, *goal.LastPaidAt)
+	assert.Equal(t, 900.0, goal.RemainingAmount)
+}
+
+func TestNextYearlyOccurrence(t *testing.T) {
+	date := time.Date(2024, 9, 5, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC), NextYearlyOccurrence(date, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC), NextYearlyOccurrence(date, time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2027, 9, 5, 0, 0, 0, 0, time.UTC), NextYearlyOccurrence(date, time.Date(2026, 9, 6, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, date, NextYearlyOccurrence(date, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestGoal_CanConvertToBudget(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	goal := &Goal{Behavior: GoalBehaviorWilling, StartDate: start, TargetAmount: 2000}
+	goal.SetCoolingOff(30)
+
+	assert.True(t, goal.IsWishlistItem())
+	assert.Equal(t, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), *goal.CoolingOffUntil)
+	assert.True(t, goal.IsCoolingOff(time.Date(2026, 3, 30, 23, 0, 0, 0, time.UTC)))
+	assert.Error(t, goal.CanConvertToBudget(time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, goal.CanConvertToBudget(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, goal.CanCancelWishlist())
+
+	goal.MarkConverted(uuid.New(), time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, GoalStatusCompleted, goal.Status)
+	assert.Error(t, goal.CanConvertToBudget(time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC)))
+	assert.Error(t, goal.CanCancelWishlist())
+
+	flexible := &Goal{Behavior: GoalBehaviorFlexible}
+	assert.Error(t, flexible.CanConvertToBudget(start))
+}
+
+func TestGoal_ValidateWishlist(t *testing.T) {
+	goal := &Goal{Behavior: GoalBehaviorWilling, StartDate: time.Now()}
+	assert.NoError(t, goal.ValidateWishlist())
+
+	goal.SetCoolingOff(0)
+	assert.Error(t, goal.ValidateWishlist())
+
+	goal.SetCoolingOff(14)
+	assert.NoError(t, goal.ValidateWishlist())
+
+	goal.Behavior = GoalBehaviorFlexible
+	assert.Error(t, goal.ValidateWishlist())
+}
+
+func TestGoal_AmountNotSpent(t *testing.T) {
+	goal := &Goal{Behavior: GoalBehaviorWilling, StartDate: time.Now(), TargetAmount: 3000, CurrentAmount: 500}
+	goal.SetCoolingOff(7)
+	assert.Equal(t, 0.0, goal.AmountNotSpent())
+	assert.Equal(t, 500.0, goal.BudgetAmount())
+
+	goal.MarkWishlistCancelled(time.Now())
+	assert.Equal(t, GoalStatusArchived, goal.Status)
+	assert.Equal(t, 3000.0, goal.AmountNotSpent())
+}
+
+func TestGoal_AmountBehindSchedule(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	target := start.AddDate(0, 0, 100)
+	goal := &Goal{StartDate: start, TargetDate: &target, TargetAmount: 1000, CurrentAmount: 200}
+
+	assert.InDelta(t, 300.0, goal.AmountBehindSchedule(start.AddDate(0, 0, 50)), 0.001)
+	assert.Equal(t, 0.0, goal.AmountBehindSchedule(start.AddDate(0, 0, 10)))
+	assert.InDelta(t, 800.0, goal.AmountBehindSchedule(target.AddDate(0, 0, 30)), 0.001)
+	assert.Equal(t, 0.0, (&Goal{StartDate: start, TargetAmount: 1000}).AmountBehindSchedule(target))
+}
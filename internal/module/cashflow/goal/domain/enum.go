@@ -132,3 +132,20 @@ func (cf ContributionFrequency) DaysPerPeriod() int {
 		return 0
 	}
 }
+
+// WishlistDecision records what became of a wishlist item after its cooling-off period
+type WishlistDecision string
+
+const (
+	WishlistConverted WishlistDecision = "converted" // Still wanted: turned into a one-time budget
+	WishlistCancelled WishlistDecision = "cancelled" // Dropped: the purchase was not made
+)
+
+// IsValid checks if the wishlist decision is valid
+func (wd WishlistDecision) IsValid() bool {
+	switch wd {
+	case WishlistConverted, WishlistCancelled:
+		return true
+	}
+	return false
+}
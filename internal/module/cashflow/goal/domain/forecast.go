@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// contributionHistoryMonths is how far back contribution history is measured for forecasts
+const contributionHistoryMonths = 12
+
+// maxRequiredContributionMultiple caps the required contribution, as a multiple of the straight-line
+// amount, when too many runs cannot complete within the months at any contribution
+const maxRequiredContributionMultiple = 1 << 20
+
+// ForecastAssumptions describe the uncertainty a goal forecast simulates month by month
+type ForecastAssumptions struct {
+	MonthlyContribution float64 // Expected net contribution per month
+	ContributionCV      float64 // Month-to-month contribution variability (std dev / mean)
+	IncomeVolatility    float64 // Std dev of the monthly income factor around 1
+	MonthlyReturn       float64 // Expected return on the balance per month (investment goals)
+	MonthlyReturnStdDev float64
+}
+
+// ForecastConfig controls a simulation. Runs sharing a seed draw the same random paths,
+// so results for different contributions are directly comparable.
+type ForecastConfig struct {
+	Simulations   int
+	HorizonMonths int
+	Seed          int64
+}
+
+// SimulateCompletionMonths simulates the goal's funded amount and returns, sorted, the month each
+// run reaches the target: 0 if already funded, HorizonMonths+1 if not within the horizon
+func SimulateCompletionMonths(funded, target float64, a ForecastAssumptions, cfg ForecastConfig) []int {
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	months := make([]int, cfg.Simulations)
+
+	for i := range months {
+		balance := funded
+		months[i] = cfg.HorizonMonths + 1
+		if balance >= target {
+			months[i] = 0
+			continue
+		}
+		for m := 1; m <= cfg.HorizonMonths; m++ {
+			// Draw every variate each month so paths stay aligned across contribution levels
+			incomeFactor := math.Max(0, 1+a.IncomeVolatility*rng.NormFloat64())
+			contributionFactor := math.Max(0, 1+a.ContributionCV*rng.NormFloat64())
+			monthlyReturn := math.Max(-0.99, a.MonthlyReturn+a.MonthlyReturnStdDev*rng.NormFloat64())
+
+			balance *= 1 + monthlyReturn
+			balance += math.Max(0, a.MonthlyContribution) * incomeFactor * contributionFactor
+			if balance >= target {
+				months[i] = m
+				break
+			}
+		}
+	}
+
+	sort.Ints(months)
+	return months
+}
+
+// CompletionProbability returns the share of runs that completed within the given number of months
+func CompletionProbability(completionMonths []int, within int) float64 {
+	if len(completionMonths) == 0 {
+		return 0
+	}
+	completed := sort.SearchInts(completionMonths, within+1)
+	return float64(completed) / float64(len(completionMonths))
+}
+
+// CompletionPercentile returns the p-th percentile completion month of sorted results,
+// and false if that share of runs did not complete within the horizon
+func CompletionPercentile(completionMonths []int, p int, horizonMonths int) (int, bool) {
+	if len(completionMonths) == 0 {
+		return 0, false
+	}
+	idx := len(completionMonths) * p / 100
+	if idx >= len(completionMonths) {
+		idx = len(completionMonths) - 1
+	}
+	month := completionMonths[idx]
+	return month, month <= horizonMonths
+}
+
+// RequiredMonthlyContribution finds the smallest expected monthly contribution for which the
+// goal completes within the given months in at least the given share of runs. The funded amount
+// of a run is linear in the contribution, so each run is simulated once to find the contribution
+// it needs, and the result is the matching quantile of those needs.
+func RequiredMonthlyContribution(funded, target float64, a ForecastAssumptions, within int, confidence float64, cfg ForecastConfig) float64 {
+	remaining := target - funded
+	if remaining <= 0 {
+		return 0
+	}
+	if within <= 0 {
+		return remaining
+	}
+	if cfg.Simulations <= 0 {
+		return remaining / float64(within)
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	needs := make([]float64, cfg.Simulations)
+	for i := range needs {
+		// After m months a run holds funded*growth + contribution*saved
+		growth, saved := 1.0, 0.0
+		needs[i] = math.Inf(1)
+		for m := 1; m <= within; m++ {
+			// Same draws, in the same order, as SimulateCompletionMonths
+			incomeFactor := math.Max(0, 1+a.IncomeVolatility*rng.NormFloat64())
+			contributionFactor := math.Max(0, 1+a.ContributionCV*rng.NormFloat64())
+			monthlyReturn := math.Max(-0.99, a.MonthlyReturn+a.MonthlyReturnStdDev*rng.NormFloat64())
+
+			growth *= 1 + monthlyReturn
+			saved = saved*(1+monthlyReturn) + incomeFactor*contributionFactor
+			shortfall := target - funded*growth
+			if shortfall <= 0 {
+				needs[i] = 0
+				break
+			}
+			if saved > 0 {
+				needs[i] = math.Min(needs[i], shortfall/saved)
+			}
+		}
+	}
+	sort.Float64s(needs)
+
+	idx := int(math.Ceil(confidence*float64(len(needs))-1e-9)) - 1
+	idx = max(0, min(idx, len(needs)-1))
+	need := needs[idx]
+	if limit := remaining / float64(within) * maxRequiredContributionMultiple; math.IsInf(need, 1) || need > limit {
+		return limit
+	}
+	// Leave headroom so rounding in the month-by-month simulation cannot leave the run just short
+	return need * (1 + 1e-9)
+}
+
+// MonthlyContributionStats measures net contributions per 30-day month over the recent history,
+// counting months without contributions. Returns the mean, std dev and number of months measured.
+func (g *Goal) MonthlyContributionStats(contributions []GoalContribution, now time.Time) (float64, float64, int) {
+	windowStart := now.AddDate(0, -contributionHistoryMonths, 0)
+	if g.StartDate.After(windowStart) {
+		windowStart = g.StartDate
+	}
+	months := int(math.Ceil(now.Sub(windowStart).Hours() / 24 / 30))
+	if months < 1 {
+		months = 1
+	}
+
+	buckets := make([]float64, months)
+	for i := range contributions {
+		c := &contributions[i]
+		if c.CreatedAt.Before(windowStart) || c.CreatedAt.After(now) {
+			continue
+		}
+		idx := int(c.CreatedAt.Sub(windowStart).Hours() / 24 / 30)
+		if idx >= months {
+			idx = months - 1
+		}
+		buckets[idx] += c.NetAmount()
+	}
+
+	var sum float64
+	for _, v := range buckets {
+		sum += v
+	}
+	mean := sum / float64(months)
+
+	var squares float64
+	for _, v := range buckets {
+		squares += (v - mean) * (v - mean)
+	}
+	stdDev := 0.0
+	if months > 1 {
+		stdDev = math.Sqrt(squares / float64(months-1))
+	}
+
+	return mean, stdDev, months
+}
+
+// MonthsUntilTarget returns the whole months from now until the target date, and false without one
+func (g *Goal) MonthsUntilTarget(now time.Time) (int, bool) {
+	if g.TargetDate == nil {
+		return 0, false
+	}
+	from := TruncateToDate(now)
+	months := (g.TargetDate.Year()-from.Year())*12 + int(g.TargetDate.Month()-from.Month())
+	if g.TargetDate.Day() < from.Day() {
+		months--
+	}
+	if months < 0 {
+		months = 0
+	}
+	return months, true
+}
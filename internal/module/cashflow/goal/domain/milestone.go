@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MilestoneStatus reports how a milestone is tracking against the goal's contribution pace
+type MilestoneStatus string
+
+const (
+	// MilestoneStatusAchieved - the goal's funded amount has reached the milestone
+	MilestoneStatusAchieved MilestoneStatus = "achieved"
+	// MilestoneStatusOnTrack - the current pace reaches the milestone by its target date
+	MilestoneStatusOnTrack MilestoneStatus = "on_track"
+	// MilestoneStatusBehind - the current pace misses the target date, or the date has passed
+	MilestoneStatusBehind MilestoneStatus = "behind"
+	// MilestoneStatusUnscheduled - the milestone has no target date to be measured against
+	MilestoneStatusUnscheduled MilestoneStatus = "unscheduled"
+)
+
+// milestonePaceWindowDays is how far back contributions count towards the pace
+const milestonePaceWindowDays = 90
+
+// minMilestonePaceDays keeps a few early contributions from being extrapolated too aggressively
+const minMilestonePaceDays = 30
+
+// GoalMilestone is an intermediate checkpoint on the way to a goal, set either as an
+// absolute funded amount or as a percentage of the goal's target amount
+type GoalMilestone struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	GoalID uuid.UUID `gorm:"type:uuid;not null;index;column:goal_id" json:"goal_id"`
+	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+
+	Label string `gorm:"type:varchar(255);not null;column:label" json:"label"`
+
+	// Exactly one of TargetAmount and TargetPercentage is set
+	TargetAmount     *float64 `gorm:"type:decimal(15,2);column:target_amount" json:"target_amount,omitempty"`
+	TargetPercentage *float64 `gorm:"type:decimal(5,2);column:target_percentage" json:"target_percentage,omitempty"`
+
+	TargetDate *time.Time `gorm:"type:date;column:target_date" json:"target_date,omitempty"`
+	RewardNote *string    `gorm:"type:text;column:reward_note" json:"reward_note,omitempty"`
+
+	// Set when the goal's funded amount crosses the milestone, cleared if it drops back below
+	AchievedAt *time.Time `gorm:"column:achieved_at" json:"achieved_at,omitempty"`
+
+	CreatedAt time.Time      `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index;column:deleted_at" json:"deleted_at,omitempty"`
+}
+
+// TableName returns the table name for GORM
+func (GoalMilestone) TableName() string {
+	return "goal_milestones"
+}
+
+// Validate checks the milestone against the goal it belongs to
+func (m *GoalMilestone) Validate(goal *Goal) error {
+	if m.Label == "" {
+		return errors.New("label is required")
+	}
+	if (m.TargetAmount == nil) == (m.TargetPercentage == nil) {
+		return errors.New("set either target amount or target percentage")
+	}
+	if m.TargetAmount != nil && (*m.TargetAmount <= 0 || *m.TargetAmount > goal.TargetAmount) {
+		return errors.New("target amount must be greater than 0 and at most the goal target amount")
+	}
+	if m.TargetPercentage != nil && (*m.TargetPercentage <= 0 || *m.TargetPercentage > 100) {
+		return errors.New("target percentage must be greater than 0 and at most 100")
+	}
+	if m.TargetDate != nil && goal.TargetDate != nil && m.TargetDate.After(*goal.TargetDate) {
+		return errors.New("target date cannot be after the goal target date")
+	}
+	return nil
+}
+
+// ThresholdAmount returns the funded amount at which the milestone is reached
+func (m *GoalMilestone) ThresholdAmount(goal *Goal) float64 {
+	if m.TargetAmount != nil {
+		return *m.TargetAmount
+	}
+	if m.TargetPercentage != nil {
+		return goal.TargetAmount * *m.TargetPercentage / 100
+	}
+	return 0
+}
+
+// IsReached checks if the goal's funded amount has reached the milestone
+func (m *GoalMilestone) IsReached(goal *Goal) bool {
+	return goal.FundedAmount() >= m.ThresholdAmount(goal)
+}
+
+// MilestoneCrossing records a milestone whose reached state changed
+type MilestoneCrossing struct {
+	Milestone *GoalMilestone
+	Reached   bool // false when a withdrawal dropped the goal back below the milestone
+}
+
+// SyncMilestones marks milestones achieved or un-achieved against the goal's funded amount
+// and returns those that changed
+func (g *Goal) SyncMilestones(milestones []GoalMilestone, now time.Time) []MilestoneCrossing {
+	var crossings []MilestoneCrossing
+	for i := range milestones {
+		m := &milestones[i]
+		reached := m.IsReached(g)
+		switch {
+		case reached && m.AchievedAt == nil:
+			achievedAt := now
+			m.AchievedAt = &achievedAt
+		case !reached && m.AchievedAt != nil:
+			m.AchievedAt = nil
+		default:
+			continue
+		}
+		crossings = append(crossings, MilestoneCrossing{Milestone: m, Reached: reached})
+	}
+	return crossings
+}
+
+// ContributionPace returns the goal's net contributions per 30 days over the recent window
+func (g *Goal) ContributionPace(contributions []GoalContribution, now time.Time) float64 {
+	windowStart := now.AddDate(0, 0, -milestonePaceWindowDays)
+	if g.StartDate.After(windowStart) {
+		windowStart = g.StartDate
+	}
+
+	var net float64
+	for i := range contributions {
+		if !contributions[i].CreatedAt.Before(windowStart) && !contributions[i].CreatedAt.After(now) {
+			net += contributions[i].NetAmount()
+		}
+	}
+
+	days := math.Max(now.Sub(windowStart).Hours()/24, minMilestonePaceDays)
+	return net / days * 30
+}
+
+// MilestoneAssessment is where a milestone stands against the goal's contribution pace
+type MilestoneAssessment struct {
+	Status          MilestoneStatus
+	ThresholdAmount float64
+	RemainingAmount float64
+	// When the current pace reaches the milestone; nil if achieved or the pace is not positive
+	ProjectedDate *time.Time
+	// Pace per 30 days needed to reach the milestone by its target date; nil without a future target date
+	RequiredPace *float64
+}
+
+// Assess projects the milestone against a pace of net contributions per 30 days
+func (m *GoalMilestone) Assess(goal *Goal, pace float64, now time.Time) MilestoneAssessment {
+	assessment := MilestoneAssessment{ThresholdAmount: m.ThresholdAmount(goal)}
+	assessment.RemainingAmount = math.Max(assessment.ThresholdAmount-goal.FundedAmount(), 0)
+
+	if assessment.RemainingAmount == 0 {
+		assessment.Status = MilestoneStatusAchieved
+		return assessment
+	}
+
+	if pace > 0 {
+		days := int(math.Ceil(assessment.RemainingAmount / pace * 30))
+		projected := TruncateToDate(now).AddDate(0, 0, days)
+		assessment.ProjectedDate = &projected
+	}
+
+	if m.TargetDate == nil {
+		assessment.Status = MilestoneStatusUnscheduled
+		return assessment
+	}
+
+	daysLeft := m.TargetDate.Sub(TruncateToDate(now)).Hours() / 24
+	if daysLeft <= 0 {
+		assessment.Status = MilestoneStatusBehind
+		return assessment
+	}
+	required := assessment.RemainingAmount / daysLeft * 30
+	assessment.RequiredPace = &required
+
+	if assessment.ProjectedDate != nil && !assessment.ProjectedDate.After(*m.TargetDate) {
+		assessment.Status = MilestoneStatusOnTrack
+	} else {
+		assessment.Status = MilestoneStatusBehind
+	}
+	return assessment
+}
+
+// LegacyMilestones converts the deprecated Milestones JSON into milestone records. Entries that
+// do not make a valid milestone for the goal, such as ones without an amount or percentage, are
+// dropped.
+func (g *Goal) LegacyMilestones() ([]GoalMilestone, error) {
+	if g.Milestones == nil || strings.TrimSpace(*g.Milestones) == "" {
+		return nil, nil
+	}
+
+	var legacy []Milestone
+	if err := json.Unmarshal([]byte(*g.Milestones), &legacy); err != nil {
+		return nil, fmt.Errorf("invalid legacy milestones: %w", err)
+	}
+
+	milestones := make([]GoalMilestone, 0, len(legacy))
+	for _, l := range legacy {
+		milestone := GoalMilestone{GoalID: g.ID, UserID: g.UserID, Label: strings.TrimSpace(l.Description)}
+		switch {
+		case l.Percentage > 0:
+			percentage := l.Percentage
+			milestone.TargetPercentage = &percentage
+			if milestone.Label == "" {
+				milestone.Label = fmt.Sprintf("%g%% of target", percentage)
+			}
+		case l.Amount > 0:
+			amount := l.Amount
+			milestone.TargetAmount = &amount
+			if milestone.Label == "" {
+				milestone.Label = fmt.Sprintf("%.0f %s saved", amount, g.Currency)
+			}
+		}
+		if l.Achieved {
+			achievedAt := g.UpdatedAt
+			if l.AchievedAt != nil {
+				achievedAt = *l.AchievedAt
+			}
+			milestone.AchievedAt = &achievedAt
+		}
+
+		if milestone.Validate(g) != nil {
+			continue
+		}
+		milestones = append(milestones, milestone)
+	}
+	return milestones, nil
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// IsSinkingFund reports whether the goal saves for an expense that is due every year
+func (g *Goal) IsSinkingFund() bool {
+	return g.SinkingFund && g.Behavior == GoalBehaviorRecurring
+}
+
+// ValidateSinkingFund checks the settings a sinking fund needs
+func (g *Goal) ValidateSinkingFund() error {
+	if !g.SinkingFund {
+		return nil
+	}
+	if g.Behavior != GoalBehaviorRecurring {
+		return errors.New("sinking funds must use the recurring behavior")
+	}
+	if g.TargetDate == nil {
+		return errors.New("sinking funds require a due date or a calendar event")
+	}
+	return nil
+}
+
+// MonthlySetAside returns what must be put aside each month to have the yearly amount by the
+// next due date; everything still missing is due this month once less than a month remains
+func (g *Goal) MonthlySetAside(now time.Time) float64 {
+	if !g.IsSinkingFund() || g.TargetDate == nil {
+		return 0
+	}
+	remaining := g.TargetAmount - g.FundedAmount()
+	if remaining <= 0 {
+		return 0
+	}
+	months, _ := g.MonthsUntilTarget(now)
+	if months < 1 {
+		return remaining
+	}
+	return remaining / float64(months)
+}
+
+// ResetSinkingFund starts the next cycle once the expense has been paid. Any balance left
+// over after paying is carried into the new cycle.
+func (g *Goal) ResetSinkingFund(paidAt, nextDue time.Time) {
+	g.LastPaidAt = &paidAt
+	g.TargetDate = &nextDue
+	g.Status = GoalStatusActive
+	g.CompletedAt = nil
+	g.UpdateCalculatedFields()
+}
+
+// NextYearlyOccurrence returns the first anniversary of date on or after from
+func NextYearlyOccurrence(date, from time.Time) time.Time {
+	years := from.Year() - date.Year() - 1
+	if years < 0 {
+		years = 0
+	}
+	next := date.AddDate(years, 0, 0)
+	for next.Before(from) {
+		years++
+		next = date.AddDate(years, 0, 0)
+	}
+	return next
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxCoolingOffDays bounds how long a wishlist item can be made to wait
+const MaxCoolingOffDays = 365
+
+// wishlistBudgetNamespace scopes the budget IDs derived from wishlist goal IDs
+var wishlistBudgetNamespace = uuid.MustParse("6f1b2c0e-5d4a-4c8e-9a31-2b7e8d0f4a65")
+
+// IsWishlistItem reports whether the goal is a planned purchase with a cooling-off period
+func (g *Goal) IsWishlistItem() bool {
+	return g.Behavior == GoalBehaviorWilling && g.CoolingOffUntil != nil
+}
+
+// SetCoolingOff makes the goal a wishlist item whose cooling-off period runs the given days from its start date
+func (g *Goal) SetCoolingOff(days int) {
+	until := TruncateToDate(g.StartDate).AddDate(0, 0, days)
+	g.CoolingOffDays = days
+	g.CoolingOffUntil = &until
+	g.CoolingOffNotifiedAt = nil
+}
+
+// ValidateWishlist checks the settings a wishlist item needs
+func (g *Goal) ValidateWishlist() error {
+	if g.CoolingOffUntil == nil {
+		return nil
+	}
+	if g.Behavior != GoalBehaviorWilling {
+		return errors.New("wishlist items must use the willing behavior")
+	}
+	if g.CoolingOffDays < 1 || g.CoolingOffDays > MaxCoolingOffDays {
+		return fmt.Errorf("cooling-off period must be between 1 and %d days", MaxCoolingOffDays)
+	}
+	if g.WishlistDecision != nil && !g.WishlistDecision.IsValid() {
+		return fmt.Errorf("invalid wishlist decision: %s", *g.WishlistDecision)
+	}
+	return nil
+}
+
+// AwaitingDecision reports whether the wishlist item has not been converted or cancelled yet
+func (g *Goal) AwaitingDecision() bool {
+	return g.IsWishlistItem() && g.WishlistDecision == nil
+}
+
+// IsCoolingOff reports whether the item's cooling-off period is still running
+func (g *Goal) IsCoolingOff(now time.Time) bool {
+	return g.AwaitingDecision() && TruncateToDate(now).Before(*g.CoolingOffUntil)
+}
+
+// CanConvertToBudget checks that the item is undecided and has waited out its cooling-off period
+func (g *Goal) CanConvertToBudget(now time.Time) error {
+	if !g.IsWishlistItem() {
+		return errors.New("goal is not a wishlist item")
+	}
+	if g.WishlistDecision != nil {
+		return fmt.Errorf("wishlist item was already %s", *g.WishlistDecision)
+	}
+	if g.IsCoolingOff(now) {
+		return fmt.Errorf("cooling-off period runs until %s", g.CoolingOffUntil.Format("2006-01-02"))
+	}
+	return nil
+}
+
+// CanCancelWishlist checks that the item is undecided; it may be dropped during its cooling-off period
+func (g *Goal) CanCancelWishlist() error {
+	if !g.IsWishlistItem() {
+		return errors.New("goal is not a wishlist item")
+	}
+	if g.WishlistDecision != nil {
+		return fmt.Errorf("wishlist item was already %s", *g.WishlistDecision)
+	}
+	return nil
+}
+
+// BudgetAmount is what the converted budget holds: the amount saved, or the price if nothing was saved
+func (g *Goal) BudgetAmount() float64 {
+	if g.CurrentAmount > 0 {
+		return g.CurrentAmount
+	}
+	return g.TargetAmount
+}
+
+// WishlistBudgetID returns the ID of the budget the item converts into. It is derived from the goal
+// ID so a retried conversion finds the budget an earlier attempt created instead of adding another.
+func (g *Goal) WishlistBudgetID() uuid.UUID {
+	return uuid.NewSHA1(wishlistBudgetNamespace, g.ID[:])
+}
+
+// MarkConverted records the item as still wanted and turned into the given budget
+func (g *Goal) MarkConverted(budgetID uuid.UUID, now time.Time) {
+	decision := WishlistConverted
+	g.WishlistDecision = &decision
+	g.DecidedAt = &now
+	g.ConvertedBudgetID = &budgetID
+	g.Status = GoalStatusCompleted
+	g.CompletedAt = &now
+}
+
+// MarkWishlistCancelled records the purchase as dropped and archives the item
+func (g *Goal) MarkWishlistCancelled(now time.Time) {
+	decision := WishlistCancelled
+	g.WishlistDecision = &decision
+	g.DecidedAt = &now
+	g.Status = GoalStatusArchived
+}
+
+// AmountNotSpent is the price of a cancelled wishlist item, i.e. the money the dropped purchase did not cost
+func (g *Goal) AmountNotSpent() float64 {
+	if g.WishlistDecision == nil || *g.WishlistDecision != WishlistCancelled {
+		return 0
+	}
+	return g.TargetAmount
+}
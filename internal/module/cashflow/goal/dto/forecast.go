@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ForecastAssumptions are the inputs a goal forecast simulated
+type ForecastAssumptions struct {
+	MonthlyContribution float64 `json:"monthlyContribution"`
+	ContributionCV      float64 `json:"contributionCv"` // Month-to-month contribution variability
+	// "history" when measured from past contributions, otherwise "auto_contribute", "suggested" or "none"
+	ContributionSource string  `json:"contributionSource"`
+	HistoryMonths      int     `json:"historyMonths"`
+	IncomeVolatility   float64 `json:"incomeVolatility"`
+	IncomeStability    *string `json:"incomeStability,omitempty"`
+	// Annual expected return and volatility: the risk tolerance returns for investment and
+	// retirement goals, the term deposit yield for other savings goals of users holding deposits
+	ExpectedAnnualReturn   *float64 `json:"expectedAnnualReturn,omitempty"`
+	AnnualReturnVolatility *float64 `json:"annualReturnVolatility,omitempty"`
+	// "risk_tolerance" or "term_deposits"; empty when no return is simulated
+	ReturnSource string `json:"returnSource,omitempty"`
+}
+
+// RequiredContribution is the monthly contribution needed to finish by the target date at a confidence level
+type RequiredContribution struct {
+	Confidence          float64 `json:"confidence"`
+	MonthlyContribution float64 `json:"monthlyContribution"`
+}
+
+// GoalForecast is a probabilistic completion forecast for a goal
+type GoalForecast struct {
+	GoalID        uuid.UUID  `json:"goalId"`
+	FundedAmount  float64    `json:"fundedAmount"`
+	TargetAmount  float64    `json:"targetAmount"`
+	TargetDate    *time.Time `json:"targetDate,omitempty"`
+	Simulations   int        `json:"simulations"`
+	HorizonMonths int        `json:"horizonMonths"`
+
+	Assumptions ForecastAssumptions `json:"assumptions"`
+
+	// Probability of completing by the target date; nil without a target date
+	ProbabilityByTargetDate *float64 `json:"probabilityByTargetDate,omitempty"`
+
+	// Completion date percentiles; nil when that share of runs does not finish within the horizon
+	CompletionDateP10 *time.Time `json:"completionDateP10,omitempty"`
+	CompletionDateP50 *time.Time `json:"completionDateP50,omitempty"`
+	CompletionDateP90 *time.Time `json:"completionDateP90,omitempty"`
+
+	// Monthly contribution needed to finish by the target date at 80% and 90% confidence
+	RequiredContributions []RequiredContribution `json:"requiredContributions,omitempty"`
+}
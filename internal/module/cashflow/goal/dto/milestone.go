@@ -0,0 +1,113 @@
+package dto
+
+import (
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateMilestoneRequest represents a request to add a milestone to a goal
+type CreateMilestoneRequest struct {
+	Label string `json:"label" binding:"required,max=255"`
+
+	// Set exactly one of targetAmount and targetPercentage
+	TargetAmount     *float64 `json:"targetAmount" binding:"omitempty,gt=0"`
+	TargetPercentage *float64 `json:"targetPercentage" binding:"omitempty,gt=0,lte=100"`
+
+	TargetDate *time.Time `json:"targetDate"`
+	RewardNote *string    `json:"rewardNote"`
+}
+
+// UpdateMilestoneRequest represents a request to update a goal milestone.
+// Setting targetAmount clears targetPercentage and vice versa.
+type UpdateMilestoneRequest struct {
+	Label *string `json:"label" binding:"omitempty,max=255"`
+
+	TargetAmount     *float64 `json:"targetAmount" binding:"omitempty,gt=0"`
+	TargetPercentage *float64 `json:"targetPercentage" binding:"omitempty,gt=0,lte=100"`
+
+	TargetDate *time.Time `json:"targetDate"`
+	RewardNote *string    `json:"rewardNote"`
+}
+
+// ApplyTo applies the update request fields to the milestone domain object
+func (req *UpdateMilestoneRequest) ApplyTo(milestone *domain.GoalMilestone) {
+	if req.Label != nil {
+		milestone.Label = *req.Label
+	}
+	if req.TargetAmount != nil {
+		milestone.TargetAmount = req.TargetAmount
+		milestone.TargetPercentage = nil
+	}
+	if req.TargetPercentage != nil {
+		milestone.TargetPercentage = req.TargetPercentage
+		milestone.TargetAmount = nil
+	}
+	if req.TargetDate != nil {
+		milestone.TargetDate = req.TargetDate
+	}
+	if req.RewardNote != nil {
+		milestone.RewardNote = req.RewardNote
+	}
+}
+
+// MilestoneResponse represents a goal milestone in API responses
+type MilestoneResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	GoalID           uuid.UUID  `json:"goalId"`
+	Label            string     `json:"label"`
+	TargetAmount     *float64   `json:"targetAmount,omitempty"`
+	TargetPercentage *float64   `json:"targetPercentage,omitempty"`
+	TargetDate       *time.Time `json:"targetDate,omitempty"`
+	RewardNote       *string    `json:"rewardNote,omitempty"`
+	AchievedAt       *time.Time `json:"achievedAt,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+// ToMilestoneResponse converts a milestone to a response DTO
+func ToMilestoneResponse(milestone *domain.GoalMilestone) *MilestoneResponse {
+	return &MilestoneResponse{
+		ID:               milestone.ID,
+		GoalID:           milestone.GoalID,
+		Label:            milestone.Label,
+		TargetAmount:     milestone.TargetAmount,
+		TargetPercentage: milestone.TargetPercentage,
+		TargetDate:       milestone.TargetDate,
+		RewardNote:       milestone.RewardNote,
+		AchievedAt:       milestone.AchievedAt,
+		CreatedAt:        milestone.CreatedAt,
+		UpdatedAt:        milestone.UpdatedAt,
+	}
+}
+
+// MilestoneProgress is a milestone with where it stands against the goal's contribution pace
+type MilestoneProgress struct {
+	MilestoneResponse
+	Status          domain.MilestoneStatus `json:"status"` // achieved, on_track, behind, unscheduled
+	ThresholdAmount float64                `json:"thresholdAmount"`
+	RemainingAmount float64                `json:"remainingAmount"`
+	ProjectedDate   *time.Time             `json:"projectedDate,omitempty"`
+	RequiredPace    *float64               `json:"requiredPace,omitempty"` // Per 30 days, to reach it by the target date
+}
+
+// GoalMilestonesProgress reports every milestone of a goal
+type GoalMilestonesProgress struct {
+	GoalID           uuid.UUID           `json:"goalId"`
+	FundedAmount     float64             `json:"fundedAmount"`
+	ContributionPace float64             `json:"contributionPace"` // Net contributions per 30 days over the last 90 days
+	Milestones       []MilestoneProgress `json:"milestones"`
+}
+
+// ToMilestoneProgress converts a milestone and its assessment to a response DTO
+func ToMilestoneProgress(milestone *domain.GoalMilestone, assessment domain.MilestoneAssessment) MilestoneProgress {
+	return MilestoneProgress{
+		MilestoneResponse: *ToMilestoneResponse(milestone),
+		Status:            assessment.Status,
+		ThresholdAmount:   assessment.ThresholdAmount,
+		RemainingAmount:   assessment.RemainingAmount,
+		ProjectedDate:     assessment.ProjectedDate,
+		RequiredPace:      assessment.RequiredPace,
+	}
+}
@@ -27,6 +27,17 @@ type CreateGoalRequest struct {
 	AutoContribute          bool                          `json:"autoContribute"`
 	AutoContributeAmount    *float64                      `json:"autoContributeAmount"`
 	AutoContributeAccountID *uuid.UUID                    `json:"autoContributeAccountId"`
+	// Lowest balance auto-contributions may leave in the source account
+	AutoContributeMinBalance *float64                        `json:"autoContributeMinBalance" binding:"omitempty,gte=0"`
+	AutoContributeShortfall  *domain.AutoContributeShortfall `json:"autoContributeShortfall" binding:"omitempty,oneof=reduce skip"`
+
+	// Sinking funds are recurring goals for a yearly expense: TargetAmount is the yearly amount and
+	// TargetDate the next due date, taken from DueEventID when a calendar event is linked
+	SinkingFund bool       `json:"sinkingFund"`
+	DueEventID  *uuid.UUID `json:"dueEventId"`
+
+	// Makes a willing goal a wishlist item that can only become a budget after this many days
+	CoolingOffDays *int `json:"coolingOffDays" binding:"omitempty,gte=1,lte=365"`
 
 	AccountID uuid.UUID `json:"accountId" binding:"required"` // Required, must be cash/bank/savings account
 
@@ -50,10 +61,17 @@ type UpdateGoalRequest struct {
 	StartDate  *time.Time `json:"startDate"`
 	TargetDate *time.Time `json:"targetDate"`
 
-	ContributionFrequency   *domain.ContributionFrequency `json:"contributionFrequency"`
-	AutoContribute          *bool                         `json:"autoContribute"`
-	AutoContributeAmount    *float64                      `json:"autoContributeAmount"`
-	AutoContributeAccountID *uuid.UUID                    `json:"autoContributeAccountId"`
+	ContributionFrequency    *domain.ContributionFrequency   `json:"contributionFrequency"`
+	AutoContribute           *bool                           `json:"autoContribute"`
+	AutoContributeAmount     *float64                        `json:"autoContributeAmount"`
+	AutoContributeAccountID  *uuid.UUID                      `json:"autoContributeAccountId"`
+	AutoContributeMinBalance *float64                        `json:"autoContributeMinBalance" binding:"omitempty,gte=0"`
+	AutoContributeShortfall  *domain.AutoContributeShortfall `json:"autoContributeShortfall" binding:"omitempty,oneof=reduce skip"`
+
+	SinkingFund *bool      `json:"sinkingFund"`
+	DueEventID  *uuid.UUID `json:"dueEventId"`
+
+	CoolingOffDays *int `json:"coolingOffDays" binding:"omitempty,gte=1,lte=365"`
 
 	AccountID *uuid.UUID `json:"accountId"`
 
@@ -81,6 +99,23 @@ type WithdrawContributionRequest struct {
 	ReversingContributionID *uuid.UUID `json:"reversingContributionId"` // Optional: reference to the original contribution
 }
 
+// SinkingFundPaymentRequest records a sinking fund's yearly expense as paid
+type SinkingFundPaymentRequest struct {
+	Amount float64    `json:"amount" binding:"required,gt=0"`
+	PaidAt *time.Time `json:"paidAt"` // Defaults to now
+	Note   *string    `json:"note"`
+}
+
+// ConvertWishlistRequest turns a wishlist item that has waited out its cooling-off period into a budget
+type ConvertWishlistRequest struct {
+	CategoryID *uuid.UUID `json:"categoryId"` // Optional: category the purchase will be booked under
+}
+
+// WishlistPurchaseRequest links the transaction a converted wishlist item was bought with
+type WishlistPurchaseRequest struct {
+	TransactionID uuid.UUID `json:"transactionId" binding:"required"`
+}
+
 // ApplyTo applies the update request fields to the goal domain object
 func (req *UpdateGoalRequest) ApplyTo(goal *domain.Goal) {
 	if req.Name != nil {
@@ -119,6 +154,21 @@ func (req *UpdateGoalRequest) ApplyTo(goal *domain.Goal) {
 	if req.AutoContributeAccountID != nil {
 		goal.AutoContributeAccountID = req.AutoContributeAccountID
 	}
+	if req.AutoContributeMinBalance != nil {
+		goal.AutoContributeMinBalance = req.AutoContributeMinBalance
+	}
+	if req.AutoContributeShortfall != nil {
+		goal.AutoContributeShortfall = req.AutoContributeShortfall
+	}
+	if req.SinkingFund != nil {
+		goal.SinkingFund = *req.SinkingFund
+	}
+	if req.DueEventID != nil {
+		goal.DueEventID = req.DueEventID
+	}
+	if req.CoolingOffDays != nil {
+		goal.SetCoolingOff(*req.CoolingOffDays)
+	}
 	if req.AccountID != nil {
 		goal.AccountID = *req.AccountID
 	}
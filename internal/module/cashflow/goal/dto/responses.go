@@ -9,8 +9,9 @@ import (
 
 // GoalResponse represents a goal in API responses
 type GoalResponse struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"userId"`
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"userId"`
+	WorkspaceID *uuid.UUID `json:"workspaceId,omitempty"`
 
 	Name        string              `json:"name"`
 	Description *string             `json:"description,omitempty"`
@@ -20,7 +21,9 @@ type GoalResponse struct {
 
 	TargetAmount  float64 `json:"targetAmount"`
 	CurrentAmount float64 `json:"currentAmount"`
-	Currency      string  `json:"currency"`
+	// Value of manual assets earmarked for the goal, counted toward progress
+	AssetFundedAmount float64 `json:"assetFundedAmount"`
+	Currency          string  `json:"currency"`
 
 	StartDate   time.Time  `json:"startDate"`
 	TargetDate  *time.Time `json:"targetDate,omitempty"`
@@ -31,11 +34,26 @@ type GoalResponse struct {
 	Status             domain.GoalStatus `json:"status"`
 	DaysRemaining      int               `json:"daysRemaining"`
 
-	SuggestedContribution   *float64                      `json:"suggestedContribution,omitempty"`
-	ContributionFrequency   *domain.ContributionFrequency `json:"contributionFrequency,omitempty"`
-	AutoContribute          bool                          `json:"autoContribute"`
-	AutoContributeAmount    *float64                      `json:"autoContributeAmount,omitempty"`
-	AutoContributeAccountID *uuid.UUID                    `json:"autoContributeAccountId,omitempty"`
+	SuggestedContribution    *float64                        `json:"suggestedContribution,omitempty"`
+	ContributionFrequency    *domain.ContributionFrequency   `json:"contributionFrequency,omitempty"`
+	AutoContribute           bool                            `json:"autoContribute"`
+	AutoContributeAmount     *float64                        `json:"autoContributeAmount,omitempty"`
+	AutoContributeAccountID  *uuid.UUID                      `json:"autoContributeAccountId,omitempty"`
+	AutoContributeMinBalance *float64                        `json:"autoContributeMinBalance,omitempty"`
+	AutoContributeShortfall  *domain.AutoContributeShortfall `json:"autoContributeShortfall,omitempty"`
+	NextAutoContributeAt     *time.Time                      `json:"nextAutoContributeAt,omitempty"`
+
+	SinkingFund bool       `json:"sinkingFund"`
+	DueEventID  *uuid.UUID `json:"dueEventId,omitempty"`
+	LastPaidAt  *time.Time `json:"lastPaidAt,omitempty"`
+	// Amount to put aside each month to have the yearly expense by its due date; sinking funds only
+	MonthlySetAside *float64 `json:"monthlySetAside,omitempty"`
+
+	CoolingOffDays        int                      `json:"coolingOffDays,omitempty"`
+	CoolingOffUntil       *time.Time               `json:"coolingOffUntil,omitempty"`
+	WishlistDecision      *domain.WishlistDecision `json:"wishlistDecision,omitempty"`
+	DecidedAt             *time.Time               `json:"decidedAt,omitempty"`
+	PurchaseTransactionID *uuid.UUID               `json:"purchaseTransactionId,omitempty"`
 
 	AccountID         uuid.UUID  `json:"accountId"`
 	ConvertedBudgetID *uuid.UUID `json:"convertedBudgetId,omitempty"`
@@ -59,40 +77,60 @@ func ToGoalResponse(goal *domain.Goal) *GoalResponse {
 		return nil
 	}
 
-	return &GoalResponse{
-		ID:                      goal.ID,
-		UserID:                  goal.UserID,
-		Name:                    goal.Name,
-		Description:             goal.Description,
-		Behavior:                goal.Behavior,
-		Category:                goal.Category,
-		Priority:                goal.Priority,
-		TargetAmount:            goal.TargetAmount,
-		CurrentAmount:           goal.CurrentAmount,
-		Currency:                goal.Currency,
-		StartDate:               goal.StartDate,
-		TargetDate:              goal.TargetDate,
-		CompletedAt:             goal.CompletedAt,
-		PercentageComplete:      goal.PercentageComplete,
-		RemainingAmount:         goal.RemainingAmount,
-		Status:                  goal.Status,
-		DaysRemaining:           goal.DaysRemaining(),
-		SuggestedContribution:   goal.SuggestedContribution,
-		ContributionFrequency:   goal.ContributionFrequency,
-		AutoContribute:          goal.AutoContribute,
-		AutoContributeAmount:    goal.AutoContributeAmount,
-		AutoContributeAccountID: goal.AutoContributeAccountID,
-		AccountID:               goal.AccountID,
-		ConvertedBudgetID:       goal.ConvertedBudgetID,
-		EnableReminders:         goal.EnableReminders,
-		ReminderFrequency:       goal.ReminderFrequency,
-		LastReminderSentAt:      goal.LastReminderSentAt,
-		Milestones:              goal.Milestones,
-		Notes:                   goal.Notes,
-		Tags:                    goal.Tags,
-		CreatedAt:               goal.CreatedAt,
-		UpdatedAt:               goal.UpdatedAt,
+	response := &GoalResponse{
+		ID:                       goal.ID,
+		UserID:                   goal.UserID,
+		WorkspaceID:              goal.WorkspaceID,
+		Name:                     goal.Name,
+		Description:              goal.Description,
+		Behavior:                 goal.Behavior,
+		Category:                 goal.Category,
+		Priority:                 goal.Priority,
+		TargetAmount:             goal.TargetAmount,
+		CurrentAmount:            goal.CurrentAmount,
+		AssetFundedAmount:        goal.AssetFundedAmount,
+		Currency:                 goal.Currency,
+		StartDate:                goal.StartDate,
+		TargetDate:               goal.TargetDate,
+		CompletedAt:              goal.CompletedAt,
+		PercentageComplete:       goal.PercentageComplete,
+		RemainingAmount:          goal.RemainingAmount,
+		Status:                   goal.Status,
+		DaysRemaining:            goal.DaysRemaining(),
+		SuggestedContribution:    goal.SuggestedContribution,
+		ContributionFrequency:    goal.ContributionFrequency,
+		AutoContribute:           goal.AutoContribute,
+		AutoContributeAmount:     goal.AutoContributeAmount,
+		AutoContributeAccountID:  goal.AutoContributeAccountID,
+		AutoContributeMinBalance: goal.AutoContributeMinBalance,
+		AutoContributeShortfall:  goal.AutoContributeShortfall,
+		NextAutoContributeAt:     goal.NextAutoContributeAt,
+		SinkingFund:              goal.SinkingFund,
+		DueEventID:               goal.DueEventID,
+		LastPaidAt:               goal.LastPaidAt,
+		CoolingOffDays:           goal.CoolingOffDays,
+		CoolingOffUntil:          goal.CoolingOffUntil,
+		WishlistDecision:         goal.WishlistDecision,
+		DecidedAt:                goal.DecidedAt,
+		PurchaseTransactionID:    goal.PurchaseTransactionID,
+		AccountID:                goal.AccountID,
+		ConvertedBudgetID:        goal.ConvertedBudgetID,
+		EnableReminders:          goal.EnableReminders,
+		ReminderFrequency:        goal.ReminderFrequency,
+		LastReminderSentAt:       goal.LastReminderSentAt,
+		Milestones:               goal.Milestones,
+		Notes:                    goal.Notes,
+		Tags:                     goal.Tags,
+		CreatedAt:                goal.CreatedAt,
+		UpdatedAt:                goal.UpdatedAt,
+	}
+
+	if goal.IsSinkingFund() {
+		setAside := goal.MonthlySetAside(time.Now())
+		response.MonthlySetAside = &setAside
 	}
+
+	return response
 }
 
 // ToGoalResponseList converts a list of domain goals to response DTOs
@@ -153,6 +191,7 @@ type ContributionResponse struct {
 	Source string  `json:"source"`
 
 	ReversingContributionID *uuid.UUID `json:"reversingContributionId,omitempty"`
+	TransactionID           *uuid.UUID `json:"transactionId,omitempty"`
 
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -182,6 +221,7 @@ func ToContributionResponse(contribution *domain.GoalContribution) *Contribution
 		Note:                    contribution.Note,
 		Source:                  contribution.Source,
 		ReversingContributionID: contribution.ReversingContributionID,
+		TransactionID:           contribution.TransactionID,
 		CreatedAt:               contribution.CreatedAt,
 	}
 }
@@ -207,3 +247,39 @@ func ToContributionResponseList(contributions []domain.GoalContribution) *Contri
 		NetAmount:        totalDeposits - totalWithdrawals,
 	}
 }
+
+// AutoContributionRunResponse represents one scheduled auto-contribution in API responses
+type AutoContributionRunResponse struct {
+	ID              uuid.UUID                     `json:"id"`
+	GoalID          uuid.UUID                     `json:"goalId"`
+	DueDate         time.Time                     `json:"dueDate"`
+	Status          domain.AutoContributionStatus `json:"status"`
+	RequestedAmount float64                       `json:"requestedAmount"`
+	Amount          float64                       `json:"amount"`
+	SourceAccountID uuid.UUID                     `json:"sourceAccountId"`
+	ContributionID  *uuid.UUID                    `json:"contributionId,omitempty"`
+	Reason          *string                       `json:"reason,omitempty"`
+	Attempts        int                           `json:"attempts"`
+	UpdatedAt       time.Time                     `json:"updatedAt"`
+}
+
+// ToAutoContributionRunResponses converts auto-contribution runs to response DTOs
+func ToAutoContributionRunResponses(runs []domain.AutoContributionRun) []AutoContributionRunResponse {
+	responses := make([]AutoContributionRunResponse, 0, len(runs))
+	for _, run := range runs {
+		responses = append(responses, AutoContributionRunResponse{
+			ID:              run.ID,
+			GoalID:          run.GoalID,
+			DueDate:         run.DueDate,
+			Status:          run.Status,
+			RequestedAmount: run.RequestedAmount,
+			Amount:          run.Amount,
+			SourceAccountID: run.SourceAccountID,
+			ContributionID:  run.ContributionID,
+			Reason:          run.Reason,
+			Attempts:        run.Attempts,
+			UpdatedAt:       run.UpdatedAt,
+		})
+	}
+	return responses
+}
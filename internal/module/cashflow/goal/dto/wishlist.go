@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+
+	"github.com/google/uuid"
+)
+
+// WishlistItem is one planned purchase in the wishlist report
+type WishlistItem struct {
+	GoalID          uuid.UUID                `json:"goalId"`
+	Name            string                   `json:"name"`
+	TargetAmount    float64                  `json:"targetAmount"`
+	SavedAmount     float64                  `json:"savedAmount"`
+	Currency        string                   `json:"currency"`
+	CoolingOffUntil time.Time                `json:"coolingOffUntil"`
+	CoolingOff      bool                     `json:"coolingOff"` // Still waiting out its cooling-off period
+	Decision        *domain.WishlistDecision `json:"decision,omitempty"`
+	DecidedAt       *time.Time               `json:"decidedAt,omitempty"`
+	// Set once converted; PurchaseTransactionID once the purchase was linked
+	ConvertedBudgetID     *uuid.UUID `json:"convertedBudgetId,omitempty"`
+	PurchaseTransactionID *uuid.UUID `json:"purchaseTransactionId,omitempty"`
+}
+
+// WishlistMonthSavings is what the purchases cancelled in one month would have cost
+type WishlistMonthSavings struct {
+	Month          string  `json:"month"` // YYYY-MM
+	CancelledCount int     `json:"cancelledCount"`
+	AmountNotSpent float64 `json:"amountNotSpent"`
+}
+
+// WishlistReport summarizes the wishlist and how much cancelled impulse buys saved
+type WishlistReport struct {
+	CoolingOffCount       int `json:"coolingOffCount"`
+	AwaitingDecisionCount int `json:"awaitingDecisionCount"` // Cooling-off ended, not decided yet
+	ConvertedCount        int `json:"convertedCount"`
+	PurchasedCount        int `json:"purchasedCount"` // Converted items with a linked purchase
+	CancelledCount        int `json:"cancelledCount"`
+
+	// Price of every cancelled purchase: money not spent on impulse buys
+	AmountNotSpent  float64                `json:"amountNotSpent"`
+	ConvertedAmount float64                `json:"convertedAmount"` // Total moved into budgets
+	SavingsByMonth  []WishlistMonthSavings `json:"savingsByMonth"`  // Oldest month first
+
+	Items []WishlistItem `json:"items"`
+}
@@ -10,7 +10,8 @@ import (
 	"go.uber.org/fx"
 )
 
-// Module provides goal module dependencies
+// Module provides goal module dependencies. The auto-contribution and cooling-off jobs run on
+// the notification scheduler through the scheduled_jobs group.
 var Module = fx.Module("goal",
 	fx.Provide(
 		// Repository - provide as interface
@@ -27,6 +28,16 @@ var Module = fx.Module("goal",
 
 		// Handler
 		handler.NewHandler,
+
+		// Scheduled jobs
+		fx.Annotate(
+			service.NewAutoContributionJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
+		fx.Annotate(
+			service.NewCoolingOffJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
 	),
 	fx.Invoke(registerGoalRoutes),
 )
@@ -24,7 +24,7 @@ import (
 // @Failure 500 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id}/archive [put]
 func (h *Handler) ArchiveGoal(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -37,6 +37,11 @@ func (h *Handler) ArchiveGoal(c *gin.Context) {
 		return
 	}
 
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
 	if err := h.service.ArchiveGoal(c.Request.Context(), id); err != nil {
 		shared.HandleError(c, err)
 		return
@@ -58,7 +63,7 @@ func (h *Handler) ArchiveGoal(c *gin.Context) {
 // @Failure 500 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id}/unarchive [put]
 func (h *Handler) UnarchiveGoal(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -71,6 +76,11 @@ func (h *Handler) UnarchiveGoal(c *gin.Context) {
 		return
 	}
 
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
 	if err := h.service.UnarchiveGoal(c.Request.Context(), id); err != nil {
 		shared.HandleError(c, err)
 		return
@@ -38,6 +38,7 @@ func (h *Handler) RegisterRoutes(router *gin.Engine, authMiddleware *middleware.
 		goals.GET("/completed", h.GetCompletedGoals)
 		goals.GET("/archived", h.GetArchivedGoals)
 		goals.GET("/summary", h.GetGoalSummary)
+		goals.GET("/wishlist/report", h.GetWishlistReport)
 		goals.GET("/:id", h.GetGoalByID)
 		goals.PUT("/:id", h.UpdateGoal)
 		goals.PUT("/:id/archive", h.ArchiveGoal)
@@ -46,6 +47,16 @@ func (h *Handler) RegisterRoutes(router *gin.Engine, authMiddleware *middleware.
 		goals.POST("/:id/contribute", h.AddContribution)
 		goals.POST("/:id/withdraw", h.WithdrawContribution)
 		goals.GET("/:id/contributions", h.GetContributions)
+		goals.GET("/:id/auto-contributions", h.GetAutoContributionRuns)
+		goals.POST("/:id/milestones", h.CreateMilestone)
+		goals.GET("/:id/milestones", h.GetMilestones)
+		goals.PUT("/:id/milestones/:milestoneId", h.UpdateMilestone)
+		goals.DELETE("/:id/milestones/:milestoneId", h.DeleteMilestone)
+		goals.GET("/:id/forecast", h.ForecastGoal)
+		goals.POST("/:id/sinking-fund/payments", h.RecordSinkingFundPayment)
+		goals.POST("/:id/wishlist/convert", h.ConvertWishlistItem)
+		goals.POST("/:id/wishlist/cancel", h.CancelWishlistItem)
+		goals.POST("/:id/wishlist/purchase", h.LinkWishlistPurchase)
 		goals.POST("/:id/complete", h.MarkAsCompleted)
 	}
 }
@@ -77,25 +88,32 @@ func (h *Handler) CreateGoal(c *gin.Context) {
 	}
 
 	goal := &domain.Goal{
-		UserID:                  user.ID,
-		Name:                    req.Name,
-		Description:             req.Description,
-		Behavior:                req.Behavior,
-		Category:                req.Category,
-		Priority:                req.Priority,
-		TargetAmount:            req.TargetAmount,
-		Currency:                req.Currency,
-		StartDate:               req.StartDate,
-		TargetDate:              req.TargetDate,
-		ContributionFrequency:   req.ContributionFrequency,
-		AutoContribute:          req.AutoContribute,
-		AutoContributeAmount:    req.AutoContributeAmount,
-		AutoContributeAccountID: req.AutoContributeAccountID,
-		AccountID:               req.AccountID,
-		EnableReminders:         req.EnableReminders,
-		ReminderFrequency:       req.ReminderFrequency,
-		Notes:                   req.Notes,
-		Tags:                    req.Tags,
+		UserID:                   user.ID,
+		Name:                     req.Name,
+		Description:              req.Description,
+		Behavior:                 req.Behavior,
+		Category:                 req.Category,
+		Priority:                 req.Priority,
+		TargetAmount:             req.TargetAmount,
+		Currency:                 req.Currency,
+		StartDate:                req.StartDate,
+		TargetDate:               req.TargetDate,
+		ContributionFrequency:    req.ContributionFrequency,
+		AutoContribute:           req.AutoContribute,
+		AutoContributeAmount:     req.AutoContributeAmount,
+		AutoContributeAccountID:  req.AutoContributeAccountID,
+		AutoContributeMinBalance: req.AutoContributeMinBalance,
+		AutoContributeShortfall:  req.AutoContributeShortfall,
+		SinkingFund:              req.SinkingFund,
+		DueEventID:               req.DueEventID,
+		AccountID:                req.AccountID,
+		EnableReminders:          req.EnableReminders,
+		ReminderFrequency:        req.ReminderFrequency,
+		Notes:                    req.Notes,
+		Tags:                     req.Tags,
+	}
+	if req.CoolingOffDays != nil {
+		goal.SetCoolingOff(*req.CoolingOffDays)
 	}
 
 	if err := h.service.CreateGoal(c.Request.Context(), goal); err != nil {
@@ -197,7 +215,7 @@ func (h *Handler) GetCompletedGoals(c *gin.Context) {
 // @Failure 404 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id} [get]
 func (h *Handler) GetGoalByID(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -210,7 +228,7 @@ func (h *Handler) GetGoalByID(c *gin.Context) {
 		return
 	}
 
-	goal, err := h.service.GetGoalByID(c.Request.Context(), id)
+	goal, err := h.service.GetGoalForUser(c.Request.Context(), id, user.ID)
 	if err != nil {
 		shared.HandleError(c, err)
 		return
@@ -235,7 +253,7 @@ func (h *Handler) GetGoalByID(c *gin.Context) {
 // @Failure 500 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id} [put]
 func (h *Handler) UpdateGoal(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -254,7 +272,7 @@ func (h *Handler) UpdateGoal(c *gin.Context) {
 		return
 	}
 
-	goal, err := h.service.GetGoalByID(c.Request.Context(), id)
+	goal, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID)
 	if err != nil {
 		shared.HandleError(c, err)
 		return
@@ -283,7 +301,7 @@ func (h *Handler) UpdateGoal(c *gin.Context) {
 // @Failure 500 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id} [delete]
 func (h *Handler) DeleteGoal(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -296,6 +314,11 @@ func (h *Handler) DeleteGoal(c *gin.Context) {
 		return
 	}
 
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
 	if err := h.service.DeleteGoal(c.Request.Context(), id); err != nil {
 		shared.HandleError(c, err)
 		return
@@ -319,7 +342,7 @@ func (h *Handler) DeleteGoal(c *gin.Context) {
 // @Failure 500 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id}/contribute [post]
 func (h *Handler) AddContribution(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -332,6 +355,11 @@ func (h *Handler) AddContribution(c *gin.Context) {
 		return
 	}
 
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
 	var req dto.AddContributionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data: "+err.Error())
@@ -368,7 +396,7 @@ func (h *Handler) AddContribution(c *gin.Context) {
 // @Failure 500 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id}/withdraw [post]
 func (h *Handler) WithdrawContribution(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -381,6 +409,11 @@ func (h *Handler) WithdrawContribution(c *gin.Context) {
 		return
 	}
 
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
 	var req dto.WithdrawContributionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data: "+err.Error())
@@ -409,7 +442,7 @@ func (h *Handler) WithdrawContribution(c *gin.Context) {
 // @Failure 500 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id}/contributions [get]
 func (h *Handler) GetContributions(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -422,6 +455,11 @@ func (h *Handler) GetContributions(c *gin.Context) {
 		return
 	}
 
+	if _, err := h.service.GetGoalForUser(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
 	contributions, err := h.service.GetContributions(c.Request.Context(), id)
 	if err != nil {
 		shared.HandleError(c, err)
@@ -431,6 +469,86 @@ func (h *Handler) GetContributions(c *gin.Context) {
 	shared.RespondWithSuccess(c, http.StatusOK, "Contributions retrieved successfully", dto.ToContributionResponseList(contributions))
 }
 
+// GetAutoContributionRuns godoc
+// @Summary Get goal auto-contribution history
+// @Description Get the outcome of each scheduled auto-contribution, including reduced, skipped and failed runs
+// @Tags goals
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Success 200 {array} dto.AutoContributionRunResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/auto-contributions [get]
+func (h *Handler) GetAutoContributionRuns(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+
+	if _, err := h.service.GetGoalForUser(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	runs, err := h.service.GetAutoContributionRuns(c.Request.Context(), id)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Auto-contributions retrieved successfully", dto.ToAutoContributionRunResponses(runs))
+}
+
+// ForecastGoal godoc
+// @Summary Forecast goal completion
+// @Description Simulate contribution, income and investment return uncertainty to estimate the chance of completing by the target date, the P10/P50/P90 completion dates and the monthly contribution needed for 80% and 90% confidence
+// @Tags goals
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Success 200 {object} dto.GoalForecast
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/forecast [get]
+func (h *Handler) ForecastGoal(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+
+	if _, err := h.service.GetGoalForUser(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	forecast, err := h.service.ForecastGoal(c.Request.Context(), id)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Goal forecast computed successfully", forecast)
+}
+
 // MarkAsCompleted godoc
 // @Summary Mark goal as completed
 // @Description Mark a goal as completed
@@ -444,7 +562,7 @@ func (h *Handler) GetContributions(c *gin.Context) {
 // @Failure 500 {object} shared.ErrorResponse
 // @Router /api/v1/goals/{id}/complete [post]
 func (h *Handler) MarkAsCompleted(c *gin.Context) {
-	_, exists := middleware.GetCurrentUser(c)
+	user, exists := middleware.GetCurrentUser(c)
 	if !exists {
 		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
 		return
@@ -457,6 +575,11 @@ func (h *Handler) MarkAsCompleted(c *gin.Context) {
 		return
 	}
 
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
 	if err := h.service.MarkAsCompleted(c.Request.Context(), id); err != nil {
 		shared.HandleError(c, err)
 		return
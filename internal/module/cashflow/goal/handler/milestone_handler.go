@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"net/http"
+
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/module/cashflow/goal/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CreateMilestone godoc
+// @Summary Add goal milestone
+// @Description Add a milestone set as an amount or a percentage of the goal target
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Param milestone body dto.CreateMilestoneRequest true "Milestone details"
+// @Success 201 {object} dto.MilestoneResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/milestones [post]
+func (h *Handler) CreateMilestone(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+
+	var req dto.CreateMilestoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data: "+err.Error())
+		return
+	}
+
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	milestone := &domain.GoalMilestone{
+		Label:            req.Label,
+		TargetAmount:     req.TargetAmount,
+		TargetPercentage: req.TargetPercentage,
+		TargetDate:       req.TargetDate,
+		RewardNote:       req.RewardNote,
+	}
+
+	if err := h.service.CreateMilestone(c.Request.Context(), id, milestone); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Milestone created successfully", dto.ToMilestoneResponse(milestone))
+}
+
+// GetMilestones godoc
+// @Summary Get goal milestones
+// @Description Get a goal's milestones with whether each is achieved, on track or behind the recent contribution pace
+// @Tags goals
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Success 200 {object} dto.GoalMilestonesProgress
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/milestones [get]
+func (h *Handler) GetMilestones(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+
+	if _, err := h.service.GetGoalForUser(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	progress, err := h.service.GetMilestoneProgress(c.Request.Context(), id)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Milestones retrieved successfully", progress)
+}
+
+// UpdateMilestone godoc
+// @Summary Update goal milestone
+// @Description Update a goal milestone
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Param milestoneId path string true "Milestone ID"
+// @Param milestone body dto.UpdateMilestoneRequest true "Milestone details"
+// @Success 200 {object} dto.MilestoneResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/milestones/{milestoneId} [put]
+func (h *Handler) UpdateMilestone(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+	milestoneID, err := uuid.Parse(c.Param("milestoneId"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid milestone ID")
+		return
+	}
+
+	var req dto.UpdateMilestoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data: "+err.Error())
+		return
+	}
+
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	milestone, err := h.service.GetMilestone(c.Request.Context(), id, milestoneID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	req.ApplyTo(milestone)
+
+	if err := h.service.UpdateMilestone(c.Request.Context(), milestone); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Milestone updated successfully", dto.ToMilestoneResponse(milestone))
+}
+
+// DeleteMilestone godoc
+// @Summary Delete goal milestone
+// @Description Delete a goal milestone
+// @Tags goals
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Param milestoneId path string true "Milestone ID"
+// @Success 204
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/milestones/{milestoneId} [delete]
+func (h *Handler) DeleteMilestone(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+	milestoneID, err := uuid.Parse(c.Param("milestoneId"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid milestone ID")
+		return
+	}
+
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	if err := h.service.DeleteMilestone(c.Request.Context(), id, milestoneID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithNoContent(c)
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/goal/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RecordSinkingFundPayment godoc
+// @Summary Pay sinking fund expense
+// @Description Record a sinking fund's yearly expense as paid: the saved balance is withdrawn and the fund resets to its next due date
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Param payment body dto.SinkingFundPaymentRequest true "Payment details"
+// @Success 200 {object} dto.GoalResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/sinking-fund/payments [post]
+func (h *Handler) RecordSinkingFundPayment(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+
+	var req dto.SinkingFundPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data: "+err.Error())
+		return
+	}
+
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	paidAt := time.Now()
+	if req.PaidAt != nil {
+		paidAt = *req.PaidAt
+	}
+
+	goal, err := h.service.RecordSinkingFundPayment(c.Request.Context(), id, req.Amount, paidAt, req.Note)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Sinking fund payment recorded", dto.ToGoalResponse(goal))
+}
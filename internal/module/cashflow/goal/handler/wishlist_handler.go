@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"net/http"
+
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/goal/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ConvertWishlistItem godoc
+// @Summary Convert wishlist item to budget
+// @Description Turn a wishlist item that has waited out its cooling-off period into a one-time budget holding the saved amount
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Param request body dto.ConvertWishlistRequest false "Conversion options"
+// @Success 200 {object} dto.GoalResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/wishlist/convert [post]
+func (h *Handler) ConvertWishlistItem(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+
+	var req dto.ConvertWishlistRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			shared.RespondWithError(c, http.StatusBadRequest, "invalid request data: "+err.Error())
+			return
+		}
+	}
+
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	goal, err := h.service.ConvertWishlistItem(c.Request.Context(), id, req.CategoryID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Wishlist item converted to budget", dto.ToGoalResponse(goal))
+}
+
+// CancelWishlistItem godoc
+// @Summary Cancel wishlist item
+// @Description Drop a planned purchase: the saved amount is released and the item is archived
+// @Tags goals
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Success 200 {object} dto.GoalResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/wishlist/cancel [post]
+func (h *Handler) CancelWishlistItem(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	goal, err := h.service.CancelWishlistItem(c.Request.Context(), id)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Wishlist item cancelled", dto.ToGoalResponse(goal))
+}
+
+// LinkWishlistPurchase godoc
+// @Summary Link wishlist purchase
+// @Description Record the transaction a converted wishlist item was bought with
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID"
+// @Param request body dto.WishlistPurchaseRequest true "Purchase transaction"
+// @Success 200 {object} dto.GoalResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/{id}/wishlist/purchase [post]
+func (h *Handler) LinkWishlistPurchase(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid goal ID")
+		return
+	}
+
+	var req dto.WishlistPurchaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data: "+err.Error())
+		return
+	}
+
+	if _, err := h.service.GetEditableGoal(c.Request.Context(), id, user.ID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	goal, err := h.service.LinkWishlistPurchase(c.Request.Context(), id, req.TransactionID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Wishlist purchase linked", dto.ToGoalResponse(goal))
+}
+
+// GetWishlistReport godoc
+// @Summary Get wishlist report
+// @Description Summarize the wishlist and how much cancelled impulse buys saved
+// @Tags goals
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.WishlistReport
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/goals/wishlist/report [get]
+func (h *Handler) GetWishlistReport(c *gin.Context) {
+	user, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	report, err := h.service.GetWishlistReport(c.Request.Context(), user.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Wishlist report retrieved successfully", report)
+}
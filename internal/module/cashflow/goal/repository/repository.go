@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // Repository defines the interface for goal data access
@@ -16,7 +17,13 @@ type Repository interface {
 	// FindByID retrieves a goal by its ID
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Goal, error)
 
-	// FindByUserID retrieves all goals for a user
+	// FindVisibleByID retrieves a goal the user owns or can see through a household
+	FindVisibleByID(ctx context.Context, id, userID uuid.UUID) (*domain.Goal, error)
+
+	// FindEditableByID retrieves a goal the user owns or edits through a household
+	FindEditableByID(ctx context.Context, id, userID uuid.UUID) (*domain.Goal, error)
+
+	// FindByUserID retrieves a user's goals, including those shared with them
 	FindByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error)
 
 	// FindActiveByUserID retrieves all active goals for a user
@@ -34,9 +41,15 @@ type Repository interface {
 	// FindOverdueGoals retrieves overdue goals
 	FindOverdueGoals(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error)
 
+	// FindByIDWithTx retrieves and locks a goal within an existing database transaction
+	FindByIDWithTx(tx *gorm.DB, id uuid.UUID) (*domain.Goal, error)
+
 	// Update updates an existing goal
 	Update(ctx context.Context, goal *domain.Goal) error
 
+	// UpdateWithTx updates a goal within an existing database transaction
+	UpdateWithTx(tx *gorm.DB, goal *domain.Goal) error
+
 	// Delete soft deletes a goal
 	Delete(ctx context.Context, id uuid.UUID) error
 
@@ -50,6 +63,12 @@ type Repository interface {
 	// CreateContribution creates a new goal contribution record
 	CreateContribution(ctx context.Context, contribution *domain.GoalContribution) error
 
+	// CreateContributionWithTx creates a contribution record within an existing database transaction
+	CreateContributionWithTx(tx *gorm.DB, contribution *domain.GoalContribution) error
+
+	// FindContributionsByTransactionIDWithTx retrieves the contributions recorded for a cash transaction
+	FindContributionsByTransactionIDWithTx(tx *gorm.DB, transactionID uuid.UUID) ([]domain.GoalContribution, error)
+
 	// FindContributionsByGoalID retrieves all contributions for a goal
 	FindContributionsByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.GoalContribution, error)
 
@@ -65,4 +84,78 @@ type Repository interface {
 
 	// GetContributionsByDateRange retrieves contributions for a goal within a date range
 	GetContributionsByDateRange(ctx context.Context, goalID uuid.UUID, startDate, endDate time.Time) ([]domain.GoalContribution, error)
+
+	// ============================================================
+	// Auto-Contribution Methods
+	// ============================================================
+
+	// FindDueAutoContributions retrieves active auto-contributing goals due on or before asOf
+	FindDueAutoContributions(ctx context.Context, asOf time.Time) ([]domain.Goal, error)
+
+	// FindAutoContributionRunWithTx retrieves the run of a goal's due date; shared.ErrNotFound if none
+	FindAutoContributionRunWithTx(tx *gorm.DB, goalID uuid.UUID, dueDate time.Time) (*domain.AutoContributionRun, error)
+
+	// SaveAutoContributionRunWithTx creates or updates a run within an existing database transaction
+	SaveAutoContributionRunWithTx(tx *gorm.DB, run *domain.AutoContributionRun) error
+
+	// FindAutoContributionRuns retrieves a goal's runs, most recent due date first
+	FindAutoContributionRuns(ctx context.Context, goalID uuid.UUID) ([]domain.AutoContributionRun, error)
+
+	// ============================================================
+	// Reminder Methods
+	// ============================================================
+
+	// FindReminderCandidates retrieves active and overdue goals with reminders enabled
+	FindReminderCandidates(ctx context.Context) ([]domain.Goal, error)
+
+	// MarkReminderSent records when a goal's reminder was last sent
+	MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+
+	// ============================================================
+	// Wishlist Methods
+	// ============================================================
+
+	// FindWishlistByUserID retrieves a user's wishlist items, decided ones included, most recent first
+	FindWishlistByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error)
+
+	// FindEndedCoolingOffs retrieves undecided wishlist items whose cooling-off period ended on or
+	// before asOf and whose owner has not been told yet
+	FindEndedCoolingOffs(ctx context.Context, asOf time.Time) ([]domain.Goal, error)
+
+	// FindByConvertedBudgetID retrieves the wishlist item converted into a budget; shared.ErrNotFound if none
+	FindByConvertedBudgetID(ctx context.Context, budgetID uuid.UUID) (*domain.Goal, error)
+
+	// MarkCoolingOffNotified records when the owner was told a wishlist item's cooling-off period ended,
+	// only if they were not told yet, and reports whether it changed
+	MarkCoolingOffNotified(ctx context.Context, id uuid.UUID, notifiedAt time.Time) (bool, error)
+
+	// SetPurchaseTransactionID records the transaction a converted wishlist item was bought with
+	SetPurchaseTransactionID(ctx context.Context, id, transactionID uuid.UUID) error
+
+	// LinkPurchaseIfUnlinked records the purchase transaction only if none is linked yet, and
+	// reports whether it changed
+	LinkPurchaseIfUnlinked(ctx context.Context, id, transactionID uuid.UUID) (bool, error)
+
+	// ============================================================
+	// Milestone Methods
+	// ============================================================
+
+	// CreateMilestone creates a new goal milestone
+	CreateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error
+
+	// FindMilestoneByID retrieves a milestone of a goal; shared.ErrNotFound if none
+	FindMilestoneByID(ctx context.Context, goalID, milestoneID uuid.UUID) (*domain.GoalMilestone, error)
+
+	// FindMilestonesByGoalID retrieves a goal's milestones, earliest target date first
+	FindMilestonesByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.GoalMilestone, error)
+
+	// UpdateMilestone updates an existing milestone
+	UpdateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error
+
+	// SetMilestoneAchievedAt sets (or clears, when nil) a milestone's achieved time only if it is
+	// not already in that state, and reports whether it changed
+	SetMilestoneAchievedAt(ctx context.Context, milestoneID uuid.UUID, achievedAt *time.Time) (bool, error)
+
+	// DeleteMilestone soft deletes a milestone of a goal
+	DeleteMilestone(ctx context.Context, goalID, milestoneID uuid.UUID) error
 }
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"personalfinancedss/internal/module/cashflow/goal/domain"
+	householddomain "personalfinancedss/internal/module/identify/household/domain"
+	householdrepo "personalfinancedss/internal/module/identify/household/repository"
+	"personalfinancedss/internal/shared"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type repository struct {
@@ -35,10 +39,31 @@ func (r *repository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Goal,
 	return &goal, nil
 }
 
+func (r *repository) FindVisibleByID(ctx context.Context, id, userID uuid.UUID) (*domain.Goal, error) {
+	return r.first(ctx, visibleTo(userID), id)
+}
+
+func (r *repository) FindEditableByID(ctx context.Context, id, userID uuid.UUID) (*domain.Goal, error) {
+	return r.first(ctx, householdrepo.EditableBy(userID), id)
+}
+
+// first loads a goal within an access scope
+func (r *repository) first(ctx context.Context, scope func(*gorm.DB) *gorm.DB, id uuid.UUID) (*domain.Goal, error) {
+	var goal domain.Goal
+	err := r.db.WithContext(ctx).Scopes(scope).Where("id = ?", id).First(&goal).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &goal, nil
+}
+
 func (r *repository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error) {
 	var goals []domain.Goal
 	err := r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
+		Scopes(visibleTo(userID)).
 		Order("priority DESC, created_at DESC").
 		Find(&goals).Error
 	return goals, err
@@ -47,7 +72,8 @@ func (r *repository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]doma
 func (r *repository) FindActiveByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error) {
 	var goals []domain.Goal
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND status = ?", userID, domain.GoalStatusActive).
+		Scopes(visibleTo(userID)).
+		Where("status = ?", domain.GoalStatusActive).
 		Order("priority DESC, target_date ASC").
 		Find(&goals).Error
 	return goals, err
@@ -56,7 +82,8 @@ func (r *repository) FindActiveByUserID(ctx context.Context, userID uuid.UUID) (
 func (r *repository) FindByCategory(ctx context.Context, userID uuid.UUID, category domain.GoalCategory) ([]domain.Goal, error) {
 	var goals []domain.Goal
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND category = ?", userID, category).
+		Scopes(visibleTo(userID)).
+		Where("category = ?", category).
 		Order("created_at DESC").
 		Find(&goals).Error
 	return goals, err
@@ -65,7 +92,8 @@ func (r *repository) FindByCategory(ctx context.Context, userID uuid.UUID, categ
 func (r *repository) FindByStatus(ctx context.Context, userID uuid.UUID, status domain.GoalStatus) ([]domain.Goal, error) {
 	var goals []domain.Goal
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND status = ?", userID, status).
+		Scopes(visibleTo(userID)).
+		Where("status = ?", status).
 		Order("created_at DESC").
 		Find(&goals).Error
 	return goals, err
@@ -74,7 +102,8 @@ func (r *repository) FindByStatus(ctx context.Context, userID uuid.UUID, status
 func (r *repository) FindCompletedGoals(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error) {
 	var goals []domain.Goal
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND status = ?", userID, domain.GoalStatusCompleted).
+		Scopes(visibleTo(userID)).
+		Where("status = ?", domain.GoalStatusCompleted).
 		Order("completed_at DESC").
 		Find(&goals).Error
 	return goals, err
@@ -84,7 +113,8 @@ func (r *repository) FindOverdueGoals(ctx context.Context, userID uuid.UUID) ([]
 	var goals []domain.Goal
 	now := time.Now()
 	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND target_date < ? AND status NOT IN (?)", userID, now, []string{
+		Scopes(visibleTo(userID)).
+		Where("target_date < ? AND status NOT IN (?)", now, []string{
 			string(domain.GoalStatusCompleted),
 			string(domain.GoalStatusCancelled),
 		}).
@@ -93,8 +123,24 @@ func (r *repository) FindOverdueGoals(ctx context.Context, userID uuid.UUID) ([]
 	return goals, err
 }
 
+func (r *repository) FindByIDWithTx(tx *gorm.DB, id uuid.UUID) (*domain.Goal, error) {
+	var goal domain.Goal
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&goal).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &goal, nil
+}
+
 func (r *repository) Update(ctx context.Context, goal *domain.Goal) error {
-	return r.db.WithContext(ctx).Save(goal).Error
+	return r.UpdateWithTx(r.db.WithContext(ctx), goal)
+}
+
+func (r *repository) UpdateWithTx(tx *gorm.DB, goal *domain.Goal) error {
+	return tx.Save(goal).Error
 }
 
 func (r *repository) Delete(ctx context.Context, id uuid.UUID) error {
@@ -115,7 +161,20 @@ func (r *repository) AddContribution(ctx context.Context, id uuid.UUID, amount f
 // ============================================================
 
 func (r *repository) CreateContribution(ctx context.Context, contribution *domain.GoalContribution) error {
-	return r.db.WithContext(ctx).Create(contribution).Error
+	return r.CreateContributionWithTx(r.db.WithContext(ctx), contribution)
+}
+
+func (r *repository) CreateContributionWithTx(tx *gorm.DB, contribution *domain.GoalContribution) error {
+	return tx.Create(contribution).Error
+}
+
+func (r *repository) FindContributionsByTransactionIDWithTx(tx *gorm.DB, transactionID uuid.UUID) ([]domain.GoalContribution, error) {
+	var contributions []domain.GoalContribution
+	err := tx.
+		Where("transaction_id = ?", transactionID).
+		Order("created_at ASC").
+		Find(&contributions).Error
+	return contributions, err
 }
 
 func (r *repository) FindContributionsByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.GoalContribution, error) {
@@ -180,3 +239,185 @@ func (r *repository) GetContributionsByDateRange(ctx context.Context, goalID uui
 		Find(&contributions).Error
 	return contributions, err
 }
+
+// ============================================================
+// Auto-Contribution Methods
+// ============================================================
+
+func (r *repository) FindDueAutoContributions(ctx context.Context, asOf time.Time) ([]domain.Goal, error) {
+	var goals []domain.Goal
+	err := r.db.WithContext(ctx).
+		Where("auto_contribute = ? AND status = ? AND next_auto_contribute_at <= ?", true, domain.GoalStatusActive, asOf).
+		Order("next_auto_contribute_at ASC").
+		Find(&goals).Error
+	return goals, err
+}
+
+func (r *repository) FindAutoContributionRunWithTx(tx *gorm.DB, goalID uuid.UUID, dueDate time.Time) (*domain.AutoContributionRun, error) {
+	var run domain.AutoContributionRun
+	err := tx.Where("goal_id = ? AND due_date = ?", goalID, dueDate).First(&run).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &run, nil
+}
+
+func (r *repository) SaveAutoContributionRunWithTx(tx *gorm.DB, run *domain.AutoContributionRun) error {
+	return tx.Save(run).Error
+}
+
+func (r *repository) FindAutoContributionRuns(ctx context.Context, goalID uuid.UUID) ([]domain.AutoContributionRun, error) {
+	var runs []domain.AutoContributionRun
+	err := r.db.WithContext(ctx).
+		Where("goal_id = ?", goalID).
+		Order("due_date DESC").
+		Find(&runs).Error
+	return runs, err
+}
+
+// ============================================================
+// Reminder Methods
+// ============================================================
+
+func (r *repository) FindReminderCandidates(ctx context.Context) ([]domain.Goal, error) {
+	var goals []domain.Goal
+	err := r.db.WithContext(ctx).
+		Where("enable_reminders = ? AND status IN (?)", true, []string{
+			string(domain.GoalStatusActive),
+			string(domain.GoalStatusOverdue),
+		}).
+		Order("user_id ASC").
+		Find(&goals).Error
+	return goals, err
+}
+
+func (r *repository) MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.Goal{}).
+		Where("id = ?", id).
+		Update("last_reminder_sent_at", sentAt).Error
+}
+
+// ============================================================
+// Wishlist Methods
+// ============================================================
+
+func (r *repository) FindWishlistByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error) {
+	var goals []domain.Goal
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND behavior = ? AND cooling_off_until IS NOT NULL", userID, domain.GoalBehaviorWilling).
+		Order("created_at DESC").
+		Find(&goals).Error
+	return goals, err
+}
+
+func (r *repository) FindEndedCoolingOffs(ctx context.Context, asOf time.Time) ([]domain.Goal, error) {
+	var goals []domain.Goal
+	err := r.db.WithContext(ctx).
+		Where("behavior = ? AND wishlist_decision IS NULL AND cooling_off_notified_at IS NULL", domain.GoalBehaviorWilling).
+		Where("cooling_off_until <= ? AND status NOT IN (?)", asOf, []string{
+			string(domain.GoalStatusArchived),
+			string(domain.GoalStatusCancelled),
+		}).
+		Order("cooling_off_until ASC").
+		Find(&goals).Error
+	return goals, err
+}
+
+func (r *repository) FindByConvertedBudgetID(ctx context.Context, budgetID uuid.UUID) (*domain.Goal, error) {
+	var goal domain.Goal
+	err := r.db.WithContext(ctx).Where("converted_budget_id = ?", budgetID).First(&goal).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &goal, nil
+}
+
+func (r *repository) MarkCoolingOffNotified(ctx context.Context, id uuid.UUID, notifiedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&domain.Goal{}).
+		Where("id = ? AND cooling_off_notified_at IS NULL", id).
+		Update("cooling_off_notified_at", notifiedAt)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *repository) SetPurchaseTransactionID(ctx context.Context, id, transactionID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.Goal{}).
+		Where("id = ?", id).
+		Update("purchase_transaction_id", transactionID).Error
+}
+
+func (r *repository) LinkPurchaseIfUnlinked(ctx context.Context, id, transactionID uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&domain.Goal{}).
+		Where("id = ? AND purchase_transaction_id IS NULL", id).
+		Update("purchase_transaction_id", transactionID)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ============================================================
+// Milestone Methods
+// ============================================================
+
+func (r *repository) CreateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error {
+	return r.db.WithContext(ctx).Create(milestone).Error
+}
+
+func (r *repository) FindMilestoneByID(ctx context.Context, goalID, milestoneID uuid.UUID) (*domain.GoalMilestone, error) {
+	var milestone domain.GoalMilestone
+	err := r.db.WithContext(ctx).Where("id = ? AND goal_id = ?", milestoneID, goalID).First(&milestone).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &milestone, nil
+}
+
+func (r *repository) FindMilestonesByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.GoalMilestone, error) {
+	var milestones []domain.GoalMilestone
+	err := r.db.WithContext(ctx).
+		Where("goal_id = ?", goalID).
+		Order("target_date ASC NULLS LAST, created_at ASC").
+		Find(&milestones).Error
+	return milestones, err
+}
+
+func (r *repository) UpdateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error {
+	return r.db.WithContext(ctx).Save(milestone).Error
+}
+
+func (r *repository) SetMilestoneAchievedAt(ctx context.Context, milestoneID uuid.UUID, achievedAt *time.Time) (bool, error) {
+	query := r.db.WithContext(ctx).Model(&domain.GoalMilestone{}).Where("id = ?", milestoneID)
+	if achievedAt != nil {
+		query = query.Where("achieved_at IS NULL")
+	} else {
+		query = query.Where("achieved_at IS NOT NULL")
+	}
+	result := query.Update("achieved_at", achievedAt)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *repository) DeleteMilestone(ctx context.Context, goalID, milestoneID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND goal_id = ?", milestoneID, goalID).Delete(&domain.GoalMilestone{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return shared.ErrNotFound
+	}
+	return nil
+}
+
+// visibleTo limits a query to goals the user owns or that are shared with them
+func visibleTo(userID uuid.UUID) func(*gorm.DB) *gorm.DB {
+	return householdrepo.VisibleTo(userID, householddomain.ResourceGoal)
+}
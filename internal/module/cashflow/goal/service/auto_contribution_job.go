@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// autoContributionJobSpec runs every hour so contributions run within the hour they fall due
+const autoContributionJobSpec = "0 20 * * * *"
+
+// autoContributionJob runs the goal auto-contributions on the notification scheduler
+type autoContributionJob struct {
+	service GoalAutoContributor
+}
+
+// NewAutoContributionJob creates the scheduled job that executes goal auto-contributions on their due dates
+func NewAutoContributionJob(service Service) notificationService.ScheduledJob {
+	return &autoContributionJob{service: service}
+}
+
+func (j *autoContributionJob) Name() string {
+	return "goal_auto_contributions"
+}
+
+func (j *autoContributionJob) Spec() string {
+	return autoContributionJobSpec
+}
+
+func (j *autoContributionJob) Run(ctx context.Context) error {
+	_, err := j.service.ProcessDueAutoContributions(ctx, time.Now())
+	return err
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// coolingOffJobSpec runs each morning; cooling-off periods end on a date
+const coolingOffJobSpec = "0 0 8 * * *"
+
+// coolingOffJob runs the wishlist cooling-off notices on the notification scheduler
+type coolingOffJob struct {
+	service GoalWishlistManager
+}
+
+// NewCoolingOffJob creates the scheduled job that tells users their wishlist items have waited out the cooling-off period
+func NewCoolingOffJob(service Service) notificationService.ScheduledJob {
+	return &coolingOffJob{service: service}
+}
+
+func (j *coolingOffJob) Name() string {
+	return "wishlist_cooling_off"
+}
+
+func (j *coolingOffJob) Spec() string {
+	return coolingOffJobSpec
+}
+
+func (j *coolingOffJob) Run(ctx context.Context) error {
+	_, err := j.service.ProcessEndedCoolingOffs(ctx, time.Now())
+	return err
+}
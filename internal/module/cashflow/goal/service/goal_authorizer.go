@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetGoalForUser retrieves a goal the user owns or that is shared with them through a household
+func (s *goalService) GetGoalForUser(ctx context.Context, goalID, userID uuid.UUID) (*domain.Goal, error) {
+	goal, err := s.repo.FindVisibleByID(ctx, goalID, userID)
+	if err != nil {
+		return nil, s.accessError(err, goalID, userID)
+	}
+	return goal, nil
+}
+
+// GetEditableGoal retrieves a goal the user owns or edits as a household owner or editor
+func (s *goalService) GetEditableGoal(ctx context.Context, goalID, userID uuid.UUID) (*domain.Goal, error) {
+	goal, err := s.repo.FindEditableByID(ctx, goalID, userID)
+	if err != nil {
+		return nil, s.accessError(err, goalID, userID)
+	}
+	return goal, nil
+}
+
+// accessError passes not found through and wraps anything else as an internal error
+func (s *goalService) accessError(err error, goalID, userID uuid.UUID) error {
+	if err == shared.ErrNotFound {
+		return err
+	}
+	s.logger.Error("Failed to load goal for user",
+		zap.String("goal_id", goalID.String()),
+		zap.String("user_id", userID.String()),
+		zap.Error(err),
+	)
+	return shared.ErrInternal.WithError(err)
+}
@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	notificationDomain "personalfinancedss/internal/module/notification/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxAutoContributeAttempts is how often a failing due date is retried before it is given up
+	maxAutoContributeAttempts = 3
+
+	// maxAutoContributeCatchUp caps how many missed periods one run executes for a goal
+	maxAutoContributeCatchUp = 12
+)
+
+// ProcessDueAutoContributions executes every auto-contribution due on or before asOf.
+// Safe to run repeatedly: each due date is executed at most once, guarded by its run record.
+func (s *goalService) ProcessDueAutoContributions(ctx context.Context, asOf time.Time) (int, error) {
+	goals, err := s.repo.FindDueAutoContributions(ctx, asOf)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for i := range goals {
+		for n := 0; n < maxAutoContributeCatchUp; n++ {
+			due, err := s.runAutoContribution(ctx, goals[i].ID, asOf)
+			if err != nil {
+				s.logger.Error("Failed to run goal auto-contribution",
+					zap.String("goal_id", goals[i].ID.String()),
+					zap.Error(err),
+				)
+				break
+			}
+			if !due {
+				break
+			}
+			processed++
+		}
+	}
+
+	return processed, nil
+}
+
+// GetAutoContributionRuns retrieves the auto-contribution history of a goal
+func (s *goalService) GetAutoContributionRuns(ctx context.Context, goalID uuid.UUID) ([]domain.AutoContributionRun, error) {
+	runs, err := s.repo.FindAutoContributionRuns(ctx, goalID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return runs, nil
+}
+
+// runAutoContribution executes the goal's next due date, if any, and reports whether one was due.
+// The contribution, the account transfer, the run record and the new schedule commit together.
+func (s *goalService) runAutoContribution(ctx context.Context, goalID uuid.UUID, asOf time.Time) (bool, error) {
+	tx := s.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	goal, err := s.repo.FindByIDWithTx(tx, goalID)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if !goal.AutoContributeDue(asOf) {
+		tx.Rollback()
+		return false, nil
+	}
+	dueDate := *goal.NextAutoContributeAt
+
+	run, err := s.repo.FindAutoContributionRunWithTx(tx, goalID, dueDate)
+	switch {
+	case errors.Is(err, shared.ErrNotFound):
+		run = &domain.AutoContributionRun{GoalID: goal.ID, UserID: goal.UserID, DueDate: dueDate}
+	case err != nil:
+		tx.Rollback()
+		return false, err
+	case run.Status != domain.AutoContributionFailed:
+		// Already executed (e.g. before a crash that lost the schedule update); just move on
+		goal.AdvanceAutoContribute()
+		if err := s.repo.UpdateWithTx(tx, goal); err != nil {
+			tx.Rollback()
+			return false, err
+		}
+		return true, tx.Commit().Error
+	}
+
+	run.SourceAccountID = goal.SourceAccountID()
+	if goal.AutoContributeAmount != nil {
+		run.RequestedAmount = *goal.AutoContributeAmount
+	}
+	run.Attempts++
+
+	if execErr := s.executeAutoContribution(tx, goal, run); execErr != nil {
+		tx.Rollback()
+		return true, s.recordAutoContributionFailure(ctx, goalID, dueDate, execErr)
+	}
+
+	goal.AdvanceAutoContribute()
+	if err := s.repo.SaveAutoContributionRunWithTx(tx, run); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := s.repo.UpdateWithTx(tx, goal); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+
+	s.logger.Info("Goal auto-contribution executed",
+		zap.String("goal_id", goal.ID.String()),
+		zap.Time("due_date", dueDate),
+		zap.String("status", string(run.Status)),
+		zap.Float64("amount", run.Amount),
+	)
+	if run.Status != domain.AutoContributionCompleted {
+		s.notifyAutoContribution(ctx, goal, run)
+	}
+	if run.Amount > 0 {
+		s.refreshMilestones(ctx, goal)
+	}
+
+	return true, nil
+}
+
+// executeAutoContribution plans the amount against the source account floor and, when something
+// can move, records the contribution and the transfer from the source account into the goal account.
+// The source balance is read locked within the transaction, so other debits wait for the transfer.
+func (s *goalService) executeAutoContribution(tx *gorm.DB, goal *domain.Goal, run *domain.AutoContributionRun) error {
+	source, err := s.accountRepo.GetByIDWithTx(tx, run.SourceAccountID.String())
+	if err != nil {
+		return fmt.Errorf("source account unavailable: %w", err)
+	}
+
+	plan := goal.PlanAutoContribution(source.CurrentBalance)
+	run.Status = plan.Status
+	run.Amount = plan.Amount
+	run.Reason = nil
+	if plan.Reason != "" {
+		reason := plan.Reason
+		run.Reason = &reason
+	}
+	if plan.Amount <= 0 {
+		return nil
+	}
+
+	contribution := domain.NewDeposit(goal.ID, goal.AccountID, goal.UserID, plan.Amount, nil)
+	contribution.Currency = goal.Currency
+	contribution.Source = domain.ContributionSourceAuto
+
+	// Money only moves between accounts when the goal saves into a different account
+	if run.SourceAccountID != goal.AccountID {
+		debitID, err := s.recordAutoTransfer(tx, goal, run)
+		if err != nil {
+			return err
+		}
+		contribution.TransactionID = &debitID
+	}
+
+	if err := s.applyContributionWithTx(tx, goal, contribution); err != nil {
+		return err
+	}
+	run.ContributionID = &contribution.ID
+	return nil
+}
+
+// recordAutoTransfer books the transfer out of the source account and into the goal account.
+// The outgoing leg carries the GOAL link, so editing or deleting it adjusts the contribution.
+func (s *goalService) recordAutoTransfer(tx *gorm.DB, goal *domain.Goal, run *domain.AutoContributionRun) (uuid.UUID, error) {
+	amount := int64(math.Round(run.Amount))
+	date := run.DueDate
+	key := "goal_auto:" + goal.ID.String() + ":" + date.Format("2006-01-02")
+	description := "Auto-contribution: " + goal.Name
+	links := transactionDomain.TransactionLinks{{Type: transactionDomain.LinkGoal, ID: goal.ID.String()}}
+
+	legs := []struct {
+		accountID uuid.UUID
+		direction transactionDomain.Direction
+		suffix    string
+		links     *transactionDomain.TransactionLinks
+	}{
+		{run.SourceAccountID, transactionDomain.DirectionDebit, ":out", &links},
+		{goal.AccountID, transactionDomain.DirectionCredit, ":in", nil},
+	}
+
+	var debitID uuid.UUID
+	for _, leg := range legs {
+		txn := &transactionDomain.Transaction{
+			ID:          uuid.New(),
+			UserID:      goal.UserID,
+			AccountID:   leg.accountID,
+			Source:      transactionDomain.SourceManual,
+			ExternalID:  key + leg.suffix,
+			Direction:   leg.direction,
+			Channel:     transactionDomain.ChannelUnknown,
+			Instrument:  transactionDomain.InstrumentBankAccount,
+			BookingDate: date,
+			ValueDate:   date,
+			Amount:      amount,
+			Currency:    goal.Currency,
+			Description: description,
+			Reference:   goal.ID.String(),
+			Links:       leg.links,
+		}
+		if err := s.transactionRepo.CreateWithTx(tx, txn); err != nil {
+			return uuid.Nil, err
+		}
+
+		delta := float64(amount)
+		if leg.direction == transactionDomain.DirectionDebit {
+			delta = -delta
+			debitID = txn.ID
+		}
+		if err := s.accountRepo.UpdateBalanceWithTx(tx, leg.accountID.String(), delta); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	return debitID, nil
+}
+
+// recordAutoContributionFailure stores a failed attempt in its own transaction. After the last
+// attempt the due date is given up, the schedule moves on and the user is told.
+func (s *goalService) recordAutoContributionFailure(ctx context.Context, goalID uuid.UUID, dueDate time.Time, cause error) error {
+	tx := s.db.WithContext(ctx).Begin()
+
+	goal, err := s.repo.FindByIDWithTx(tx, goalID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	run, err := s.repo.FindAutoContributionRunWithTx(tx, goalID, dueDate)
+	if errors.Is(err, shared.ErrNotFound) {
+		run = &domain.AutoContributionRun{GoalID: goal.ID, UserID: goal.UserID, DueDate: dueDate}
+	} else if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	reason := cause.Error()
+	run.Status = domain.AutoContributionFailed
+	run.SourceAccountID = goal.SourceAccountID()
+	if goal.AutoContributeAmount != nil {
+		run.RequestedAmount = *goal.AutoContributeAmount
+	}
+	run.Amount = 0
+	run.Reason = &reason
+	run.Attempts++
+
+	givenUp := run.Attempts >= maxAutoContributeAttempts
+	if givenUp && goal.NextAutoContributeAt != nil && goal.NextAutoContributeAt.Equal(dueDate) {
+		goal.AdvanceAutoContribute()
+		if err := s.repo.UpdateWithTx(tx, goal); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := s.repo.SaveAutoContributionRunWithTx(tx, run); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	s.logger.Warn("Goal auto-contribution failed",
+		zap.String("goal_id", goalID.String()),
+		zap.Time("due_date", dueDate),
+		zap.Int("attempts", run.Attempts),
+		zap.Error(cause),
+	)
+	if givenUp {
+		s.notifyAutoContribution(ctx, goal, run)
+		return nil
+	}
+	return cause
+}
+
+// notifyAutoContribution tells the user about a reduced, skipped or failed auto-contribution
+func (s *goalService) notifyAutoContribution(ctx context.Context, goal *domain.Goal, run *domain.AutoContributionRun) {
+	if s.notifier == nil {
+		return
+	}
+
+	subject := "Auto-contribution to " + goal.Name
+	switch run.Status {
+	case domain.AutoContributionReduced:
+		subject += " was reduced"
+	case domain.AutoContributionSkipped:
+		subject += " was skipped"
+	case domain.AutoContributionFailed:
+		subject += " failed"
+	}
+
+	data := map[string]interface{}{
+		"goal_id":           goal.ID.String(),
+		"goal_name":         goal.Name,
+		"due_date":          run.DueDate.Format("2006-01-02"),
+		"status":            string(run.Status),
+		"requested_amount":  run.RequestedAmount,
+		"amount":            run.Amount,
+		"source_account_id": run.SourceAccountID.String(),
+	}
+	if run.Reason != nil {
+		data["reason"] = *run.Reason
+	}
+
+	if err := s.notifier.NotifyInApp(ctx, goal.UserID, notificationDomain.NotificationTypeGoalAutoContribution, subject, data); err != nil {
+		s.logger.Warn("Failed to notify auto-contribution outcome",
+			zap.String("goal_id", goal.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
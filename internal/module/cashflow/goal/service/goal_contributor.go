@@ -105,6 +105,8 @@ func (s *goalService) AddContribution(
 		zap.Float64("percentage_complete", goal.PercentageComplete),
 	)
 
+	s.refreshMilestones(ctx, goal)
+
 	return goal, nil
 }
 
@@ -149,9 +151,9 @@ func (s *goalService) WithdrawContribution(
 
 	// Update goal's current amount (subtract)
 	goal.CurrentAmount -= amount
-	goal.RemainingAmount = goal.TargetAmount - goal.CurrentAmount
+	goal.RemainingAmount = goal.TargetAmount - goal.FundedAmount()
 	if goal.TargetAmount > 0 {
-		goal.PercentageComplete = (goal.CurrentAmount / goal.TargetAmount) * 100
+		goal.PercentageComplete = (goal.FundedAmount() / goal.TargetAmount) * 100
 	}
 
 	if err := s.repo.Update(ctx, goal); err != nil {
@@ -187,6 +189,8 @@ func (s *goalService) WithdrawContribution(
 		zap.Float64("new_current_amount", goal.CurrentAmount),
 	)
 
+	s.refreshMilestones(ctx, goal)
+
 	return goal, nil
 }
 
@@ -3,18 +3,24 @@ package service
 import (
 	"context"
 	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"time"
 
 	"go.uber.org/zap"
 )
 
 // CreateGoal creates a new goal for a user
 func (s *goalService) CreateGoal(ctx context.Context, goal *domain.Goal) error {
+	if err := s.resolveSinkingFundDueDate(ctx, goal, time.Now()); err != nil {
+		return err
+	}
+
 	// Calculate initial progress
 	goal.UpdateCalculatedFields()
 
 	if err := s.validateGoal(goal); err != nil {
 		return err
 	}
+	goal.SyncAutoContributeSchedule(time.Now())
 
 	if err := s.repo.Create(ctx, goal); err != nil {
 		s.logger.Error("Failed to create goal",
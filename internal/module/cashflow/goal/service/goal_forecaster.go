@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/module/cashflow/goal/dto"
+	incomeDomain "personalfinancedss/internal/module/cashflow/income_profile/domain"
+	profileDomain "personalfinancedss/internal/module/identify/profile/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// forecastSimulations is the number of simulated paths per forecast
+	forecastSimulations = 2000
+	// forecastHorizonMonths is how far completion dates are simulated; extended for distant target dates
+	forecastHorizonMonths    = 360
+	maxForecastHorizonMonths = 600
+
+	// minForecastHistoryMonths is the history needed before past contributions drive the forecast
+	minForecastHistoryMonths = 3
+	// defaultContributionCV is the contribution variability assumed without enough history
+	defaultContributionCV = 0.25
+	maxContributionCV     = 2.0
+
+	// defaultIncomeVolatility applies when the user has not set their income stability
+	defaultIncomeVolatility = 0.10
+	// oneTimeIncomeVolatility is the volatility of an income made up only of one-time payments
+	oneTimeIncomeVolatility = 0.5
+)
+
+// forecastConfidences are the confidence levels the required contribution is reported for
+var forecastConfidences = []float64{0.8, 0.9}
+
+// incomeStabilityVolatility maps the user's declared income stability to monthly income volatility
+var incomeStabilityVolatility = map[profileDomain.IncomeStability]float64{
+	profileDomain.IncomeStabilityStable:    0.05,
+	profileDomain.IncomeStabilityVariable:  0.15,
+	profileDomain.IncomeStabilityFreelance: 0.30,
+}
+
+// riskToleranceReturns maps risk tolerance to the expected annual return and volatility of investment goals
+var riskToleranceReturns = map[profileDomain.RiskTolerance][2]float64{
+	profileDomain.RiskToleranceConservative: {0.04, 0.05},
+	profileDomain.RiskToleranceModerate:     {0.07, 0.12},
+	profileDomain.RiskToleranceAggressive:   {0.10, 0.20},
+}
+
+// ForecastGoal simulates the goal's funded amount under contribution, income and return
+// uncertainty, and reports the chance and range of completion dates
+func (s *goalService) ForecastGoal(ctx context.Context, goalID uuid.UUID) (*dto.GoalForecast, error) {
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions, err := s.repo.FindContributionsByGoalID(ctx, goalID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	now := time.Now()
+	profile := s.loadForecastProfile(ctx, goal)
+	assumptions, summary := s.forecastAssumptions(ctx, goal, contributions, profile, now)
+
+	horizon := forecastHorizonMonths
+	monthsToTarget, hasTarget := goal.MonthsUntilTarget(now)
+	if hasTarget && monthsToTarget*2 > horizon {
+		horizon = min(monthsToTarget*2, maxForecastHorizonMonths)
+	}
+	cfg := domain.ForecastConfig{
+		Simulations:   forecastSimulations,
+		HorizonMonths: horizon,
+		Seed:          now.UnixNano(),
+	}
+
+	funded := goal.FundedAmount()
+	completionMonths := domain.SimulateCompletionMonths(funded, goal.TargetAmount, assumptions, cfg)
+
+	forecast := &dto.GoalForecast{
+		GoalID:        goal.ID,
+		FundedAmount:  funded,
+		TargetAmount:  goal.TargetAmount,
+		TargetDate:    goal.TargetDate,
+		Simulations:   cfg.Simulations,
+		HorizonMonths: cfg.HorizonMonths,
+		Assumptions:   summary,
+	}
+
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	completionDate := func(p int) *time.Time {
+		month, ok := domain.CompletionPercentile(completionMonths, p, cfg.HorizonMonths)
+		if !ok {
+			return nil
+		}
+		date := start.AddDate(0, month, 0)
+		return &date
+	}
+	forecast.CompletionDateP10 = completionDate(10)
+	forecast.CompletionDateP50 = completionDate(50)
+	forecast.CompletionDateP90 = completionDate(90)
+
+	if hasTarget {
+		probability := domain.CompletionProbability(completionMonths, monthsToTarget)
+		forecast.ProbabilityByTargetDate = &probability
+
+		for _, confidence := range forecastConfidences {
+			forecast.RequiredContributions = append(forecast.RequiredContributions, dto.RequiredContribution{
+				Confidence:          confidence,
+				MonthlyContribution: domain.RequiredMonthlyContribution(funded, goal.TargetAmount, assumptions, monthsToTarget, confidence, cfg),
+			})
+		}
+	}
+
+	s.logger.Info("Goal forecast computed",
+		zap.String("goal_id", goal.ID.String()),
+		zap.Float64("monthly_contribution", assumptions.MonthlyContribution),
+		zap.Int("horizon_months", cfg.HorizonMonths),
+	)
+
+	return forecast, nil
+}
+
+// loadForecastProfile loads the goal owner's profile; forecasts fall back to defaults without one
+func (s *goalService) loadForecastProfile(ctx context.Context, goal *domain.Goal) *profileDomain.UserProfile {
+	if s.profileService == nil {
+		return nil
+	}
+	profile, err := s.profileService.GetProfile(ctx, goal.UserID.String())
+	if err != nil {
+		s.logger.Debug("No user profile for goal forecast, using defaults",
+			zap.String("user_id", goal.UserID.String()),
+			zap.Error(err),
+		)
+		return nil
+	}
+	return profile
+}
+
+// forecastAssumptions derives the simulation inputs and the summary reported with the forecast
+func (s *goalService) forecastAssumptions(
+	ctx context.Context,
+	goal *domain.Goal,
+	contributions []domain.GoalContribution,
+	profile *profileDomain.UserProfile,
+	now time.Time,
+) (domain.ForecastAssumptions, dto.ForecastAssumptions) {
+	var assumptions domain.ForecastAssumptions
+	var summary dto.ForecastAssumptions
+
+	// Contributions: measured history first, then the goal's own plan
+	mean, stdDev, months := goal.MonthlyContributionStats(contributions, now)
+	summary.HistoryMonths = months
+	assumptions.ContributionCV = defaultContributionCV
+	switch {
+	case months >= minForecastHistoryMonths && mean > 0:
+		assumptions.MonthlyContribution = mean
+		assumptions.ContributionCV = math.Min(stdDev/mean, maxContributionCV)
+		summary.ContributionSource = "history"
+	case goal.AutoContribute && goal.AutoContributeAmount != nil && goal.ContributionFrequency != nil && goal.ContributionFrequency.DaysPerPeriod() > 0:
+		assumptions.MonthlyContribution = *goal.AutoContributeAmount * 30 / float64(goal.ContributionFrequency.DaysPerPeriod())
+		summary.ContributionSource = "auto_contribute"
+	case goal.SuggestedContribution != nil && goal.ContributionFrequency != nil && goal.ContributionFrequency.DaysPerPeriod() > 0:
+		assumptions.MonthlyContribution = *goal.SuggestedContribution * 30 / float64(goal.ContributionFrequency.DaysPerPeriod())
+		summary.ContributionSource = "suggested"
+	case mean > 0:
+		assumptions.MonthlyContribution = mean
+		summary.ContributionSource = "history"
+	default:
+		summary.ContributionSource = "none"
+	}
+	summary.MonthlyContribution = assumptions.MonthlyContribution
+	summary.ContributionCV = assumptions.ContributionCV
+
+	// Income: declared stability, raised when much of the active income is not recurring
+	assumptions.IncomeVolatility = defaultIncomeVolatility
+	if profile != nil && profile.IncomeStability != nil {
+		if volatility, ok := incomeStabilityVolatility[*profile.IncomeStability]; ok {
+			assumptions.IncomeVolatility = volatility
+		}
+		stability := string(*profile.IncomeStability)
+		summary.IncomeStability = &stability
+	}
+	if share := s.nonRecurringIncomeShare(ctx, goal); share*oneTimeIncomeVolatility > assumptions.IncomeVolatility {
+		assumptions.IncomeVolatility = share * oneTimeIncomeVolatility
+	}
+	summary.IncomeVolatility = assumptions.IncomeVolatility
+
+	// Returns: investment goals follow the owner's risk tolerance; other savings goals earn the
+	// yield of the owner's term deposits, which is fixed and so adds no volatility
+	switch goal.Category {
+	case domain.GoalCategoryInvestment, domain.GoalCategoryRetirement:
+		returns := riskToleranceReturns[profileDomain.RiskToleranceModerate]
+		if profile != nil {
+			if r, ok := riskToleranceReturns[profile.RiskTolerance]; ok {
+				returns = r
+			}
+		}
+		assumptions.MonthlyReturn = returns[0] / 12
+		assumptions.MonthlyReturnStdDev = returns[1] / math.Sqrt(12)
+		summary.ExpectedAnnualReturn = &returns[0]
+		summary.AnnualReturnVolatility = &returns[1]
+		summary.ReturnSource = "risk_tolerance"
+	case domain.GoalCategoryDebt:
+		// Repayment goals hold no savings to earn on
+	default:
+		if yield, ok := s.depositYield(ctx, goal); ok {
+			volatility := 0.0
+			assumptions.MonthlyReturn = yield / 12
+			summary.ExpectedAnnualReturn = &yield
+			summary.AnnualReturnVolatility = &volatility
+			summary.ReturnSource = "term_deposits"
+		}
+	}
+
+	return assumptions, summary
+}
+
+// depositYield returns the effective annual yield of the owner's active term deposits, if they have any
+func (s *goalService) depositYield(ctx context.Context, goal *domain.Goal) (float64, bool) {
+	if s.deposits == nil {
+		return 0, false
+	}
+	yield, err := s.deposits.GetYieldSummary(ctx, goal.UserID)
+	if err != nil {
+		s.logger.Debug("Failed to load term deposit yield for goal forecast",
+			zap.String("user_id", goal.UserID.String()),
+			zap.Error(err),
+		)
+		return 0, false
+	}
+	if yield.Count == 0 || yield.AnnualYield <= 0 {
+		return 0, false
+	}
+	return yield.AnnualYield, true
+}
+
+// nonRecurringIncomeShare returns the share of the user's active monthly income that is not recurring
+func (s *goalService) nonRecurringIncomeShare(ctx context.Context, goal *domain.Goal) float64 {
+	if s.incomeService == nil {
+		return 0
+	}
+	incomes, err := s.incomeService.GetActiveIncomes(ctx, goal.UserID.String())
+	if err != nil {
+		s.logger.Debug("Failed to load incomes for goal forecast",
+			zap.String("user_id", goal.UserID.String()),
+			zap.Error(err),
+		)
+		return 0
+	}
+
+	var total, nonRecurring float64
+	for _, income := range incomes {
+		monthly := monthlyIncomeEquivalent(income)
+		total += monthly
+		if !income.IsRecurring {
+			nonRecurring += monthly
+		}
+	}
+	if total <= 0 {
+		return 0
+	}
+	return nonRecurring / total
+}
+
+// monthlyIncomeEquivalent converts an income to a monthly amount; one-time income is spread over a year
+func monthlyIncomeEquivalent(income *incomeDomain.IncomeProfile) float64 {
+	switch income.Frequency {
+	case "weekly":
+		return income.Amount * 52 / 12
+	case "bi-weekly":
+		return income.Amount * 26 / 12
+	case "quarterly":
+		return income.Amount / 3
+	case "yearly", "one-time":
+		return income.Amount / 12
+	default:
+		return income.Amount
+	}
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/module/cashflow/goal/dto"
+	notificationDomain "personalfinancedss/internal/module/notification/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// milestoneEventType is the WebSocket event pushed when a goal crosses a milestone
+const milestoneEventType = "goal_milestone"
+
+// CreateMilestone adds a milestone to a goal. A milestone the goal has already reached
+// is stored as achieved without a notification.
+func (s *goalService) CreateMilestone(ctx context.Context, goalID uuid.UUID, milestone *domain.GoalMilestone) error {
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		return err
+	}
+
+	milestone.GoalID = goal.ID
+	milestone.UserID = goal.UserID
+	if err := milestone.Validate(goal); err != nil {
+		return shared.ErrBadRequest.WithDetails("milestone", err.Error())
+	}
+	markReachedState(goal, milestone)
+
+	if err := s.repo.CreateMilestone(ctx, milestone); err != nil {
+		s.logger.Error("Failed to create goal milestone",
+			zap.String("goal_id", goalID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Goal milestone created",
+		zap.String("goal_id", goalID.String()),
+		zap.String("milestone_id", milestone.ID.String()),
+		zap.String("label", milestone.Label),
+	)
+
+	return nil
+}
+
+// GetMilestone retrieves a milestone of a goal
+func (s *goalService) GetMilestone(ctx context.Context, goalID, milestoneID uuid.UUID) (*domain.GoalMilestone, error) {
+	milestone, err := s.repo.FindMilestoneByID(ctx, goalID, milestoneID)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return milestone, nil
+}
+
+// UpdateMilestone saves changes to a milestone, re-checking whether the goal has reached it
+func (s *goalService) UpdateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error {
+	goal, err := s.repo.FindByID(ctx, milestone.GoalID)
+	if err != nil {
+		return err
+	}
+
+	if err := milestone.Validate(goal); err != nil {
+		return shared.ErrBadRequest.WithDetails("milestone", err.Error())
+	}
+	markReachedState(goal, milestone)
+
+	if err := s.repo.UpdateMilestone(ctx, milestone); err != nil {
+		s.logger.Error("Failed to update goal milestone",
+			zap.String("milestone_id", milestone.ID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrInternal.WithError(err)
+	}
+
+	return nil
+}
+
+// DeleteMilestone removes a milestone from a goal
+func (s *goalService) DeleteMilestone(ctx context.Context, goalID, milestoneID uuid.UUID) error {
+	if err := s.repo.DeleteMilestone(ctx, goalID, milestoneID); err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return err
+		}
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
+
+// GetMilestoneProgress reports each milestone of a goal as achieved, on track or behind,
+// projecting the goal's recent contribution pace forward
+func (s *goalService) GetMilestoneProgress(ctx context.Context, goalID uuid.UUID) (*dto.GoalMilestonesProgress, error) {
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones, err := s.repo.FindMilestonesByGoalID(ctx, goalID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	contributions, err := s.repo.FindContributionsByGoalID(ctx, goalID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	now := time.Now()
+	pace := goal.ContributionPace(contributions, now)
+
+	progress := &dto.GoalMilestonesProgress{
+		GoalID:           goal.ID,
+		FundedAmount:     goal.FundedAmount(),
+		ContributionPace: pace,
+		Milestones:       make([]dto.MilestoneProgress, 0, len(milestones)),
+	}
+	for i := range milestones {
+		assessment := milestones[i].Assess(goal, pace, now)
+		progress.Milestones = append(progress.Milestones, dto.ToMilestoneProgress(&milestones[i], assessment))
+	}
+
+	return progress, nil
+}
+
+// SyncMilestones detects milestones the goal has crossed since they were last checked,
+// records them and notifies the goal owner
+func (s *goalService) SyncMilestones(ctx context.Context, goalID uuid.UUID) error {
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		return err
+	}
+	return s.syncMilestones(ctx, goal)
+}
+
+// syncMilestones records the milestones whose reached state changed with the goal's funded amount.
+// The achieved time only changes if it is still in the old state, so a crossing is notified once
+// even when contributions land concurrently.
+func (s *goalService) syncMilestones(ctx context.Context, goal *domain.Goal) error {
+	milestones, err := s.repo.FindMilestonesByGoalID(ctx, goal.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, crossing := range goal.SyncMilestones(milestones, time.Now()) {
+		changed, err := s.repo.SetMilestoneAchievedAt(ctx, crossing.Milestone.ID, crossing.Milestone.AchievedAt)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+
+		s.logger.Info("Goal milestone crossed",
+			zap.String("goal_id", goal.ID.String()),
+			zap.String("milestone_id", crossing.Milestone.ID.String()),
+			zap.Bool("reached", crossing.Reached),
+			zap.Float64("funded_amount", goal.FundedAmount()),
+		)
+		s.notifyMilestone(ctx, goal, crossing)
+	}
+
+	return nil
+}
+
+// refreshMilestones syncs milestones after a goal's funded amount changed. The change is
+// already saved, so a failure here is only logged.
+func (s *goalService) refreshMilestones(ctx context.Context, goal *domain.Goal) {
+	if err := s.syncMilestones(ctx, goal); err != nil {
+		s.logger.Warn("Failed to sync goal milestones",
+			zap.String("goal_id", goal.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// notifyMilestone stores an in-app notification and pushes a live event for a milestone crossing
+func (s *goalService) notifyMilestone(ctx context.Context, goal *domain.Goal, crossing domain.MilestoneCrossing) {
+	if s.notifier == nil {
+		return
+	}
+
+	milestone := crossing.Milestone
+	subject := "Milestone reached: " + milestone.Label + " (" + goal.Name + ")"
+	if !crossing.Reached {
+		subject = goal.Name + " dropped below milestone " + milestone.Label
+	}
+
+	data := map[string]interface{}{
+		"goal_id":          goal.ID.String(),
+		"goal_name":        goal.Name,
+		"milestone_id":     milestone.ID.String(),
+		"label":            milestone.Label,
+		"reached":          crossing.Reached,
+		"threshold_amount": milestone.ThresholdAmount(goal),
+		"funded_amount":    goal.FundedAmount(),
+	}
+	if milestone.TargetDate != nil {
+		data["target_date"] = milestone.TargetDate.Format("2006-01-02")
+	}
+	if crossing.Reached && milestone.RewardNote != nil {
+		data["reward_note"] = *milestone.RewardNote
+	}
+
+	if err := s.notifier.NotifyInApp(ctx, goal.UserID, notificationDomain.NotificationTypeGoalMilestone, subject, data); err != nil {
+		s.logger.Warn("Failed to notify goal milestone",
+			zap.String("goal_id", goal.ID.String()),
+			zap.String("milestone_id", milestone.ID.String()),
+			zap.Error(err),
+		)
+	}
+	s.notifier.PushEvent(goal.UserID, milestoneEventType, data)
+}
+
+// markReachedState keeps a milestone's achieved time consistent with the goal's funded amount
+// when the milestone itself is created or edited
+func markReachedState(goal *domain.Goal, milestone *domain.GoalMilestone) {
+	reached := milestone.IsReached(goal)
+	switch {
+	case reached && milestone.AchievedAt == nil:
+		now := time.Now()
+		milestone.AchievedAt = &now
+	case !reached:
+		milestone.AchievedAt = nil
+	}
+}
@@ -110,7 +110,7 @@ func (s *goalService) GetGoalSummary(ctx context.Context, userID uuid.UUID) (*dt
 	for _, goal := range goals {
 		summary.TotalGoals++
 		summary.TotalTargetAmount += goal.TargetAmount
-		summary.TotalCurrentAmount += goal.CurrentAmount
+		summary.TotalCurrentAmount += goal.FundedAmount()
 		summary.TotalRemaining += goal.RemainingAmount
 		totalProgress += goal.PercentageComplete
 
@@ -132,7 +132,7 @@ func (s *goalService) GetGoalSummary(ctx context.Context, userID uuid.UUID) (*dt
 		categorySum := summary.GoalsByCategory[categoryKey]
 		categorySum.Count++
 		categorySum.TargetAmount += goal.TargetAmount
-		categorySum.CurrentAmount += goal.CurrentAmount
+		categorySum.CurrentAmount += goal.FundedAmount()
 		if categorySum.TargetAmount > 0 {
 			categorySum.Progress = (categorySum.CurrentAmount / categorySum.TargetAmount) * 100
 		}
@@ -173,7 +173,7 @@ func (s *goalService) GetGoalProgress(ctx context.Context, goalID uuid.UUID) (*d
 		Category:           goal.Category,
 		Priority:           goal.Priority,
 		TargetAmount:       goal.TargetAmount,
-		CurrentAmount:      goal.CurrentAmount,
+		CurrentAmount:      goal.FundedAmount(),
 		RemainingAmount:    goal.RemainingAmount,
 		PercentageComplete: goal.PercentageComplete,
 		Status:             goal.Status,
@@ -1,27 +1,69 @@
 package service
 
 import (
+	eventService "personalfinancedss/internal/module/calendar/event/service"
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
 	accountservice "personalfinancedss/internal/module/cashflow/account/service"
+	budgetService "personalfinancedss/internal/module/cashflow/budget/service"
 	"personalfinancedss/internal/module/cashflow/goal/repository"
+	incomeProfileService "personalfinancedss/internal/module/cashflow/income_profile/service"
+	termDepositService "personalfinancedss/internal/module/cashflow/term_deposit/service"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+	profileService "personalfinancedss/internal/module/identify/profile/service"
+	notificationService "personalfinancedss/internal/module/notification/service"
 
+	"go.uber.org/fx"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 type goalService struct {
-	repo           repository.Repository
-	accountService accountservice.Service
-	logger         *zap.Logger
+	repo            repository.Repository
+	accountService  accountservice.Service
+	accountRepo     accountRepo.Repository
+	transactionRepo transactionRepo.Repository
+	notifier        notificationService.InAppNotifier
+	profileService  profileService.Service
+	incomeService   incomeProfileService.Service
+	eventService    eventService.Service
+	budgetService   budgetService.Service
+	deposits        termDepositService.YieldReporter
+	db              *gorm.DB
+	logger          *zap.Logger
+}
+
+// Params holds the dependencies of the goal service
+type Params struct {
+	fx.In
+
+	Repo            repository.Repository
+	AccountService  accountservice.Service
+	AccountRepo     accountRepo.Repository
+	TransactionRepo transactionRepo.Repository
+	Notifier        notificationService.InAppNotifier
+	ProfileService  profileService.Service
+	IncomeService   incomeProfileService.Service
+	EventService    eventService.Service
+	BudgetService   budgetService.Service
+	Deposits        termDepositService.YieldReporter
+	DB              *gorm.DB
+	Logger          *zap.Logger
 }
 
 // NewService creates a new goal service
-func NewService(
-	repo repository.Repository,
-	accountService accountservice.Service,
-	logger *zap.Logger,
-) Service {
+func NewService(p Params) Service {
 	return &goalService{
-		repo:           repo,
-		accountService: accountService,
-		logger:         logger,
+		repo:            p.Repo,
+		accountService:  p.AccountService,
+		accountRepo:     p.AccountRepo,
+		transactionRepo: p.TransactionRepo,
+		notifier:        p.Notifier,
+		profileService:  p.ProfileService,
+		incomeService:   p.IncomeService,
+		eventService:    p.EventService,
+		budgetService:   p.BudgetService,
+		deposits:        p.Deposits,
+		db:              p.DB,
+		logger:          p.Logger,
 	}
 }
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	eventDomain "personalfinancedss/internal/module/calendar/event/domain"
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// sinkingFundPaymentNote describes the withdrawal recorded when a sinking fund's expense is paid
+const sinkingFundPaymentNote = "Sinking fund expense paid"
+
+// RecordSinkingFundPayment records the yearly expense as paid from the fund and moves it to the
+// next due date. Only the saved balance is withdrawn; what is left over carries into the next cycle.
+func (s *goalService) RecordSinkingFundPayment(
+	ctx context.Context,
+	goalID uuid.UUID,
+	amount float64,
+	paidAt time.Time,
+	note *string,
+) (*domain.Goal, error) {
+	if amount <= 0 {
+		return nil, shared.ErrBadRequest.WithDetails("amount", "must be greater than 0")
+	}
+
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+	if !goal.IsSinkingFund() {
+		return nil, shared.ErrBadRequest.WithDetails("goal", "goal is not a sinking fund")
+	}
+
+	// The next cycle starts after both the payment and the due date it paid for
+	from := domain.TruncateToDate(paidAt)
+	if goal.TargetDate != nil && goal.TargetDate.After(from) {
+		from = domain.TruncateToDate(*goal.TargetDate)
+	}
+	nextDueDate := s.nextSinkingFundDueDate(ctx, goal, from.AddDate(0, 0, 1))
+
+	withdrawn := math.Min(amount, goal.CurrentAmount)
+	if err := s.saveSinkingFundPayment(ctx, goal, withdrawn, note, paidAt, nextDueDate); err != nil {
+		s.logger.Error("Failed to record sinking fund payment",
+			zap.String("goal_id", goalID.String()),
+			zap.Error(err),
+		)
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Sinking fund expense paid",
+		zap.String("goal_id", goalID.String()),
+		zap.Float64("amount", amount),
+		zap.Float64("withdrawn", withdrawn),
+		zap.Time("next_due_date", *goal.TargetDate),
+	)
+
+	s.refreshMilestones(ctx, goal)
+
+	return goal, nil
+}
+
+// saveSinkingFundPayment withdraws the paid amount and starts the next cycle in one transaction,
+// so a failed reset does not leave the withdrawal behind
+func (s *goalService) saveSinkingFundPayment(
+	ctx context.Context,
+	goal *domain.Goal,
+	withdrawn float64,
+	note *string,
+	paidAt, nextDueDate time.Time,
+) error {
+	tx := s.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if withdrawn > 0 {
+		if note == nil {
+			defaultNote := sinkingFundPaymentNote
+			note = &defaultNote
+		}
+		contribution := domain.NewWithdrawal(goal.ID, goal.AccountID, goal.UserID, withdrawn, note, nil)
+		contribution.Currency = goal.Currency
+
+		if err := s.repo.CreateContributionWithTx(tx, contribution); err != nil {
+			tx.Rollback()
+			return err
+		}
+		goal.CurrentAmount -= withdrawn
+	}
+	goal.ResetSinkingFund(paidAt, nextDueDate)
+
+	if err := s.repo.UpdateWithTx(tx, goal); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
+
+// resolveSinkingFundDueDate sets a sinking fund's due date from its calendar event, taking the
+// first occurrence on or after from
+func (s *goalService) resolveSinkingFundDueDate(ctx context.Context, goal *domain.Goal, from time.Time) error {
+	if !goal.SinkingFund || goal.DueEventID == nil || s.eventService == nil {
+		return nil
+	}
+
+	dueDate, err := s.eventDueDate(ctx, goal, domain.TruncateToDate(from))
+	if err != nil {
+		s.logger.Debug("Calendar event for sinking fund not found",
+			zap.String("event_id", goal.DueEventID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrBadRequest.WithDetails("dueEventId", "calendar event not found")
+	}
+	goal.TargetDate = &dueDate
+	return nil
+}
+
+// syncSinkingFundEvent takes a sinking fund's due date from its calendar event when the event
+// is newly linked; an unchanged event keeps the current cycle's due date
+func (s *goalService) syncSinkingFundEvent(ctx context.Context, goal *domain.Goal) error {
+	if !goal.SinkingFund || goal.DueEventID == nil || s.eventService == nil {
+		return nil
+	}
+
+	existing, err := s.repo.FindByID(ctx, goal.ID)
+	if err != nil {
+		return err
+	}
+	if existing.SinkingFund && existing.DueEventID != nil && *existing.DueEventID == *goal.DueEventID {
+		return nil
+	}
+	return s.resolveSinkingFundDueDate(ctx, goal, time.Now())
+}
+
+// nextSinkingFundDueDate returns the fund's due date on or after from, following its calendar
+// event when it has one and otherwise repeating the current due date yearly
+func (s *goalService) nextSinkingFundDueDate(ctx context.Context, goal *domain.Goal, from time.Time) time.Time {
+	if goal.DueEventID != nil && s.eventService != nil {
+		dueDate, err := s.eventDueDate(ctx, goal, from)
+		if err == nil {
+			return dueDate
+		}
+		s.logger.Warn("Failed to read sinking fund calendar event, repeating the last due date",
+			zap.String("goal_id", goal.ID.String()),
+			zap.String("event_id", goal.DueEventID.String()),
+			zap.Error(err),
+		)
+	}
+
+	if goal.TargetDate == nil {
+		return from.AddDate(1, 0, 0)
+	}
+	return domain.NextYearlyOccurrence(domain.TruncateToDate(*goal.TargetDate), from)
+}
+
+// eventDueDate finds the first occurrence on or after from of the goal's calendar event.
+// Yearly events repeat on their date; events generated once per year, such as lunar holidays
+// whose date moves, are matched by name among the user's later events.
+func (s *goalService) eventDueDate(ctx context.Context, goal *domain.Goal, from time.Time) (time.Time, error) {
+	event, err := s.eventService.GetEvent(ctx, *goal.DueEventID, goal.UserID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	eventDate := domain.TruncateToDate(event.StartDate)
+	if event.IsRecurring && event.RecurrenceType != nil && *event.RecurrenceType == eventDomain.RecurrenceYearly {
+		return domain.NextYearlyOccurrence(eventDate, from), nil
+	}
+	if !eventDate.Before(from) {
+		return eventDate, nil
+	}
+
+	later, err := s.eventService.ListEventsByDateRange(ctx, goal.UserID, from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return time.Time{}, err
+	}
+	var next *time.Time
+	for _, candidate := range later {
+		date := domain.TruncateToDate(candidate.StartDate)
+		if candidate.Name != event.Name || date.Before(from) {
+			continue
+		}
+		if next == nil || date.Before(*next) {
+			next = &date
+		}
+	}
+	if next != nil {
+		return *next, nil
+	}
+
+	return domain.NextYearlyOccurrence(eventDate, from), nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"errors"
+
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RecordTransactionContribution records a contribution produced by a cash transaction
+// linked to the goal. It runs inside the caller's database transaction so the
+// contribution commits or rolls back together with the cash transaction.
+func (s *goalService) RecordTransactionContribution(
+	tx *gorm.DB,
+	goalID uuid.UUID,
+	transactionID uuid.UUID,
+	accountID uuid.UUID,
+	contributionType domain.ContributionType,
+	amount float64,
+) (*domain.Goal, error) {
+	if amount <= 0 {
+		return nil, shared.ErrBadRequest.WithDetails("amount", "contribution amount must be greater than 0")
+	}
+	if !contributionType.IsValid() {
+		return nil, shared.ErrBadRequest.WithDetails("type", "invalid contribution type")
+	}
+
+	goal, err := s.repo.FindByIDWithTx(tx, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if contributionType == domain.ContributionTypeWithdrawal && goal.CurrentAmount < amount {
+		return nil, shared.ErrBadRequest.WithDetails("reason", "insufficient goal balance for withdrawal")
+	}
+
+	var contribution *domain.GoalContribution
+	if contributionType == domain.ContributionTypeDeposit {
+		contribution = domain.NewDeposit(goalID, accountID, goal.UserID, amount, nil)
+	} else {
+		contribution = domain.NewWithdrawal(goalID, accountID, goal.UserID, amount, nil, nil)
+	}
+	contribution.Currency = goal.Currency
+	contribution.Source = domain.ContributionSourceTransaction
+	contribution.TransactionID = &transactionID
+
+	if err := s.applyContributionWithTx(tx, goal, contribution); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Recorded goal contribution from transaction",
+		zap.String("goal_id", goalID.String()),
+		zap.String("transaction_id", transactionID.String()),
+		zap.String("type", string(contributionType)),
+		zap.Float64("amount", amount),
+		zap.Float64("new_current_amount", goal.CurrentAmount),
+	)
+
+	return goal, nil
+}
+
+// ReverseTransactionContributions offsets every contribution a cash transaction produced
+// that has not been reversed yet. Used when the transaction is edited or deleted.
+func (s *goalService) ReverseTransactionContributions(tx *gorm.DB, transactionID uuid.UUID) error {
+	contributions, err := s.repo.FindContributionsByTransactionIDWithTx(tx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	reversed := make(map[uuid.UUID]bool, len(contributions))
+	for _, c := range contributions {
+		if c.ReversingContributionID != nil {
+			reversed[*c.ReversingContributionID] = true
+		}
+	}
+
+	for i := range contributions {
+		original := &contributions[i]
+		if original.ReversingContributionID != nil || reversed[original.ID] {
+			continue
+		}
+
+		goal, err := s.repo.FindByIDWithTx(tx, original.GoalID)
+		if err != nil {
+			if errors.Is(err, shared.ErrNotFound) {
+				// The goal was deleted; there is no balance left to correct
+				continue
+			}
+			return err
+		}
+
+		var reversal *domain.GoalContribution
+		if original.Type == domain.ContributionTypeDeposit {
+			reversal = domain.NewWithdrawal(original.GoalID, original.AccountID, original.UserID, original.Amount, nil, &original.ID)
+		} else {
+			reversal = domain.NewDeposit(original.GoalID, original.AccountID, original.UserID, original.Amount, nil)
+			reversal.ReversingContributionID = &original.ID
+		}
+		reversal.Currency = original.Currency
+		reversal.Source = domain.ContributionSourceTransaction
+		reversal.TransactionID = &transactionID
+
+		if err := s.applyContributionWithTx(tx, goal, reversal); err != nil {
+			return err
+		}
+
+		s.logger.Info("Reversed goal contribution from transaction",
+			zap.String("goal_id", original.GoalID.String()),
+			zap.String("transaction_id", transactionID.String()),
+			zap.String("contribution_id", original.ID.String()),
+			zap.Float64("new_current_amount", goal.CurrentAmount),
+		)
+	}
+
+	return nil
+}
+
+// applyContributionWithTx stores a contribution and moves the goal's current amount
+// by its net amount, keeping CurrentAmount in step with GetGoalNetContributions
+func (s *goalService) applyContributionWithTx(tx *gorm.DB, goal *domain.Goal, contribution *domain.GoalContribution) error {
+	if err := s.repo.CreateContributionWithTx(tx, contribution); err != nil {
+		s.logger.Error("Failed to create contribution record",
+			zap.String("goal_id", goal.ID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	goal.AddContribution(contribution.NetAmount())
+	if err := s.repo.UpdateWithTx(tx, goal); err != nil {
+		s.logger.Error("Failed to update goal after contribution",
+			zap.String("goal_id", goal.ID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
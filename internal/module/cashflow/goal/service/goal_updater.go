@@ -13,11 +13,16 @@ import (
 
 // UpdateGoal updates an existing goal
 func (s *goalService) UpdateGoal(ctx context.Context, goal *domain.Goal) error {
+	if err := s.syncSinkingFundEvent(ctx, goal); err != nil {
+		return err
+	}
+
 	if err := s.validateGoal(goal); err != nil {
 		return err
 	}
 
 	goal.UpdateCalculatedFields()
+	goal.SyncAutoContributeSchedule(time.Now())
 
 	s.logger.Info("Updating goal",
 		zap.String("goal_id", goal.ID.String()),
@@ -76,6 +81,38 @@ func (s *goalService) MarkAsCompleted(ctx context.Context, goalID uuid.UUID) err
 	return s.repo.Update(ctx, goal)
 }
 
+// SetAssetFunding records the value of manual assets earmarked for a goal and recalculates its progress.
+// The amount must already be in the goal's currency.
+func (s *goalService) SetAssetFunding(ctx context.Context, goalID uuid.UUID, amount float64) (*domain.Goal, error) {
+	if amount < 0 {
+		return nil, errors.New("asset funded amount cannot be negative")
+	}
+
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		s.logger.Error("Failed to find goal for asset funding",
+			zap.String("goal_id", goalID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	goal.AssetFundedAmount = amount
+	goal.UpdateCalculatedFields()
+
+	if err := s.repo.Update(ctx, goal); err != nil {
+		s.logger.Error("Failed to update goal asset funding",
+			zap.String("goal_id", goalID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.refreshMilestones(ctx, goal)
+
+	return goal, nil
+}
+
 // CheckOverdueGoals checks and marks overdue goals
 func (s *goalService) CheckOverdueGoals(ctx context.Context, userID uuid.UUID) error {
 	overdueGoals, err := s.repo.FindOverdueGoals(ctx, userID)
@@ -133,5 +170,30 @@ func (s *goalService) validateGoal(goal *domain.Goal) error {
 		return fmt.Errorf("invalid contribution frequency: %s", *goal.ContributionFrequency)
 	}
 
+	if goal.AutoContribute {
+		if goal.AutoContributeAmount == nil || *goal.AutoContributeAmount <= 0 {
+			return errors.New("auto-contribute amount must be greater than 0")
+		}
+		if goal.ContributionFrequency == nil {
+			return errors.New("contribution frequency is required for auto-contribute")
+		}
+	}
+
+	if goal.AutoContributeMinBalance != nil && *goal.AutoContributeMinBalance < 0 {
+		return errors.New("auto-contribute minimum balance must not be negative")
+	}
+
+	if goal.AutoContributeShortfall != nil && !goal.AutoContributeShortfall.IsValid() {
+		return fmt.Errorf("invalid auto-contribute shortfall policy: %s", *goal.AutoContributeShortfall)
+	}
+
+	if err := goal.ValidateSinkingFund(); err != nil {
+		return err
+	}
+
+	if err := goal.ValidateWishlist(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	budgetDomain "personalfinancedss/internal/module/cashflow/budget/domain"
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/module/cashflow/goal/dto"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	notificationDomain "personalfinancedss/internal/module/notification/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// wishlistEventType is the real-time event pushed when a cooling-off period ends
+const wishlistEventType = "wishlist_cooling_off"
+
+// ConvertWishlistItem turns a wishlist item that has waited out its cooling-off period into a
+// one-time budget. The saved amount is released from the goal and becomes the budget amount. A retry
+// after a failed attempt reuses the budget that attempt created.
+func (s *goalService) ConvertWishlistItem(ctx context.Context, goalID uuid.UUID, categoryID *uuid.UUID) (*domain.Goal, error) {
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := goal.CanConvertToBudget(now); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("goal", err.Error())
+	}
+	if s.budgetService == nil {
+		return nil, shared.ErrInternal.WithDetails("reason", "budget service unavailable")
+	}
+
+	budget, err := s.wishlistBudget(ctx, goal, categoryID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	goal, err = s.saveWishlistDecision(ctx, goalID, "Moved to budget: "+goal.Name,
+		func(g *domain.Goal) error { return g.CanConvertToBudget(now) },
+		func(g *domain.Goal) { g.MarkConverted(budget.ID, now) },
+	)
+	if err != nil {
+		s.logger.Error("Failed to mark wishlist item converted",
+			zap.String("goal_id", goalID.String()),
+			zap.String("budget_id", budget.ID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("Wishlist item converted to budget",
+		zap.String("goal_id", goalID.String()),
+		zap.String("budget_id", budget.ID.String()),
+		zap.Float64("amount", budget.Amount),
+	)
+
+	return goal, nil
+}
+
+// CancelWishlistItem drops a planned purchase, during or after its cooling-off period. The saved
+// amount is released back to the account and the item is archived.
+func (s *goalService) CancelWishlistItem(ctx context.Context, goalID uuid.UUID) (*domain.Goal, error) {
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+	if err := goal.CanCancelWishlist(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("goal", err.Error())
+	}
+
+	now := time.Now()
+	goal, err = s.saveWishlistDecision(ctx, goalID, "Wishlist item cancelled",
+		func(g *domain.Goal) error { return g.CanCancelWishlist() },
+		func(g *domain.Goal) { g.MarkWishlistCancelled(now) },
+	)
+	if err != nil {
+		s.logger.Error("Failed to cancel wishlist item",
+			zap.String("goal_id", goalID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("Wishlist item cancelled",
+		zap.String("goal_id", goalID.String()),
+		zap.Float64("amount_not_spent", goal.AmountNotSpent()),
+	)
+
+	return goal, nil
+}
+
+// LinkWishlistPurchase records the transaction a converted wishlist item was bought with
+func (s *goalService) LinkWishlistPurchase(ctx context.Context, goalID, transactionID uuid.UUID) (*domain.Goal, error) {
+	goal, err := s.repo.FindByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+	if goal.WishlistDecision == nil || *goal.WishlistDecision != domain.WishlistConverted {
+		return nil, shared.ErrBadRequest.WithDetails("goal", "only converted wishlist items can be linked to a purchase")
+	}
+
+	if s.transactionRepo != nil {
+		transaction, err := s.transactionRepo.GetByUserID(ctx, transactionID, goal.UserID)
+		if err != nil {
+			return nil, shared.ErrBadRequest.WithDetails("transactionId", "transaction not found")
+		}
+		if transaction.Direction != transactionDomain.DirectionDebit {
+			return nil, shared.ErrBadRequest.WithDetails("transactionId", "purchase must be an outgoing transaction")
+		}
+	}
+
+	if err := s.repo.SetPurchaseTransactionID(ctx, goalID, transactionID); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	goal.PurchaseTransactionID = &transactionID
+
+	s.logger.Info("Wishlist purchase linked",
+		zap.String("goal_id", goalID.String()),
+		zap.String("transaction_id", transactionID.String()),
+	)
+
+	return goal, nil
+}
+
+// LinkBudgetPurchase links a transaction booked against a budget as the purchase of the wishlist
+// item converted into that budget. Budgets that did not come from the wishlist, and items whose
+// purchase is already linked, are left alone.
+func (s *goalService) LinkBudgetPurchase(ctx context.Context, budgetID, transactionID uuid.UUID) error {
+	goal, err := s.repo.FindByConvertedBudgetID(ctx, budgetID)
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if goal.PurchaseTransactionID != nil {
+		return nil
+	}
+
+	linked, err := s.repo.LinkPurchaseIfUnlinked(ctx, goal.ID, transactionID)
+	if err != nil || !linked {
+		return err
+	}
+
+	s.logger.Info("Wishlist purchase linked from budget transaction",
+		zap.String("goal_id", goal.ID.String()),
+		zap.String("budget_id", budgetID.String()),
+		zap.String("transaction_id", transactionID.String()),
+	)
+	return nil
+}
+
+// ProcessEndedCoolingOffs tells owners that their wishlist items have waited out the cooling-off
+// period and can be converted or cancelled. Each item is notified once.
+func (s *goalService) ProcessEndedCoolingOffs(ctx context.Context, asOf time.Time) (int, error) {
+	goals, err := s.repo.FindEndedCoolingOffs(ctx, asOf)
+	if err != nil {
+		return 0, err
+	}
+
+	notified := 0
+	for i := range goals {
+		goal := &goals[i]
+		marked, err := s.repo.MarkCoolingOffNotified(ctx, goal.ID, asOf)
+		if err != nil {
+			s.logger.Error("Failed to record wishlist cooling-off notice",
+				zap.String("goal_id", goal.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		// Another run already told the owner
+		if !marked {
+			continue
+		}
+		notifiedAt := asOf
+		goal.CoolingOffNotifiedAt = &notifiedAt
+		s.notifyCoolingOffEnded(ctx, goal)
+		notified++
+	}
+
+	return notified, nil
+}
+
+// GetWishlistReport summarizes a user's wishlist and the money cancelled purchases did not cost
+func (s *goalService) GetWishlistReport(ctx context.Context, userID uuid.UUID) (*dto.WishlistReport, error) {
+	goals, err := s.repo.FindWishlistByUserID(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	now := time.Now()
+	report := &dto.WishlistReport{
+		SavingsByMonth: []dto.WishlistMonthSavings{},
+		Items:          make([]dto.WishlistItem, 0, len(goals)),
+	}
+	byMonth := make(map[string]*dto.WishlistMonthSavings)
+
+	for i := range goals {
+		goal := &goals[i]
+		report.Items = append(report.Items, dto.WishlistItem{
+			GoalID:                goal.ID,
+			Name:                  goal.Name,
+			TargetAmount:          goal.TargetAmount,
+			SavedAmount:           goal.CurrentAmount,
+			Currency:              goal.Currency,
+			CoolingOffUntil:       *goal.CoolingOffUntil,
+			CoolingOff:            goal.IsCoolingOff(now),
+			Decision:              goal.WishlistDecision,
+			DecidedAt:             goal.DecidedAt,
+			ConvertedBudgetID:     goal.ConvertedBudgetID,
+			PurchaseTransactionID: goal.PurchaseTransactionID,
+		})
+
+		switch {
+		case goal.IsCoolingOff(now):
+			report.CoolingOffCount++
+		case goal.WishlistDecision == nil:
+			report.AwaitingDecisionCount++
+		case *goal.WishlistDecision == domain.WishlistConverted:
+			report.ConvertedCount++
+			if goal.PurchaseTransactionID != nil {
+				report.PurchasedCount++
+			}
+		case *goal.WishlistDecision == domain.WishlistCancelled:
+			report.CancelledCount++
+			report.AmountNotSpent += goal.AmountNotSpent()
+			if goal.DecidedAt != nil {
+				month := goal.DecidedAt.Format("2006-01")
+				if byMonth[month] == nil {
+					byMonth[month] = &dto.WishlistMonthSavings{Month: month}
+				}
+				byMonth[month].CancelledCount++
+				byMonth[month].AmountNotSpent += goal.AmountNotSpent()
+			}
+		}
+	}
+
+	if report.ConvertedCount > 0 && s.budgetService != nil {
+		for i := range goals {
+			if goals[i].ConvertedBudgetID == nil {
+				continue
+			}
+			budget, err := s.budgetService.GetBudgetByIDForUser(ctx, *goals[i].ConvertedBudgetID, userID)
+			if err != nil {
+				continue
+			}
+			report.ConvertedAmount += budget.Amount
+		}
+	}
+
+	for _, month := range byMonth {
+		report.SavingsByMonth = append(report.SavingsByMonth, *month)
+	}
+	sort.Slice(report.SavingsByMonth, func(i, j int) bool {
+		return report.SavingsByMonth[i].Month < report.SavingsByMonth[j].Month
+	})
+
+	return report, nil
+}
+
+// wishlistBudget creates the one-time budget a wishlist item converts into, or returns the one an
+// earlier attempt at the conversion already created
+func (s *goalService) wishlistBudget(ctx context.Context, goal *domain.Goal, categoryID *uuid.UUID, now time.Time) (*budgetDomain.Budget, error) {
+	budgetID := goal.WishlistBudgetID()
+	existing, err := s.budgetService.GetBudgetByIDForUser(ctx, budgetID, goal.UserID)
+	if err == nil {
+		s.logger.Info("Reusing budget from an earlier wishlist conversion",
+			zap.String("goal_id", goal.ID.String()),
+			zap.String("budget_id", budgetID.String()),
+		)
+		return existing, nil
+	}
+	if !budgetDomain.IsBudgetNotFound(err) {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	budget := &budgetDomain.Budget{
+		ID:           budgetID,
+		UserID:       goal.UserID,
+		WorkspaceID:  goal.WorkspaceID,
+		Name:         goal.Name,
+		Description:  goal.Description,
+		Amount:       goal.BudgetAmount(),
+		Currency:     goal.Currency,
+		Period:       budgetDomain.BudgetPeriodOneTime,
+		StartDate:    domain.TruncateToDate(now),
+		CategoryID:   categoryID,
+		EnableAlerts: true,
+	}
+	if err := s.budgetService.CreateBudgetFromDomain(ctx, budget); err != nil {
+		s.logger.Error("Failed to create budget for wishlist item",
+			zap.String("goal_id", goal.ID.String()),
+			zap.Error(err),
+		)
+		if _, ok := err.(*shared.AppError); ok {
+			return nil, err
+		}
+		return nil, shared.ErrBadRequest.WithDetails("budget", err.Error())
+	}
+	return budget, nil
+}
+
+// saveWishlistDecision re-reads the item locked, checks it can still take the decision, releases its
+// savings, applies the decision and saves the goal in one transaction. A concurrent decision waits
+// for the lock and then fails the check, so the savings are released once.
+func (s *goalService) saveWishlistDecision(ctx context.Context, goalID uuid.UUID, note string, check func(*domain.Goal) error, decide func(*domain.Goal)) (*domain.Goal, error) {
+	tx := s.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	goal, err := s.repo.FindByIDWithTx(tx, goalID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := check(goal); err != nil {
+		tx.Rollback()
+		return nil, shared.ErrBadRequest.WithDetails("goal", err.Error())
+	}
+
+	if err := s.releaseSavingsWithTx(tx, goal, note); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	decide(goal)
+
+	if err := s.repo.UpdateWithTx(tx, goal); err != nil {
+		tx.Rollback()
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return goal, nil
+}
+
+// releaseSavingsWithTx withdraws everything saved in the goal so the money is no longer earmarked
+func (s *goalService) releaseSavingsWithTx(tx *gorm.DB, goal *domain.Goal, note string) error {
+	if goal.CurrentAmount <= 0 {
+		return nil
+	}
+
+	contribution := domain.NewWithdrawal(goal.ID, goal.AccountID, goal.UserID, goal.CurrentAmount, &note, nil)
+	contribution.Currency = goal.Currency
+	if err := s.repo.CreateContributionWithTx(tx, contribution); err != nil {
+		s.logger.Error("Failed to release wishlist savings",
+			zap.String("goal_id", goal.ID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrInternal.WithError(err)
+	}
+
+	goal.CurrentAmount = 0
+	goal.UpdateCalculatedFields()
+	return nil
+}
+
+// notifyCoolingOffEnded asks the user whether they still want the item now the wait is over
+func (s *goalService) notifyCoolingOffEnded(ctx context.Context, goal *domain.Goal) {
+	if s.notifier == nil {
+		return
+	}
+
+	subject := "Still want " + goal.Name + "? Your cooling-off period is over"
+	data := map[string]interface{}{
+		"goal_id":           goal.ID.String(),
+		"goal_name":         goal.Name,
+		"target_amount":     goal.TargetAmount,
+		"saved_amount":      goal.CurrentAmount,
+		"cooling_off_days":  goal.CoolingOffDays,
+		"cooling_off_until": goal.CoolingOffUntil.Format("2006-01-02"),
+	}
+
+	if err := s.notifier.NotifyInApp(ctx, goal.UserID, notificationDomain.NotificationTypeWishlistCoolingOff, subject, data); err != nil {
+		s.logger.Warn("Failed to notify wishlist cooling-off end",
+			zap.String("goal_id", goal.ID.String()),
+			zap.Error(err),
+		)
+	}
+	s.notifier.PushEvent(goal.UserID, wishlistEventType, data)
+}
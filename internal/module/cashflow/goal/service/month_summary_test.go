@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // MockRepository is a mock implementation of goal repository
@@ -27,6 +28,22 @@ func (m *MockRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Go
 	return args.Get(0).(*domain.Goal), args.Error(1)
 }
 
+func (m *MockRepository) FindVisibleByID(ctx context.Context, id, userID uuid.UUID) (*domain.Goal, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Goal), args.Error(1)
+}
+
+func (m *MockRepository) FindEditableByID(ctx context.Context, id, userID uuid.UUID) (*domain.Goal, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Goal), args.Error(1)
+}
+
 func (m *MockRepository) GetContributionsByDateRange(ctx context.Context, goalID uuid.UUID, startDate, endDate time.Time) ([]domain.GoalContribution, error) {
 	args := m.Called(ctx, goalID, startDate, endDate)
 	if args.Get(0) == nil {
@@ -71,6 +88,28 @@ func (m *MockRepository) AddContribution(ctx context.Context, id uuid.UUID, amou
 func (m *MockRepository) CreateContribution(ctx context.Context, contribution *domain.GoalContribution) error {
 	return nil
 }
+func (m *MockRepository) FindByIDWithTx(tx *gorm.DB, id uuid.UUID) (*domain.Goal, error) {
+	return nil, nil
+}
+func (m *MockRepository) UpdateWithTx(tx *gorm.DB, goal *domain.Goal) error { return nil }
+func (m *MockRepository) CreateContributionWithTx(tx *gorm.DB, contribution *domain.GoalContribution) error {
+	return nil
+}
+func (m *MockRepository) FindContributionsByTransactionIDWithTx(tx *gorm.DB, transactionID uuid.UUID) ([]domain.GoalContribution, error) {
+	return nil, nil
+}
+func (m *MockRepository) FindDueAutoContributions(ctx context.Context, asOf time.Time) ([]domain.Goal, error) {
+	return nil, nil
+}
+func (m *MockRepository) FindAutoContributionRunWithTx(tx *gorm.DB, goalID uuid.UUID, dueDate time.Time) (*domain.AutoContributionRun, error) {
+	return nil, nil
+}
+func (m *MockRepository) SaveAutoContributionRunWithTx(tx *gorm.DB, run *domain.AutoContributionRun) error {
+	return nil
+}
+func (m *MockRepository) FindAutoContributionRuns(ctx context.Context, goalID uuid.UUID) ([]domain.AutoContributionRun, error) {
+	return nil, nil
+}
 func (m *MockRepository) FindContributionsByAccountID(ctx context.Context, accountID uuid.UUID) ([]domain.GoalContribution, error) {
 	return nil, nil
 }
@@ -80,6 +119,48 @@ func (m *MockRepository) GetNetContributionsByAccountID(ctx context.Context, acc
 func (m *MockRepository) GetNetContributionsByGoalID(ctx context.Context, goalID uuid.UUID) (float64, error) {
 	return 0, nil
 }
+func (m *MockRepository) CreateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error {
+	return nil
+}
+func (m *MockRepository) FindMilestoneByID(ctx context.Context, goalID, milestoneID uuid.UUID) (*domain.GoalMilestone, error) {
+	return nil, nil
+}
+func (m *MockRepository) FindMilestonesByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.GoalMilestone, error) {
+	return nil, nil
+}
+func (m *MockRepository) UpdateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error {
+	return nil
+}
+func (m *MockRepository) SetMilestoneAchievedAt(ctx context.Context, milestoneID uuid.UUID, achievedAt *time.Time) (bool, error) {
+	return false, nil
+}
+func (m *MockRepository) DeleteMilestone(ctx context.Context, goalID, milestoneID uuid.UUID) error {
+	return nil
+}
+func (m *MockRepository) FindWishlistByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error) {
+	return nil, nil
+}
+func (m *MockRepository) FindEndedCoolingOffs(ctx context.Context, asOf time.Time) ([]domain.Goal, error) {
+	return nil, nil
+}
+func (m *MockRepository) FindByConvertedBudgetID(ctx context.Context, budgetID uuid.UUID) (*domain.Goal, error) {
+	return nil, nil
+}
+func (m *MockRepository) MarkCoolingOffNotified(ctx context.Context, id uuid.UUID, notifiedAt time.Time) (bool, error) {
+	return false, nil
+}
+func (m *MockRepository) SetPurchaseTransactionID(ctx context.Context, id, transactionID uuid.UUID) error {
+	return nil
+}
+func (m *MockRepository) LinkPurchaseIfUnlinked(ctx context.Context, id, transactionID uuid.UUID) (bool, error) {
+	return false, nil
+}
+func (m *MockRepository) FindReminderCandidates(ctx context.Context) ([]domain.Goal, error) {
+	return nil, nil
+}
+func (m *MockRepository) MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	return nil
+}
 
 func TestGetMonthSummary(t *testing.T) {
 	goalID := uuid.New()
@@ -117,7 +198,7 @@ func TestGetMonthSummary(t *testing.T) {
 		mockRepo.On("FindByID", mock.Anything, goalID).Return(goal, nil)
 		mockRepo.On("GetContributionsByDateRange", mock.Anything, goalID, startDate, endDate).Return(contributions, nil)
 
-		svc := service.NewService(mockRepo, nil, logger)
+		svc := service.NewService(service.Params{Repo: mockRepo, Logger: logger})
 
 		result, err := svc.GetMonthSummary(context.Background(), goalID, startDate, endDate)
 
@@ -160,7 +241,7 @@ func TestGetMonthSummary(t *testing.T) {
 		mockRepo.On("FindByID", mock.Anything, goalID).Return(goal, nil)
 		mockRepo.On("GetContributionsByDateRange", mock.Anything, goalID, startDate, endDate).Return(contributions, nil)
 
-		svc := service.NewService(mockRepo, nil, logger)
+		svc := service.NewService(service.Params{Repo: mockRepo, Logger: logger})
 
 		result, err := svc.GetMonthSummary(context.Background(), goalID, startDate, endDate)
 
@@ -183,7 +264,7 @@ func TestGetMonthSummary(t *testing.T) {
 		mockRepo.On("FindByID", mock.Anything, goalID).Return(goal, nil)
 		mockRepo.On("GetContributionsByDateRange", mock.Anything, goalID, startDate, endDate).Return([]domain.GoalContribution{}, nil)
 
-		svc := service.NewService(mockRepo, nil, logger)
+		svc := service.NewService(service.Params{Repo: mockRepo, Logger: logger})
 
 		result, err := svc.GetMonthSummary(context.Background(), goalID, startDate, endDate)
 
@@ -200,7 +281,7 @@ func TestGetMonthSummary(t *testing.T) {
 
 		mockRepo.On("FindByID", mock.Anything, goalID).Return(nil, assert.AnError)
 
-		svc := service.NewService(mockRepo, nil, logger)
+		svc := service.NewService(service.Params{Repo: mockRepo, Logger: logger})
 
 		result, err := svc.GetMonthSummary(context.Background(), goalID, startDate, endDate)
 
@@ -248,7 +329,7 @@ func TestGetAllTimeSummary(t *testing.T) {
 		mockRepo.On("FindByID", mock.Anything, goalID).Return(goal, nil)
 		mockRepo.On("FindContributionsByGoalID", mock.Anything, goalID).Return(contributions, nil)
 
-		svc := service.NewService(mockRepo, nil, logger)
+		svc := service.NewService(service.Params{Repo: mockRepo, Logger: logger})
 
 		result, err := svc.GetAllTimeSummary(context.Background(), goalID)
 
@@ -280,7 +361,7 @@ func TestGetAllTimeSummary(t *testing.T) {
 		mockRepo.On("FindByID", mock.Anything, goalID).Return(goal, nil)
 		mockRepo.On("FindContributionsByGoalID", mock.Anything, goalID).Return([]domain.GoalContribution{}, nil)
 
-		svc := service.NewService(mockRepo, nil, logger)
+		svc := service.NewService(service.Params{Repo: mockRepo, Logger: logger})
 
 		result, err := svc.GetAllTimeSummary(context.Background(), goalID)
 
@@ -322,7 +403,7 @@ func TestGetAllTimeSummary(t *testing.T) {
 		mockRepo.On("FindByID", mock.Anything, goalID).Return(goal, nil)
 		mockRepo.On("FindContributionsByGoalID", mock.Anything, goalID).Return(contributions, nil)
 
-		svc := service.NewService(mockRepo, nil, logger)
+		svc := service.NewService(service.Params{Repo: mockRepo, Logger: logger})
 
 		result, err := svc.GetAllTimeSummary(context.Background(), goalID)
 
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // GoalCreator defines the interface for creating goals
@@ -27,12 +28,21 @@ type GoalReader interface {
 	GetGoalAnalytics(ctx context.Context, goalID uuid.UUID) (*dto.GoalAnalytics, error)
 }
 
+// GoalAuthorizer defines access checks for goals that may be shared through a household
+type GoalAuthorizer interface {
+	// GetGoalForUser retrieves a goal the user owns or can see; others get shared.ErrNotFound
+	GetGoalForUser(ctx context.Context, goalID, userID uuid.UUID) (*domain.Goal, error)
+	// GetEditableGoal retrieves a goal the user owns or can edit; others get shared.ErrNotFound
+	GetEditableGoal(ctx context.Context, goalID, userID uuid.UUID) (*domain.Goal, error)
+}
+
 // GoalUpdater defines the interface for updating goals
 type GoalUpdater interface {
 	UpdateGoal(ctx context.Context, goal *domain.Goal) error
 	CalculateProgress(ctx context.Context, goalID uuid.UUID) error
 	MarkAsCompleted(ctx context.Context, goalID uuid.UUID) error
 	CheckOverdueGoals(ctx context.Context, userID uuid.UUID) error
+	SetAssetFunding(ctx context.Context, goalID uuid.UUID, amount float64) (*domain.Goal, error)
 }
 
 // GoalArchiver defines the interface for archiving/unarchiving goals
@@ -61,12 +71,77 @@ type GoalContributor interface {
 	GetAllTimeSummary(ctx context.Context, goalID uuid.UUID) (*dto.GoalAllTimeSummary, error)
 }
 
+// GoalTransactionLinker defines contributions driven by cash transactions linked to a goal.
+// Both methods run inside the caller's database transaction; call SyncMilestones for the
+// affected goals once it commits.
+type GoalTransactionLinker interface {
+	RecordTransactionContribution(tx *gorm.DB, goalID, transactionID, accountID uuid.UUID, contributionType domain.ContributionType, amount float64) (*domain.Goal, error)
+	ReverseTransactionContributions(tx *gorm.DB, transactionID uuid.UUID) error
+}
+
+// GoalAutoContributor defines scheduled auto-contributions
+type GoalAutoContributor interface {
+	// ProcessDueAutoContributions executes every auto-contribution due on or before asOf
+	ProcessDueAutoContributions(ctx context.Context, asOf time.Time) (int, error)
+	// GetAutoContributionRuns retrieves the auto-contribution history of a goal
+	GetAutoContributionRuns(ctx context.Context, goalID uuid.UUID) ([]domain.AutoContributionRun, error)
+}
+
+// GoalMilestoneManager defines milestones on the way to a goal
+type GoalMilestoneManager interface {
+	CreateMilestone(ctx context.Context, goalID uuid.UUID, milestone *domain.GoalMilestone) error
+	GetMilestone(ctx context.Context, goalID, milestoneID uuid.UUID) (*domain.GoalMilestone, error)
+	UpdateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error
+	DeleteMilestone(ctx context.Context, goalID, milestoneID uuid.UUID) error
+	// GetMilestoneProgress reports each milestone as achieved, on track or behind the contribution pace
+	GetMilestoneProgress(ctx context.Context, goalID uuid.UUID) (*dto.GoalMilestonesProgress, error)
+	// SyncMilestones records and notifies milestones crossed since the goal's funded amount last changed.
+	// Called once changes made inside another module's database transaction have committed.
+	SyncMilestones(ctx context.Context, goalID uuid.UUID) error
+}
+
+// GoalForecaster defines probabilistic completion forecasts
+type GoalForecaster interface {
+	// ForecastGoal simulates contribution, income and return uncertainty to estimate when the goal completes
+	ForecastGoal(ctx context.Context, goalID uuid.UUID) (*dto.GoalForecast, error)
+}
+
+// GoalSinkingFundManager defines sinking funds saving for expenses that are due every year
+type GoalSinkingFundManager interface {
+	// RecordSinkingFundPayment pays the yearly expense from the fund and starts its next cycle
+	RecordSinkingFundPayment(ctx context.Context, goalID uuid.UUID, amount float64, paidAt time.Time, note *string) (*domain.Goal, error)
+}
+
+// GoalWishlistManager defines the wishlist: willing goals for planned purchases that wait out a
+// cooling-off period before they become a budget or are dropped
+type GoalWishlistManager interface {
+	// ConvertWishlistItem turns an item past its cooling-off period into a one-time budget holding the saved amount
+	ConvertWishlistItem(ctx context.Context, goalID uuid.UUID, categoryID *uuid.UUID) (*domain.Goal, error)
+	// CancelWishlistItem drops the purchase, releases the saved amount and archives the item
+	CancelWishlistItem(ctx context.Context, goalID uuid.UUID) (*domain.Goal, error)
+	// LinkWishlistPurchase records the transaction a converted item was bought with
+	LinkWishlistPurchase(ctx context.Context, goalID, transactionID uuid.UUID) (*domain.Goal, error)
+	// LinkBudgetPurchase links a transaction booked against a converted item's budget as its purchase
+	LinkBudgetPurchase(ctx context.Context, budgetID, transactionID uuid.UUID) error
+	// ProcessEndedCoolingOffs notifies owners of items whose cooling-off period ended on or before asOf
+	ProcessEndedCoolingOffs(ctx context.Context, asOf time.Time) (int, error)
+	// GetWishlistReport summarizes the wishlist and what cancelled purchases saved
+	GetWishlistReport(ctx context.Context, userID uuid.UUID) (*dto.WishlistReport, error)
+}
+
 // Service is the composite interface for all goal operations
 type Service interface {
 	GoalCreator
 	GoalReader
+	GoalAuthorizer
 	GoalUpdater
 	GoalArchiver
 	GoalDeleter
 	GoalContributor
+	GoalTransactionLinker
+	GoalAutoContributor
+	GoalMilestoneManager
+	GoalForecaster
+	GoalSinkingFundManager
+	GoalWishlistManager
 }
@@ -38,10 +38,11 @@ func TestGoalContributor_AddContribution_Success(t *testing.T) {
 	})).Return(nil)
 
 	mockRepo.On("GetNetContributionsByAccountID", ctx, accountID).Return(500.0, nil)
+	mockRepo.On("FindMilestonesByGoalID", ctx, goalID).Return([]domain.GoalMilestone{}, nil)
 	// Note: AccountService mock logic is skipped in setup but logged in real implementation.
 	// Since we passed nil account service in setupGoalService, it won't be called.
 
-	updatedGoal, err := svc.AddContribution(ctx, goalID, amount, nil, "manual")
+	updatedGoal, err := svc.AddContribution(ctx, goalID, amount, nil, nil, "manual")
 
 	assert.NoError(t, err)
 	assert.NotNil(t, updatedGoal)
@@ -55,7 +56,7 @@ func TestGoalContributor_AddContribution_ValidationError(t *testing.T) {
 	svc := setupGoalService(t, mockRepo)
 	ctx := context.Background()
 
-	_, err := svc.AddContribution(ctx, uuid.New(), -100, nil, "")
+	_, err := svc.AddContribution(ctx, uuid.New(), -100, nil, nil, "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "greater than 0")
 }
@@ -88,6 +89,7 @@ func TestGoalContributor_WithdrawContribution_Success(t *testing.T) {
 	})).Return(nil)
 
 	mockRepo.On("GetNetContributionsByAccountID", ctx, accountID).Return(1500.0, nil)
+	mockRepo.On("FindMilestonesByGoalID", ctx, goalID).Return([]domain.GoalMilestone{}, nil)
 
 	updatedGoal, err := svc.WithdrawContribution(ctx, goalID, amount, nil, nil)
 
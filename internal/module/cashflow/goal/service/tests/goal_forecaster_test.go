@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/module/cashflow/goal/service"
+	termDepositDomain "personalfinancedss/internal/module/cashflow/term_deposit/domain"
+	termDepositService "personalfinancedss/internal/module/cashflow/term_deposit/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// mockYieldReporter returns a fixed term deposit yield summary
+type mockYieldReporter struct {
+	termDepositService.YieldReporter
+	summary termDepositDomain.YieldSummary
+}
+
+func (m *mockYieldReporter) GetYieldSummary(ctx context.Context, userID uuid.UUID) (*termDepositDomain.YieldSummary, error) {
+	return &m.summary, nil
+}
+
+func forecastGoal(category domain.GoalCategory) *domain.Goal {
+	target := time.Now().AddDate(2, 0, 0)
+	return &domain.Goal{
+		ID:            uuid.New(),
+		UserID:        uuid.New(),
+		Name:          "Forecast",
+		Category:      category,
+		TargetAmount:  100000000,
+		CurrentAmount: 20000000,
+		StartDate:     time.Now().AddDate(0, -1, 0),
+		TargetDate:    &target,
+		Status:        domain.GoalStatusActive,
+	}
+}
+
+func TestGoalForecaster_SavingsGoalUsesDepositYield(t *testing.T) {
+	mockRepo := &MockRepository{}
+	deposits := &mockYieldReporter{summary: termDepositDomain.YieldSummary{Balance: 50000000, AnnualYield: 0.055, Count: 2}}
+	svc := service.NewService(service.Params{Repo: mockRepo, Deposits: deposits, Logger: zap.NewNop()})
+	ctx := context.Background()
+
+	goal := forecastGoal(domain.GoalCategoryEmergency)
+	mockRepo.On("FindByID", ctx, goal.ID).Return(goal, nil)
+	mockRepo.On("FindContributionsByGoalID", ctx, goal.ID).Return([]domain.GoalContribution{}, nil)
+
+	forecast, err := svc.ForecastGoal(ctx, goal.ID)
+
+	require.NoError(t, err)
+	require.NotNil(t, forecast.Assumptions.ExpectedAnnualReturn)
+	assert.Equal(t, 0.055, *forecast.Assumptions.ExpectedAnnualReturn)
+	assert.Equal(t, 0.0, *forecast.Assumptions.AnnualReturnVolatility)
+	assert.Equal(t, "term_deposits", forecast.Assumptions.ReturnSource)
+}
+
+func TestGoalForecaster_ReturnsBySource(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("investment goals keep the risk tolerance returns", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		deposits := &mockYieldReporter{summary: termDepositDomain.YieldSummary{AnnualYield: 0.055, Count: 1}}
+		svc := service.NewService(service.Params{Repo: mockRepo, Deposits: deposits, Logger: zap.NewNop()})
+
+		goal := forecastGoal(domain.GoalCategoryInvestment)
+		mockRepo.On("FindByID", ctx, goal.ID).Return(goal, nil)
+		mockRepo.On("FindContributionsByGoalID", ctx, goal.ID).Return([]domain.GoalContribution{}, nil)
+
+		forecast, err := svc.ForecastGoal(ctx, goal.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0.07, *forecast.Assumptions.ExpectedAnnualReturn)
+		assert.Equal(t, "risk_tolerance", forecast.Assumptions.ReturnSource)
+	})
+
+	t.Run("savings goals without deposits earn nothing", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		svc := service.NewService(service.Params{Repo: mockRepo, Deposits: &mockYieldReporter{}, Logger: zap.NewNop()})
+
+		goal := forecastGoal(domain.GoalCategorySavings)
+		mockRepo.On("FindByID", ctx, goal.ID).Return(goal, nil)
+		mockRepo.On("FindContributionsByGoalID", ctx, goal.ID).Return([]domain.GoalContribution{}, nil)
+
+		forecast, err := svc.ForecastGoal(ctx, goal.ID)
+
+		require.NoError(t, err)
+		assert.Nil(t, forecast.Assumptions.ExpectedAnnualReturn)
+		assert.Empty(t, forecast.Assumptions.ReturnSource)
+	})
+}
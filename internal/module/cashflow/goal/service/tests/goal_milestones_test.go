@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGoalMilestones_CreateMilestone_AlreadyReached(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	goalID := uuid.New()
+	userID := uuid.New()
+	half := 50.0
+
+	goal := &domain.Goal{ID: goalID, UserID: userID, TargetAmount: 1000, CurrentAmount: 600}
+	milestone := &domain.GoalMilestone{Label: "Half way", TargetPercentage: &half}
+
+	mockRepo.On("FindByID", ctx, goalID).Return(goal, nil)
+	mockRepo.On("CreateMilestone", ctx, milestone).Return(nil)
+
+	err := svc.CreateMilestone(ctx, goalID, milestone)
+
+	assert.NoError(t, err)
+	assert.Equal(t, goalID, milestone.GoalID)
+	assert.Equal(t, userID, milestone.UserID)
+	assert.NotNil(t, milestone.AchievedAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGoalMilestones_CreateMilestone_ValidationError(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	goalID := uuid.New()
+	amount := 2000.0
+	goal := &domain.Goal{ID: goalID, TargetAmount: 1000}
+
+	mockRepo.On("FindByID", ctx, goalID).Return(goal, nil)
+
+	err := svc.CreateMilestone(ctx, goalID, &domain.GoalMilestone{Label: "Too far", TargetAmount: &amount})
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "CreateMilestone", mock.Anything, mock.Anything)
+}
+
+func TestGoalMilestones_SyncMilestones_RecordsCrossings(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	goalID := uuid.New()
+	reachedID := uuid.New()
+	droppedID := uuid.New()
+	pendingID := uuid.New()
+	low := 300.0
+	high := 800.0
+	top := 900.0
+	achievedAt := time.Now().AddDate(0, 0, -3)
+
+	goal := &domain.Goal{ID: goalID, TargetAmount: 1000, CurrentAmount: 500}
+	milestones := []domain.GoalMilestone{
+		{ID: reachedID, GoalID: goalID, Label: "Low", TargetAmount: &low},
+		{ID: droppedID, GoalID: goalID, Label: "High", TargetAmount: &high, AchievedAt: &achievedAt},
+		{ID: pendingID, GoalID: goalID, Label: "Top", TargetAmount: &top},
+	}
+
+	mockRepo.On("FindByID", ctx, goalID).Return(goal, nil)
+	mockRepo.On("FindMilestonesByGoalID", ctx, goalID).Return(milestones, nil)
+	mockRepo.On("SetMilestoneAchievedAt", ctx, reachedID, mock.MatchedBy(func(t *time.Time) bool {
+		return t != nil
+	})).Return(true, nil).Once()
+	mockRepo.On("SetMilestoneAchievedAt", ctx, droppedID, (*time.Time)(nil)).Return(true, nil).Once()
+
+	err := svc.SyncMilestones(ctx, goalID)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNumberOfCalls(t, "SetMilestoneAchievedAt", 2)
+}
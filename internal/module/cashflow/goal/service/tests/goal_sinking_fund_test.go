@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/module/cashflow/goal/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func TestGoalSinkingFund_RecordPayment_ResetsToNextYear(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	goalID := uuid.New()
+	due := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	goal := &domain.Goal{
+		ID:            goalID,
+		UserID:        uuid.New(),
+		AccountID:     uuid.New(),
+		Behavior:      domain.GoalBehaviorRecurring,
+		SinkingFund:   true,
+		TargetAmount:  5000000,
+		CurrentAmount: 5200000,
+		TargetDate:    &due,
+		Status:        domain.GoalStatusCompleted,
+	}
+
+	mockRepo.On("FindByID", ctx, goalID).Return(goal, nil)
+	mockRepo.On("CreateContributionWithTx", mock.Anything, mock.MatchedBy(func(c *domain.GoalContribution) bool {
+		return c.Type == domain.ContributionTypeWithdrawal && c.Amount == 4800000
+	})).Return(nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, goal).Return(nil)
+	mockRepo.On("FindMilestonesByGoalID", ctx, goalID).Return([]domain.GoalMilestone{}, nil)
+
+	paidAt := time.Date(2026, 8, 28, 0, 0, 0, 0, time.UTC)
+	updated, err := svc.RecordSinkingFundPayment(ctx, goalID, 4800000, paidAt, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 400000.0, updated.CurrentAmount)
+	assert.Equal(t, time.Date(2027, 9, 1, 0, 0, 0, 0, time.UTC), *updated.TargetDate)
+	assert.Equal(t, domain.GoalStatusActive, updated.Status)
+	assert.Equal(t, paidAt, *updated.LastPaidAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGoalSinkingFund_RecordPayment_NotSinkingFund(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	goalID := uuid.New()
+	goal := &domain.Goal{ID: goalID, Behavior: domain.GoalBehaviorFlexible, TargetAmount: 1000, CurrentAmount: 500}
+
+	mockRepo.On("FindByID", ctx, goalID).Return(goal, nil)
+
+	_, err := svc.RecordSinkingFundPayment(ctx, goalID, 500, time.Now(), nil)
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "CreateContributionWithTx", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateWithTx", mock.Anything, mock.Anything)
+}
+
+func TestGoalSinkingFund_RecordPayment_FailedResetRollsBack(t *testing.T) {
+	mockRepo := &MockRepository{}
+	db := newTestDB()
+	require.NoError(t, db.Exec("CREATE TABLE withdrawals (goal_id TEXT, amount REAL)").Error)
+	svc := service.NewService(service.Params{Repo: mockRepo, DB: db, Logger: zap.NewNop()})
+	ctx := context.Background()
+
+	goalID := uuid.New()
+	due := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	goal := &domain.Goal{
+		ID:            goalID,
+		UserID:        uuid.New(),
+		AccountID:     uuid.New(),
+		Behavior:      domain.GoalBehaviorRecurring,
+		SinkingFund:   true,
+		TargetAmount:  5000000,
+		CurrentAmount: 5000000,
+		TargetDate:    &due,
+		Status:        domain.GoalStatusCompleted,
+	}
+
+	mockRepo.On("FindByID", ctx, goalID).Return(goal, nil)
+	mockRepo.On("CreateContributionWithTx", mock.Anything, mock.AnythingOfType("*domain.GoalContribution")).
+		Run(func(args mock.Arguments) {
+			c := args.Get(1).(*domain.GoalContribution)
+			args.Get(0).(*gorm.DB).Exec("INSERT INTO withdrawals (goal_id, amount) VALUES (?, ?)", c.GoalID.String(), c.Amount)
+		}).Return(nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, goal).Return(errors.New("connection reset"))
+
+	_, err := svc.RecordSinkingFundPayment(ctx, goalID, 5000000, time.Date(2026, 8, 28, 0, 0, 0, 0, time.UTC), nil)
+
+	require.Error(t, err)
+	var withdrawals int64
+	require.NoError(t, db.Raw("SELECT COUNT(*) FROM withdrawals").Scan(&withdrawals).Error)
+	assert.Equal(t, int64(0), withdrawals)
+	mockRepo.AssertNotCalled(t, "FindMilestonesByGoalID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGoalTransactionLinker_RecordDeposit(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+
+	goalID := uuid.New()
+	transactionID := uuid.New()
+	accountID := uuid.New()
+	goal := &domain.Goal{ID: goalID, UserID: uuid.New(), TargetAmount: 5000, CurrentAmount: 1000, Currency: "VND"}
+
+	mockRepo.On("FindByIDWithTx", mock.Anything, goalID).Return(goal, nil)
+	mockRepo.On("CreateContributionWithTx", mock.Anything, mock.MatchedBy(func(c *domain.GoalContribution) bool {
+		return c.Type == domain.ContributionTypeDeposit &&
+			c.Amount == 500 &&
+			c.AccountID == accountID &&
+			c.Source == domain.ContributionSourceTransaction &&
+			c.TransactionID != nil && *c.TransactionID == transactionID
+	})).Return(nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, goal).Return(nil)
+
+	updated, err := svc.RecordTransactionContribution(nil, goalID, transactionID, accountID, domain.ContributionTypeDeposit, 500)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1500.0, updated.CurrentAmount)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGoalTransactionLinker_RecordWithdrawalInsufficient(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+
+	goalID := uuid.New()
+	goal := &domain.Goal{ID: goalID, TargetAmount: 5000, CurrentAmount: 100}
+	mockRepo.On("FindByIDWithTx", mock.Anything, goalID).Return(goal, nil)
+
+	_, err := svc.RecordTransactionContribution(nil, goalID, uuid.New(), uuid.New(), domain.ContributionTypeWithdrawal, 500)
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "CreateContributionWithTx", mock.Anything, mock.Anything)
+}
+
+func TestGoalTransactionLinker_ReverseSkipsReversed(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+
+	goalID := uuid.New()
+	transactionID := uuid.New()
+	first := domain.GoalContribution{ID: uuid.New(), GoalID: goalID, Type: domain.ContributionTypeDeposit, Amount: 300}
+	firstReversal := domain.GoalContribution{ID: uuid.New(), GoalID: goalID, Type: domain.ContributionTypeWithdrawal, Amount: 300, ReversingContributionID: &first.ID}
+	second := domain.GoalContribution{ID: uuid.New(), GoalID: goalID, Type: domain.ContributionTypeDeposit, Amount: 400}
+	goal := &domain.Goal{ID: goalID, TargetAmount: 5000, CurrentAmount: 1400}
+
+	mockRepo.On("FindContributionsByTransactionIDWithTx", mock.Anything, transactionID).
+		Return([]domain.GoalContribution{first, firstReversal, second}, nil)
+	mockRepo.On("FindByIDWithTx", mock.Anything, goalID).Return(goal, nil).Once()
+	mockRepo.On("CreateContributionWithTx", mock.Anything, mock.MatchedBy(func(c *domain.GoalContribution) bool {
+		return c.Type == domain.ContributionTypeWithdrawal &&
+			c.Amount == 400 &&
+			c.ReversingContributionID != nil && *c.ReversingContributionID == second.ID
+	})).Return(nil).Once()
+	mockRepo.On("UpdateWithTx", mock.Anything, goal).Return(nil).Once()
+
+	err := svc.ReverseTransactionContributions(nil, transactionID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1000.0, goal.CurrentAmount)
+	mockRepo.AssertExpectations(t)
+}
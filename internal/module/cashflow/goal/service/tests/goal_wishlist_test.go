@@ -0,0 +1,252 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	budgetDomain "personalfinancedss/internal/module/cashflow/budget/domain"
+	budgetService "personalfinancedss/internal/module/cashflow/budget/service"
+	"personalfinancedss/internal/module/cashflow/goal/domain"
+	"personalfinancedss/internal/module/cashflow/goal/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// mockBudgetService keeps the budgets a conversion creates in memory
+type mockBudgetService struct {
+	budgetService.Service
+	budgets map[uuid.UUID]*budgetDomain.Budget
+	created int
+}
+
+func (m *mockBudgetService) CreateBudgetFromDomain(ctx context.Context, budget *budgetDomain.Budget) error {
+	m.budgets[budget.ID] = budget
+	m.created++
+	return nil
+}
+
+func (m *mockBudgetService) GetBudgetByIDForUser(ctx context.Context, budgetID, userID uuid.UUID) (*budgetDomain.Budget, error) {
+	budget, ok := m.budgets[budgetID]
+	if !ok || budget.UserID != userID {
+		return nil, budgetDomain.ErrBudgetNotFound
+	}
+	return budget, nil
+}
+
+func newWishlistItem(start time.Time, days int) *domain.Goal {
+	goal := &domain.Goal{
+		ID:            uuid.New(),
+		UserID:        uuid.New(),
+		AccountID:     uuid.New(),
+		Name:          "Headphones",
+		Behavior:      domain.GoalBehaviorWilling,
+		TargetAmount:  3000000,
+		CurrentAmount: 1200000,
+		StartDate:     start,
+		Status:        domain.GoalStatusActive,
+	}
+	goal.SetCoolingOff(days)
+	return goal
+}
+
+func TestGoalWishlist_Cancel_ReleasesSavingsAndArchives(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	goal := newWishlistItem(time.Now(), 30)
+
+	listed := *goal
+	mockRepo.On("FindByID", ctx, goal.ID).Return(&listed, nil)
+	mockRepo.On("FindByIDWithTx", mock.Anything, goal.ID).Return(goal, nil)
+	mockRepo.On("CreateContributionWithTx", mock.Anything, mock.MatchedBy(func(c *domain.GoalContribution) bool {
+		return c.Type == domain.ContributionTypeWithdrawal && c.Amount == 1200000
+	})).Return(nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, goal).Return(nil)
+
+	updated, err := svc.CancelWishlistItem(ctx, goal.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.GoalStatusArchived, updated.Status)
+	assert.Equal(t, domain.WishlistCancelled, *updated.WishlistDecision)
+	assert.Equal(t, 0.0, updated.CurrentAmount)
+	assert.Equal(t, 3000000.0, updated.AmountNotSpent())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGoalWishlist_Cancel_DecidedMeanwhile(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	// The first read still sees an undecided item; a concurrent cancel committed before the lock was taken
+	goal := newWishlistItem(time.Now(), 30)
+	decided := *goal
+	decided.MarkWishlistCancelled(time.Now())
+	decided.CurrentAmount = 0
+	mockRepo.On("FindByID", ctx, goal.ID).Return(goal, nil)
+	mockRepo.On("FindByIDWithTx", mock.Anything, goal.ID).Return(&decided, nil)
+
+	_, err := svc.CancelWishlistItem(ctx, goal.ID)
+
+	assert.ErrorIs(t, err, shared.ErrBadRequest)
+	mockRepo.AssertNotCalled(t, "CreateContributionWithTx", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateWithTx", mock.Anything, mock.Anything)
+}
+
+func TestGoalWishlist_Convert_DuringCoolingOff(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	goal := newWishlistItem(time.Now(), 30)
+	mockRepo.On("FindByID", ctx, goal.ID).Return(goal, nil)
+
+	_, err := svc.ConvertWishlistItem(ctx, goal.ID, nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, goal.WishlistDecision)
+	mockRepo.AssertNotCalled(t, "CreateContributionWithTx", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateWithTx", mock.Anything, mock.Anything)
+}
+
+func TestGoalWishlist_Convert_RetryReusesBudget(t *testing.T) {
+	mockRepo := &MockRepository{}
+	budgets := &mockBudgetService{budgets: map[uuid.UUID]*budgetDomain.Budget{}}
+	db := newTestDB()
+	require.NoError(t, db.Exec("CREATE TABLE withdrawals (goal_id TEXT, amount REAL)").Error)
+	svc := service.NewService(service.Params{Repo: mockRepo, BudgetService: budgets, DB: db, Logger: zap.NewNop()})
+	ctx := context.Background()
+
+	item := newWishlistItem(time.Now().AddDate(0, 0, -40), 30)
+	firstLoad, secondLoad := *item, *item
+
+	mockRepo.On("FindByID", ctx, item.ID).Return(item, nil)
+	mockRepo.On("FindByIDWithTx", mock.Anything, item.ID).Return(&firstLoad, nil).Once()
+	mockRepo.On("FindByIDWithTx", mock.Anything, item.ID).Return(&secondLoad, nil).Once()
+	mockRepo.On("CreateContributionWithTx", mock.Anything, mock.MatchedBy(func(c *domain.GoalContribution) bool {
+		return c.Type == domain.ContributionTypeWithdrawal && c.Amount == 1200000
+	})).Run(func(args mock.Arguments) {
+		c := args.Get(1).(*domain.GoalContribution)
+		args.Get(0).(*gorm.DB).Exec("INSERT INTO withdrawals (goal_id, amount) VALUES (?, ?)", c.GoalID.String(), c.Amount)
+	}).Return(nil)
+	mockRepo.On("UpdateWithTx", mock.Anything, &firstLoad).Return(errors.New("connection reset")).Once()
+	mockRepo.On("UpdateWithTx", mock.Anything, &secondLoad).Return(nil).Once()
+
+	_, err := svc.ConvertWishlistItem(ctx, item.ID, nil)
+	require.Error(t, err)
+
+	var withdrawals int64
+	require.NoError(t, db.Raw("SELECT COUNT(*) FROM withdrawals").Scan(&withdrawals).Error)
+	assert.Equal(t, int64(0), withdrawals, "failed update rolls the withdrawal back")
+
+	updated, err := svc.ConvertWishlistItem(ctx, item.ID, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, budgets.created, "retry reuses the budget of the failed attempt")
+	assert.Equal(t, item.WishlistBudgetID(), *updated.ConvertedBudgetID)
+	assert.Equal(t, 1200000.0, budgets.budgets[item.WishlistBudgetID()].Amount)
+	assert.Equal(t, domain.WishlistConverted, *updated.WishlistDecision)
+	require.NoError(t, db.Raw("SELECT COUNT(*) FROM withdrawals").Scan(&withdrawals).Error)
+	assert.Equal(t, int64(1), withdrawals)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGoalWishlist_LinkBudgetPurchase(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	budgetID := uuid.New()
+	otherBudgetID := uuid.New()
+	transactionID := uuid.New()
+	goal := newWishlistItem(time.Now().AddDate(0, 0, -40), 30)
+	goal.MarkConverted(budgetID, time.Now())
+
+	laterID := uuid.New()
+
+	mockRepo.On("FindByConvertedBudgetID", ctx, budgetID).Return(goal, nil)
+	mockRepo.On("FindByConvertedBudgetID", ctx, otherBudgetID).Return(nil, shared.ErrNotFound)
+	mockRepo.On("LinkPurchaseIfUnlinked", ctx, goal.ID, transactionID).Return(true, nil).Once()
+	// A later transaction on the same budget keeps the first purchase
+	mockRepo.On("LinkPurchaseIfUnlinked", ctx, goal.ID, laterID).Return(false, nil).Once()
+
+	assert.NoError(t, svc.LinkBudgetPurchase(ctx, budgetID, transactionID))
+	assert.NoError(t, svc.LinkBudgetPurchase(ctx, budgetID, laterID))
+
+	// Budgets that did not come from the wishlist are ignored
+	assert.NoError(t, svc.LinkBudgetPurchase(ctx, otherBudgetID, uuid.New()))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGoalWishlist_ProcessEndedCoolingOffs(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	asOf := time.Now()
+	goals := []domain.Goal{
+		*newWishlistItem(asOf.AddDate(0, 0, -10), 7),
+		*newWishlistItem(asOf.AddDate(0, 0, -10), 7),
+	}
+
+	mockRepo.On("FindEndedCoolingOffs", ctx, asOf).Return(goals, nil)
+	mockRepo.On("MarkCoolingOffNotified", ctx, goals[0].ID, asOf).Return(true, nil)
+	// An overlapping run already told the owner of the second item
+	mockRepo.On("MarkCoolingOffNotified", ctx, goals[1].ID, asOf).Return(false, nil)
+
+	notified, err := svc.ProcessEndedCoolingOffs(ctx, asOf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, notified)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGoalWishlist_Report(t *testing.T) {
+	mockRepo := &MockRepository{}
+	svc := setupGoalService(t, mockRepo)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	now := time.Now()
+
+	coolingOff := newWishlistItem(now, 30)
+	awaiting := newWishlistItem(now.AddDate(0, 0, -40), 30)
+	converted := newWishlistItem(now.AddDate(0, 0, -40), 30)
+	converted.MarkConverted(uuid.New(), now)
+	purchaseID := uuid.New()
+	converted.PurchaseTransactionID = &purchaseID
+	cancelledA := newWishlistItem(now.AddDate(0, 0, -60), 14)
+	cancelledA.MarkWishlistCancelled(time.Date(2026, 5, 3, 0, 0, 0, 0, time.UTC))
+	cancelledB := newWishlistItem(now.AddDate(0, 0, -60), 14)
+	cancelledB.TargetAmount = 500000
+	cancelledB.MarkWishlistCancelled(time.Date(2026, 4, 20, 0, 0, 0, 0, time.UTC))
+
+	mockRepo.On("FindWishlistByUserID", ctx, userID).Return([]domain.Goal{
+		*coolingOff, *awaiting, *converted, *cancelledA, *cancelledB,
+	}, nil)
+
+	report, err := svc.GetWishlistReport(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.CoolingOffCount)
+	assert.Equal(t, 1, report.AwaitingDecisionCount)
+	assert.Equal(t, 1, report.ConvertedCount)
+	assert.Equal(t, 1, report.PurchasedCount)
+	assert.Equal(t, 2, report.CancelledCount)
+	assert.Equal(t, 3500000.0, report.AmountNotSpent)
+	assert.Len(t, report.Items, 5)
+	if assert.Len(t, report.SavingsByMonth, 2) {
+		assert.Equal(t, "2026-04", report.SavingsByMonth[0].Month)
+		assert.Equal(t, 500000.0, report.SavingsByMonth[0].AmountNotSpent)
+		assert.Equal(t, "2026-05", report.SavingsByMonth[1].Month)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
 )
 
 // MockRepository is a mock implementation of repository.Repository
@@ -30,6 +32,22 @@ func (m *MockRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Go
 	return args.Get(0).(*domain.Goal), args.Error(1)
 }
 
+func (m *MockRepository) FindVisibleByID(ctx context.Context, id, userID uuid.UUID) (*domain.Goal, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Goal), args.Error(1)
+}
+
+func (m *MockRepository) FindEditableByID(ctx context.Context, id, userID uuid.UUID) (*domain.Goal, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Goal), args.Error(1)
+}
+
 func (m *MockRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error) {
 	args := m.Called(ctx, userID)
 	return args.Get(0).([]domain.Goal), args.Error(1)
@@ -80,6 +98,29 @@ func (m *MockRepository) CreateContribution(ctx context.Context, contribution *d
 	return args.Error(0)
 }
 
+func (m *MockRepository) FindByIDWithTx(tx *gorm.DB, id uuid.UUID) (*domain.Goal, error) {
+	args := m.Called(tx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Goal), args.Error(1)
+}
+
+func (m *MockRepository) UpdateWithTx(tx *gorm.DB, goal *domain.Goal) error {
+	args := m.Called(tx, goal)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreateContributionWithTx(tx *gorm.DB, contribution *domain.GoalContribution) error {
+	args := m.Called(tx, contribution)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindContributionsByTransactionIDWithTx(tx *gorm.DB, transactionID uuid.UUID) ([]domain.GoalContribution, error) {
+	args := m.Called(tx, transactionID)
+	return args.Get(0).([]domain.GoalContribution), args.Error(1)
+}
+
 func (m *MockRepository) FindContributionsByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.GoalContribution, error) {
 	args := m.Called(ctx, goalID)
 	return args.Get(0).([]domain.GoalContribution), args.Error(1)
@@ -105,6 +146,105 @@ func (m *MockRepository) GetContributionsByDateRange(ctx context.Context, userID
 	return args.Get(0).([]domain.GoalContribution), args.Error(1)
 }
 
+func (m *MockRepository) FindDueAutoContributions(ctx context.Context, asOf time.Time) ([]domain.Goal, error) {
+	args := m.Called(ctx, asOf)
+	return args.Get(0).([]domain.Goal), args.Error(1)
+}
+
+func (m *MockRepository) FindAutoContributionRunWithTx(tx *gorm.DB, goalID uuid.UUID, dueDate time.Time) (*domain.AutoContributionRun, error) {
+	args := m.Called(tx, goalID, dueDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AutoContributionRun), args.Error(1)
+}
+
+func (m *MockRepository) SaveAutoContributionRunWithTx(tx *gorm.DB, run *domain.AutoContributionRun) error {
+	args := m.Called(tx, run)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindAutoContributionRuns(ctx context.Context, goalID uuid.UUID) ([]domain.AutoContributionRun, error) {
+	args := m.Called(ctx, goalID)
+	return args.Get(0).([]domain.AutoContributionRun), args.Error(1)
+}
+
+func (m *MockRepository) CreateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error {
+	args := m.Called(ctx, milestone)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindMilestoneByID(ctx context.Context, goalID, milestoneID uuid.UUID) (*domain.GoalMilestone, error) {
+	args := m.Called(ctx, goalID, milestoneID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GoalMilestone), args.Error(1)
+}
+
+func (m *MockRepository) FindMilestonesByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.GoalMilestone, error) {
+	args := m.Called(ctx, goalID)
+	return args.Get(0).([]domain.GoalMilestone), args.Error(1)
+}
+
+func (m *MockRepository) UpdateMilestone(ctx context.Context, milestone *domain.GoalMilestone) error {
+	args := m.Called(ctx, milestone)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SetMilestoneAchievedAt(ctx context.Context, milestoneID uuid.UUID, achievedAt *time.Time) (bool, error) {
+	args := m.Called(ctx, milestoneID, achievedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) DeleteMilestone(ctx context.Context, goalID, milestoneID uuid.UUID) error {
+	args := m.Called(ctx, goalID, milestoneID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindReminderCandidates(ctx context.Context) ([]domain.Goal, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Goal), args.Error(1)
+}
+
+func (m *MockRepository) MarkReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	args := m.Called(ctx, id, sentAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FindWishlistByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Goal, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]domain.Goal), args.Error(1)
+}
+
+func (m *MockRepository) FindEndedCoolingOffs(ctx context.Context, asOf time.Time) ([]domain.Goal, error) {
+	args := m.Called(ctx, asOf)
+	return args.Get(0).([]domain.Goal), args.Error(1)
+}
+
+func (m *MockRepository) FindByConvertedBudgetID(ctx context.Context, budgetID uuid.UUID) (*domain.Goal, error) {
+	args := m.Called(ctx, budgetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Goal), args.Error(1)
+}
+
+func (m *MockRepository) MarkCoolingOffNotified(ctx context.Context, id uuid.UUID, notifiedAt time.Time) (bool, error) {
+	args := m.Called(ctx, id, notifiedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) SetPurchaseTransactionID(ctx context.Context, id, transactionID uuid.UUID) error {
+	args := m.Called(ctx, id, transactionID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) LinkPurchaseIfUnlinked(ctx context.Context, id, transactionID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, id, transactionID)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockRepository) CalculateProgress(ctx context.Context, goalID uuid.UUID) error {
 	args := m.Called(ctx, goalID)
 	return args.Error(0)
@@ -129,5 +269,20 @@ func (m *MockAccountService) UpdateAvailableBalance(ctx context.Context, account
 func setupGoalService(t *testing.T, mockRepo *MockRepository) service.Service {
 	logger := zap.NewNop()
 	// For now, pass nil for account service since it's not used in basic tests
-	return service.NewService(mockRepo, nil, logger)
+	return service.NewService(service.Params{Repo: mockRepo, DB: newTestDB(), Logger: logger})
+}
+
+// newTestDB opens an in-memory database for the transactions the service runs. Its single
+// connection makes every transaction see the same database.
+func newTestDB() *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		panic(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	return db
 }
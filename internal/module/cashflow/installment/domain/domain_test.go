@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func testPlan() *InstallmentPlan {
+	p := &InstallmentPlan{
+		ID:                     uuid.New(),
+		UserID:                 uuid.New(),
+		Name:                   "Phone",
+		Provider:               ProviderCard,
+		AccountID:              uuid.New(),
+		Currency:               "VND",
+		PurchaseAmount:         10_000_000,
+		InstallmentCount:       3,
+		ConversionFee:          150_000,
+		EarlySettlementFeeRate: 3,
+		FirstChargeDate:        date(2026, 1, 31),
+		Status:                 PlanStatusActive,
+	}
+	p.Charges = p.BuildSchedule()
+	return p
+}
+
+func TestInstallmentPlan_Validate(t *testing.T) {
+	p := testPlan()
+	assert.NoError(t, p.Validate())
+
+	p.InstallmentCount = 0
+	assert.Error(t, p.Validate())
+
+	p = testPlan()
+	p.Provider = "cash"
+	assert.Error(t, p.Validate())
+}
+
+func TestInstallmentPlan_BuildSchedule(t *testing.T) {
+	t.Run("interest free", func(t *testing.T) {
+		charges := testPlan().Charges
+		require.Len(t, charges, 3)
+
+		assert.Equal(t, date(2026, 1, 31), charges[0].DueDate)
+		assert.Equal(t, date(2026, 2, 28), charges[1].DueDate, "clamped to the end of February")
+		assert.Equal(t, date(2026, 3, 31), charges[2].DueDate)
+
+		assert.Equal(t, 3_333_333.0, charges[0].Principal)
+		assert.Equal(t, 3_483_333.0, charges[0].Amount, "conversion fee with the first charge")
+		assert.Equal(t, 3_333_334.0, charges[2].Principal, "rounding remainder on the last charge")
+		assert.Equal(t, 0.0, charges[2].RemainingPrincipal)
+		for _, charge := range charges {
+			assert.Equal(t, 0.0, charge.Interest)
+		}
+	})
+
+	t.Run("declining balance interest", func(t *testing.T) {
+		p := testPlan()
+		p.InterestRate = 12
+		p.ConversionFee = 0
+		p.MonthlyFee = 10_000
+		charges := p.BuildSchedule()
+
+		assert.Equal(t, 100_000.0, charges[0].Interest)
+		assert.Equal(t, 66_667.0, charges[1].Interest)
+		assert.Equal(t, 33_333.0, charges[2].Interest)
+		assert.Equal(t, 3_443_333.0, charges[0].Amount)
+	})
+}
+
+func TestInstallmentPlan_DueChargesAndCompletion(t *testing.T) {
+	p := testPlan()
+
+	due := p.DueCharges(date(2026, 2, 28))
+	require.Len(t, due, 2)
+
+	for _, charge := range due {
+		p.MarkPosted(charge, uuid.New(), date(2026, 2, 28))
+	}
+	assert.Equal(t, PlanStatusActive, p.Status)
+	assert.Equal(t, 3, p.NextCharge().Period)
+	assert.Equal(t, 3_333_334.0, p.RemainingPrincipal())
+	assert.Equal(t, 6_816_666.0, p.PaidAmount())
+
+	p.MarkPosted(p.NextCharge(), uuid.New(), date(2026, 3, 31))
+	assert.Equal(t, PlanStatusCompleted, p.Status)
+	assert.Nil(t, p.NextCharge())
+	assert.Equal(t, 150_000.0, p.TotalCost())
+}
+
+func TestInstallmentPlan_ChargesBetween(t *testing.T) {
+	p := testPlan()
+	p.MarkPosted(&p.Charges[0], uuid.New(), date(2026, 1, 31))
+
+	charges := p.ChargesBetween(date(2026, 1, 1), date(2026, 2, 28))
+	require.Len(t, charges, 2, "posted charges of the month still count")
+	assert.Equal(t, 1, charges[0].Period)
+	assert.Equal(t, 2, charges[1].Period)
+}
+
+func TestInstallmentPlan_Settle(t *testing.T) {
+	p := testPlan()
+	p.MarkPosted(&p.Charges[0], uuid.New(), date(2026, 1, 31))
+
+	quote := p.QuoteSettlement(date(2026, 2, 10))
+	assert.Equal(t, 6_666_667.0, quote.RemainingPrincipal)
+	assert.Equal(t, 200_000.0, quote.SettlementFee)
+	assert.Equal(t, 6_866_667.0, quote.SettlementAmount)
+	assert.Equal(t, -200_000.0, quote.Savings, "interest-free plans only cost the fee to settle")
+	assert.Equal(t, 2, quote.RemainingCharges)
+
+	settled, err := p.Settle(date(2026, 2, 10))
+	require.NoError(t, err)
+	assert.Equal(t, quote, settled)
+	assert.Equal(t, PlanStatusSettledEarly, p.Status)
+	assert.Equal(t, 0.0, p.RemainingObligation())
+	assert.Equal(t, ChargeStatusCancelled, p.Charges[2].Status)
+	assert.Equal(t, 350_000.0, p.TotalCost())
+
+	_, err = p.Settle(date(2026, 2, 11))
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package domain
+
+// Provider identifies who finances an installment plan
+type Provider string
+
+const (
+	ProviderCard Provider = "card" // a credit card purchase converted to installments by the issuer
+	ProviderBNPL Provider = "bnpl" // a buy-now-pay-later app or consumer finance lender
+)
+
+// IsValid checks if the provider is valid
+func (p Provider) IsValid() bool {
+	return p == ProviderCard || p == ProviderBNPL
+}
+
+// PlanStatus represents the lifecycle of an installment plan
+type PlanStatus string
+
+const (
+	PlanStatusActive       PlanStatus = "active"        // charges still to come
+	PlanStatusCompleted    PlanStatus = "completed"     // every charge posted
+	PlanStatusSettledEarly PlanStatus = "settled_early" // remaining principal paid off before the last charge
+)
+
+// IsValid checks if the plan status is valid
+func (s PlanStatus) IsValid() bool {
+	switch s {
+	case PlanStatusActive, PlanStatusCompleted, PlanStatusSettledEarly:
+		return true
+	}
+	return false
+}
+
+// ChargeStatus represents the state of one monthly charge
+type ChargeStatus string
+
+const (
+	ChargeStatusScheduled ChargeStatus = "scheduled" // not yet due or not yet posted
+	ChargeStatusPosted    ChargeStatus = "posted"    // booked on the plan's account
+	ChargeStatusCancelled ChargeStatus = "cancelled" // replaced by an early settlement
+)
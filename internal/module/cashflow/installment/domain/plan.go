@@ -0,0 +1,343 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MaxInstallments is the longest installment plan accepted
+const MaxInstallments = 60
+
+// InstallmentPlan is a purchase paid off in monthly installments, either a credit card purchase
+// converted by the issuer (trả góp qua thẻ) or a buy-now-pay-later loan
+type InstallmentPlan struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	Name       string    `gorm:"type:varchar(255);not null;column:name" json:"name"`
+	Provider   Provider  `gorm:"type:varchar(20);not null;column:provider" json:"provider"`
+	LenderName string    `gorm:"type:varchar(255);column:lender_name" json:"lender_name,omitempty"` // BNPL app or finance company
+
+	AccountID             uuid.UUID  `gorm:"type:uuid;not null;index;column:account_id" json:"account_id"`                            // Card account or account the installments are paid from
+	PurchaseTransactionID *uuid.UUID `gorm:"type:uuid;index;column:purchase_transaction_id" json:"purchase_transaction_id,omitempty"` // Purchase the plan finances
+	CategoryID            uuid.UUID  `gorm:"type:uuid;not null;column:category_id" json:"category_id"`                                // Category the charges are booked to
+	Currency              string     `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+
+	// Terms
+	PurchaseAmount         float64   `gorm:"type:decimal(15,2);not null;column:purchase_amount" json:"purchase_amount"` // Principal financed
+	InstallmentCount       int       `gorm:"not null;column:installment_count" json:"installment_count"`
+	InterestRate           float64   `gorm:"type:decimal(5,2);default:0;column:interest_rate" json:"interest_rate"`                         // Annual rate (%) on the declining balance; 0 for interest-free plans
+	ConversionFee          float64   `gorm:"type:decimal(15,2);default:0;column:conversion_fee" json:"conversion_fee"`                      // One-off fee charged with the first installment
+	MonthlyFee             float64   `gorm:"type:decimal(15,2);default:0;column:monthly_fee" json:"monthly_fee"`                            // Charged with every installment
+	EarlySettlementFeeRate float64   `gorm:"type:decimal(5,2);default:0;column:early_settlement_fee_rate" json:"early_settlement_fee_rate"` // % of the remaining principal charged to settle early
+	FirstChargeDate        time.Time `gorm:"type:date;not null;column:first_charge_date" json:"first_charge_date"`
+
+	Status                  PlanStatus `gorm:"type:varchar(20);not null;default:'active';index;column:status" json:"status"`
+	ConversionTransactionID *uuid.UUID `gorm:"type:uuid;column:conversion_transaction_id" json:"conversion_transaction_id,omitempty"` // Credit that moved the purchase off the card balance
+	SettledAt               *time.Time `gorm:"type:date;column:settled_at" json:"settled_at,omitempty"`
+	SettlementAmount        float64    `gorm:"type:decimal(15,2);default:0;column:settlement_amount" json:"settlement_amount"`
+	SettlementTransactionID *uuid.UUID `gorm:"type:uuid;column:settlement_transaction_id" json:"settlement_transaction_id,omitempty"`
+
+	Charges []InstallmentCharge `gorm:"foreignKey:PlanID" json:"charges,omitempty"`
+
+	CreatedAt time.Time      `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
+}
+
+// TableName specifies the table name for InstallmentPlan
+func (InstallmentPlan) TableName() string {
+	return "installment_plans"
+}
+
+// InstallmentCharge is one monthly charge of a plan
+type InstallmentCharge struct {
+	ID      uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	PlanID  uuid.UUID `gorm:"type:uuid;not null;index;column:plan_id" json:"plan_id"`
+	UserID  uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	Period  int       `gorm:"not null;column:period" json:"period"`
+	DueDate time.Time `gorm:"type:date;not null;index;column:due_date" json:"due_date"`
+
+	Principal          float64 `gorm:"type:decimal(15,2);not null;column:principal" json:"principal"`
+	Interest           float64 `gorm:"type:decimal(15,2);default:0;column:interest" json:"interest"`
+	Fee                float64 `gorm:"type:decimal(15,2);default:0;column:fee" json:"fee"`
+	Amount             float64 `gorm:"type:decimal(15,2);not null;column:amount" json:"amount"`                           // Principal + interest + fee
+	RemainingPrincipal float64 `gorm:"type:decimal(15,2);not null;column:remaining_principal" json:"remaining_principal"` // Principal left after this charge
+
+	Status        ChargeStatus `gorm:"type:varchar(20);not null;default:'scheduled';column:status" json:"status"`
+	TransactionID *uuid.UUID   `gorm:"type:uuid;column:transaction_id" json:"transaction_id,omitempty"`
+	PostedAt      *time.Time   `gorm:"column:posted_at" json:"posted_at,omitempty"`
+}
+
+// TableName specifies the table name for InstallmentCharge
+func (InstallmentCharge) TableName() string {
+	return "installment_charges"
+}
+
+// SettlementQuote is what paying off a plan early costs compared with letting it run
+type SettlementQuote struct {
+	AsOf               time.Time `json:"as_of"`
+	RemainingPrincipal float64   `json:"remaining_principal"`
+	SettlementFee      float64   `json:"settlement_fee"`      // Early settlement fee rate × remaining principal
+	SettlementAmount   float64   `json:"settlement_amount"`   // Remaining principal + settlement fee
+	ScheduledRemaining float64   `json:"scheduled_remaining"` // What the remaining charges would cost
+	Savings            float64   `json:"savings"`             // Scheduled remaining - settlement amount; negative when settling costs more
+	RemainingCharges   int       `json:"remaining_charges"`
+}
+
+// Validate checks the plan terms
+func (p *InstallmentPlan) Validate() error {
+	if !p.Provider.IsValid() {
+		return errors.New("invalid provider")
+	}
+	if p.PurchaseAmount <= 0 {
+		return errors.New("purchase amount must be positive")
+	}
+	if p.InstallmentCount < 1 || p.InstallmentCount > MaxInstallments {
+		return errors.New("installment count must be between 1 and 60")
+	}
+	if p.InterestRate < 0 || p.InterestRate > 100 {
+		return errors.New("interest rate must be between 0 and 100")
+	}
+	if p.ConversionFee < 0 || p.MonthlyFee < 0 {
+		return errors.New("fees cannot be negative")
+	}
+	if p.EarlySettlementFeeRate < 0 || p.EarlySettlementFeeRate > 100 {
+		return errors.New("early settlement fee rate must be between 0 and 100")
+	}
+	if p.FirstChargeDate.IsZero() {
+		return errors.New("first charge date is required")
+	}
+	return nil
+}
+
+// BuildSchedule lays out the monthly charges. The principal is split into equal whole-unit parts
+// with the rounding remainder on the last charge; interest is charged on the principal still owed
+// and the conversion fee is added to the first charge.
+func (p *InstallmentPlan) BuildSchedule() []InstallmentCharge {
+	first := truncateToDay(p.FirstChargeDate)
+	part := math.Floor(p.PurchaseAmount / float64(p.InstallmentCount))
+	monthlyRate := p.InterestRate / 100 / 12
+
+	charges := make([]InstallmentCharge, 0, p.InstallmentCount)
+	remaining := roundUnits(p.PurchaseAmount)
+	for k := 1; k <= p.InstallmentCount; k++ {
+		principal := part
+		if k == p.InstallmentCount {
+			principal = remaining
+		}
+		interest := roundUnits(remaining * monthlyRate)
+		fee := p.MonthlyFee
+		if k == 1 {
+			fee += p.ConversionFee
+		}
+		remaining -= principal
+
+		charges = append(charges, InstallmentCharge{
+			PlanID:             p.ID,
+			UserID:             p.UserID,
+			Period:             k,
+			DueDate:            addMonths(first, k-1),
+			Principal:          principal,
+			Interest:           interest,
+			Fee:                fee,
+			Amount:             principal + interest + fee,
+			RemainingPrincipal: remaining,
+			Status:             ChargeStatusScheduled,
+		})
+	}
+	return charges
+}
+
+// DueCharges returns the scheduled charges due on or before asOf, oldest first
+func (p *InstallmentPlan) DueCharges(asOf time.Time) []*InstallmentCharge {
+	asOf = truncateToDay(asOf)
+	var due []*InstallmentCharge
+	for i := range p.Charges {
+		charge := &p.Charges[i]
+		if charge.Status == ChargeStatusScheduled && !charge.DueDate.After(asOf) {
+			due = append(due, charge)
+		}
+	}
+	return due
+}
+
+// ChargesBetween returns the charges, posted or still scheduled, due from from through to inclusive
+func (p *InstallmentPlan) ChargesBetween(from, to time.Time) []InstallmentCharge {
+	from, to = truncateToDay(from), truncateToDay(to)
+	var charges []InstallmentCharge
+	for _, charge := range p.Charges {
+		if charge.Status == ChargeStatusCancelled || charge.DueDate.Before(from) || charge.DueDate.After(to) {
+			continue
+		}
+		charges = append(charges, charge)
+	}
+	return charges
+}
+
+// NextCharge returns the earliest scheduled charge, or nil when none is left
+func (p *InstallmentPlan) NextCharge() *InstallmentCharge {
+	var next *InstallmentCharge
+	for i := range p.Charges {
+		charge := &p.Charges[i]
+		if charge.Status == ChargeStatusScheduled && (next == nil || charge.Period < next.Period) {
+			next = charge
+		}
+	}
+	return next
+}
+
+// RemainingPrincipal returns the principal of the charges still scheduled
+func (p *InstallmentPlan) RemainingPrincipal() float64 {
+	total := 0.0
+	for _, charge := range p.Charges {
+		if charge.Status == ChargeStatusScheduled {
+			total += charge.Principal
+		}
+	}
+	return total
+}
+
+// RemainingObligation returns what the charges still scheduled will cost, interest and fees included
+func (p *InstallmentPlan) RemainingObligation() float64 {
+	total := 0.0
+	for _, charge := range p.Charges {
+		if charge.Status == ChargeStatusScheduled {
+			total += charge.Amount
+		}
+	}
+	return total
+}
+
+// PaidAmount returns what has been paid on the plan so far, early settlement included
+func (p *InstallmentPlan) PaidAmount() float64 {
+	total := p.SettlementAmount
+	for _, charge := range p.Charges {
+		if charge.Status == ChargeStatusPosted {
+			total += charge.Amount
+		}
+	}
+	return total
+}
+
+// TotalCost returns the interest and fees the plan costs on top of the purchase: those of posted
+// and scheduled charges, plus the early settlement fee once settled
+func (p *InstallmentPlan) TotalCost() float64 {
+	total := 0.0
+	settledPrincipal := 0.0
+	for _, charge := range p.Charges {
+		switch charge.Status {
+		case ChargeStatusCancelled:
+			settledPrincipal += charge.Principal
+		default:
+			total += charge.Interest + charge.Fee
+		}
+	}
+	if p.SettledAt != nil {
+		total += p.SettlementAmount - settledPrincipal
+	}
+	return total
+}
+
+// MarkPosted records the transaction of a charge and completes the plan once no charge is left
+func (p *InstallmentPlan) MarkPosted(charge *InstallmentCharge, transactionID uuid.UUID, postedAt time.Time) {
+	charge.Status = ChargeStatusPosted
+	charge.TransactionID = &transactionID
+	charge.PostedAt = &postedAt
+
+	if p.Status == PlanStatusActive && p.NextCharge() == nil {
+		p.Status = PlanStatusCompleted
+	}
+}
+
+// QuoteSettlement prices paying off the remaining principal on asOf
+func (p *InstallmentPlan) QuoteSettlement(asOf time.Time) *SettlementQuote {
+	remaining := p.RemainingPrincipal()
+	fee := roundUnits(remaining * p.EarlySettlementFeeRate / 100)
+	scheduled := p.RemainingObligation()
+
+	count := 0
+	for _, charge := range p.Charges {
+		if charge.Status == ChargeStatusScheduled {
+			count++
+		}
+	}
+
+	return &SettlementQuote{
+		AsOf:               truncateToDay(asOf),
+		RemainingPrincipal: remaining,
+		SettlementFee:      fee,
+		SettlementAmount:   remaining + fee,
+		ScheduledRemaining: scheduled,
+		Savings:            scheduled - remaining - fee,
+		RemainingCharges:   count,
+	}
+}
+
+// Settle pays off an active plan on asOf: the remaining charges are cancelled and the quoted
+// settlement amount is recorded. It returns the quote the settlement was made at.
+func (p *InstallmentPlan) Settle(asOf time.Time) (*SettlementQuote, error) {
+	if p.Status != PlanStatusActive {
+		return nil, errors.New("only active plans can be settled")
+	}
+
+	quote := p.QuoteSettlement(asOf)
+	for i := range p.Charges {
+		if p.Charges[i].Status == ChargeStatusScheduled {
+			p.Charges[i].Status = ChargeStatusCancelled
+		}
+	}
+
+	settledAt := quote.AsOf
+	p.Status = PlanStatusSettledEarly
+	p.SettledAt = &settledAt
+	p.SettlementAmount = quote.SettlementAmount
+	return quote, nil
+}
+
+// DefaultFirstChargeDate returns the first charge date of a plan started on the purchase date:
+// one month later, as issuers bill the first installment on the next statement
+func DefaultFirstChargeDate(purchaseDate time.Time) time.Time {
+	return addMonths(truncateToDay(purchaseDate), 1)
+}
+
+// ChargeExternalID returns the idempotency key of a charge's transaction
+func (p *InstallmentPlan) ChargeExternalID(period int) string {
+	return "installment:" + p.ID.String() + ":charge:" + strconv.Itoa(period)
+}
+
+// ConversionExternalID returns the idempotency key of the credit converting a purchase. It is keyed
+// on the purchase so a purchase is only ever taken off its account once, even by a replaced plan.
+func ConversionExternalID(purchaseTransactionID uuid.UUID) string {
+	return "installment:conversion:" + purchaseTransactionID.String()
+}
+
+// SettlementExternalID returns the idempotency key of the early settlement payment
+func (p *InstallmentPlan) SettlementExternalID() string {
+	return "installment:" + p.ID.String() + ":settlement"
+}
+
+// addMonths adds months to a date, clamping to the last day of shorter months
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// roundUnits rounds to whole currency units, the precision transactions are stored in
+func roundUnits(v float64) float64 {
+	return math.Round(v)
+}
@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+// CreateInstallmentPlanRequest represents a purchase converted to monthly installments
+type CreateInstallmentPlanRequest struct {
+	Name                   string     `json:"name" binding:"required,max=255"`
+	Provider               string     `json:"provider" binding:"required,oneof=card bnpl"`
+	LenderName             *string    `json:"lender_name,omitempty" binding:"omitempty,max=255"`          // BNPL app or finance company
+	AccountID              string     `json:"account_id" binding:"required,uuid"`                         // Card account, or the account BNPL installments are paid from
+	PurchaseTransactionID  *string    `json:"purchase_transaction_id,omitempty" binding:"omitempty,uuid"` // Purchase the plan finances
+	CategoryID             *string    `json:"category_id,omitempty" binding:"omitempty,uuid"`             // Defaults to the purchase's category
+	PurchaseAmount         *float64   `json:"purchase_amount,omitempty" binding:"omitempty,gt=0"`         // Defaults to the purchase amount
+	InstallmentCount       int        `json:"installment_count" binding:"required,min=1,max=60"`
+	InterestRate           float64    `json:"interest_rate" binding:"min=0,max=100"` // Annual rate (%); 0 for interest-free plans
+	ConversionFee          float64    `json:"conversion_fee" binding:"min=0"`        // One-off fee charged with the first installment
+	MonthlyFee             float64    `json:"monthly_fee" binding:"min=0"`
+	EarlySettlementFeeRate float64    `json:"early_settlement_fee_rate" binding:"min=0,max=100"` // % of the remaining principal
+	FirstChargeDate        *time.Time `json:"first_charge_date,omitempty"`                       // Defaults to one month after the purchase
+}
+
+// ListInstallmentPlansQuery represents query parameters for listing plans
+type ListInstallmentPlansQuery struct {
+	Status *string `form:"status" binding:"omitempty,oneof=active completed settled_early"`
+}
+
+// SettleInstallmentPlanRequest represents paying off a plan early
+type SettleInstallmentPlanRequest struct {
+	SettlementDate *time.Time `json:"settlement_date,omitempty"` // Defaults to today
+}
@@ -0,0 +1,158 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/installment/domain"
+
+	"github.com/google/uuid"
+)
+
+// InstallmentChargeResponse represents one monthly charge in API responses
+type InstallmentChargeResponse struct {
+	ID                 uuid.UUID           `json:"id"`
+	Period             int                 `json:"period"`
+	DueDate            time.Time           `json:"due_date"`
+	Principal          float64             `json:"principal"`
+	Interest           float64             `json:"interest"`
+	Fee                float64             `json:"fee"`
+	Amount             float64             `json:"amount"`
+	RemainingPrincipal float64             `json:"remaining_principal"`
+	Status             domain.ChargeStatus `json:"status"`
+	TransactionID      *uuid.UUID          `json:"transaction_id,omitempty"`
+	PostedAt           *time.Time          `json:"posted_at,omitempty"`
+}
+
+// InstallmentPlanResponse represents an installment plan in API responses
+type InstallmentPlanResponse struct {
+	ID                    uuid.UUID       `json:"id"`
+	Name                  string          `json:"name"`
+	Provider              domain.Provider `json:"provider"`
+	LenderName            string          `json:"lender_name,omitempty"`
+	AccountID             uuid.UUID       `json:"account_id"`
+	PurchaseTransactionID *uuid.UUID      `json:"purchase_transaction_id,omitempty"`
+	CategoryID            uuid.UUID       `json:"category_id"`
+	Currency              string          `json:"currency"`
+
+	PurchaseAmount         float64   `json:"purchase_amount"`
+	InstallmentCount       int       `json:"installment_count"`
+	InterestRate           float64   `json:"interest_rate"`
+	ConversionFee          float64   `json:"conversion_fee"`
+	MonthlyFee             float64   `json:"monthly_fee"`
+	EarlySettlementFeeRate float64   `json:"early_settlement_fee_rate"`
+	FirstChargeDate        time.Time `json:"first_charge_date"`
+
+	PaidAmount          float64    `json:"paid_amount"`
+	RemainingPrincipal  float64    `json:"remaining_principal"`
+	RemainingObligation float64    `json:"remaining_obligation"` // Remaining charges, interest and fees included
+	TotalCost           float64    `json:"total_cost"`           // Interest and fees on top of the purchase
+	NextChargeDate      *time.Time `json:"next_charge_date,omitempty"`
+	NextChargeAmount    float64    `json:"next_charge_amount"`
+
+	Status                  domain.PlanStatus           `json:"status"`
+	ConversionTransactionID *uuid.UUID                  `json:"conversion_transaction_id,omitempty"`
+	SettledAt               *time.Time                  `json:"settled_at,omitempty"`
+	SettlementAmount        float64                     `json:"settlement_amount,omitempty"`
+	SettlementTransactionID *uuid.UUID                  `json:"settlement_transaction_id,omitempty"`
+	Charges                 []InstallmentChargeResponse `json:"charges"`
+	CreatedAt               time.Time                   `json:"created_at"`
+	UpdatedAt               time.Time                   `json:"updated_at"`
+}
+
+// SettlementQuoteResponse represents the cost of paying off a plan early
+type SettlementQuoteResponse struct {
+	PlanID             uuid.UUID `json:"plan_id"`
+	AsOf               time.Time `json:"as_of"`
+	RemainingPrincipal float64   `json:"remaining_principal"`
+	SettlementFee      float64   `json:"settlement_fee"`
+	SettlementAmount   float64   `json:"settlement_amount"`
+	ScheduledRemaining float64   `json:"scheduled_remaining"`
+	Savings            float64   `json:"savings"` // Negative when settling early costs more than paying as scheduled
+	RemainingCharges   int       `json:"remaining_charges"`
+}
+
+// ToInstallmentPlanResponse converts a domain plan to response DTO
+func ToInstallmentPlanResponse(plan *domain.InstallmentPlan) *InstallmentPlanResponse {
+	if plan == nil {
+		return nil
+	}
+
+	charges := make([]InstallmentChargeResponse, len(plan.Charges))
+	for i, charge := range plan.Charges {
+		charges[i] = InstallmentChargeResponse{
+			ID:                 charge.ID,
+			Period:             charge.Period,
+			DueDate:            charge.DueDate,
+			Principal:          charge.Principal,
+			Interest:           charge.Interest,
+			Fee:                charge.Fee,
+			Amount:             charge.Amount,
+			RemainingPrincipal: charge.RemainingPrincipal,
+			Status:             charge.Status,
+			TransactionID:      charge.TransactionID,
+			PostedAt:           charge.PostedAt,
+		}
+	}
+
+	response := &InstallmentPlanResponse{
+		ID:                      plan.ID,
+		Name:                    plan.Name,
+		Provider:                plan.Provider,
+		LenderName:              plan.LenderName,
+		AccountID:               plan.AccountID,
+		PurchaseTransactionID:   plan.PurchaseTransactionID,
+		CategoryID:              plan.CategoryID,
+		Currency:                plan.Currency,
+		PurchaseAmount:          plan.PurchaseAmount,
+		InstallmentCount:        plan.InstallmentCount,
+		InterestRate:            plan.InterestRate,
+		ConversionFee:           plan.ConversionFee,
+		MonthlyFee:              plan.MonthlyFee,
+		EarlySettlementFeeRate:  plan.EarlySettlementFeeRate,
+		FirstChargeDate:         plan.FirstChargeDate,
+		PaidAmount:              plan.PaidAmount(),
+		RemainingPrincipal:      plan.RemainingPrincipal(),
+		RemainingObligation:     plan.RemainingObligation(),
+		TotalCost:               plan.TotalCost(),
+		Status:                  plan.Status,
+		ConversionTransactionID: plan.ConversionTransactionID,
+		SettledAt:               plan.SettledAt,
+		SettlementAmount:        plan.SettlementAmount,
+		SettlementTransactionID: plan.SettlementTransactionID,
+		Charges:                 charges,
+		CreatedAt:               plan.CreatedAt,
+		UpdatedAt:               plan.UpdatedAt,
+	}
+	if next := plan.NextCharge(); next != nil {
+		response.NextChargeDate = &next.DueDate
+		response.NextChargeAmount = next.Amount
+	}
+	return response
+}
+
+// ToInstallmentPlanResponses converts domain plans to response DTOs
+func ToInstallmentPlanResponses(plans []domain.InstallmentPlan) []InstallmentPlanResponse {
+	responses := make([]InstallmentPlanResponse, len(plans))
+	for i := range plans {
+		responses[i] = *ToInstallmentPlanResponse(&plans[i])
+	}
+	return responses
+}
+
+// ToSettlementQuoteResponse converts a settlement quote to response DTO
+func ToSettlementQuoteResponse(planID uuid.UUID, quote *domain.SettlementQuote) *SettlementQuoteResponse {
+	if quote == nil {
+		return nil
+	}
+
+	return &SettlementQuoteResponse{
+		PlanID:             planID,
+		AsOf:               quote.AsOf,
+		RemainingPrincipal: quote.RemainingPrincipal,
+		SettlementFee:      quote.SettlementFee,
+		SettlementAmount:   quote.SettlementAmount,
+		ScheduledRemaining: quote.ScheduledRemaining,
+		Savings:            quote.Savings,
+		RemainingCharges:   quote.RemainingCharges,
+	}
+}
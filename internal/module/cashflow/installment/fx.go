@@ -0,0 +1,43 @@
+package installment
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/installment/handler"
+	"personalfinancedss/internal/module/cashflow/installment/repository"
+	"personalfinancedss/internal/module/cashflow/installment/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides installment plan dependencies. The charge job runs on the notification
+// scheduler through the scheduled_jobs group.
+var Module = fx.Module("installment",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Scheduled job
+		fx.Annotate(
+			service.NewChargeJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
+
+		// Handler
+		handler.NewHandler,
+	),
+	fx.Invoke(registerInstallmentRoutes),
+)
+
+func registerInstallmentRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/installment/dto"
+	"personalfinancedss/internal/module/cashflow/installment/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages installment plan endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs an installment plan handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("installment.handler"),
+	}
+}
+
+// RegisterRoutes wires installment plan routes under /api/v1/installment-plans.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	plans := r.Group("/api/v1/installment-plans")
+	plans.Use(authMiddleware.AuthMiddleware())
+	{
+		plans.POST("", h.createPlan)
+		plans.GET("", h.listPlans)
+		plans.GET("/:id", h.getPlan)
+		plans.DELETE("/:id", h.deletePlan)
+		plans.GET("/:id/settlement-quote", h.quoteSettlement)
+		plans.POST("/:id/settle", h.settleEarly)
+	}
+}
+
+// createPlan godoc
+// @Summary Create installment plan
+// @Description Convert a purchase into monthly installments on a credit card or with a BNPL lender. A purchase booked on the plan's account is reversed there, and monthly charges are posted automatically when due
+// @Tags installment-plans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateInstallmentPlanRequest true "Plan terms"
+// @Success 201 {object} dto.InstallmentPlanResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/installment-plans [post]
+func (h *Handler) createPlan(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.CreateInstallmentPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	plan, err := h.service.CreatePlan(c.Request.Context(), currentUser.ID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Installment plan created successfully", dto.ToInstallmentPlanResponse(plan))
+}
+
+// listPlans godoc
+// @Summary List installment plans
+// @Description List the user's installment plans with their remaining obligation, newest first
+// @Tags installment-plans
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status (active, completed, settled_early)"
+// @Success 200 {array} dto.InstallmentPlanResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/installment-plans [get]
+func (h *Handler) listPlans(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.ListInstallmentPlansQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	plans, err := h.service.ListPlans(c.Request.Context(), currentUser.ID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Installment plans retrieved successfully", dto.ToInstallmentPlanResponses(plans))
+}
+
+// getPlan godoc
+// @Summary Get installment plan
+// @Description Get an installment plan with its charge schedule, remaining obligation and total cost
+// @Tags installment-plans
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Plan ID"
+// @Success 200 {object} dto.InstallmentPlanResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/installment-plans/{id} [get]
+func (h *Handler) getPlan(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	planID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid plan id")
+		return
+	}
+
+	plan, err := h.service.GetPlan(c.Request.Context(), currentUser.ID, planID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Installment plan retrieved successfully", dto.ToInstallmentPlanResponse(plan))
+}
+
+// deletePlan godoc
+// @Summary Delete installment plan
+// @Description Delete a plan and stop its charges; transactions already posted are kept
+// @Tags installment-plans
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Plan ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/installment-plans/{id} [delete]
+func (h *Handler) deletePlan(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	planID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid plan id")
+		return
+	}
+
+	if err := h.service.DeletePlan(c.Request.Context(), currentUser.ID, planID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Installment plan deleted successfully")
+}
+
+// quoteSettlement godoc
+// @Summary Quote early settlement
+// @Description Price paying off an active plan today: remaining principal, early settlement fee and the difference with paying as scheduled
+// @Tags installment-plans
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Plan ID"
+// @Success 200 {object} dto.SettlementQuoteResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/installment-plans/{id}/settlement-quote [get]
+func (h *Handler) quoteSettlement(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	planID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid plan id")
+		return
+	}
+
+	quote, err := h.service.QuoteSettlement(c.Request.Context(), currentUser.ID, planID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Settlement quote retrieved successfully", dto.ToSettlementQuoteResponse(planID, quote))
+}
+
+// settleEarly godoc
+// @Summary Settle installment plan early
+// @Description Pay off an active plan: charges due by the settlement date are posted, the remaining principal plus the early settlement fee is booked on the plan's account and the remaining charges are cancelled
+// @Tags installment-plans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Plan ID"
+// @Param request body dto.SettleInstallmentPlanRequest false "Settlement date"
+// @Success 200 {object} dto.InstallmentPlanResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/installment-plans/{id}/settle [post]
+func (h *Handler) settleEarly(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	planID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid plan id")
+		return
+	}
+
+	var req dto.SettleInstallmentPlanRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+			return
+		}
+	}
+
+	plan, err := h.service.SettleEarly(c.Request.Context(), currentUser.ID, planID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Installment plan settled successfully", dto.ToInstallmentPlanResponse(plan))
+}
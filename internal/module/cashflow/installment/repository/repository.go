@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"personalfinancedss/internal/module/cashflow/installment/domain"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines data access for installment plans and their charges
+type Repository interface {
+	// Create creates a plan together with its charges
+	Create(ctx context.Context, plan *domain.InstallmentPlan) error
+
+	// Update saves a plan's own fields; charges are saved with UpdateCharge
+	Update(ctx context.Context, plan *domain.InstallmentPlan) error
+
+	// UpdateCharge saves one charge
+	UpdateCharge(ctx context.Context, charge *domain.InstallmentCharge) error
+
+	// GetByID retrieves a plan with its charges
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.InstallmentPlan, error)
+
+	// ListByUserID retrieves a user's plans with their charges, optionally filtered by status, newest first
+	ListByUserID(ctx context.Context, userID uuid.UUID, status *domain.PlanStatus) ([]domain.InstallmentPlan, error)
+
+	// ExistsByPurchaseTransactionID checks whether a plan already finances the purchase
+	ExistsByPurchaseTransactionID(ctx context.Context, transactionID uuid.UUID) (bool, error)
+
+	// ListActive retrieves all active plans with their charges
+	ListActive(ctx context.Context) ([]domain.InstallmentPlan, error)
+
+	// Delete soft-deletes a plan
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"personalfinancedss/internal/module/cashflow/installment/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new installment plan repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// withCharges preloads a plan's charges in schedule order
+func withCharges(db *gorm.DB) *gorm.DB {
+	return db.Preload("Charges", func(db *gorm.DB) *gorm.DB {
+		return db.Order("period ASC")
+	})
+}
+
+func (r *repository) Create(ctx context.Context, plan *domain.InstallmentPlan) error {
+	return r.db.WithContext(ctx).Create(plan).Error
+}
+
+func (r *repository) Update(ctx context.Context, plan *domain.InstallmentPlan) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(plan).Error
+}
+
+func (r *repository) UpdateCharge(ctx context.Context, charge *domain.InstallmentCharge) error {
+	return r.db.WithContext(ctx).Save(charge).Error
+}
+
+func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*domain.InstallmentPlan, error) {
+	var plan domain.InstallmentPlan
+	if err := withCharges(r.db.WithContext(ctx)).First(&plan, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &plan, nil
+}
+
+func (r *repository) ListByUserID(ctx context.Context, userID uuid.UUID, status *domain.PlanStatus) ([]domain.InstallmentPlan, error) {
+	var plans []domain.InstallmentPlan
+	db := withCharges(r.db.WithContext(ctx)).Where("user_id = ?", userID)
+	if status != nil {
+		db = db.Where("status = ?", *status)
+	}
+	err := db.Order("created_at DESC").Find(&plans).Error
+	return plans, err
+}
+
+func (r *repository) ExistsByPurchaseTransactionID(ctx context.Context, transactionID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.InstallmentPlan{}).
+		Where("purchase_transaction_id = ?", transactionID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *repository) ListActive(ctx context.Context) ([]domain.InstallmentPlan, error) {
+	var plans []domain.InstallmentPlan
+	err := withCharges(r.db.WithContext(ctx)).
+		Where("status = ?", domain.PlanStatusActive).
+		Find(&plans).Error
+	return plans, err
+}
+
+func (r *repository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&domain.InstallmentPlan{}, "id = ?", id).Error
+}
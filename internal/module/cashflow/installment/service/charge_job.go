@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// chargeJobSpec runs shortly after midnight so each charge posts on the day it falls due
+const chargeJobSpec = "0 30 0 * * *"
+
+// chargeJob runs the daily installment charge run on the notification scheduler
+type chargeJob struct {
+	service ChargePoster
+}
+
+// NewChargeJob creates the scheduled job that posts the monthly charges of installment plans
+func NewChargeJob(service Service) notificationService.ScheduledJob {
+	return &chargeJob{service: service}
+}
+
+func (j *chargeJob) Name() string {
+	return "installment_charges"
+}
+
+func (j *chargeJob) Spec() string {
+	return chargeJobSpec
+}
+
+func (j *chargeJob) Run(ctx context.Context) error {
+	_, err := j.service.PostDueCharges(ctx, time.Now())
+	return err
+}
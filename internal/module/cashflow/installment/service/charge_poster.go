@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/installment/domain"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"go.uber.org/zap"
+)
+
+// PostDueCharges books the due charges of all active plans.
+// Safe to run repeatedly: every charge carries an external ID unique per plan and period.
+func (s *installmentService) PostDueCharges(ctx context.Context, asOf time.Time) (int, error) {
+	plans, err := s.repo.ListActive(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	posted := 0
+	for i := range plans {
+		plan := &plans[i]
+		if len(plan.DueCharges(asOf)) == 0 {
+			continue
+		}
+
+		account, err := s.accountRepo.GetEditableByIDAndUserID(ctx, plan.AccountID.String(), plan.UserID.String())
+		if err == nil {
+			err = s.processPlan(ctx, plan, account, asOf)
+		}
+		if err != nil {
+			s.logger.Error("Failed to post installment charges",
+				zap.String("plan_id", plan.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		posted++
+	}
+
+	return posted, nil
+}
+
+// processPlan books a plan's charges due by asOf on its account and saves the plan once it completes
+func (s *installmentService) processPlan(ctx context.Context, plan *domain.InstallmentPlan, account *accountDomain.Account, asOf time.Time) error {
+	for _, charge := range plan.DueCharges(asOf) {
+		transactionID, err := s.book(ctx, posting{
+			plan:        plan,
+			account:     account,
+			direction:   transactionDomain.DirectionDebit,
+			amount:      charge.Amount,
+			date:        charge.DueDate,
+			externalID:  plan.ChargeExternalID(charge.Period),
+			description: fmt.Sprintf("Installment %d/%d: %s", charge.Period, plan.InstallmentCount, plan.Name),
+		})
+		if err != nil {
+			return err
+		}
+
+		plan.MarkPosted(charge, transactionID, time.Now())
+		if err := s.repo.UpdateCharge(ctx, charge); err != nil {
+			return shared.ErrInternal.WithError(err)
+		}
+	}
+
+	if plan.Status == domain.PlanStatusCompleted {
+		if err := s.repo.Update(ctx, plan); err != nil {
+			return shared.ErrInternal.WithError(err)
+		}
+		s.logger.Info("Installment plan completed", zap.String("plan_id", plan.ID.String()))
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/installment/domain"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// posting is a transaction a plan books on its account
+type posting struct {
+	plan        *domain.InstallmentPlan
+	account     *accountDomain.Account
+	direction   transactionDomain.Direction
+	amount      float64
+	date        time.Time
+	externalID  string
+	description string
+}
+
+// book creates the transaction of a posting and applies it to the account balance. A posting
+// already recorded by an earlier run is not booked again; its transaction ID is returned.
+func (s *installmentService) book(ctx context.Context, p posting) (uuid.UUID, error) {
+	if existing, err := s.transactionRepo.GetByExternalID(ctx, p.plan.UserID, p.externalID); err == nil && existing != nil {
+		return existing.ID, nil
+	} else if err != nil && err != shared.ErrNotFound {
+		return uuid.Nil, shared.ErrInternal.WithError(err)
+	}
+
+	amount := int64(math.Round(p.amount))
+	links := transactionDomain.TransactionLinks{
+		{Type: transactionDomain.LinkInstallmentPlan, ID: p.plan.ID.String()},
+	}
+	categoryID := p.plan.CategoryID
+	txn := &transactionDomain.Transaction{
+		ID:             uuid.New(),
+		UserID:         p.plan.UserID,
+		AccountID:      p.account.ID,
+		Source:         transactionDomain.SourceManual,
+		ExternalID:     p.externalID,
+		Direction:      p.direction,
+		Channel:        transactionDomain.ChannelUnknown,
+		Instrument:     instrumentFor(p.account),
+		BookingDate:    p.date,
+		ValueDate:      p.date,
+		Amount:         amount,
+		Currency:       p.plan.Currency,
+		Description:    p.description,
+		Reference:      p.plan.ID.String(),
+		UserCategoryID: &categoryID,
+		Links:          &links,
+	}
+	if p.plan.LenderName != "" {
+		txn.Counterparty = &transactionDomain.Counterparty{Name: p.plan.LenderName, Type: "MERCHANT"}
+	}
+	if err := s.transactionRepo.Create(ctx, txn); err != nil {
+		return uuid.Nil, shared.ErrInternal.WithError(err)
+	}
+
+	delta := float64(amount)
+	if p.direction == transactionDomain.DirectionDebit {
+		delta = -delta
+	}
+	if err := s.accountRepo.UpdateBalance(ctx, p.account.ID.String(), delta); err != nil {
+		return uuid.Nil, shared.ErrInternal.WithError(err)
+	}
+	return txn.ID, nil
+}
+
+// getAccount loads the account a plan is charged to
+func (s *installmentService) getAccount(ctx context.Context, userID, accountID uuid.UUID) (*accountDomain.Account, error) {
+	account, err := s.accountRepo.GetEditableByIDAndUserID(ctx, accountID.String(), userID.String())
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, shared.ErrBadRequest.WithDetails("account_id", "account not found")
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return account, nil
+}
+
+// instrumentFor returns the payment instrument of an account
+func instrumentFor(account *accountDomain.Account) transactionDomain.Instrument {
+	switch account.AccountType {
+	case accountDomain.AccountTypeCash:
+		return transactionDomain.InstrumentCash
+	case accountDomain.AccountTypeCreditCard:
+		return transactionDomain.InstrumentCreditCard
+	}
+	return transactionDomain.InstrumentBankAccount
+}
+
+// parseOptionalID parses an optional request ID, reporting the field when it is invalid
+func parseOptionalID(field string, id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails(field, "invalid id")
+	}
+	return &parsed, nil
+}
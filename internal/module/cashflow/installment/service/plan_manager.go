@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/installment/domain"
+	"personalfinancedss/internal/module/cashflow/installment/dto"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreatePlan converts a purchase into monthly installments. Amount, category and first charge date
+// default to those of the purchase transaction. When the purchase was booked on the plan's account,
+// it is reversed there so the account and category carry the monthly charges instead of the full
+// price. Charges already due are posted immediately.
+func (s *installmentService) CreatePlan(ctx context.Context, userID uuid.UUID, req dto.CreateInstallmentPlanRequest) (*domain.InstallmentPlan, error) {
+	accountID, err := uuid.Parse(req.AccountID)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("account_id", "invalid account id")
+	}
+	account, err := s.getAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	provider := domain.Provider(req.Provider)
+	if provider == domain.ProviderCard && account.AccountType != accountDomain.AccountTypeCreditCard {
+		return nil, shared.ErrBadRequest.WithDetails("account_id", "card installments must be charged to a credit card account")
+	}
+
+	purchase, err := s.getPurchase(ctx, userID, account, req.PurchaseTransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &domain.InstallmentPlan{
+		ID:                     uuid.New(),
+		UserID:                 userID,
+		Name:                   req.Name,
+		Provider:               provider,
+		AccountID:              account.ID,
+		Currency:               string(account.Currency),
+		InstallmentCount:       req.InstallmentCount,
+		InterestRate:           req.InterestRate,
+		ConversionFee:          req.ConversionFee,
+		MonthlyFee:             req.MonthlyFee,
+		EarlySettlementFeeRate: req.EarlySettlementFeeRate,
+		Status:                 domain.PlanStatusActive,
+	}
+	if req.LenderName != nil {
+		plan.LenderName = *req.LenderName
+	}
+
+	categoryID, err := parseOptionalID("category_id", req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	purchaseDate := time.Now()
+	if purchase != nil {
+		plan.PurchaseTransactionID = &purchase.ID
+		plan.PurchaseAmount = float64(purchase.Amount)
+		purchaseDate = purchase.BookingDate
+		if categoryID == nil {
+			categoryID = purchase.UserCategoryID
+		}
+	}
+	if req.PurchaseAmount != nil {
+		plan.PurchaseAmount = *req.PurchaseAmount
+	}
+	if plan.PurchaseAmount <= 0 {
+		return nil, shared.ErrBadRequest.WithDetails("purchase_amount", "required without a purchase transaction")
+	}
+	if purchase != nil && plan.PurchaseAmount > float64(purchase.Amount) {
+		return nil, shared.ErrBadRequest.WithDetails("purchase_amount", "cannot exceed the purchase transaction")
+	}
+	if categoryID == nil {
+		return nil, shared.ErrBadRequest.WithDetails("category_id", "required when the purchase has no category")
+	}
+	plan.CategoryID = *categoryID
+
+	plan.FirstChargeDate = domain.DefaultFirstChargeDate(purchaseDate)
+	if req.FirstChargeDate != nil {
+		plan.FirstChargeDate = *req.FirstChargeDate
+	}
+
+	if err := plan.Validate(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("plan", err.Error())
+	}
+	plan.Charges = plan.BuildSchedule()
+
+	if err := s.repo.Create(ctx, plan); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	if purchase != nil && purchase.AccountID == account.ID {
+		if err := s.convertPurchase(ctx, plan, account, purchase); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.processPlan(ctx, plan, account, time.Now()); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Installment plan created",
+		zap.String("plan_id", plan.ID.String()),
+		zap.String("account_id", plan.AccountID.String()),
+		zap.Float64("purchase_amount", plan.PurchaseAmount),
+		zap.Int("installments", plan.InstallmentCount),
+	)
+
+	return plan, nil
+}
+
+// GetPlan retrieves a plan owned by the user
+func (s *installmentService) GetPlan(ctx context.Context, userID, planID uuid.UUID) (*domain.InstallmentPlan, error) {
+	plan, err := s.repo.GetByID(ctx, planID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if plan.UserID != userID {
+		return nil, shared.ErrNotFound
+	}
+	return plan, nil
+}
+
+// ListPlans retrieves a user's plans, newest first
+func (s *installmentService) ListPlans(ctx context.Context, userID uuid.UUID, query dto.ListInstallmentPlansQuery) ([]domain.InstallmentPlan, error) {
+	var status *domain.PlanStatus
+	if query.Status != nil {
+		st := domain.PlanStatus(*query.Status)
+		status = &st
+	}
+
+	plans, err := s.repo.ListByUserID(ctx, userID, status)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return plans, nil
+}
+
+// DeletePlan removes a plan and stops its charges. Posted transactions are kept.
+func (s *installmentService) DeletePlan(ctx context.Context, userID, planID uuid.UUID) error {
+	plan, err := s.GetPlan(ctx, userID, planID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, plan.ID); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
+
+// getPurchase loads the purchase a plan finances and checks it is a spend in the account's currency
+// not already financed by another plan
+func (s *installmentService) getPurchase(ctx context.Context, userID uuid.UUID, account *accountDomain.Account, id *string) (*transactionDomain.Transaction, error) {
+	purchaseID, err := parseOptionalID("purchase_transaction_id", id)
+	if err != nil || purchaseID == nil {
+		return nil, err
+	}
+
+	purchase, err := s.transactionRepo.GetByUserID(ctx, *purchaseID, userID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, shared.ErrBadRequest.WithDetails("purchase_transaction_id", "transaction not found")
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if purchase.Direction != transactionDomain.DirectionDebit {
+		return nil, shared.ErrBadRequest.WithDetails("purchase_transaction_id", "purchase must be a debit")
+	}
+	if purchase.Currency != string(account.Currency) {
+		return nil, shared.ErrBadRequest.WithDetails("purchase_transaction_id", "purchase currency must match the account currency")
+	}
+
+	exists, err := s.repo.ExistsByPurchaseTransactionID(ctx, purchase.ID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if exists {
+		return nil, shared.ErrBadRequest.WithDetails("purchase_transaction_id", "purchase is already paid in installments")
+	}
+	return purchase, nil
+}
+
+// convertPurchase books the credit that takes the financed amount of a purchase off the account,
+// as issuers do when a card purchase is converted to installments
+func (s *installmentService) convertPurchase(ctx context.Context, plan *domain.InstallmentPlan, account *accountDomain.Account, purchase *transactionDomain.Transaction) error {
+	transactionID, err := s.book(ctx, posting{
+		plan:        plan,
+		account:     account,
+		direction:   transactionDomain.DirectionCredit,
+		amount:      plan.PurchaseAmount,
+		date:        purchase.BookingDate,
+		externalID:  domain.ConversionExternalID(purchase.ID),
+		description: "Converted to installments: " + plan.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	plan.ConversionTransactionID = &transactionID
+	if err := s.repo.Update(ctx, plan); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	"personalfinancedss/internal/module/cashflow/installment/domain"
+	"personalfinancedss/internal/module/cashflow/installment/dto"
+	"personalfinancedss/internal/module/cashflow/installment/repository"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PlanManager defines installment plan CRUD operations
+type PlanManager interface {
+	// CreatePlan converts a purchase into monthly installments and posts any charge already due
+	CreatePlan(ctx context.Context, userID uuid.UUID, req dto.CreateInstallmentPlanRequest) (*domain.InstallmentPlan, error)
+
+	// GetPlan retrieves a plan owned by the user with its charge schedule
+	GetPlan(ctx context.Context, userID, planID uuid.UUID) (*domain.InstallmentPlan, error)
+
+	// ListPlans retrieves the user's plans, newest first
+	ListPlans(ctx context.Context, userID uuid.UUID, query dto.ListInstallmentPlansQuery) ([]domain.InstallmentPlan, error)
+
+	// DeletePlan removes a plan. Posted transactions are kept.
+	DeletePlan(ctx context.Context, userID, planID uuid.UUID) error
+}
+
+// SettlementManager defines early settlement of installment plans
+type SettlementManager interface {
+	// QuoteSettlement prices paying off an active plan today
+	QuoteSettlement(ctx context.Context, userID, planID uuid.UUID) (*domain.SettlementQuote, error)
+
+	// SettleEarly pays off an active plan, booking the settlement and cancelling the remaining charges
+	SettleEarly(ctx context.Context, userID, planID uuid.UUID, req dto.SettleInstallmentPlanRequest) (*domain.InstallmentPlan, error)
+}
+
+// ChargePoster defines automatic posting of monthly charges
+type ChargePoster interface {
+	// PostDueCharges books the charges due by asOf for all active plans
+	PostDueCharges(ctx context.Context, asOf time.Time) (int, error)
+}
+
+// Service is the composite interface for all installment plan operations
+type Service interface {
+	PlanManager
+	SettlementManager
+	ChargePoster
+}
+
+// installmentService implements all installment plan use cases
+type installmentService struct {
+	repo            repository.Repository
+	accountRepo     accountRepo.Repository
+	transactionRepo transactionRepo.Repository
+	logger          *zap.Logger
+}
+
+// NewService creates a new installment plan service
+func NewService(
+	repo repository.Repository,
+	accountRepo accountRepo.Repository,
+	transactionRepo transactionRepo.Repository,
+	logger *zap.Logger,
+) Service {
+	return &installmentService{
+		repo:            repo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		logger:          logger.Named("installment.service"),
+	}
+}
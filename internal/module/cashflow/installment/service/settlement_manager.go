@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/installment/domain"
+	"personalfinancedss/internal/module/cashflow/installment/dto"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// QuoteSettlement prices paying off an active plan today
+func (s *installmentService) QuoteSettlement(ctx context.Context, userID, planID uuid.UUID) (*domain.SettlementQuote, error) {
+	plan, err := s.GetPlan(ctx, userID, planID)
+	if err != nil {
+		return nil, err
+	}
+	if plan.Status != domain.PlanStatusActive {
+		return nil, shared.ErrBadRequest.WithDetails("status", "only active plans can be settled")
+	}
+	return plan.QuoteSettlement(time.Now()), nil
+}
+
+// SettleEarly pays off an active plan. Charges due by the settlement date are posted first; the
+// remaining principal plus the early settlement fee is then booked on the plan's account and the
+// charges left are cancelled.
+func (s *installmentService) SettleEarly(ctx context.Context, userID, planID uuid.UUID, req dto.SettleInstallmentPlanRequest) (*domain.InstallmentPlan, error) {
+	plan, err := s.GetPlan(ctx, userID, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	settledAt := time.Now()
+	if req.SettlementDate != nil {
+		settledAt = *req.SettlementDate
+	}
+	if settledAt.After(time.Now()) {
+		return nil, shared.ErrBadRequest.WithDetails("settlement_date", "cannot be in the future")
+	}
+
+	account, err := s.getAccount(ctx, userID, plan.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.processPlan(ctx, plan, account, settledAt); err != nil {
+		return nil, err
+	}
+
+	quote, err := plan.Settle(settledAt)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("status", err.Error())
+	}
+
+	if quote.SettlementAmount > 0 {
+		transactionID, err := s.book(ctx, posting{
+			plan:        plan,
+			account:     account,
+			direction:   transactionDomain.DirectionDebit,
+			amount:      quote.SettlementAmount,
+			date:        quote.AsOf,
+			externalID:  plan.SettlementExternalID(),
+			description: "Installment early settlement: " + plan.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		plan.SettlementTransactionID = &transactionID
+	}
+
+	for i := range plan.Charges {
+		if plan.Charges[i].Status != domain.ChargeStatusCancelled {
+			continue
+		}
+		if err := s.repo.UpdateCharge(ctx, &plan.Charges[i]); err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+	}
+	if err := s.repo.Update(ctx, plan); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Installment plan settled early",
+		zap.String("plan_id", plan.ID.String()),
+		zap.Float64("settlement_amount", quote.SettlementAmount),
+		zap.Float64("savings", quote.Savings),
+	)
+
+	return plan, nil
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func trade(tradeType TradeType, on time.Time, quantity, price, fee float64) *Trade {
+	return &Trade{
+		ID:        uuid.New(),
+		Type:      tradeType,
+		TradeDate: on,
+		Quantity:  quantity,
+		Price:     price,
+		Fee:       fee,
+		Currency:  "VND",
+	}
+}
+
+func TestReplayTrades_FIFO(t *testing.T) {
+	sell := trade(TradeTypeSell, date(2025, 3, 1), 150, 30000, 0)
+	trades := []*Trade{
+		sell, // out of order on purpose
+		trade(TradeTypeBuy, date(2025, 1, 1), 100, 20000, 0),
+		trade(TradeTypeBuy, date(2025, 2, 1), 100, 25000, 0),
+	}
+
+	result, err := ReplayTrades(CostMethodFIFO, trades)
+	require.NoError(t, err)
+
+	// 100 @ 20,000 + 50 @ 25,000 sold
+	assert.Equal(t, 3250000.0, sell.CostOfSold)
+	assert.Equal(t, 1250000.0, sell.RealizedPnL)
+	assert.Equal(t, 50.0, result.Quantity)
+	assert.Equal(t, 1250000.0, result.CostBasis)
+	assert.Equal(t, 1250000.0, result.RealizedPnL)
+
+	require.Len(t, result.Lots, 2)
+	assert.False(t, result.Lots[0].IsOpen())
+	require.NotNil(t, result.Lots[0].ClosedAt)
+	assert.Equal(t, 50.0, result.Lots[1].RemainingQuantity)
+	assert.Equal(t, 1250000.0, result.Lots[1].RemainingCost())
+}
+
+func TestReplayTrades_AverageCost(t *testing.T) {
+	sell := trade(TradeTypeSell, date(2025, 3, 1), 150, 30000, 0)
+	trades := []*Trade{
+		trade(TradeTypeBuy, date(2025, 1, 1), 100, 20000, 0),
+		trade(TradeTypeBuy, date(2025, 2, 1), 100, 25000, 0),
+		sell,
+	}
+
+	result, err := ReplayTrades(CostMethodAverageCost, trades)
+	require.NoError(t, err)
+
+	// Average 22,500 per unit
+	assert.Equal(t, 3375000.0, sell.CostOfSold)
+	assert.Equal(t, 1125000.0, sell.RealizedPnL)
+	assert.Equal(t, 50.0, result.Quantity)
+	assert.Equal(t, 1125000.0, result.CostBasis)
+}
+
+func TestReplayTrades_FeesAndTaxes(t *testing.T) {
+	buy := trade(TradeTypeBuy, date(2025, 1, 1), 100, 10000, 1500)
+	sell := trade(TradeTypeSell, date(2025, 2, 1), 100, 12000, 1800)
+	sell.Tax = 1200
+
+	result, err := ReplayTrades(CostMethodFIFO, []*Trade{buy, sell})
+	require.NoError(t, err)
+
+	// Cost 1,001,500; proceeds 1,200,000 - 1,800 - 1,200
+	assert.Equal(t, 195500.0, sell.RealizedPnL)
+	assert.Equal(t, 0.0, result.Quantity)
+	assert.Equal(t, 0.0, result.CostBasis)
+	assert.Equal(t, 4500.0, result.FeesPaid)
+}
+
+func TestReplayTrades_InsufficientQuantity(t *testing.T) {
+	trades := []*Trade{
+		trade(TradeTypeBuy, date(2025, 1, 1), 10, 1000, 0),
+		trade(TradeTypeSell, date(2025, 2, 1), 11, 1000, 0),
+	}
+
+	_, err := ReplayTrades(CostMethodFIFO, trades)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInsufficientQuantity))
+}
+
+func TestReplayTrades_DividendsAndFees(t *testing.T) {
+	cash := trade(TradeTypeDividend, date(2025, 2, 1), 0, 0, 0)
+	cash.Amount = 200000
+	cash.Tax = 10000
+	stock := trade(TradeTypeDividend, date(2025, 3, 1), 20, 0, 0)
+	fee := trade(TradeTypeFee, date(2025, 4, 1), 0, 0, 0)
+	fee.Amount = 50000
+
+	result, err := ReplayTrades(CostMethodFIFO, []*Trade{
+		trade(TradeTypeBuy, date(2025, 1, 1), 100, 10000, 0),
+		cash, stock, fee,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 120.0, result.Quantity)
+	assert.Equal(t, 1000000.0, result.CostBasis)
+	assert.Equal(t, 190000.0, result.Dividends)
+	assert.Equal(t, -50000.0, result.RealizedPnL)
+	require.Len(t, result.Lots, 2)
+	assert.Equal(t, 0.0, result.Lots[1].CostPerUnit)
+}
+
+func TestHolding_PnL(t *testing.T) {
+	h := &Holding{Quantity: 50, CostBasis: 1000000, RealizedPnL: 200000, Dividends: 50000}
+
+	// Without a price the holding is valued at cost
+	assert.Equal(t, 1000000.0, h.MarketValue())
+	assert.Equal(t, 0.0, h.UnrealizedPnL())
+
+	h.UpdatePrice(24000, date(2025, 5, 1))
+	assert.Equal(t, 1200000.0, h.MarketValue())
+	assert.Equal(t, 200000.0, h.UnrealizedPnL())
+	assert.Equal(t, 20.0, h.UnrealizedPnLPct())
+	assert.Equal(t, 450000.0, h.TotalReturn())
+}
+
+func TestPortfolioSummary(t *testing.T) {
+	price := 30000.0
+	usdPrice := 10.0
+	summary := &PortfolioSummary{Currency: "VND"}
+	summary.Add(&Holding{AssetType: "stock", Quantity: 100, CostBasis: 2500000, CurrentPrice: &price}, 1)
+	summary.Add(&Holding{AssetType: "crypto", Quantity: 2, CostBasis: 15, CurrentPrice: &usdPrice}, 25000)
+	summary.Add(&Holding{AssetType: "stock", RealizedPnL: 100000}, 1)
+	summary.Finalize()
+
+	assert.Equal(t, 3500000.0, summary.MarketValue)
+	assert.Equal(t, 2875000.0, summary.CostBasis)
+	assert.Equal(t, 625000.0, summary.UnrealizedPnL)
+	assert.Equal(t, 725000.0, summary.TotalReturn)
+	assert.Equal(t, 2, summary.OpenPositions)
+	assert.Equal(t, 3000000.0, summary.Allocation["stock"])
+	assert.Equal(t, 500000.0, summary.Allocation["crypto"])
+}
@@ -0,0 +1,51 @@
+package domain
+
+// CostMethod determines how cost basis is assigned to sold units
+type CostMethod string
+
+const (
+	CostMethodFIFO        CostMethod = "fifo"         // Oldest lots are sold first
+	CostMethodAverageCost CostMethod = "average_cost" // Sold units carry the running average cost
+)
+
+// IsValid checks if the cost method is valid
+func (m CostMethod) IsValid() bool {
+	switch m {
+	case CostMethodFIFO, CostMethodAverageCost:
+		return true
+	}
+	return false
+}
+
+// TradeType represents the kind of investment trade
+type TradeType string
+
+const (
+	TradeTypeBuy        TradeType = "buy"
+	TradeTypeSell       TradeType = "sell"
+	TradeTypeDividend   TradeType = "dividend"    // Cash dividend, or stock dividend when Quantity > 0
+	TradeTypeFee        TradeType = "fee"         // Standalone fee (custody, account maintenance)
+	TradeTypeTransferIn TradeType = "transfer_in" // Units brought in at a known cost (opening positions)
+)
+
+// IsValid checks if the trade type is valid
+func (t TradeType) IsValid() bool {
+	switch t {
+	case TradeTypeBuy, TradeTypeSell, TradeTypeDividend, TradeTypeFee, TradeTypeTransferIn:
+		return true
+	}
+	return false
+}
+
+// AddsLot reports whether the trade opens a new tax lot
+func (t TradeType) AddsLot() bool {
+	return t == TradeTypeBuy || t == TradeTypeTransferIn
+}
+
+// TradeSource records where a trade came from
+type TradeSource string
+
+const (
+	TradeSourceManual TradeSource = "manual"
+	TradeSourceBroker TradeSource = "broker"
+)
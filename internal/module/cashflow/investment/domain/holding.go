@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Holding is a position in a single symbol within an investment account.
+// Quantity, cost basis and P&L are derived by replaying the holding's trades.
+type Holding struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	AccountID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_investment_holding_account_symbol;column:account_id" json:"account_id"`
+
+	// Instrument
+	Symbol    string  `gorm:"type:varchar(30);not null;uniqueIndex:idx_investment_holding_account_symbol;column:symbol" json:"symbol"`
+	Name      *string `gorm:"type:varchar(255);column:name" json:"name,omitempty"`
+	AssetType string  `gorm:"type:varchar(30);default:'stock';column:asset_type" json:"asset_type"` // stock, fund, bond, crypto, etc.
+	Exchange  *string `gorm:"type:varchar(30);column:exchange" json:"exchange,omitempty"`           // HOSE, HNX, UPCOM, etc.
+	Currency  string  `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+
+	CostMethod CostMethod `gorm:"type:varchar(20);not null;default:'fifo';column:cost_method" json:"cost_method"`
+
+	// Position (derived from trades)
+	Quantity    float64 `gorm:"type:decimal(24,8);default:0;column:quantity" json:"quantity"`
+	CostBasis   float64 `gorm:"type:decimal(15,2);default:0;column:cost_basis" json:"cost_basis"`     // Total cost of units held, including buy fees
+	AverageCost float64 `gorm:"type:decimal(20,6);default:0;column:average_cost" json:"average_cost"` // Cost basis per unit held
+
+	// Income and costs (derived from trades)
+	RealizedPnL float64 `gorm:"type:decimal(15,2);default:0;column:realized_pnl" json:"realized_pnl"` // Net of trade fees, taxes and standalone fees
+	Dividends   float64 `gorm:"type:decimal(15,2);default:0;column:dividends" json:"dividends"`       // Cash dividends net of tax
+	FeesPaid    float64 `gorm:"type:decimal(15,2);default:0;column:fees_paid" json:"fees_paid"`       // All fees and taxes paid, for reporting
+
+	// Market data
+	CurrentPrice   *float64   `gorm:"type:decimal(20,6);column:current_price" json:"current_price,omitempty"`
+	PriceUpdatedAt *time.Time `gorm:"column:price_updated_at" json:"price_updated_at,omitempty"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for Holding
+func (Holding) TableName() string {
+	return "investment_holdings"
+}
+
+// MarketValue returns the value of the units held at the current price.
+// Falls back to cost basis when no price is known.
+func (h *Holding) MarketValue() float64 {
+	if h.CurrentPrice == nil {
+		return h.CostBasis
+	}
+	return roundMoney(h.Quantity * *h.CurrentPrice)
+}
+
+// UnrealizedPnL returns the gain on units still held
+func (h *Holding) UnrealizedPnL() float64 {
+	return roundMoney(h.MarketValue() - h.CostBasis)
+}
+
+// UnrealizedPnLPct returns the unrealized gain as a percentage of cost basis
+func (h *Holding) UnrealizedPnLPct() float64 {
+	if h.CostBasis <= 0 {
+		return 0
+	}
+	return roundMoney(h.UnrealizedPnL() / h.CostBasis * 100)
+}
+
+// TotalReturn returns realized and unrealized gains plus dividends
+func (h *Holding) TotalReturn() float64 {
+	return roundMoney(h.RealizedPnL + h.UnrealizedPnL() + h.Dividends)
+}
+
+// IsClosed reports whether all units have been sold
+func (h *Holding) IsClosed() bool {
+	return h.Quantity <= quantityEpsilon
+}
+
+// UpdatePrice sets the current market price
+func (h *Holding) UpdatePrice(price float64, at time.Time) {
+	h.CurrentPrice = &price
+	h.PriceUpdatedAt = &at
+}
+
+// ApplyReplay copies replayed position figures onto the holding
+func (h *Holding) ApplyReplay(result *ReplayResult) {
+	h.Quantity = result.Quantity
+	h.CostBasis = result.CostBasis
+	h.AverageCost = 0
+	if result.Quantity > quantityEpsilon {
+		h.AverageCost = result.CostBasis / result.Quantity
+	}
+	h.RealizedPnL = result.RealizedPnL
+	h.Dividends = result.Dividends
+	h.FeesPaid = result.FeesPaid
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// quantityEpsilon absorbs floating-point dust left after selling fractional units
+const quantityEpsilon = 1e-9
+
+// ErrInsufficientQuantity is returned when a sell exceeds the units held at that point in time
+var ErrInsufficientQuantity = errors.New("sell quantity exceeds units held")
+
+// ReplayResult is the state of a holding after replaying its trades
+type ReplayResult struct {
+	Quantity    float64
+	CostBasis   float64
+	RealizedPnL float64
+	Dividends   float64
+	FeesPaid    float64
+	Lots        []TaxLot
+}
+
+// ReplayTrades rebuilds tax lots, cost basis and P&L from a holding's trades in date order.
+//
+// Buy and transfer-in trades open lots at (amount + fee + tax) / quantity. Sells consume
+// lots oldest first; under FIFO the consumed lots' cost is the cost of sold units, under
+// average cost the running average is used instead. Each sell trade is updated in place
+// with its CostOfSold and RealizedPnL.
+func ReplayTrades(method CostMethod, trades []*Trade) (*ReplayResult, error) {
+	sorted := make([]*Trade, len(trades))
+	copy(sorted, trades)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].TradeDate.Equal(sorted[j].TradeDate) {
+			return sorted[i].TradeDate.Before(sorted[j].TradeDate)
+		}
+		// Same timestamp: acquisitions before disposals
+		return sorted[i].Type.AddsLot() && !sorted[j].Type.AddsLot()
+	})
+
+	result := &ReplayResult{}
+	var lots []*TaxLot
+
+	for _, trade := range sorted {
+		switch trade.Type {
+		case TradeTypeBuy, TradeTypeTransferIn:
+			if trade.Quantity <= 0 {
+				return nil, fmt.Errorf("trade %s: quantity must be positive", trade.ID)
+			}
+			cost := trade.GrossAmount() + trade.Fee + trade.Tax
+			lots = append(lots, &TaxLot{
+				HoldingID:         trade.HoldingID,
+				TradeID:           trade.ID,
+				AcquiredAt:        trade.TradeDate,
+				Quantity:          trade.Quantity,
+				RemainingQuantity: trade.Quantity,
+				CostPerUnit:       cost / trade.Quantity,
+			})
+			result.Quantity += trade.Quantity
+			result.CostBasis += cost
+			result.FeesPaid += trade.Fee + trade.Tax
+
+		case TradeTypeSell:
+			if trade.Quantity <= 0 {
+				return nil, fmt.Errorf("trade %s: quantity must be positive", trade.ID)
+			}
+			if trade.Quantity > result.Quantity+quantityEpsilon {
+				return nil, fmt.Errorf("trade %s on %s: %w", trade.ID, trade.TradeDate.Format("2006-01-02"), ErrInsufficientQuantity)
+			}
+
+			fifoCost := consumeLots(lots, trade.Quantity, trade)
+			costOfSold := fifoCost
+			if method == CostMethodAverageCost && result.Quantity > quantityEpsilon {
+				costOfSold = result.CostBasis / result.Quantity * trade.Quantity
+			}
+
+			proceeds := trade.GrossAmount() - trade.Fee - trade.Tax
+			trade.CostOfSold = roundMoney(costOfSold)
+			trade.RealizedPnL = roundMoney(proceeds - costOfSold)
+
+			result.Quantity -= trade.Quantity
+			result.CostBasis -= costOfSold
+			result.RealizedPnL += proceeds - costOfSold
+			result.FeesPaid += trade.Fee + trade.Tax
+
+			if result.Quantity <= quantityEpsilon {
+				result.Quantity = 0
+				result.CostBasis = 0
+			}
+
+		case TradeTypeDividend:
+			if trade.Quantity > 0 {
+				// Stock dividend: new units at zero cost
+				lots = append(lots, &TaxLot{
+					HoldingID:         trade.HoldingID,
+					TradeID:           trade.ID,
+					AcquiredAt:        trade.TradeDate,
+					Quantity:          trade.Quantity,
+					RemainingQuantity: trade.Quantity,
+				})
+				result.Quantity += trade.Quantity
+			}
+			result.Dividends += trade.Amount - trade.Tax
+			result.FeesPaid += trade.Tax
+
+		case TradeTypeFee:
+			result.RealizedPnL -= trade.GrossAmount()
+			result.FeesPaid += trade.GrossAmount()
+
+		default:
+			return nil, fmt.Errorf("trade %s: unsupported trade type %q", trade.ID, trade.Type)
+		}
+	}
+
+	result.CostBasis = roundMoney(math.Max(result.CostBasis, 0))
+	result.RealizedPnL = roundMoney(result.RealizedPnL)
+	result.Dividends = roundMoney(result.Dividends)
+	result.FeesPaid = roundMoney(result.FeesPaid)
+
+	result.Lots = make([]TaxLot, len(lots))
+	for i, lot := range lots {
+		result.Lots[i] = *lot
+	}
+
+	return result, nil
+}
+
+// consumeLots removes quantity from the oldest open lots and returns the cost of the removed units
+func consumeLots(lots []*TaxLot, quantity float64, trade *Trade) float64 {
+	remaining := quantity
+	cost := 0.0
+	for _, lot := range lots {
+		if remaining <= quantityEpsilon {
+			break
+		}
+		if !lot.IsOpen() {
+			continue
+		}
+
+		take := math.Min(lot.RemainingQuantity, remaining)
+		cost += take * lot.CostPerUnit
+		lot.RemainingQuantity -= take
+		remaining -= take
+
+		if !lot.IsOpen() {
+			lot.RemainingQuantity = 0
+			closedAt := trade.TradeDate
+			lot.ClosedAt = &closedAt
+		}
+	}
+	return cost
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,52 @@
+package domain
+
+// PortfolioSummary aggregates holdings of one or more investment accounts in a single currency
+type PortfolioSummary struct {
+	Currency         string
+	MarketValue      float64
+	CostBasis        float64
+	UnrealizedPnL    float64
+	UnrealizedPnLPct float64
+	RealizedPnL      float64
+	Dividends        float64
+	FeesPaid         float64
+	TotalReturn      float64
+	OpenPositions    int
+	Allocation       map[string]float64 // Asset type -> market value
+}
+
+// Add includes a holding already converted to the summary currency by rate
+func (s *PortfolioSummary) Add(h *Holding, rate float64) {
+	if s.Allocation == nil {
+		s.Allocation = make(map[string]float64)
+	}
+
+	value := h.MarketValue() * rate
+	s.MarketValue += value
+	s.CostBasis += h.CostBasis * rate
+	s.RealizedPnL += h.RealizedPnL * rate
+	s.Dividends += h.Dividends * rate
+	s.FeesPaid += h.FeesPaid * rate
+
+	if !h.IsClosed() {
+		s.OpenPositions++
+		s.Allocation[h.AssetType] += value
+	}
+}
+
+// Finalize computes derived totals and rounds amounts
+func (s *PortfolioSummary) Finalize() {
+	s.MarketValue = roundMoney(s.MarketValue)
+	s.CostBasis = roundMoney(s.CostBasis)
+	s.UnrealizedPnL = roundMoney(s.MarketValue - s.CostBasis)
+	s.RealizedPnL = roundMoney(s.RealizedPnL)
+	s.Dividends = roundMoney(s.Dividends)
+	s.FeesPaid = roundMoney(s.FeesPaid)
+	s.TotalReturn = roundMoney(s.RealizedPnL + s.UnrealizedPnL + s.Dividends)
+	if s.CostBasis > 0 {
+		s.UnrealizedPnLPct = roundMoney(s.UnrealizedPnL / s.CostBasis * 100)
+	}
+	for k, v := range s.Allocation {
+		s.Allocation[k] = roundMoney(v)
+	}
+}
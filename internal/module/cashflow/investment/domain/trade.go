@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Trade is a single investment event on a holding: buy, sell, dividend, fee or transfer
+type Trade struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	AccountID uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_investment_trade_external;column:account_id" json:"account_id"`
+	HoldingID uuid.UUID `gorm:"type:uuid;not null;index;column:holding_id" json:"holding_id"`
+
+	Type      TradeType `gorm:"type:varchar(20);not null;column:type" json:"type"`
+	Symbol    string    `gorm:"type:varchar(30);not null;column:symbol" json:"symbol"`
+	TradeDate time.Time `gorm:"not null;index;column:trade_date" json:"trade_date"`
+
+	Quantity float64 `gorm:"type:decimal(24,8);default:0;column:quantity" json:"quantity"`
+	Price    float64 `gorm:"type:decimal(20,6);default:0;column:price" json:"price"`
+	Amount   float64 `gorm:"type:decimal(15,2);default:0;column:amount" json:"amount"` // Gross amount (quantity × price, or cash dividend / fee amount)
+	Fee      float64 `gorm:"type:decimal(15,2);default:0;column:fee" json:"fee"`       // Commission
+	Tax      float64 `gorm:"type:decimal(15,2);default:0;column:tax" json:"tax"`       // Transaction or dividend tax
+	Currency string  `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+
+	// Derived on sells
+	CostOfSold  float64 `gorm:"type:decimal(15,2);default:0;column:cost_of_sold" json:"cost_of_sold"`
+	RealizedPnL float64 `gorm:"type:decimal(15,2);default:0;column:realized_pnl" json:"realized_pnl"`
+
+	Source     TradeSource `gorm:"type:varchar(20);not null;default:'manual';column:source" json:"source"`
+	ExternalID *string     `gorm:"type:varchar(100);uniqueIndex:idx_investment_trade_external;column:external_id" json:"external_id,omitempty"` // Broker trade ID for deduplication
+	Notes      *string     `gorm:"type:text;column:notes" json:"notes,omitempty"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for Trade
+func (Trade) TableName() string {
+	return "investment_trades"
+}
+
+// GrossAmount returns the trade amount, deriving it from quantity and price when not set
+func (t *Trade) GrossAmount() float64 {
+	if t.Amount > 0 {
+		return t.Amount
+	}
+	return roundMoney(t.Quantity * t.Price)
+}
+
+// TaxLot is a parcel of units acquired together at the same cost
+type TaxLot struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	HoldingID uuid.UUID `gorm:"type:uuid;not null;index;column:holding_id" json:"holding_id"`
+	TradeID   uuid.UUID `gorm:"type:uuid;not null;index;column:trade_id" json:"trade_id"` // Trade that opened the lot
+
+	AcquiredAt        time.Time  `gorm:"not null;column:acquired_at" json:"acquired_at"`
+	Quantity          float64    `gorm:"type:decimal(24,8);not null;column:quantity" json:"quantity"`
+	RemainingQuantity float64    `gorm:"type:decimal(24,8);not null;column:remaining_quantity" json:"remaining_quantity"`
+	CostPerUnit       float64    `gorm:"type:decimal(20,6);not null;column:cost_per_unit" json:"cost_per_unit"` // Including buy fees
+	ClosedAt          *time.Time `gorm:"column:closed_at" json:"closed_at,omitempty"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+}
+
+// TableName specifies the table name for TaxLot
+func (TaxLot) TableName() string {
+	return "investment_tax_lots"
+}
+
+// IsOpen reports whether units remain in the lot
+func (l *TaxLot) IsOpen() bool {
+	return l.RemainingQuantity > quantityEpsilon
+}
+
+// RemainingCost returns the cost of the units still in the lot
+func (l *TaxLot) RemainingCost() float64 {
+	return l.RemainingQuantity * l.CostPerUnit
+}
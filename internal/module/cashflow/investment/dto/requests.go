@@ -0,0 +1,82 @@
+package dto
+
+import "time"
+
+// RecordTradeRequest represents a manually entered trade
+type RecordTradeRequest struct {
+	AccountID string    `json:"account_id" binding:"required,uuid"`
+	Symbol    string    `json:"symbol" binding:"required,max=30"`
+	Type      string    `json:"type" binding:"required,oneof=buy sell dividend fee transfer_in"`
+	TradeDate time.Time `json:"trade_date" binding:"required"`
+	Quantity  float64   `json:"quantity" binding:"omitempty,min=0"`
+	Price     float64   `json:"price" binding:"omitempty,min=0"`
+	Amount    float64   `json:"amount" binding:"omitempty,min=0"` // Gross amount; derived from quantity × price when omitted
+	Fee       float64   `json:"fee" binding:"omitempty,min=0"`
+	Tax       float64   `json:"tax" binding:"omitempty,min=0"`
+	Currency  *string   `json:"currency,omitempty" binding:"omitempty,len=3"`
+	Notes     *string   `json:"notes,omitempty"`
+
+	// Instrument details, used when the holding is created
+	Name      *string `json:"name,omitempty"`
+	AssetType *string `json:"asset_type,omitempty"`
+	Exchange  *string `json:"exchange,omitempty"`
+}
+
+// ListHoldingsQuery represents query parameters for listing holdings
+type ListHoldingsQuery struct {
+	AccountID     *string `form:"account_id" binding:"omitempty,uuid"`
+	IncludeClosed bool    `form:"include_closed"`
+}
+
+// ListTradesQuery represents query parameters for listing trades
+type ListTradesQuery struct {
+	AccountID *string `form:"account_id" binding:"omitempty,uuid"`
+	HoldingID *string `form:"holding_id" binding:"omitempty,uuid"`
+	Symbol    *string `form:"symbol"`
+	Page      int     `form:"page" binding:"omitempty,min=1"`
+	PageSize  int     `form:"page_size" binding:"omitempty,min=1,max=200"`
+}
+
+// PortfolioSummaryQuery represents query parameters for the portfolio summary
+type PortfolioSummaryQuery struct {
+	AccountID *string `form:"account_id" binding:"omitempty,uuid"`
+	Currency  string  `form:"currency" binding:"omitempty,len=3"`
+}
+
+// UpdateCostMethodRequest changes how a holding's cost basis is calculated
+type UpdateCostMethodRequest struct {
+	CostMethod string `json:"cost_method" binding:"required,oneof=fifo average_cost"`
+}
+
+// UpdatePriceRequest sets a holding's current market price manually
+type UpdatePriceRequest struct {
+	Price float64 `json:"price" binding:"required,gt=0"`
+}
+
+// ImportPosition is a position reported by a broker
+type ImportPosition struct {
+	Symbol       string
+	Name         string
+	AssetType    string
+	Exchange     string
+	Quantity     float64
+	AverageCost  float64
+	CurrentPrice float64
+	Currency     string
+	LastUpdated  time.Time
+}
+
+// ImportTrade is a trade reported by a broker
+type ImportTrade struct {
+	ExternalID string
+	Type       string // buy, sell, dividend, fee; other types are ignored
+	Symbol     string
+	Quantity   float64
+	Price      float64
+	Amount     float64
+	Fee        float64
+	Tax        float64
+	Currency   string
+	TradeDate  time.Time
+	Notes      string
+}
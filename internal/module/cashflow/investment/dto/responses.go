@@ -0,0 +1,232 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+
+	"github.com/google/uuid"
+)
+
+// HoldingResponse represents a holding in API responses
+type HoldingResponse struct {
+	ID        uuid.UUID `json:"id"`
+	AccountID uuid.UUID `json:"account_id"`
+
+	Symbol    string  `json:"symbol"`
+	Name      *string `json:"name,omitempty"`
+	AssetType string  `json:"asset_type"`
+	Exchange  *string `json:"exchange,omitempty"`
+	Currency  string  `json:"currency"`
+
+	CostMethod  domain.CostMethod `json:"cost_method"`
+	Quantity    float64           `json:"quantity"`
+	CostBasis   float64           `json:"cost_basis"`
+	AverageCost float64           `json:"average_cost"`
+
+	CurrentPrice     *float64   `json:"current_price,omitempty"`
+	PriceUpdatedAt   *time.Time `json:"price_updated_at,omitempty"`
+	MarketValue      float64    `json:"market_value"`
+	UnrealizedPnL    float64    `json:"unrealized_pnl"`
+	UnrealizedPnLPct float64    `json:"unrealized_pnl_pct"`
+	RealizedPnL      float64    `json:"realized_pnl"`
+	Dividends        float64    `json:"dividends"`
+	FeesPaid         float64    `json:"fees_paid"`
+	TotalReturn      float64    `json:"total_return"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TaxLotResponse represents a tax lot in API responses
+type TaxLotResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	TradeID           uuid.UUID  `json:"trade_id"`
+	AcquiredAt        time.Time  `json:"acquired_at"`
+	Quantity          float64    `json:"quantity"`
+	RemainingQuantity float64    `json:"remaining_quantity"`
+	CostPerUnit       float64    `json:"cost_per_unit"`
+	RemainingCost     float64    `json:"remaining_cost"`
+	ClosedAt          *time.Time `json:"closed_at,omitempty"`
+}
+
+// HoldingDetailResponse represents a holding with its tax lots
+type HoldingDetailResponse struct {
+	HoldingResponse
+	Lots []TaxLotResponse `json:"lots"`
+}
+
+// TradeResponse represents a trade in API responses
+type TradeResponse struct {
+	ID        uuid.UUID `json:"id"`
+	AccountID uuid.UUID `json:"account_id"`
+	HoldingID uuid.UUID `json:"holding_id"`
+
+	Type      domain.TradeType `json:"type"`
+	Symbol    string           `json:"symbol"`
+	TradeDate time.Time        `json:"trade_date"`
+	Quantity  float64          `json:"quantity"`
+	Price     float64          `json:"price"`
+	Amount    float64          `json:"amount"`
+	Fee       float64          `json:"fee"`
+	Tax       float64          `json:"tax"`
+	Currency  string           `json:"currency"`
+
+	CostOfSold  float64 `json:"cost_of_sold"`
+	RealizedPnL float64 `json:"realized_pnl"`
+
+	Source     domain.TradeSource `json:"source"`
+	ExternalID *string            `json:"external_id,omitempty"`
+	Notes      *string            `json:"notes,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// TradeListResponse represents a paginated list of trades
+type TradeListResponse struct {
+	Trades   []TradeResponse `json:"trades"`
+	Total    int64           `json:"total"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"page_size"`
+}
+
+// PortfolioSummaryResponse represents aggregated portfolio figures
+type PortfolioSummaryResponse struct {
+	Currency         string             `json:"currency"`
+	MarketValue      float64            `json:"market_value"`
+	CostBasis        float64            `json:"cost_basis"`
+	UnrealizedPnL    float64            `json:"unrealized_pnl"`
+	UnrealizedPnLPct float64            `json:"unrealized_pnl_pct"`
+	RealizedPnL      float64            `json:"realized_pnl"`
+	Dividends        float64            `json:"dividends"`
+	FeesPaid         float64            `json:"fees_paid"`
+	TotalReturn      float64            `json:"total_return"`
+	OpenPositions    int                `json:"open_positions"`
+	Allocation       map[string]float64 `json:"allocation"`
+}
+
+// ImportResult reports what a broker import changed
+type ImportResult struct {
+	TradesImported  int `json:"trades_imported"`
+	TradesSkipped   int `json:"trades_skipped"`
+	HoldingsUpdated int `json:"holdings_updated"`
+	PricesUpdated   int `json:"prices_updated"`
+}
+
+// ToHoldingResponse converts a domain holding to response DTO
+func ToHoldingResponse(h *domain.Holding) HoldingResponse {
+	return HoldingResponse{
+		ID:               h.ID,
+		AccountID:        h.AccountID,
+		Symbol:           h.Symbol,
+		Name:             h.Name,
+		AssetType:        h.AssetType,
+		Exchange:         h.Exchange,
+		Currency:         h.Currency,
+		CostMethod:       h.CostMethod,
+		Quantity:         h.Quantity,
+		CostBasis:        h.CostBasis,
+		AverageCost:      h.AverageCost,
+		CurrentPrice:     h.CurrentPrice,
+		PriceUpdatedAt:   h.PriceUpdatedAt,
+		MarketValue:      h.MarketValue(),
+		UnrealizedPnL:    h.UnrealizedPnL(),
+		UnrealizedPnLPct: h.UnrealizedPnLPct(),
+		RealizedPnL:      h.RealizedPnL,
+		Dividends:        h.Dividends,
+		FeesPaid:         h.FeesPaid,
+		TotalReturn:      h.TotalReturn(),
+		UpdatedAt:        h.UpdatedAt,
+	}
+}
+
+// ToHoldingResponses converts domain holdings to response DTOs
+func ToHoldingResponses(holdings []domain.Holding) []HoldingResponse {
+	responses := make([]HoldingResponse, len(holdings))
+	for i := range holdings {
+		responses[i] = ToHoldingResponse(&holdings[i])
+	}
+	return responses
+}
+
+// ToHoldingDetailResponse converts a holding and its lots to response DTO
+func ToHoldingDetailResponse(h *domain.Holding, lots []domain.TaxLot) *HoldingDetailResponse {
+	lotResponses := make([]TaxLotResponse, len(lots))
+	for i, lot := range lots {
+		lotResponses[i] = TaxLotResponse{
+			ID:                lot.ID,
+			TradeID:           lot.TradeID,
+			AcquiredAt:        lot.AcquiredAt,
+			Quantity:          lot.Quantity,
+			RemainingQuantity: lot.RemainingQuantity,
+			CostPerUnit:       lot.CostPerUnit,
+			RemainingCost:     lot.RemainingCost(),
+			ClosedAt:          lot.ClosedAt,
+		}
+	}
+
+	return &HoldingDetailResponse{
+		HoldingResponse: ToHoldingResponse(h),
+		Lots:            lotResponses,
+	}
+}
+
+// ToTradeResponse converts a domain trade to response DTO
+func ToTradeResponse(t *domain.Trade) TradeResponse {
+	return TradeResponse{
+		ID:          t.ID,
+		AccountID:   t.AccountID,
+		HoldingID:   t.HoldingID,
+		Type:        t.Type,
+		Symbol:      t.Symbol,
+		TradeDate:   t.TradeDate,
+		Quantity:    t.Quantity,
+		Price:       t.Price,
+		Amount:      t.GrossAmount(),
+		Fee:         t.Fee,
+		Tax:         t.Tax,
+		Currency:    t.Currency,
+		CostOfSold:  t.CostOfSold,
+		RealizedPnL: t.RealizedPnL,
+		Source:      t.Source,
+		ExternalID:  t.ExternalID,
+		Notes:       t.Notes,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// ToTradeListResponse converts domain trades to a paginated response DTO
+func ToTradeListResponse(trades []domain.Trade, total int64, page, pageSize int) *TradeListResponse {
+	responses := make([]TradeResponse, len(trades))
+	for i := range trades {
+		responses[i] = ToTradeResponse(&trades[i])
+	}
+	return &TradeListResponse{
+		Trades:   responses,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+}
+
+// ToPortfolioSummaryResponse converts a domain summary to response DTO
+func ToPortfolioSummaryResponse(s *domain.PortfolioSummary) *PortfolioSummaryResponse {
+	if s == nil {
+		return nil
+	}
+	allocation := s.Allocation
+	if allocation == nil {
+		allocation = map[string]float64{}
+	}
+	return &PortfolioSummaryResponse{
+		Currency:         s.Currency,
+		MarketValue:      s.MarketValue,
+		CostBasis:        s.CostBasis,
+		UnrealizedPnL:    s.UnrealizedPnL,
+		UnrealizedPnLPct: s.UnrealizedPnLPct,
+		RealizedPnL:      s.RealizedPnL,
+		Dividends:        s.Dividends,
+		FeesPaid:         s.FeesPaid,
+		TotalReturn:      s.TotalReturn,
+		OpenPositions:    s.OpenPositions,
+		Allocation:       allocation,
+	}
+}
@@ -0,0 +1,36 @@
+package investment
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/investment/handler"
+	"personalfinancedss/internal/module/cashflow/investment/repository"
+	"personalfinancedss/internal/module/cashflow/investment/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides investment holding dependencies
+var Module = fx.Module("investment",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Handler
+		handler.NewHandler,
+	),
+	fx.Invoke(registerInvestmentRoutes),
+)
+
+func registerInvestmentRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
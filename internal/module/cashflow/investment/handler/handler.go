@@ -0,0 +1,351 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+	"personalfinancedss/internal/module/cashflow/investment/dto"
+	"personalfinancedss/internal/module/cashflow/investment/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages investment holding and trade endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs an investment handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("investment.handler"),
+	}
+}
+
+// RegisterRoutes wires investment routes under /api/v1/investments.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	investments := r.Group("/api/v1/investments")
+	investments.Use(authMiddleware.AuthMiddleware())
+	{
+		investments.GET("/summary", h.getPortfolioSummary)
+
+		investments.GET("/holdings", h.listHoldings)
+		investments.GET("/holdings/:id", h.getHolding)
+		investments.PUT("/holdings/:id/cost-method", h.setCostMethod)
+		investments.PUT("/holdings/:id/price", h.updatePrice)
+
+		investments.POST("/trades", h.recordTrade)
+		investments.GET("/trades", h.listTrades)
+		investments.DELETE("/trades/:id", h.deleteTrade)
+	}
+}
+
+// getPortfolioSummary godoc
+// @Summary Get portfolio summary
+// @Description Aggregate market value, cost basis, realized and unrealized P&L, dividends and fees across holdings, converted to one currency
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Limit to one investment account"
+// @Param currency query string false "Reporting currency (default VND)"
+// @Success 200 {object} dto.PortfolioSummaryResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/investments/summary [get]
+func (h *Handler) getPortfolioSummary(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.PortfolioSummaryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	summary, err := h.service.GetPortfolioSummary(c.Request.Context(), currentUser.ID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Portfolio summary retrieved successfully", dto.ToPortfolioSummaryResponse(summary))
+}
+
+// listHoldings godoc
+// @Summary List holdings
+// @Description List investment holdings with cost basis and P&L
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Limit to one investment account"
+// @Param include_closed query bool false "Include fully sold holdings"
+// @Success 200 {array} dto.HoldingResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/investments/holdings [get]
+func (h *Handler) listHoldings(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.ListHoldingsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	holdings, err := h.service.ListHoldings(c.Request.Context(), currentUser.ID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Holdings retrieved successfully", dto.ToHoldingResponses(holdings))
+}
+
+// getHolding godoc
+// @Summary Get holding
+// @Description Get a holding with its tax lots
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Holding ID"
+// @Success 200 {object} dto.HoldingDetailResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/investments/holdings/{id} [get]
+func (h *Handler) getHolding(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	holdingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid holding id")
+		return
+	}
+
+	holding, lots, err := h.service.GetHolding(c.Request.Context(), currentUser.ID, holdingID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Holding retrieved successfully", dto.ToHoldingDetailResponse(holding, lots))
+}
+
+// setCostMethod godoc
+// @Summary Set holding cost method
+// @Description Switch a holding between FIFO and average-cost basis; realized P&L is recalculated from its trades
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Holding ID"
+// @Param request body dto.UpdateCostMethodRequest true "Cost method"
+// @Success 200 {object} dto.HoldingResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/investments/holdings/{id}/cost-method [put]
+func (h *Handler) setCostMethod(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	holdingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid holding id")
+		return
+	}
+
+	var req dto.UpdateCostMethodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	holding, err := h.service.SetCostMethod(c.Request.Context(), currentUser.ID, holdingID, domain.CostMethod(req.CostMethod))
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Cost method updated successfully", dto.ToHoldingResponse(holding))
+}
+
+// updatePrice godoc
+// @Summary Update holding price
+// @Description Set a holding's current market price manually
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Holding ID"
+// @Param request body dto.UpdatePriceRequest true "Market price"
+// @Success 200 {object} dto.HoldingResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/investments/holdings/{id}/price [put]
+func (h *Handler) updatePrice(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	holdingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid holding id")
+		return
+	}
+
+	var req dto.UpdatePriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	holding, err := h.service.UpdatePrice(c.Request.Context(), currentUser.ID, holdingID, req.Price)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Price updated successfully", dto.ToHoldingResponse(holding))
+}
+
+// recordTrade godoc
+// @Summary Record trade
+// @Description Record a manual buy, sell, dividend, fee or transfer-in; the holding's lots and P&L are recalculated
+// @Tags investments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.RecordTradeRequest true "Trade details"
+// @Success 201 {object} dto.TradeResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/investments/trades [post]
+func (h *Handler) recordTrade(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.RecordTradeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	trade, err := h.service.RecordTrade(c.Request.Context(), currentUser.ID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Trade recorded successfully", dto.ToTradeResponse(trade))
+}
+
+// listTrades godoc
+// @Summary List trades
+// @Description List manual and broker-imported trades, newest first
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param account_id query string false "Filter by account"
+// @Param holding_id query string false "Filter by holding"
+// @Param symbol query string false "Filter by symbol"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(50)
+// @Success 200 {object} dto.TradeListResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/investments/trades [get]
+func (h *Handler) listTrades(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.ListTradesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.PageSize == 0 {
+		query.PageSize = 50
+	}
+
+	trades, total, err := h.service.ListTrades(c.Request.Context(), currentUser.ID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Trades retrieved successfully", dto.ToTradeListResponse(trades, total, query.Page, query.PageSize))
+}
+
+// deleteTrade godoc
+// @Summary Delete trade
+// @Description Delete a trade and recalculate its holding. Rejected when later sells depend on it.
+// @Tags investments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Trade ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/investments/trades/{id} [delete]
+func (h *Handler) deleteTrade(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	tradeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid trade id")
+		return
+	}
+
+	if err := h.service.DeleteTrade(c.Request.Context(), currentUser.ID, tradeID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Trade deleted successfully")
+}
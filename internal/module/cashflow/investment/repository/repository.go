@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+
+	"github.com/google/uuid"
+)
+
+// HoldingFilter narrows holding listings
+type HoldingFilter struct {
+	AccountID     *uuid.UUID
+	IncludeClosed bool
+}
+
+// TradeFilter narrows trade listings
+type TradeFilter struct {
+	AccountID *uuid.UUID
+	HoldingID *uuid.UUID
+	Symbol    *string
+	Limit     int
+	Offset    int
+}
+
+// ReplayChanges is a set of trade changes persisted together with the replayed holding state
+type ReplayChanges struct {
+	Holding    *domain.Holding
+	NewHolding bool
+	Created    []*domain.Trade
+	DeletedIDs []uuid.UUID
+	Trades     []*domain.Trade // All remaining trades with derived fields updated
+	Lots       []domain.TaxLot // Replaces the holding's lots
+}
+
+// Repository defines data access for holdings, trades and tax lots
+type Repository interface {
+	// GetHoldingByID retrieves a holding by ID
+	GetHoldingByID(ctx context.Context, id uuid.UUID) (*domain.Holding, error)
+
+	// GetHoldingBySymbol retrieves the holding of a symbol within an account
+	GetHoldingBySymbol(ctx context.Context, accountID uuid.UUID, symbol string) (*domain.Holding, error)
+
+	// ListHoldings retrieves a user's holdings
+	ListHoldings(ctx context.Context, userID uuid.UUID, filter HoldingFilter) ([]domain.Holding, error)
+
+	// UpdateHolding saves a holding
+	UpdateHolding(ctx context.Context, holding *domain.Holding) error
+
+	// GetTradeByID retrieves a trade by ID
+	GetTradeByID(ctx context.Context, id uuid.UUID) (*domain.Trade, error)
+
+	// GetTradeByExternalID retrieves a broker trade by its external ID within an account
+	GetTradeByExternalID(ctx context.Context, accountID uuid.UUID, externalID string) (*domain.Trade, error)
+
+	// ListTradesByHolding retrieves all trades of a holding
+	ListTradesByHolding(ctx context.Context, holdingID uuid.UUID) ([]*domain.Trade, error)
+
+	// ListTrades retrieves a user's trades, newest first
+	ListTrades(ctx context.Context, userID uuid.UUID, filter TradeFilter) ([]domain.Trade, int64, error)
+
+	// ListLots retrieves the tax lots of a holding, oldest first
+	ListLots(ctx context.Context, holdingID uuid.UUID) ([]domain.TaxLot, error)
+
+	// SaveReplay persists trade changes, the replayed holding and its lots in one transaction
+	SaveReplay(ctx context.Context, changes ReplayChanges) error
+}
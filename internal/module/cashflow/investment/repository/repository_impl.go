@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new investment repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) GetHoldingByID(ctx context.Context, id uuid.UUID) (*domain.Holding, error) {
+	var holding domain.Holding
+	if err := r.db.WithContext(ctx).First(&holding, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &holding, nil
+}
+
+func (r *repository) GetHoldingBySymbol(ctx context.Context, accountID uuid.UUID, symbol string) (*domain.Holding, error) {
+	var holding domain.Holding
+	if err := r.db.WithContext(ctx).
+		First(&holding, "account_id = ? AND symbol = ?", accountID, symbol).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &holding, nil
+}
+
+func (r *repository) ListHoldings(ctx context.Context, userID uuid.UUID, filter HoldingFilter) ([]domain.Holding, error) {
+	var holdings []domain.Holding
+	db := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if filter.AccountID != nil {
+		db = db.Where("account_id = ?", *filter.AccountID)
+	}
+	if !filter.IncludeClosed {
+		db = db.Where("quantity > 0")
+	}
+	err := db.Order("symbol ASC").Find(&holdings).Error
+	return holdings, err
+}
+
+func (r *repository) UpdateHolding(ctx context.Context, holding *domain.Holding) error {
+	return r.db.WithContext(ctx).Save(holding).Error
+}
+
+func (r *repository) GetTradeByID(ctx context.Context, id uuid.UUID) (*domain.Trade, error) {
+	var trade domain.Trade
+	if err := r.db.WithContext(ctx).First(&trade, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &trade, nil
+}
+
+func (r *repository) GetTradeByExternalID(ctx context.Context, accountID uuid.UUID, externalID string) (*domain.Trade, error) {
+	var trade domain.Trade
+	if err := r.db.WithContext(ctx).
+		First(&trade, "account_id = ? AND external_id = ?", accountID, externalID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &trade, nil
+}
+
+func (r *repository) ListTradesByHolding(ctx context.Context, holdingID uuid.UUID) ([]*domain.Trade, error) {
+	var trades []*domain.Trade
+	err := r.db.WithContext(ctx).
+		Where("holding_id = ?", holdingID).
+		Order("trade_date ASC, created_at ASC").
+		Find(&trades).Error
+	return trades, err
+}
+
+func (r *repository) ListTrades(ctx context.Context, userID uuid.UUID, filter TradeFilter) ([]domain.Trade, int64, error) {
+	var trades []domain.Trade
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&domain.Trade{}).Where("user_id = ?", userID)
+	if filter.AccountID != nil {
+		db = db.Where("account_id = ?", *filter.AccountID)
+	}
+	if filter.HoldingID != nil {
+		db = db.Where("holding_id = ?", *filter.HoldingID)
+	}
+	if filter.Symbol != nil {
+		db = db.Where("symbol = ?", *filter.Symbol)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if filter.Limit > 0 {
+		db = db.Limit(filter.Limit).Offset(filter.Offset)
+	}
+	if err := db.Order("trade_date DESC, created_at DESC").Find(&trades).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return trades, total, nil
+}
+
+func (r *repository) ListLots(ctx context.Context, holdingID uuid.UUID) ([]domain.TaxLot, error) {
+	var lots []domain.TaxLot
+	err := r.db.WithContext(ctx).
+		Where("holding_id = ?", holdingID).
+		Order("acquired_at ASC").
+		Find(&lots).Error
+	return lots, err
+}
+
+func (r *repository) SaveReplay(ctx context.Context, changes ReplayChanges) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if changes.NewHolding {
+			if err := tx.Create(changes.Holding).Error; err != nil {
+				return err
+			}
+		} else if err := tx.Save(changes.Holding).Error; err != nil {
+			return err
+		}
+
+		if len(changes.DeletedIDs) > 0 {
+			if err := tx.Where("id IN ?", changes.DeletedIDs).Delete(&domain.Trade{}).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, trade := range changes.Created {
+			if err := tx.Create(trade).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, trade := range changes.Trades {
+			if err := tx.Model(&domain.Trade{}).Where("id = ?", trade.ID).
+				Updates(map[string]interface{}{
+					"cost_of_sold": trade.CostOfSold,
+					"realized_pnl": trade.RealizedPnL,
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("holding_id = ?", changes.Holding.ID).Delete(&domain.TaxLot{}).Error; err != nil {
+			return err
+		}
+		if len(changes.Lots) > 0 {
+			if err := tx.CreateInBatches(changes.Lots, 200).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+	"personalfinancedss/internal/module/cashflow/investment/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// quantityTolerance absorbs rounding differences between broker and replayed quantities
+const quantityTolerance = 1e-6
+
+// ImportBrokerData ingests positions and trades reported by a broker connection.
+// Trades already imported (matched by external ID) are skipped. When the broker reports
+// more units than the imported trade history explains, an opening transfer_in at the
+// broker's average cost covers the difference so cost basis stays consistent.
+func (s *investmentService) ImportBrokerData(ctx context.Context, userID, accountID uuid.UUID, positions []dto.ImportPosition, trades []dto.ImportTrade) (*dto.ImportResult, error) {
+	account, err := s.getInvestmentAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.ImportResult{}
+	positionsBySymbol := make(map[string]dto.ImportPosition, len(positions))
+	for _, position := range positions {
+		positionsBySymbol[normalizeSymbol(position.Symbol)] = position
+	}
+
+	// Group new trades by symbol
+	tradesBySymbol := make(map[string][]dto.ImportTrade)
+	for _, trade := range trades {
+		tradeType, ok := importTradeType(trade.Type)
+		if !ok || trade.ExternalID == "" || trade.Symbol == "" {
+			result.TradesSkipped++
+			continue
+		}
+		if _, err := s.repo.GetTradeByExternalID(ctx, account.ID, trade.ExternalID); err == nil {
+			result.TradesSkipped++
+			continue
+		} else if err != shared.ErrNotFound {
+			return result, shared.ErrInternal.WithError(err)
+		}
+		trade.Type = string(tradeType)
+		symbol := normalizeSymbol(trade.Symbol)
+		tradesBySymbol[symbol] = append(tradesBySymbol[symbol], trade)
+	}
+
+	symbols := make([]string, 0, len(tradesBySymbol)+len(positionsBySymbol))
+	for symbol := range tradesBySymbol {
+		symbols = append(symbols, symbol)
+	}
+	for symbol := range positionsBySymbol {
+		if _, ok := tradesBySymbol[symbol]; !ok {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.Strings(symbols)
+
+	for _, symbol := range symbols {
+		position, hasPosition := positionsBySymbol[symbol]
+		var pos *dto.ImportPosition
+		if hasPosition {
+			pos = &position
+		}
+		if err := s.importSymbol(ctx, account, symbol, pos, tradesBySymbol[symbol], result); err != nil {
+			s.logger.Warn("Failed to import broker data for symbol",
+				zap.String("account_id", account.ID.String()),
+				zap.String("symbol", symbol),
+				zap.Error(err),
+			)
+			result.TradesSkipped += len(tradesBySymbol[symbol])
+		}
+	}
+
+	s.logger.Info("Broker investment data imported",
+		zap.String("user_id", userID.String()),
+		zap.String("account_id", accountID.String()),
+		zap.Int("trades_imported", result.TradesImported),
+		zap.Int("trades_skipped", result.TradesSkipped),
+		zap.Int("holdings_updated", result.HoldingsUpdated),
+	)
+
+	return result, nil
+}
+
+// importSymbol applies one symbol's new trades and reconciles it with the broker position
+func (s *investmentService) importSymbol(ctx context.Context, account *accountDomain.Account, symbol string, position *dto.ImportPosition, trades []dto.ImportTrade, result *dto.ImportResult) error {
+	holding, isNew, err := s.findOrNewHolding(ctx, account, symbol)
+	if err != nil {
+		return err
+	}
+	if position != nil {
+		applyPositionDetails(holding, position, isNew)
+	}
+	// Nothing to record for a broker position that was never held and reports no units
+	if isNew && len(trades) == 0 && (position == nil || position.Quantity <= quantityTolerance) {
+		return nil
+	}
+
+	created := make([]*domain.Trade, 0, len(trades)+1)
+	for _, trade := range trades {
+		externalID := trade.ExternalID
+		newTrade := &domain.Trade{
+			ID:         uuid.New(),
+			UserID:     account.UserID,
+			AccountID:  account.ID,
+			HoldingID:  holding.ID,
+			Type:       domain.TradeType(trade.Type),
+			Symbol:     symbol,
+			TradeDate:  trade.TradeDate,
+			Quantity:   trade.Quantity,
+			Price:      trade.Price,
+			Amount:     trade.Amount,
+			Fee:        trade.Fee,
+			Tax:        trade.Tax,
+			Currency:   holding.Currency,
+			Source:     domain.TradeSourceBroker,
+			ExternalID: &externalID,
+		}
+		if trade.Notes != "" {
+			notes := trade.Notes
+			newTrade.Notes = &notes
+		}
+		created = append(created, newTrade)
+	}
+
+	if position != nil {
+		opening, err := s.openingTrade(ctx, holding, isNew, position, created)
+		if err != nil {
+			return err
+		}
+		if opening != nil {
+			created = append(created, opening)
+		}
+	}
+
+	if len(created) > 0 || isNew {
+		if err := s.applyTradeChanges(ctx, holding, isNew, created, nil); err != nil {
+			return err
+		}
+		result.HoldingsUpdated++
+		result.TradesImported += len(trades)
+	} else if position != nil && position.CurrentPrice > 0 {
+		if err := s.repo.UpdateHolding(ctx, holding); err != nil {
+			return shared.ErrInternal.WithError(err)
+		}
+	}
+
+	if position != nil && position.CurrentPrice > 0 {
+		result.PricesUpdated++
+	}
+	return nil
+}
+
+// openingTrade builds a transfer_in covering units the broker reports but the trade history does not explain
+func (s *investmentService) openingTrade(ctx context.Context, holding *domain.Holding, isNew bool, position *dto.ImportPosition, created []*domain.Trade) (*domain.Trade, error) {
+	var existing []*domain.Trade
+	if !isNew {
+		var err error
+		existing, err = s.repo.ListTradesByHolding(ctx, holding.ID)
+		if err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+	}
+
+	history := append(existing, created...)
+	recorded := netQuantity(history)
+
+	missing := position.Quantity - recorded
+	if missing <= quantityTolerance {
+		if missing < -quantityTolerance {
+			s.logger.Warn("Broker position is smaller than recorded holding",
+				zap.String("holding_id", holding.ID.String()),
+				zap.Float64("broker_quantity", position.Quantity),
+				zap.Float64("recorded_quantity", recorded),
+			)
+		}
+		return nil, nil
+	}
+
+	// The opening position predates every known trade so that sells in a
+	// partial history still have lots to draw from
+	openedAt := position.LastUpdated
+	if openedAt.IsZero() {
+		openedAt = time.Now()
+	}
+	for _, trade := range history {
+		if !trade.TradeDate.After(openedAt) {
+			openedAt = trade.TradeDate.Add(-time.Second)
+		}
+	}
+
+	externalID := fmt.Sprintf("opening:%s:%s", holding.Symbol, openedAt.UTC().Format(time.RFC3339))
+	notes := "Opening position reconciled from broker"
+	return &domain.Trade{
+		ID:         uuid.New(),
+		UserID:     holding.UserID,
+		AccountID:  holding.AccountID,
+		HoldingID:  holding.ID,
+		Type:       domain.TradeTypeTransferIn,
+		Symbol:     holding.Symbol,
+		TradeDate:  openedAt,
+		Quantity:   missing,
+		Price:      position.AverageCost,
+		Currency:   holding.Currency,
+		Source:     domain.TradeSourceBroker,
+		ExternalID: &externalID,
+		Notes:      &notes,
+	}, nil
+}
+
+// netQuantity sums the unit changes of a trade history without replaying lots
+func netQuantity(trades []*domain.Trade) float64 {
+	var quantity float64
+	for _, trade := range trades {
+		switch {
+		case trade.Type.AddsLot(), trade.Type == domain.TradeTypeDividend:
+			quantity += trade.Quantity
+		case trade.Type == domain.TradeTypeSell:
+			quantity -= trade.Quantity
+		}
+	}
+	return quantity
+}
+
+// applyPositionDetails copies instrument details and the latest price from a broker position
+func applyPositionDetails(holding *domain.Holding, position *dto.ImportPosition, isNew bool) {
+	if isNew {
+		if position.Name != "" {
+			name := position.Name
+			holding.Name = &name
+		}
+		if position.Exchange != "" {
+			exchange := position.Exchange
+			holding.Exchange = &exchange
+		}
+		if position.AssetType != "" {
+			holding.AssetType = position.AssetType
+		}
+		if position.Currency != "" {
+			holding.Currency = position.Currency
+		}
+	}
+	if position.CurrentPrice > 0 {
+		updatedAt := position.LastUpdated
+		if updatedAt.IsZero() {
+			updatedAt = time.Now()
+		}
+		holding.UpdatePrice(position.CurrentPrice, updatedAt)
+	}
+}
+
+// importTradeType maps a broker transaction type onto a trade type
+func importTradeType(brokerType string) (domain.TradeType, bool) {
+	switch brokerType {
+	case "buy", "BUY":
+		return domain.TradeTypeBuy, true
+	case "sell", "SELL":
+		return domain.TradeTypeSell, true
+	case "dividend", "DIVIDEND":
+		return domain.TradeTypeDividend, true
+	case "fee", "FEE":
+		return domain.TradeTypeFee, true
+	default:
+		return "", false
+	}
+}
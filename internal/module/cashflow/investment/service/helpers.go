@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+	"personalfinancedss/internal/module/cashflow/investment/repository"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// getInvestmentAccount loads a user's account and checks that it can hold investments
+func (s *investmentService) getInvestmentAccount(ctx context.Context, userID, accountID uuid.UUID) (*accountDomain.Account, error) {
+	account, err := s.accountRepo.GetEditableByIDAndUserID(ctx, accountID.String(), userID.String())
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	switch account.AccountType {
+	case accountDomain.AccountTypeInvestment, accountDomain.AccountTypeCryptoWallet:
+		return account, nil
+	default:
+		return nil, shared.ErrBadRequest.WithDetails("account_id", "account is not an investment account")
+	}
+}
+
+// getOwnedHolding loads a holding and checks that it belongs to the user
+func (s *investmentService) getOwnedHolding(ctx context.Context, userID, holdingID uuid.UUID) (*domain.Holding, error) {
+	holding, err := s.repo.GetHoldingByID(ctx, holdingID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if holding.UserID != userID {
+		return nil, shared.ErrNotFound
+	}
+	return holding, nil
+}
+
+// findOrNewHolding returns the account's holding for a symbol, or an unsaved one when none exists
+func (s *investmentService) findOrNewHolding(ctx context.Context, account *accountDomain.Account, symbol string) (*domain.Holding, bool, error) {
+	holding, err := s.repo.GetHoldingBySymbol(ctx, account.ID, symbol)
+	if err == nil {
+		return holding, false, nil
+	}
+	if err != shared.ErrNotFound {
+		return nil, false, shared.ErrInternal.WithError(err)
+	}
+
+	return &domain.Holding{
+		ID:         uuid.New(),
+		UserID:     account.UserID,
+		AccountID:  account.ID,
+		Symbol:     symbol,
+		AssetType:  defaultAssetType(account.AccountType),
+		Currency:   string(account.Currency),
+		CostMethod: domain.CostMethodFIFO,
+	}, true, nil
+}
+
+// applyTradeChanges replays the holding's trades with the given changes and persists the result atomically.
+// Changes that would sell more units than held are rejected.
+func (s *investmentService) applyTradeChanges(ctx context.Context, holding *domain.Holding, newHolding bool, created []*domain.Trade, deletedIDs []uuid.UUID) error {
+	var trades []*domain.Trade
+	if !newHolding {
+		existing, err := s.repo.ListTradesByHolding(ctx, holding.ID)
+		if err != nil {
+			return shared.ErrInternal.WithError(err)
+		}
+		deleted := make(map[uuid.UUID]bool, len(deletedIDs))
+		for _, id := range deletedIDs {
+			deleted[id] = true
+		}
+		for _, trade := range existing {
+			if !deleted[trade.ID] {
+				trades = append(trades, trade)
+			}
+		}
+	}
+	trades = append(trades, created...)
+
+	result, err := domain.ReplayTrades(holding.CostMethod, trades)
+	if err != nil {
+		if errors.Is(err, domain.ErrInsufficientQuantity) {
+			return shared.ErrBadRequest.WithDetails("quantity", err.Error())
+		}
+		return shared.ErrBadRequest.WithDetails("trade", err.Error())
+	}
+	holding.ApplyReplay(result)
+
+	if err := s.repo.SaveReplay(ctx, repository.ReplayChanges{
+		Holding:    holding,
+		NewHolding: newHolding,
+		Created:    created,
+		DeletedIDs: deletedIDs,
+		Trades:     trades,
+		Lots:       result.Lots,
+	}); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	return nil
+}
+
+func normalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
+
+func defaultAssetType(accountType accountDomain.AccountType) string {
+	if accountType == accountDomain.AccountTypeCryptoWallet {
+		return "crypto"
+	}
+	return "stock"
+}
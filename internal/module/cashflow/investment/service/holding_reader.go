@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+	"personalfinancedss/internal/module/cashflow/investment/dto"
+	"personalfinancedss/internal/module/cashflow/investment/repository"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ListHoldings retrieves a user's holdings, optionally for one account
+func (s *investmentService) ListHoldings(ctx context.Context, userID uuid.UUID, query dto.ListHoldingsQuery) ([]domain.Holding, error) {
+	filter := repository.HoldingFilter{IncludeClosed: query.IncludeClosed}
+	if query.AccountID != nil {
+		id, err := uuid.Parse(*query.AccountID)
+		if err != nil {
+			return nil, shared.ErrBadRequest.WithDetails("account_id", "invalid account id")
+		}
+		filter.AccountID = &id
+	}
+
+	holdings, err := s.repo.ListHoldings(ctx, userID, filter)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return holdings, nil
+}
+
+// GetHolding retrieves a holding with its tax lots
+func (s *investmentService) GetHolding(ctx context.Context, userID, holdingID uuid.UUID) (*domain.Holding, []domain.TaxLot, error) {
+	holding, err := s.getOwnedHolding(ctx, userID, holdingID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lots, err := s.repo.ListLots(ctx, holdingID)
+	if err != nil {
+		return nil, nil, shared.ErrInternal.WithError(err)
+	}
+	return holding, lots, nil
+}
+
+// GetPortfolioSummary aggregates holdings into one currency (VND by default)
+func (s *investmentService) GetPortfolioSummary(ctx context.Context, userID uuid.UUID, query dto.PortfolioSummaryQuery) (*domain.PortfolioSummary, error) {
+	currency := query.Currency
+	if currency == "" {
+		currency = exchangerate.BaseCurrency
+	}
+	rates := s.rates.Rates(ctx)
+	if !rates.Supports(currency) {
+		return nil, shared.ErrBadRequest.WithDetails("currency", "unsupported currency")
+	}
+
+	holdings, err := s.ListHoldings(ctx, userID, dto.ListHoldingsQuery{AccountID: query.AccountID, IncludeClosed: true})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &domain.PortfolioSummary{Currency: currency}
+	for i := range holdings {
+		rate, err := rates.Convert(1, holdings[i].Currency, currency)
+		if err != nil {
+			s.logger.Warn("Skipping holding with unsupported currency",
+				zap.String("holding_id", holdings[i].ID.String()),
+				zap.String("currency", holdings[i].Currency),
+			)
+			continue
+		}
+		summary.Add(&holdings[i], rate)
+	}
+	summary.Finalize()
+
+	return summary, nil
+}
+
+// SetCostMethod switches the cost basis method and recalculates the holding
+func (s *investmentService) SetCostMethod(ctx context.Context, userID, holdingID uuid.UUID, method domain.CostMethod) (*domain.Holding, error) {
+	if !method.IsValid() {
+		return nil, shared.ErrBadRequest.WithDetails("cost_method", "invalid cost method")
+	}
+
+	holding, err := s.getOwnedHolding(ctx, userID, holdingID)
+	if err != nil {
+		return nil, err
+	}
+	if holding.CostMethod == method {
+		return holding, nil
+	}
+
+	holding.CostMethod = method
+	if err := s.applyTradeChanges(ctx, holding, false, nil, nil); err != nil {
+		return nil, err
+	}
+	return holding, nil
+}
+
+// UpdatePrice sets a holding's current market price manually
+func (s *investmentService) UpdatePrice(ctx context.Context, userID, holdingID uuid.UUID, price float64) (*domain.Holding, error) {
+	if price <= 0 {
+		return nil, shared.ErrBadRequest.WithDetails("price", "price must be positive")
+	}
+
+	holding, err := s.getOwnedHolding(ctx, userID, holdingID)
+	if err != nil {
+		return nil, err
+	}
+
+	holding.UpdatePrice(price, time.Now())
+	if err := s.repo.UpdateHolding(ctx, holding); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return holding, nil
+}
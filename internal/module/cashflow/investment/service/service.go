@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+	"personalfinancedss/internal/module/cashflow/investment/dto"
+	"personalfinancedss/internal/module/cashflow/investment/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// HoldingReader defines holding and portfolio read operations
+type HoldingReader interface {
+	ListHoldings(ctx context.Context, userID uuid.UUID, query dto.ListHoldingsQuery) ([]domain.Holding, error)
+	GetHolding(ctx context.Context, userID, holdingID uuid.UUID) (*domain.Holding, []domain.TaxLot, error)
+	GetPortfolioSummary(ctx context.Context, userID uuid.UUID, query dto.PortfolioSummaryQuery) (*domain.PortfolioSummary, error)
+}
+
+// HoldingUpdater defines holding settings and market data operations
+type HoldingUpdater interface {
+	// SetCostMethod switches the cost basis method and recalculates the holding
+	SetCostMethod(ctx context.Context, userID, holdingID uuid.UUID, method domain.CostMethod) (*domain.Holding, error)
+
+	// UpdatePrice sets a holding's current market price manually
+	UpdatePrice(ctx context.Context, userID, holdingID uuid.UUID, price float64) (*domain.Holding, error)
+}
+
+// TradeManager defines manual trade operations
+type TradeManager interface {
+	RecordTrade(ctx context.Context, userID uuid.UUID, req dto.RecordTradeRequest) (*domain.Trade, error)
+	ListTrades(ctx context.Context, userID uuid.UUID, query dto.ListTradesQuery) ([]domain.Trade, int64, error)
+	DeleteTrade(ctx context.Context, userID, tradeID uuid.UUID) error
+}
+
+// BrokerImporter ingests positions and trades reported by a broker connection
+type BrokerImporter interface {
+	ImportBrokerData(ctx context.Context, userID, accountID uuid.UUID, positions []dto.ImportPosition, trades []dto.ImportTrade) (*dto.ImportResult, error)
+}
+
+// Service is the composite interface for all investment operations
+type Service interface {
+	HoldingReader
+	HoldingUpdater
+	TradeManager
+	BrokerImporter
+}
+
+// investmentService implements all investment use cases
+type investmentService struct {
+	repo        repository.Repository
+	accountRepo accountRepo.Repository
+	rates       exchangerate.Provider
+	logger      *zap.Logger
+}
+
+// NewService creates a new investment service
+func NewService(
+	repo repository.Repository,
+	accountRepo accountRepo.Repository,
+	rates exchangerate.Provider,
+	logger *zap.Logger,
+) Service {
+	return &investmentService{
+		repo:        repo,
+		accountRepo: accountRepo,
+		rates:       rates,
+		logger:      logger.Named("investment.service"),
+	}
+}
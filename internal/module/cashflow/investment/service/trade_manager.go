@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/investment/domain"
+	"personalfinancedss/internal/module/cashflow/investment/dto"
+	"personalfinancedss/internal/module/cashflow/investment/repository"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const defaultTradePageSize = 50
+
+// RecordTrade records a manual trade and recalculates the holding
+func (s *investmentService) RecordTrade(ctx context.Context, userID uuid.UUID, req dto.RecordTradeRequest) (*domain.Trade, error) {
+	accountID, err := uuid.Parse(req.AccountID)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("account_id", "invalid account id")
+	}
+
+	account, err := s.getInvestmentAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	tradeType := domain.TradeType(req.Type)
+	if (tradeType.AddsLot() || tradeType == domain.TradeTypeSell) && req.Quantity <= 0 {
+		return nil, shared.ErrBadRequest.WithDetails("quantity", "quantity is required for buy, sell and transfer trades")
+	}
+	if (tradeType == domain.TradeTypeFee || tradeType == domain.TradeTypeDividend) && req.Amount <= 0 && req.Quantity <= 0 {
+		return nil, shared.ErrBadRequest.WithDetails("amount", "amount is required for dividend and fee trades")
+	}
+	if req.TradeDate.After(time.Now()) {
+		return nil, shared.ErrBadRequest.WithDetails("trade_date", "trade date cannot be in the future")
+	}
+
+	symbol := normalizeSymbol(req.Symbol)
+	holding, isNew, err := s.findOrNewHolding(ctx, account, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		holding.Name = req.Name
+		holding.Exchange = req.Exchange
+		if req.AssetType != nil {
+			holding.AssetType = *req.AssetType
+		}
+		if req.Currency != nil {
+			holding.Currency = *req.Currency
+		}
+	}
+
+	trade := &domain.Trade{
+		ID:        uuid.New(),
+		UserID:    userID,
+		AccountID: account.ID,
+		HoldingID: holding.ID,
+		Type:      tradeType,
+		Symbol:    symbol,
+		TradeDate: req.TradeDate,
+		Quantity:  req.Quantity,
+		Price:     req.Price,
+		Amount:    req.Amount,
+		Fee:       req.Fee,
+		Tax:       req.Tax,
+		Currency:  holding.Currency,
+		Source:    domain.TradeSourceManual,
+		Notes:     req.Notes,
+	}
+
+	if err := s.applyTradeChanges(ctx, holding, isNew, []*domain.Trade{trade}, nil); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Trade recorded",
+		zap.String("user_id", userID.String()),
+		zap.String("holding_id", holding.ID.String()),
+		zap.String("type", string(trade.Type)),
+		zap.String("symbol", symbol),
+	)
+
+	return trade, nil
+}
+
+// ListTrades retrieves a user's trades, newest first
+func (s *investmentService) ListTrades(ctx context.Context, userID uuid.UUID, query dto.ListTradesQuery) ([]domain.Trade, int64, error) {
+	filter := repository.TradeFilter{Symbol: query.Symbol}
+	if query.AccountID != nil {
+		id, err := uuid.Parse(*query.AccountID)
+		if err != nil {
+			return nil, 0, shared.ErrBadRequest.WithDetails("account_id", "invalid account id")
+		}
+		filter.AccountID = &id
+	}
+	if query.HoldingID != nil {
+		id, err := uuid.Parse(*query.HoldingID)
+		if err != nil {
+			return nil, 0, shared.ErrBadRequest.WithDetails("holding_id", "invalid holding id")
+		}
+		filter.HoldingID = &id
+	}
+	if filter.Symbol != nil {
+		symbol := normalizeSymbol(*filter.Symbol)
+		filter.Symbol = &symbol
+	}
+
+	page, pageSize := query.Page, query.PageSize
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultTradePageSize
+	}
+	filter.Limit = pageSize
+	filter.Offset = (page - 1) * pageSize
+
+	trades, total, err := s.repo.ListTrades(ctx, userID, filter)
+	if err != nil {
+		return nil, 0, shared.ErrInternal.WithError(err)
+	}
+	return trades, total, nil
+}
+
+// DeleteTrade removes a trade and recalculates the holding.
+// Deleting a buy that later sells depend on is rejected.
+func (s *investmentService) DeleteTrade(ctx context.Context, userID, tradeID uuid.UUID) error {
+	trade, err := s.repo.GetTradeByID(ctx, tradeID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return err
+		}
+		return shared.ErrInternal.WithError(err)
+	}
+	if trade.UserID != userID {
+		return shared.ErrNotFound
+	}
+
+	holding, err := s.getOwnedHolding(ctx, userID, trade.HoldingID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.applyTradeChanges(ctx, holding, false, nil, []uuid.UUID{trade.ID}); err != nil {
+		return err
+	}
+
+	s.logger.Info("Trade deleted",
+		zap.String("user_id", userID.String()),
+		zap.String("trade_id", tradeID.String()),
+	)
+	return nil
+}
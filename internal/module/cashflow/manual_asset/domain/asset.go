@@ -0,0 +1,271 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ManualAsset is an asset or liability held outside linked accounts, such as gold, land,
+// a motorbike or money lent to relatives
+type ManualAsset struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+
+	Name     string    `gorm:"type:varchar(255);not null;column:name" json:"name"`
+	Type     AssetType `gorm:"type:varchar(30);not null;column:type" json:"type"`
+	Quantity float64   `gorm:"type:decimal(20,6);not null;default:1;column:quantity" json:"quantity"`
+	Unit     Unit      `gorm:"type:varchar(20);not null;default:'piece';column:unit" json:"unit"`
+	Purity   *float64  `gorm:"type:decimal(6,5);column:purity" json:"purity,omitempty"` // Fine metal fraction for weight units, e.g. 0.9999 or 0.75 (18K)
+	Currency string    `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+
+	// Valuation
+	ValuationMethod ValuationMethod `gorm:"type:varchar(20);not null;default:'manual';column:valuation_method" json:"valuation_method"`
+	PriceSymbol     *string         `gorm:"type:varchar(30);index;column:price_symbol" json:"price_symbol,omitempty"` // Price series per unit, for price_series
+
+	// Acquisition and depreciation
+	AcquiredDate       *time.Time          `gorm:"type:date;column:acquired_date" json:"acquired_date,omitempty"`
+	AcquisitionCost    float64             `gorm:"type:decimal(15,2);default:0;column:acquisition_cost" json:"acquisition_cost"`
+	DepreciationMethod *DepreciationMethod `gorm:"type:varchar(20);column:depreciation_method" json:"depreciation_method,omitempty"`
+	UsefulLifeMonths   int                 `gorm:"default:0;column:useful_life_months" json:"useful_life_months"`           // straight_line
+	DecliningRate      float64             `gorm:"type:decimal(5,2);default:0;column:declining_rate" json:"declining_rate"` // declining_balance, annual %
+	SalvageValue       float64             `gorm:"type:decimal(15,2);default:0;column:salvage_value" json:"salvage_value"`
+
+	// Latest value, refreshed when valuations or prices change
+	CurrentValue   float64         `gorm:"type:decimal(15,2);default:0;column:current_value" json:"current_value"`
+	ValueSource    ValuationSource `gorm:"type:varchar(20);column:value_source" json:"value_source,omitempty"`
+	LastValuedDate *time.Time      `gorm:"type:date;column:last_valued_date" json:"last_valued_date,omitempty"`
+
+	DisposedDate      *time.Time `gorm:"type:date;column:disposed_date" json:"disposed_date,omitempty"` // Sold, repaid or written off
+	IncludeInNetWorth bool       `gorm:"default:true;column:include_in_net_worth" json:"include_in_net_worth"`
+	GoalID            *uuid.UUID `gorm:"type:uuid;index;column:goal_id" json:"goal_id,omitempty"` // Goal the asset is earmarked to fund
+	Notes             *string    `gorm:"type:text;column:notes" json:"notes,omitempty"`
+
+	CreatedAt time.Time      `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
+}
+
+// TableName specifies the table name for ManualAsset
+func (ManualAsset) TableName() string {
+	return "manual_assets"
+}
+
+// Valuation is the value of a manual asset on a date
+type Valuation struct {
+	ID            uuid.UUID       `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	AssetID       uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_manual_asset_valuation_date;column:asset_id" json:"asset_id"`
+	UserID        uuid.UUID       `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	ValuationDate time.Time       `gorm:"type:date;not null;uniqueIndex:idx_manual_asset_valuation_date;column:valuation_date" json:"valuation_date"`
+	Value         float64         `gorm:"type:decimal(15,2);not null;column:value" json:"value"`
+	UnitPrice     *float64        `gorm:"type:decimal(20,4);column:unit_price" json:"unit_price,omitempty"`
+	Source        ValuationSource `gorm:"type:varchar(20);not null;default:'manual';column:source" json:"source"`
+	Note          *string         `gorm:"type:text;column:note" json:"note,omitempty"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for Valuation
+func (Valuation) TableName() string {
+	return "manual_asset_valuations"
+}
+
+// AssetPrice is the price of one unit of a symbol on a date, quoted in the currency of the assets that use it.
+// Dealer quotes carry a bid (the dealer buys, "mua vào") and an ask (the dealer sells, "bán ra").
+type AssetPrice struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_manual_asset_price;column:user_id" json:"user_id"`
+	Symbol    string    `gorm:"type:varchar(30);not null;uniqueIndex:idx_manual_asset_price;column:symbol" json:"symbol"` // e.g. SJC, DOJI_RING
+	PriceDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_manual_asset_price;column:price_date" json:"price_date"`
+	Price     float64   `gorm:"type:decimal(20,4);not null;column:price" json:"price"`
+	Bid       *float64  `gorm:"type:decimal(20,4);column:bid" json:"bid,omitempty"`
+	Ask       *float64  `gorm:"type:decimal(20,4);column:ask" json:"ask,omitempty"`
+	Unit      Unit      `gorm:"type:varchar(20);column:unit" json:"unit,omitempty"`      // Unit quoted; empty means per unit of the asset
+	Purity    *float64  `gorm:"type:decimal(6,5);column:purity" json:"purity,omitempty"` // Fineness quoted, for metals
+	Source    string    `gorm:"type:varchar(30);default:'manual';column:source" json:"source"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for AssetPrice
+func (AssetPrice) TableName() string {
+	return "manual_asset_prices"
+}
+
+// Validate checks the asset's type, unit and valuation settings
+func (a *ManualAsset) Validate() error {
+	if !a.Type.IsValid() {
+		return errors.New("invalid asset type")
+	}
+	if !a.Unit.IsValid() {
+		return errors.New("invalid unit")
+	}
+	if a.Quantity <= 0 {
+		return errors.New("quantity must be positive")
+	}
+	if a.AcquisitionCost < 0 || a.SalvageValue < 0 {
+		return errors.New("acquisition cost and salvage value cannot be negative")
+	}
+	if a.AcquiredDate != nil && a.DisposedDate != nil && a.DisposedDate.Before(*a.AcquiredDate) {
+		return errors.New("disposed date must not be before acquired date")
+	}
+	if a.Purity != nil {
+		if *a.Purity <= 0 || *a.Purity > 1 {
+			return errors.New("purity must be a fraction between 0 and 1")
+		}
+		if !a.Unit.IsWeight() {
+			return errors.New("purity requires a weight unit")
+		}
+	}
+	if a.GoalID != nil && a.Type.IsLiability() {
+		return errors.New("liabilities cannot fund a goal")
+	}
+
+	switch a.ValuationMethod {
+	case ValuationManual:
+		return nil
+	case ValuationPriceSeries:
+		if a.PriceSymbol == nil || *a.PriceSymbol == "" {
+			return errors.New("price series valuation requires a price symbol")
+		}
+		return nil
+	case ValuationDepreciation:
+		return a.validateDepreciation()
+	}
+	return errors.New("invalid valuation method")
+}
+
+func (a *ManualAsset) validateDepreciation() error {
+	if a.Type.IsLiability() {
+		return errors.New("liabilities cannot be depreciated")
+	}
+	if a.AcquiredDate == nil || a.AcquisitionCost <= 0 {
+		return errors.New("depreciation requires an acquired date and acquisition cost")
+	}
+	if a.SalvageValue > a.AcquisitionCost {
+		return errors.New("salvage value cannot exceed acquisition cost")
+	}
+	if a.DepreciationMethod == nil || !a.DepreciationMethod.IsValid() {
+		return errors.New("invalid depreciation method")
+	}
+	if *a.DepreciationMethod == DepreciationStraightLine && a.UsefulLifeMonths <= 0 {
+		return errors.New("straight-line depreciation requires a useful life")
+	}
+	if *a.DepreciationMethod == DepreciationDecliningBalance && (a.DecliningRate <= 0 || a.DecliningRate >= 100) {
+		return errors.New("declining balance rate must be between 0 and 100")
+	}
+	return nil
+}
+
+// HeldOn reports whether the asset was owned (or the liability owed) on the given day
+func (a *ManualAsset) HeldOn(day time.Time) bool {
+	day = truncateToDay(day)
+	if a.AcquiredDate != nil && day.Before(truncateToDay(*a.AcquiredDate)) {
+		return false
+	}
+	if a.DisposedDate != nil && !day.Before(truncateToDay(*a.DisposedDate)) {
+		return false
+	}
+	return true
+}
+
+// ValueAt returns the asset's value at the close of a day, in the asset's currency.
+// Valuations and prices must be sorted by date ascending. Price series and depreciation
+// take precedence, with price-series assets marked at the dealer's bid when quoted;
+// otherwise the latest valuation on or before the day is used, then the acquisition cost.
+// Returns false when the asset was not held or has no value yet.
+func (a *ManualAsset) ValueAt(day time.Time, valuations []Valuation, prices []AssetPrice) (float64, ValuationSource, bool) {
+	day = truncateToDay(day)
+	if !a.HeldOn(day) {
+		return 0, "", false
+	}
+
+	switch a.ValuationMethod {
+	case ValuationDepreciation:
+		if a.AcquiredDate != nil {
+			return a.DepreciatedValue(day), ValuationSourceDepreciation, true
+		}
+	case ValuationPriceSeries:
+		if price, ok := latestPrice(prices, day); ok {
+			return roundUnits(a.PriceQuantity(price) * price.RealizablePrice()), ValuationSourcePriceSeries, true
+		}
+	}
+
+	if valuation, ok := latestValuation(valuations, day); ok {
+		return valuation.Value, valuation.Source, true
+	}
+	if a.AcquiredDate != nil && a.AcquisitionCost > 0 {
+		return a.AcquisitionCost, ValuationSourceAcquisition, true
+	}
+	return 0, "", false
+}
+
+// DepreciatedValue returns the acquisition cost depreciated to the given day, never below salvage value
+func (a *ManualAsset) DepreciatedValue(day time.Time) float64 {
+	if a.AcquiredDate == nil || a.DepreciationMethod == nil {
+		return a.AcquisitionCost
+	}
+	years := truncateToDay(day).Sub(truncateToDay(*a.AcquiredDate)).Hours() / 24 / 365
+	if years <= 0 {
+		return a.AcquisitionCost
+	}
+
+	var value float64
+	switch *a.DepreciationMethod {
+	case DepreciationDecliningBalance:
+		value = a.AcquisitionCost * math.Pow(1-a.DecliningRate/100, years)
+	default:
+		life := float64(a.UsefulLifeMonths) / 12
+		if life <= 0 {
+			return a.AcquisitionCost
+		}
+		value = a.AcquisitionCost - (a.AcquisitionCost-a.SalvageValue)*math.Min(years/life, 1)
+	}
+	return roundUnits(math.Max(value, a.SalvageValue))
+}
+
+// UnrealizedGain returns the current value minus the acquisition cost
+func (a *ManualAsset) UnrealizedGain() float64 {
+	return a.CurrentValue - a.AcquisitionCost
+}
+
+// latestValuation returns the last valuation on or before day
+func latestValuation(valuations []Valuation, day time.Time) (Valuation, bool) {
+	var found Valuation
+	ok := false
+	for _, v := range valuations {
+		if truncateToDay(v.ValuationDate).After(day) {
+			break
+		}
+		found, ok = v, true
+	}
+	return found, ok
+}
+
+// latestPrice returns the last price on or before day
+func latestPrice(prices []AssetPrice, day time.Time) (AssetPrice, bool) {
+	var found AssetPrice
+	ok := false
+	for _, p := range prices {
+		if truncateToDay(p.PriceDate).After(day) {
+			break
+		}
+		found, ok = p, true
+	}
+	return found, ok
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// roundUnits rounds to whole currency units
+func roundUnits(v float64) float64 {
+	return math.Round(v)
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestManualAsset_Validate(t *testing.T) {
+	asset := &ManualAsset{Type: AssetTypeGold, Unit: UnitTael, Quantity: 2, ValuationMethod: ValuationManual}
+	assert.NoError(t, asset.Validate())
+
+	asset.ValuationMethod = ValuationPriceSeries
+	assert.Error(t, asset.Validate(), "price series needs a symbol")
+	asset.PriceSymbol = ptr("SJC")
+	assert.NoError(t, asset.Validate())
+
+	vehicle := &ManualAsset{
+		Type: AssetTypeVehicle, Unit: UnitPiece, Quantity: 1,
+		ValuationMethod: ValuationDepreciation, DepreciationMethod: ptr(DepreciationStraightLine),
+		AcquiredDate: ptr(date(2024, 1, 1)), AcquisitionCost: 50_000_000,
+	}
+	assert.Error(t, vehicle.Validate(), "straight line needs a useful life")
+	vehicle.UsefulLifeMonths = 60
+	assert.NoError(t, vehicle.Validate())
+
+	loan := &ManualAsset{Type: AssetTypeInformalLoan, Unit: UnitPiece, Quantity: 1, ValuationMethod: ValuationDepreciation}
+	assert.Error(t, loan.Validate())
+	assert.True(t, loan.Type.IsLiability())
+}
+
+func TestManualAsset_ValueAtManual(t *testing.T) {
+	asset := &ManualAsset{
+		Type: AssetTypeRealEstate, Unit: UnitSquareMeter, Quantity: 80, ValuationMethod: ValuationManual,
+		AcquiredDate: ptr(date(2020, 6, 1)), AcquisitionCost: 2_000_000_000,
+	}
+	valuations := []Valuation{
+		{ValuationDate: date(2023, 1, 1), Value: 2_500_000_000, Source: ValuationSourceManual},
+		{ValuationDate: date(2024, 1, 1), Value: 3_000_000_000, Source: ValuationSourceManual},
+	}
+
+	_, _, ok := asset.ValueAt(date(2020, 5, 31), valuations, nil)
+	assert.False(t, ok, "not held before acquisition")
+
+	value, source, ok := asset.ValueAt(date(2021, 1, 1), valuations, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 2_000_000_000.0, value)
+	assert.Equal(t, ValuationSourceAcquisition, source)
+
+	value, _, _ = asset.ValueAt(date(2023, 6, 30), valuations, nil)
+	assert.Equal(t, 2_500_000_000.0, value)
+	value, _, _ = asset.ValueAt(date(2025, 1, 1), valuations, nil)
+	assert.Equal(t, 3_000_000_000.0, value)
+
+	asset.DisposedDate = ptr(date(2024, 6, 1))
+	_, _, ok = asset.ValueAt(date(2024, 6, 1), valuations, nil)
+	assert.False(t, ok, "not held from the disposal date")
+}
+
+func TestManualAsset_ValueAtPriceSeries(t *testing.T) {
+	asset := &ManualAsset{Type: AssetTypeGold, Unit: UnitTael, Quantity: 2.5, ValuationMethod: ValuationPriceSeries, PriceSymbol: ptr("SJC")}
+	prices := []AssetPrice{
+		{PriceDate: date(2025, 3, 1), Price: 90_000_000},
+		{PriceDate: date(2025, 3, 5), Price: 92_000_000},
+	}
+	valuations := []Valuation{{ValuationDate: date(2025, 1, 1), Value: 200_000_000, Source: ValuationSourceManual}}
+
+	value, source, ok := asset.ValueAt(date(2025, 3, 4), valuations, prices)
+	assert.True(t, ok)
+	assert.Equal(t, 225_000_000.0, value)
+	assert.Equal(t, ValuationSourcePriceSeries, source)
+
+	value, _, _ = asset.ValueAt(date(2025, 3, 10), valuations, prices)
+	assert.Equal(t, 230_000_000.0, value)
+
+	// Before the first price, manual valuations are the fallback
+	value, source, _ = asset.ValueAt(date(2025, 2, 1), valuations, prices)
+	assert.Equal(t, 200_000_000.0, value)
+	assert.Equal(t, ValuationSourceManual, source)
+}
+
+func TestManualAsset_DepreciatedValue(t *testing.T) {
+	motorbike := &ManualAsset{
+		Type: AssetTypeVehicle, Unit: UnitPiece, Quantity: 1, ValuationMethod: ValuationDepreciation,
+		DepreciationMethod: ptr(DepreciationStraightLine), UsefulLifeMonths: 60,
+		AcquiredDate: ptr(date(2020, 1, 1)), AcquisitionCost: 40_000_000, SalvageValue: 10_000_000,
+	}
+	assert.Equal(t, 40_000_000.0, motorbike.DepreciatedValue(date(2020, 1, 1)))
+	assert.InDelta(t, 34_000_000, motorbike.DepreciatedValue(date(2021, 1, 1)), 50_000) // one fifth of 30M lost
+	assert.Equal(t, 10_000_000.0, motorbike.DepreciatedValue(date(2030, 1, 1)), "never below salvage")
+
+	car := &ManualAsset{
+		Type: AssetTypeVehicle, Unit: UnitPiece, Quantity: 1, ValuationMethod: ValuationDepreciation,
+		DepreciationMethod: ptr(DepreciationDecliningBalance), DecliningRate: 15,
+		AcquiredDate: ptr(date(2022, 1, 1)), AcquisitionCost: 800_000_000,
+	}
+	value, source, ok := car.ValueAt(date(2024, 1, 1), nil, nil)
+	assert.True(t, ok)
+	assert.Equal(t, ValuationSourceDepreciation, source)
+	assert.InDelta(t, 800_000_000*0.85*0.85, value, 500_000)
+}
+
+func TestValuer(t *testing.T) {
+	gold := ManualAsset{ID: uuidFor(1), Type: AssetTypeGold, Unit: UnitChi, Quantity: 5, ValuationMethod: ValuationPriceSeries, PriceSymbol: ptr("SJC_CHI")}
+	loan := ManualAsset{ID: uuidFor(2), Type: AssetTypeReceivable, Unit: UnitPiece, Quantity: 1, ValuationMethod: ValuationManual}
+	valuer := NewValuer(
+		[]Valuation{{AssetID: loan.ID, ValuationDate: date(2025, 1, 1), Value: 30_000_000}},
+		[]AssetPrice{{Symbol: "SJC_CHI", PriceDate: date(2025, 1, 1), Price: 8_500_000}},
+	)
+
+	value, _, ok := valuer.ValueAt(&gold, date(2025, 2, 1))
+	assert.True(t, ok)
+	assert.Equal(t, 42_500_000.0, value)
+
+	value, _, ok = valuer.ValueAt(&loan, date(2025, 2, 1))
+	assert.True(t, ok)
+	assert.Equal(t, 30_000_000.0, value)
+
+	assert.Equal(t, []string{"SJC_CHI"}, Symbols([]ManualAsset{gold, loan, gold}))
+}
+
+func uuidFor(n byte) uuid.UUID {
+	return uuid.UUID{n}
+}
+
+func TestManualAsset_PriceQuantityConvertsUnitsAndPurity(t *testing.T) {
+	ring := &ManualAsset{Type: AssetTypeGold, Unit: UnitChi, Quantity: 5, Purity: ptr(0.9999)}
+	sjc := AssetPrice{Unit: UnitTael, Purity: ptr(0.9999), Price: 90_000_000}
+	assert.InDelta(t, 0.5, ring.PriceQuantity(sjc), 1e-9)
+	assert.InDelta(t, 18.75, ring.PureWeightGrams(), 0.01)
+
+	jewellery := &ManualAsset{Type: AssetTypeGold, Unit: UnitChi, Quantity: 10, Purity: ptr(0.75)}
+	assert.InDelta(t, 0.75, jewellery.PriceQuantity(AssetPrice{Unit: UnitTael, Purity: ptr(1.0)}), 1e-9)
+
+	bad := &ManualAsset{Type: AssetTypeGold, Unit: UnitPiece, Quantity: 1, ValuationMethod: ValuationManual, Purity: ptr(0.9999)}
+	assert.Error(t, bad.Validate(), "purity needs a weight unit")
+}
+
+func TestManualAsset_MetalPosition(t *testing.T) {
+	asset := &ManualAsset{
+		Type: AssetTypeGold, Unit: UnitTael, Quantity: 2, Purity: ptr(0.9999),
+		ValuationMethod: ValuationPriceSeries, PriceSymbol: ptr("SJC"), AcquisitionCost: 180_000_000,
+	}
+	price := AssetPrice{PriceDate: date(2025, 3, 4), Price: 91_000_000, Bid: ptr(90_000_000.0), Ask: ptr(92_000_000.0), Unit: UnitTael}
+
+	position := asset.MetalPosition(price)
+	assert.Equal(t, 180_000_000.0, position.MarketValue)
+	assert.Equal(t, 184_000_000.0, position.ReplacementValue)
+	assert.Equal(t, 4_000_000.0, position.SpreadCost)
+	assert.InDelta(t, 2.17, position.SpreadPct, 0.01)
+	assert.Equal(t, 0.0, position.UnrealizedGain)
+	assert.Equal(t, 90_000_000.0, position.BreakEvenBid)
+
+	value, _, ok := asset.ValueAt(date(2025, 3, 5), nil, []AssetPrice{price})
+	assert.True(t, ok)
+	assert.Equal(t, 180_000_000.0, value, "marked at the bid")
+}
@@ -0,0 +1,93 @@
+package domain
+
+// AssetType classifies a manually tracked asset or liability
+type AssetType string
+
+const (
+	AssetTypeGold           AssetType = "gold"            // Gold bars and jewellery (SJC taels, rings)
+	AssetTypeRealEstate     AssetType = "real_estate"     // Land, houses, apartments
+	AssetTypeVehicle        AssetType = "vehicle"         // Cars, motorbikes
+	AssetTypeReceivable     AssetType = "receivable"      // Money lent to relatives or friends
+	AssetTypeCollectible    AssetType = "collectible"     // Art, watches, other valuables
+	AssetTypeBusiness       AssetType = "business"        // Stake in a private business
+	AssetTypeOther          AssetType = "other"           // Any other asset
+	AssetTypeInformalLoan   AssetType = "informal_loan"   // Money borrowed outside banks (hụi, relatives)
+	AssetTypeOtherLiability AssetType = "other_liability" // Any other amount owed
+)
+
+// IsValid checks if the asset type is valid
+func (t AssetType) IsValid() bool {
+	switch t {
+	case AssetTypeGold, AssetTypeRealEstate, AssetTypeVehicle, AssetTypeReceivable, AssetTypeCollectible,
+		AssetTypeBusiness, AssetTypeOther, AssetTypeInformalLoan, AssetTypeOtherLiability:
+		return true
+	}
+	return false
+}
+
+// IsLiability reports whether the type is an amount owed rather than owned
+func (t AssetType) IsLiability() bool {
+	return t == AssetTypeInformalLoan || t == AssetTypeOtherLiability
+}
+
+// Unit is the unit an asset's quantity is measured in
+type Unit string
+
+const (
+	UnitPiece       Unit = "piece"        // Items, vehicles, properties
+	UnitTael        Unit = "tael"         // lượng (37.5 g)
+	UnitChi         Unit = "chi"          // chỉ (3.75 g, a tenth of a tael)
+	UnitGram        Unit = "gram"         // grams
+	UnitTroyOunce   Unit = "troy_ounce"   // troy ounces (31.1 g)
+	UnitSquareMeter Unit = "square_meter" // land and floor area
+)
+
+// IsValid checks if the unit is valid
+func (u Unit) IsValid() bool {
+	switch u {
+	case UnitPiece, UnitTael, UnitChi, UnitGram, UnitTroyOunce, UnitSquareMeter:
+		return true
+	}
+	return false
+}
+
+// ValuationMethod determines how an asset's value is obtained
+type ValuationMethod string
+
+const (
+	ValuationManual       ValuationMethod = "manual"       // latest value entered by the user
+	ValuationPriceSeries  ValuationMethod = "price_series" // quantity × latest price of a symbol
+	ValuationDepreciation ValuationMethod = "depreciation" // acquisition cost depreciated over time
+)
+
+// IsValid checks if the valuation method is valid
+func (m ValuationMethod) IsValid() bool {
+	switch m {
+	case ValuationManual, ValuationPriceSeries, ValuationDepreciation:
+		return true
+	}
+	return false
+}
+
+// DepreciationMethod determines how a depreciating asset loses value
+type DepreciationMethod string
+
+const (
+	DepreciationStraightLine     DepreciationMethod = "straight_line"     // equal loss each month over the useful life
+	DepreciationDecliningBalance DepreciationMethod = "declining_balance" // fixed annual percentage of the remaining value
+)
+
+// IsValid checks if the depreciation method is valid
+func (m DepreciationMethod) IsValid() bool {
+	return m == DepreciationStraightLine || m == DepreciationDecliningBalance
+}
+
+// ValuationSource records where a valuation came from
+type ValuationSource string
+
+const (
+	ValuationSourceManual       ValuationSource = "manual"       // entered by the user
+	ValuationSourceAcquisition  ValuationSource = "acquisition"  // acquisition cost
+	ValuationSourcePriceSeries  ValuationSource = "price_series" // quantity × stored price
+	ValuationSourceDepreciation ValuationSource = "depreciation" // depreciation schedule
+)
@@ -0,0 +1,144 @@
+package domain
+
+import "time"
+
+// Weights of the units precious metals are traded in
+const (
+	GramsPerChi       = 3.75
+	GramsPerTael      = 37.5
+	GramsPerTroyOunce = 31.1034768
+)
+
+// Grams returns the weight of one unit in grams, or 0 for units that are not weights
+func (u Unit) Grams() float64 {
+	switch u {
+	case UnitGram:
+		return 1
+	case UnitChi:
+		return GramsPerChi
+	case UnitTael:
+		return GramsPerTael
+	case UnitTroyOunce:
+		return GramsPerTroyOunce
+	}
+	return 0
+}
+
+// IsWeight reports whether the unit measures metal weight
+func (u Unit) IsWeight() bool {
+	return u.Grams() > 0
+}
+
+// RealizablePrice returns what a holder receives when selling: the dealer's bid when quoted, otherwise the price
+func (p AssetPrice) RealizablePrice() float64 {
+	if p.Bid != nil && *p.Bid > 0 {
+		return *p.Bid
+	}
+	return p.Price
+}
+
+// ReplacementPrice returns what buying costs: the dealer's ask when quoted, otherwise the price
+func (p AssetPrice) ReplacementPrice() float64 {
+	if p.Ask != nil && *p.Ask > 0 {
+		return *p.Ask
+	}
+	return p.Price
+}
+
+// PureWeightGrams returns the fine metal content of a weight-denominated asset.
+// Purity defaults to 1 when not recorded; assets in other units weigh nothing.
+func (a *ManualAsset) PureWeightGrams() float64 {
+	purity := 1.0
+	if a.Purity != nil {
+		purity = *a.Purity
+	}
+	return a.Quantity * a.Unit.Grams() * purity
+}
+
+// PriceQuantity converts the asset's quantity into units of a price quote. Weights convert
+// between taels, chỉ, grams and troy ounces, and when both the asset and the quote state a
+// purity the quantity is scaled to the quote's fineness.
+func (a *ManualAsset) PriceQuantity(p AssetPrice) float64 {
+	quantity := a.Quantity
+	if p.Unit != "" && p.Unit != a.Unit && a.Unit.IsWeight() && p.Unit.IsWeight() {
+		quantity = quantity * a.Unit.Grams() / p.Unit.Grams()
+	}
+	if a.Purity != nil && p.Purity != nil && *p.Purity > 0 {
+		quantity = quantity * *a.Purity / *p.Purity
+	}
+	return quantity
+}
+
+// MetalPosition is a holding marked to a dealer quote. Market value uses the bid, the price
+// a dealer pays today, so the buy/sell spread shows up as an immediate unrealized loss.
+type MetalPosition struct {
+	PriceDate        time.Time
+	PureWeightGrams  float64
+	Bid              float64 // per quote unit
+	Ask              float64 // per quote unit
+	MarketValue      float64 // selling to a dealer today
+	ReplacementValue float64 // buying the same holding today
+	SpreadCost       float64 // replacement minus market value
+	SpreadPct        float64 // spread as a percentage of the ask
+	CostBasis        float64
+	UnrealizedGain   float64 // market value minus cost basis
+	UnrealizedPct    float64
+	BreakEvenBid     float64 // bid per quote unit at which selling recovers the cost basis
+}
+
+// MetalPosition marks the asset to a price quote
+func (a *ManualAsset) MetalPosition(p AssetPrice) MetalPosition {
+	quantity := a.PriceQuantity(p)
+	position := MetalPosition{
+		PriceDate:        truncateToDay(p.PriceDate),
+		PureWeightGrams:  a.PureWeightGrams(),
+		Bid:              p.RealizablePrice(),
+		Ask:              p.ReplacementPrice(),
+		MarketValue:      roundUnits(quantity * p.RealizablePrice()),
+		ReplacementValue: roundUnits(quantity * p.ReplacementPrice()),
+		CostBasis:        a.AcquisitionCost,
+	}
+	position.SpreadCost = position.ReplacementValue - position.MarketValue
+	if position.Ask > 0 {
+		position.SpreadPct = (position.Ask - position.Bid) / position.Ask * 100
+	}
+	if a.AcquisitionCost > 0 {
+		position.UnrealizedGain = position.MarketValue - a.AcquisitionCost
+		position.UnrealizedPct = position.UnrealizedGain / a.AcquisitionCost * 100
+		if quantity > 0 {
+			position.BreakEvenBid = roundUnits(a.AcquisitionCost / quantity)
+		}
+	}
+	return position
+}
+
+// MetalHolding pairs a metal asset with its position; Position is nil when no price is stored yet
+type MetalHolding struct {
+	Asset    ManualAsset
+	Position *MetalPosition
+}
+
+// MetalSummary totals a user's precious-metal holdings in one currency
+type MetalSummary struct {
+	Holdings         []MetalHolding
+	PureWeightGrams  float64
+	MarketValue      float64
+	ReplacementValue float64
+	CostBasis        float64
+	UnrealizedGain   float64
+}
+
+// Add includes a holding in the summary; converted values must already be in the summary currency
+func (s *MetalSummary) Add(holding MetalHolding, marketValue, replacementValue, costBasis float64) {
+	s.Holdings = append(s.Holdings, holding)
+	s.PureWeightGrams += holding.Asset.PureWeightGrams()
+	s.MarketValue += marketValue
+	s.ReplacementValue += replacementValue
+	s.CostBasis += costBasis
+	s.UnrealizedGain = s.MarketValue - s.CostBasis
+}
+
+// PureWeightTaels returns the summary's fine metal weight in taels
+func (s *MetalSummary) PureWeightTaels() float64 {
+	return s.PureWeightGrams / GramsPerTael
+}
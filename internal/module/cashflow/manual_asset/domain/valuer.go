@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Valuer values manual assets on any day from preloaded valuations and prices
+type Valuer struct {
+	valuations map[uuid.UUID][]Valuation
+	prices     map[string][]AssetPrice
+}
+
+// NewValuer groups valuations by asset and prices by symbol; both must be sorted by date ascending
+func NewValuer(valuations []Valuation, prices []AssetPrice) *Valuer {
+	v := &Valuer{
+		valuations: make(map[uuid.UUID][]Valuation),
+		prices:     make(map[string][]AssetPrice),
+	}
+	for _, valuation := range valuations {
+		v.valuations[valuation.AssetID] = append(v.valuations[valuation.AssetID], valuation)
+	}
+	for _, price := range prices {
+		v.prices[price.Symbol] = append(v.prices[price.Symbol], price)
+	}
+	return v
+}
+
+// ValueAt returns an asset's value at the close of a day, in the asset's currency
+func (v *Valuer) ValueAt(asset *ManualAsset, day time.Time) (float64, ValuationSource, bool) {
+	var prices []AssetPrice
+	if asset.PriceSymbol != nil {
+		prices = v.prices[*asset.PriceSymbol]
+	}
+	return asset.ValueAt(day, v.valuations[asset.ID], prices)
+}
+
+// LatestPrice returns the last price of the asset's symbol on or before a day
+func (v *Valuer) LatestPrice(asset *ManualAsset, day time.Time) (AssetPrice, bool) {
+	if asset.PriceSymbol == nil {
+		return AssetPrice{}, false
+	}
+	return latestPrice(v.prices[*asset.PriceSymbol], truncateToDay(day))
+}
+
+// Symbols returns the distinct price symbols used by price-series assets
+func Symbols(assets []ManualAsset) []string {
+	seen := make(map[string]bool)
+	var symbols []string
+	for _, asset := range assets {
+		if asset.ValuationMethod != ValuationPriceSeries || asset.PriceSymbol == nil || seen[*asset.PriceSymbol] {
+			continue
+		}
+		seen[*asset.PriceSymbol] = true
+		symbols = append(symbols, *asset.PriceSymbol)
+	}
+	return symbols
+}
+
+// ValuePoint is an asset's value at the close of a day
+type ValuePoint struct {
+	Date   time.Time
+	Value  float64
+	Source ValuationSource
+}
@@ -0,0 +1,106 @@
+package dto
+
+import "time"
+
+// CreateManualAssetRequest represents a new manually tracked asset or liability
+type CreateManualAssetRequest struct {
+	Name     string   `json:"name" binding:"required,max=255"`
+	Type     string   `json:"type" binding:"required,oneof=gold real_estate vehicle receivable collectible business other informal_loan other_liability"`
+	Quantity *float64 `json:"quantity,omitempty" binding:"omitempty,gt=0"` // Defaults to 1
+	Unit     *string  `json:"unit,omitempty" binding:"omitempty,oneof=piece tael chi gram troy_ounce square_meter"`
+	Purity   *float64 `json:"purity,omitempty" binding:"omitempty,gt=0,lte=1"` // Fine metal fraction, e.g. 0.9999
+	Currency *string  `json:"currency,omitempty" binding:"omitempty,len=3"`
+
+	ValuationMethod *string `json:"valuation_method,omitempty" binding:"omitempty,oneof=manual price_series depreciation"`
+	PriceSymbol     *string `json:"price_symbol,omitempty" binding:"omitempty,max=30"`
+
+	AcquiredDate       *time.Time `json:"acquired_date,omitempty"`
+	AcquisitionCost    *float64   `json:"acquisition_cost,omitempty" binding:"omitempty,min=0"`
+	DepreciationMethod *string    `json:"depreciation_method,omitempty" binding:"omitempty,oneof=straight_line declining_balance"`
+	UsefulLifeMonths   *int       `json:"useful_life_months,omitempty" binding:"omitempty,min=1,max=1200"`
+	DecliningRate      *float64   `json:"declining_rate,omitempty" binding:"omitempty,gt=0,lt=100"`
+	SalvageValue       *float64   `json:"salvage_value,omitempty" binding:"omitempty,min=0"`
+
+	CurrentValue      *float64 `json:"current_value,omitempty" binding:"omitempty,min=0"` // Recorded as today's valuation
+	IncludeInNetWorth *bool    `json:"include_in_net_worth,omitempty"`
+	GoalID            *string  `json:"goal_id,omitempty" binding:"omitempty,uuid"` // Goal the asset is earmarked to fund
+	Notes             *string  `json:"notes,omitempty"`
+}
+
+// UpdateManualAssetRequest represents changes to a manual asset
+type UpdateManualAssetRequest struct {
+	Name     *string  `json:"name,omitempty" binding:"omitempty,max=255"`
+	Quantity *float64 `json:"quantity,omitempty" binding:"omitempty,gt=0"`
+	Unit     *string  `json:"unit,omitempty" binding:"omitempty,oneof=piece tael chi gram troy_ounce square_meter"`
+	Purity   *float64 `json:"purity,omitempty" binding:"omitempty,gt=0,lte=1"`
+
+	ValuationMethod *string `json:"valuation_method,omitempty" binding:"omitempty,oneof=manual price_series depreciation"`
+	PriceSymbol     *string `json:"price_symbol,omitempty" binding:"omitempty,max=30"`
+
+	AcquiredDate       *time.Time `json:"acquired_date,omitempty"`
+	AcquisitionCost    *float64   `json:"acquisition_cost,omitempty" binding:"omitempty,min=0"`
+	DepreciationMethod *string    `json:"depreciation_method,omitempty" binding:"omitempty,oneof=straight_line declining_balance"`
+	UsefulLifeMonths   *int       `json:"useful_life_months,omitempty" binding:"omitempty,min=1,max=1200"`
+	DecliningRate      *float64   `json:"declining_rate,omitempty" binding:"omitempty,gt=0,lt=100"`
+	SalvageValue       *float64   `json:"salvage_value,omitempty" binding:"omitempty,min=0"`
+
+	DisposedDate      *time.Time `json:"disposed_date,omitempty"` // Sold, repaid or written off
+	IncludeInNetWorth *bool      `json:"include_in_net_worth,omitempty"`
+	GoalID            *string    `json:"goal_id,omitempty" binding:"omitempty,uuid"` // Empty string unlinks the goal
+	Notes             *string    `json:"notes,omitempty"`
+}
+
+// ListManualAssetsQuery represents query parameters for listing manual assets
+type ListManualAssetsQuery struct {
+	Type            *string `form:"type" binding:"omitempty,oneof=gold real_estate vehicle receivable collectible business other informal_loan other_liability"`
+	IncludeDisposed bool    `form:"include_disposed"`
+}
+
+// RecordValuationRequest represents a dated valuation; give either value or unit_price
+type RecordValuationRequest struct {
+	ValuationDate time.Time `json:"valuation_date" binding:"required"`
+	Value         *float64  `json:"value,omitempty" binding:"omitempty,min=0"`
+	UnitPrice     *float64  `json:"unit_price,omitempty" binding:"omitempty,min=0"` // Value is quantity × unit price
+	Note          *string   `json:"note,omitempty"`
+}
+
+// ValueHistoryQuery represents query parameters for an asset's value history
+type ValueHistoryQuery struct {
+	From     time.Time `form:"from" time_format:"2006-01-02" binding:"required"`
+	To       time.Time `form:"to" time_format:"2006-01-02" binding:"required"`
+	Interval string    `form:"interval" binding:"omitempty,oneof=daily weekly monthly"`
+}
+
+// PriceInput is one closing price of a symbol, optionally with the dealer's bid and ask
+type PriceInput struct {
+	Date   time.Time `json:"date" binding:"required"`
+	Price  float64   `json:"price" binding:"required,gt=0"`
+	Bid    *float64  `json:"bid,omitempty" binding:"omitempty,gt=0"` // Dealer buys at ("mua vào")
+	Ask    *float64  `json:"ask,omitempty" binding:"omitempty,gt=0"` // Dealer sells at ("bán ra")
+	Unit   *string   `json:"unit,omitempty" binding:"omitempty,oneof=piece tael chi gram troy_ounce square_meter"`
+	Purity *float64  `json:"purity,omitempty" binding:"omitempty,gt=0,lte=1"`
+}
+
+// UpsertPricesRequest represents prices of a symbol, replacing prices stored for the same days
+type UpsertPricesRequest struct {
+	Prices []PriceInput `json:"prices" binding:"required,min=1,max=5000,dive"`
+}
+
+// PricesQuery represents query parameters for listing prices
+type PricesQuery struct {
+	From time.Time `form:"from" time_format:"2006-01-02" binding:"required"`
+	To   time.Time `form:"to" time_format:"2006-01-02" binding:"required"`
+}
+
+// ImportPricesQuery represents options for importing prices from a file.
+// Symbols defaults to those of the user's price-series assets; dates default to everything in the file.
+type ImportPricesQuery struct {
+	Symbols string     `form:"symbols"` // Comma-separated
+	From    *time.Time `form:"from" time_format:"2006-01-02"`
+	To      *time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+// MetalSummaryQuery represents query parameters for the precious metals summary
+type MetalSummaryQuery struct {
+	Currency string `form:"currency" binding:"omitempty,len=3"`
+}
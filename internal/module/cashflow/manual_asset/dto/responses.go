@@ -0,0 +1,289 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/manual_asset/domain"
+
+	"github.com/google/uuid"
+)
+
+// ManualAssetResponse represents a manual asset in API responses
+type ManualAssetResponse struct {
+	ID          uuid.UUID        `json:"id"`
+	Name        string           `json:"name"`
+	Type        domain.AssetType `json:"type"`
+	IsLiability bool             `json:"is_liability"`
+	Quantity    float64          `json:"quantity"`
+	Unit        domain.Unit      `json:"unit"`
+	Purity      *float64         `json:"purity,omitempty"`
+	Currency    string           `json:"currency"`
+
+	PureWeightGrams float64 `json:"pure_weight_grams,omitempty"` // Fine metal content for weight units
+
+	ValuationMethod domain.ValuationMethod `json:"valuation_method"`
+	PriceSymbol     *string                `json:"price_symbol,omitempty"`
+
+	AcquiredDate       *time.Time                 `json:"acquired_date,omitempty"`
+	AcquisitionCost    float64                    `json:"acquisition_cost"`
+	DepreciationMethod *domain.DepreciationMethod `json:"depreciation_method,omitempty"`
+	UsefulLifeMonths   int                        `json:"useful_life_months,omitempty"`
+	DecliningRate      float64                    `json:"declining_rate,omitempty"`
+	SalvageValue       float64                    `json:"salvage_value,omitempty"`
+
+	CurrentValue   float64                `json:"current_value"`
+	ValueSource    domain.ValuationSource `json:"value_source,omitempty"`
+	LastValuedDate *time.Time             `json:"last_valued_date,omitempty"`
+	UnrealizedGain float64                `json:"unrealized_gain"`
+
+	DisposedDate      *time.Time `json:"disposed_date,omitempty"`
+	IncludeInNetWorth bool       `json:"include_in_net_worth"`
+	GoalID            *uuid.UUID `json:"goal_id,omitempty"`
+	Notes             *string    `json:"notes,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// ValuationResponse represents a dated valuation in API responses
+type ValuationResponse struct {
+	ID            uuid.UUID              `json:"id"`
+	AssetID       uuid.UUID              `json:"asset_id"`
+	ValuationDate string                 `json:"valuation_date"`
+	Value         float64                `json:"value"`
+	UnitPrice     *float64               `json:"unit_price,omitempty"`
+	Source        domain.ValuationSource `json:"source"`
+	Note          *string                `json:"note,omitempty"`
+}
+
+// ValuePointResponse represents an asset's value on one day
+type ValuePointResponse struct {
+	Date   string                 `json:"date"`
+	Value  float64                `json:"value"`
+	Source domain.ValuationSource `json:"source"`
+}
+
+// ValueHistoryResponse represents an asset's value over time
+type ValueHistoryResponse struct {
+	AssetID  uuid.UUID            `json:"asset_id"`
+	Currency string               `json:"currency"`
+	Points   []ValuePointResponse `json:"points"`
+}
+
+// AssetPriceResponse represents a stored price in API responses
+type AssetPriceResponse struct {
+	Symbol string      `json:"symbol"`
+	Date   string      `json:"date"`
+	Price  float64     `json:"price"`
+	Bid    *float64    `json:"bid,omitempty"`
+	Ask    *float64    `json:"ask,omitempty"`
+	Unit   domain.Unit `json:"unit,omitempty"`
+	Purity *float64    `json:"purity,omitempty"`
+	Source string      `json:"source"`
+}
+
+// ImportPricesResponse reports the result of a price import
+type ImportPricesResponse struct {
+	Provider string   `json:"provider"`
+	Symbols  []string `json:"symbols"`
+	Stored   int      `json:"stored"`
+}
+
+// MetalHoldingResponse represents a precious-metal holding marked to the latest dealer quote.
+// Values are in the asset's currency; quote fields are omitted until a price is stored.
+type MetalHoldingResponse struct {
+	AssetID         uuid.UUID   `json:"asset_id"`
+	Name            string      `json:"name"`
+	Quantity        float64     `json:"quantity"`
+	Unit            domain.Unit `json:"unit"`
+	Purity          *float64    `json:"purity,omitempty"`
+	PureWeightGrams float64     `json:"pure_weight_grams"`
+	PriceSymbol     *string     `json:"price_symbol,omitempty"`
+	Currency        string      `json:"currency"`
+	CostBasis       float64     `json:"cost_basis"`
+	GoalID          *uuid.UUID  `json:"goal_id,omitempty"`
+
+	PriceDate        *string  `json:"price_date,omitempty"`
+	Bid              *float64 `json:"bid,omitempty"`
+	Ask              *float64 `json:"ask,omitempty"`
+	MarketValue      *float64 `json:"market_value,omitempty"`      // Selling to a dealer today
+	ReplacementValue *float64 `json:"replacement_value,omitempty"` // Buying the same holding today
+	SpreadCost       *float64 `json:"spread_cost,omitempty"`
+	SpreadPct        *float64 `json:"spread_pct,omitempty"`
+	UnrealizedGain   *float64 `json:"unrealized_gain,omitempty"`
+	UnrealizedPct    *float64 `json:"unrealized_pct,omitempty"`
+	BreakEvenBid     *float64 `json:"break_even_bid,omitempty"`
+}
+
+// MetalSummaryResponse represents all precious-metal holdings with totals in one currency
+type MetalSummaryResponse struct {
+	Currency         string                 `json:"currency"`
+	PureWeightGrams  float64                `json:"pure_weight_grams"`
+	PureWeightTaels  float64                `json:"pure_weight_taels"`
+	MarketValue      float64                `json:"market_value"`
+	ReplacementValue float64                `json:"replacement_value"`
+	SpreadCost       float64                `json:"spread_cost"`
+	CostBasis        float64                `json:"cost_basis"`
+	UnrealizedGain   float64                `json:"unrealized_gain"`
+	Holdings         []MetalHoldingResponse `json:"holdings"`
+}
+
+// UpsertPricesResponse reports how many prices were stored for a symbol
+type UpsertPricesResponse struct {
+	Symbol string `json:"symbol"`
+	Stored int    `json:"stored"`
+}
+
+// ToManualAssetResponse converts a domain asset to response DTO
+func ToManualAssetResponse(asset *domain.ManualAsset) *ManualAssetResponse {
+	if asset == nil {
+		return nil
+	}
+
+	return &ManualAssetResponse{
+		ID:                 asset.ID,
+		Name:               asset.Name,
+		Type:               asset.Type,
+		IsLiability:        asset.Type.IsLiability(),
+		Quantity:           asset.Quantity,
+		Unit:               asset.Unit,
+		Purity:             asset.Purity,
+		Currency:           asset.Currency,
+		PureWeightGrams:    asset.PureWeightGrams(),
+		ValuationMethod:    asset.ValuationMethod,
+		PriceSymbol:        asset.PriceSymbol,
+		AcquiredDate:       asset.AcquiredDate,
+		AcquisitionCost:    asset.AcquisitionCost,
+		DepreciationMethod: asset.DepreciationMethod,
+		UsefulLifeMonths:   asset.UsefulLifeMonths,
+		DecliningRate:      asset.DecliningRate,
+		SalvageValue:       asset.SalvageValue,
+		CurrentValue:       asset.CurrentValue,
+		ValueSource:        asset.ValueSource,
+		LastValuedDate:     asset.LastValuedDate,
+		UnrealizedGain:     asset.UnrealizedGain(),
+		DisposedDate:       asset.DisposedDate,
+		IncludeInNetWorth:  asset.IncludeInNetWorth,
+		GoalID:             asset.GoalID,
+		Notes:              asset.Notes,
+		CreatedAt:          asset.CreatedAt,
+		UpdatedAt:          asset.UpdatedAt,
+	}
+}
+
+// ToManualAssetResponses converts domain assets to response DTOs
+func ToManualAssetResponses(assets []domain.ManualAsset) []ManualAssetResponse {
+	responses := make([]ManualAssetResponse, len(assets))
+	for i := range assets {
+		responses[i] = *ToManualAssetResponse(&assets[i])
+	}
+	return responses
+}
+
+// ToValuationResponse converts a domain valuation to response DTO
+func ToValuationResponse(valuation *domain.Valuation) *ValuationResponse {
+	if valuation == nil {
+		return nil
+	}
+
+	return &ValuationResponse{
+		ID:            valuation.ID,
+		AssetID:       valuation.AssetID,
+		ValuationDate: valuation.ValuationDate.Format("2006-01-02"),
+		Value:         valuation.Value,
+		UnitPrice:     valuation.UnitPrice,
+		Source:        valuation.Source,
+		Note:          valuation.Note,
+	}
+}
+
+// ToValuationResponses converts domain valuations to response DTOs
+func ToValuationResponses(valuations []domain.Valuation) []ValuationResponse {
+	responses := make([]ValuationResponse, len(valuations))
+	for i := range valuations {
+		responses[i] = *ToValuationResponse(&valuations[i])
+	}
+	return responses
+}
+
+// ToAssetPriceResponses converts stored prices to response DTOs
+func ToAssetPriceResponses(prices []domain.AssetPrice) []AssetPriceResponse {
+	responses := make([]AssetPriceResponse, len(prices))
+	for i, p := range prices {
+		responses[i] = AssetPriceResponse{
+			Symbol: p.Symbol,
+			Date:   p.PriceDate.Format("2006-01-02"),
+			Price:  p.Price,
+			Bid:    p.Bid,
+			Ask:    p.Ask,
+			Unit:   p.Unit,
+			Purity: p.Purity,
+			Source: p.Source,
+		}
+	}
+	return responses
+}
+
+// ToValueHistoryResponse converts an asset's value points to response DTO
+func ToValueHistoryResponse(asset *domain.ManualAsset, points []domain.ValuePoint) *ValueHistoryResponse {
+	response := &ValueHistoryResponse{
+		AssetID:  asset.ID,
+		Currency: asset.Currency,
+		Points:   make([]ValuePointResponse, len(points)),
+	}
+	for i, p := range points {
+		response.Points[i] = ValuePointResponse{
+			Date:   p.Date.Format("2006-01-02"),
+			Value:  p.Value,
+			Source: p.Source,
+		}
+	}
+	return response
+}
+
+// ToMetalSummaryResponse converts a metal summary to response DTO
+func ToMetalSummaryResponse(summary *domain.MetalSummary, currency string) *MetalSummaryResponse {
+	response := &MetalSummaryResponse{
+		Currency:         currency,
+		PureWeightGrams:  summary.PureWeightGrams,
+		PureWeightTaels:  summary.PureWeightTaels(),
+		MarketValue:      summary.MarketValue,
+		ReplacementValue: summary.ReplacementValue,
+		SpreadCost:       summary.ReplacementValue - summary.MarketValue,
+		CostBasis:        summary.CostBasis,
+		UnrealizedGain:   summary.UnrealizedGain,
+		Holdings:         make([]MetalHoldingResponse, len(summary.Holdings)),
+	}
+
+	for i, h := range summary.Holdings {
+		holding := MetalHoldingResponse{
+			AssetID:         h.Asset.ID,
+			Name:            h.Asset.Name,
+			Quantity:        h.Asset.Quantity,
+			Unit:            h.Asset.Unit,
+			Purity:          h.Asset.Purity,
+			PureWeightGrams: h.Asset.PureWeightGrams(),
+			PriceSymbol:     h.Asset.PriceSymbol,
+			Currency:        h.Asset.Currency,
+			CostBasis:       h.Asset.AcquisitionCost,
+			GoalID:          h.Asset.GoalID,
+		}
+		if p := h.Position; p != nil {
+			priceDate := p.PriceDate.Format("2006-01-02")
+			holding.PriceDate = &priceDate
+			holding.Bid = &p.Bid
+			holding.Ask = &p.Ask
+			holding.MarketValue = &p.MarketValue
+			holding.ReplacementValue = &p.ReplacementValue
+			holding.SpreadCost = &p.SpreadCost
+			holding.SpreadPct = &p.SpreadPct
+			if h.Asset.AcquisitionCost > 0 {
+				holding.UnrealizedGain = &p.UnrealizedGain
+				holding.UnrealizedPct = &p.UnrealizedPct
+				holding.BreakEvenBid = &p.BreakEvenBid
+			}
+		}
+		response.Holdings[i] = holding
+	}
+
+	return response
+}
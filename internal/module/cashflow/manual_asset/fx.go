@@ -0,0 +1,43 @@
+package manual_asset
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/manual_asset/handler"
+	"personalfinancedss/internal/module/cashflow/manual_asset/repository"
+	"personalfinancedss/internal/module/cashflow/manual_asset/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides manual asset dependencies. The revaluation job runs on the notification
+// scheduler through the scheduled_jobs group.
+var Module = fx.Module("manual_asset",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Handler
+		handler.NewHandler,
+
+		// Scheduled job
+		fx.Annotate(
+			service.NewRevaluationJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
+	),
+	fx.Invoke(registerManualAssetRoutes),
+)
+
+func registerManualAssetRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
@@ -0,0 +1,569 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/module/cashflow/manual_asset/dto"
+	"personalfinancedss/internal/module/cashflow/manual_asset/pricefeed/csvfeed"
+	"personalfinancedss/internal/module/cashflow/manual_asset/service"
+	"personalfinancedss/internal/shared"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages manual asset endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs a manual asset handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("manual_asset.handler"),
+	}
+}
+
+// RegisterRoutes wires manual asset routes under /api/v1/manual-assets.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	assets := r.Group("/api/v1/manual-assets")
+	assets.Use(authMiddleware.AuthMiddleware())
+	{
+		assets.POST("", h.createAsset)
+		assets.GET("", h.listAssets)
+		assets.GET("/metals", h.getMetalSummary)
+		assets.POST("/prices/import", h.importPrices)
+		assets.PUT("/prices/:symbol", h.upsertPrices)
+		assets.GET("/prices/:symbol", h.listPrices)
+		assets.GET("/:id", h.getAsset)
+		assets.PUT("/:id", h.updateAsset)
+		assets.DELETE("/:id", h.deleteAsset)
+		assets.POST("/:id/valuations", h.recordValuation)
+		assets.GET("/:id/valuations", h.listValuations)
+		assets.DELETE("/:id/valuations/:valuation_id", h.deleteValuation)
+		assets.GET("/:id/history", h.getValueHistory)
+	}
+}
+
+// createAsset godoc
+// @Summary Create manual asset
+// @Description Track an asset or liability held outside linked accounts (gold, land, vehicles, informal loans). It is counted in net worth and the balance sheet
+// @Tags manual-assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateManualAssetRequest true "Asset details"
+// @Success 201 {object} dto.ManualAssetResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets [post]
+func (h *Handler) createAsset(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.CreateManualAssetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	asset, err := h.service.CreateAsset(c.Request.Context(), currentUser.ID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Manual asset created successfully", dto.ToManualAssetResponse(asset))
+}
+
+// listAssets godoc
+// @Summary List manual assets
+// @Description List the user's manual assets and liabilities
+// @Tags manual-assets
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Filter by asset type"
+// @Param include_disposed query bool false "Include sold, repaid or written-off items"
+// @Success 200 {array} dto.ManualAssetResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets [get]
+func (h *Handler) listAssets(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.ListManualAssetsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	assets, err := h.service.ListAssets(c.Request.Context(), currentUser.ID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Manual assets retrieved successfully", dto.ToManualAssetResponses(assets))
+}
+
+// getAsset godoc
+// @Summary Get manual asset
+// @Description Get a manual asset with its current value
+// @Tags manual-assets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {object} dto.ManualAssetResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/{id} [get]
+func (h *Handler) getAsset(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid asset id")
+		return
+	}
+
+	asset, err := h.service.GetAsset(c.Request.Context(), currentUser.ID, assetID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Manual asset retrieved successfully", dto.ToManualAssetResponse(asset))
+}
+
+// updateAsset godoc
+// @Summary Update manual asset
+// @Description Change an asset's details or valuation settings, or mark it disposed
+// @Tags manual-assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param request body dto.UpdateManualAssetRequest true "Changes"
+// @Success 200 {object} dto.ManualAssetResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/{id} [put]
+func (h *Handler) updateAsset(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid asset id")
+		return
+	}
+
+	var req dto.UpdateManualAssetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	asset, err := h.service.UpdateAsset(c.Request.Context(), currentUser.ID, assetID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Manual asset updated successfully", dto.ToManualAssetResponse(asset))
+}
+
+// deleteAsset godoc
+// @Summary Delete manual asset
+// @Description Stop tracking a manual asset. Use disposed_date instead to keep it in past net worth
+// @Tags manual-assets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/{id} [delete]
+func (h *Handler) deleteAsset(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid asset id")
+		return
+	}
+
+	if err := h.service.DeleteAsset(c.Request.Context(), currentUser.ID, assetID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Manual asset deleted successfully")
+}
+
+// recordValuation godoc
+// @Summary Record valuation
+// @Description Record an asset's value on a date, as a total or a unit price. Replaces any valuation of the same day
+// @Tags manual-assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param request body dto.RecordValuationRequest true "Valuation"
+// @Success 201 {object} dto.ValuationResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/{id}/valuations [post]
+func (h *Handler) recordValuation(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid asset id")
+		return
+	}
+
+	var req dto.RecordValuationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	valuation, err := h.service.RecordValuation(c.Request.Context(), currentUser.ID, assetID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Valuation recorded successfully", dto.ToValuationResponse(valuation))
+}
+
+// listValuations godoc
+// @Summary List valuations
+// @Description List an asset's recorded valuations, oldest first
+// @Tags manual-assets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {array} dto.ValuationResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/{id}/valuations [get]
+func (h *Handler) listValuations(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid asset id")
+		return
+	}
+
+	valuations, err := h.service.ListValuations(c.Request.Context(), currentUser.ID, assetID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Valuations retrieved successfully", dto.ToValuationResponses(valuations))
+}
+
+// deleteValuation godoc
+// @Summary Delete valuation
+// @Description Delete a recorded valuation; the asset's current value is recomputed
+// @Tags manual-assets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param valuation_id path string true "Valuation ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/{id}/valuations/{valuation_id} [delete]
+func (h *Handler) deleteValuation(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid asset id")
+		return
+	}
+	valuationID, err := uuid.Parse(c.Param("valuation_id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid valuation id")
+		return
+	}
+
+	if err := h.service.DeleteValuation(c.Request.Context(), currentUser.ID, assetID, valuationID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Valuation deleted successfully")
+}
+
+// getValueHistory godoc
+// @Summary Get asset value history
+// @Description Value an asset at each interval close between two dates from its valuations, price series or depreciation schedule
+// @Tags manual-assets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Param interval query string false "Point spacing (daily, weekly, monthly)"
+// @Success 200 {object} dto.ValueHistoryResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/{id}/history [get]
+func (h *Handler) getValueHistory(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid asset id")
+		return
+	}
+
+	var query dto.ValueHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	asset, points, err := h.service.GetValueHistory(c.Request.Context(), currentUser.ID, assetID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Value history retrieved successfully", dto.ToValueHistoryResponse(asset, points))
+}
+
+// upsertPrices godoc
+// @Summary Upsert price series
+// @Description Store daily prices of a symbol (e.g. SJC gold per tael) and revalue the assets priced from it
+// @Tags manual-assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param symbol path string true "Price symbol"
+// @Param request body dto.UpsertPricesRequest true "Prices"
+// @Success 200 {object} dto.UpsertPricesResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/prices/{symbol} [put]
+func (h *Handler) upsertPrices(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.UpsertPricesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	symbol := c.Param("symbol")
+	stored, err := h.service.UpsertPrices(c.Request.Context(), currentUser.ID, symbol, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Prices stored successfully", dto.UpsertPricesResponse{
+		Symbol: strings.ToUpper(strings.TrimSpace(symbol)),
+		Stored: stored,
+	})
+}
+
+// listPrices godoc
+// @Summary List price series
+// @Description List stored prices of a symbol within a date range
+// @Tags manual-assets
+// @Produce json
+// @Security BearerAuth
+// @Param symbol path string true "Price symbol"
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Success 200 {array} dto.AssetPriceResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/prices/{symbol} [get]
+func (h *Handler) listPrices(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.PricesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	prices, err := h.service.ListPrices(c.Request.Context(), currentUser.ID, c.Param("symbol"), query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Prices retrieved successfully", dto.ToAssetPriceResponses(prices))
+}
+
+// importPrices godoc
+// @Summary Import price series
+// @Description Import dealer quotes from a CSV file with columns date, symbol, price or bid, and optionally ask, unit, purity and currency. Assets priced from the imported symbols are revalued
+// @Tags manual-assets
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "CSV price file"
+// @Param symbols query string false "Comma-separated symbols to import (defaults to the symbols your assets are priced from)"
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} dto.ImportPricesResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/prices/import [post]
+func (h *Handler) importPrices(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.ImportPricesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "price file is required")
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid price file")
+		return
+	}
+	defer file.Close()
+
+	provider, err := csvfeed.NewProvider(file)
+	if err != nil {
+		shared.HandleError(c, shared.ErrBadRequest.WithDetails("file", err.Error()))
+		return
+	}
+
+	symbols, stored, err := h.service.ImportPrices(c.Request.Context(), currentUser.ID, provider, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Prices imported successfully", dto.ImportPricesResponse{
+		Provider: provider.Name(),
+		Symbols:  symbols,
+		Stored:   stored,
+	})
+}
+
+// getMetalSummary godoc
+// @Summary Precious metals summary
+// @Description Mark gold holdings to the latest dealer quotes: fine weight, value at the dealer's bid and ask, spread cost and unrealized gain
+// @Tags manual-assets
+// @Produce json
+// @Security BearerAuth
+// @Param currency query string false "Report currency (default VND)"
+// @Success 200 {object} dto.MetalSummaryResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/manual-assets/metals [get]
+func (h *Handler) getMetalSummary(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.MetalSummaryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+	currency := strings.ToUpper(query.Currency)
+	if currency == "" {
+		currency = exchangerate.BaseCurrency
+	}
+
+	summary, err := h.service.GetMetalSummary(c.Request.Context(), currentUser.ID, currency)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Metal summary retrieved successfully", dto.ToMetalSummaryResponse(summary, currency))
+}
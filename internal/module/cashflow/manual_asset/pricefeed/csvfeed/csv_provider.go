@@ -0,0 +1,260 @@
+package csvfeed
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"personalfinancedss/internal/module/cashflow/manual_asset/pricefeed"
+	"personalfinancedss/internal/module/identify/broker/client"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	providerName    = "csv"
+	defaultCurrency = "VND"
+)
+
+// dateLayouts are the date formats accepted in the date column
+var dateLayouts = []string{"2006-01-02", "02/01/2006"}
+
+// Provider implements pricefeed.Provider over quotes loaded from a CSV file.
+//
+// The first row is a header naming the columns; order does not matter. Required columns are
+// date and symbol plus price or bid. Optional columns are bid, ask, unit, purity and currency.
+// A missing price is taken as the bid/ask midpoint, or the bid alone.
+//
+//	date,symbol,bid,ask,unit,purity
+//	2025-03-03,SJC,89500000,91500000,tael,0.9999
+type Provider struct {
+	quotes map[string][]pricefeed.Quote // by symbol, oldest first
+}
+
+// NewProvider parses quotes from a CSV reader
+func NewProvider(r io.Reader) (*Provider, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("price file is empty")
+		}
+		return nil, fmt.Errorf("failed to read price file header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"date", "symbol"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("price file is missing the %s column", required)
+		}
+	}
+	_, hasPrice := columns["price"]
+	_, hasBid := columns["bid"]
+	if !hasPrice && !hasBid {
+		return nil, errors.New("price file needs a price or bid column")
+	}
+
+	p := &Provider{quotes: make(map[string][]pricefeed.Quote)}
+	line := 1
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		quote, err := parseRecord(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		p.quotes[quote.Symbol] = append(p.quotes[quote.Symbol], quote)
+	}
+
+	for symbol := range p.quotes {
+		quotes := p.quotes[symbol]
+		sort.SliceStable(quotes, func(i, j int) bool {
+			return quotes[i].LastUpdated.Before(quotes[j].LastUpdated)
+		})
+		p.quotes[symbol] = withChanges(quotes)
+	}
+
+	return p, nil
+}
+
+// NewFileProvider parses quotes from a CSV file on disk
+func NewFileProvider(path string) (*Provider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open price file: %w", err)
+	}
+	defer file.Close()
+
+	return NewProvider(file)
+}
+
+// Name identifies the provider
+func (p *Provider) Name() string {
+	return providerName
+}
+
+// Symbols returns the symbols present in the file, sorted
+func (p *Provider) Symbols() []string {
+	symbols := make([]string, 0, len(p.quotes))
+	for symbol := range p.quotes {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// GetMarketPrice returns the latest quote of a symbol
+func (p *Provider) GetMarketPrice(ctx context.Context, symbol string) (*pricefeed.Quote, error) {
+	quotes, ok := p.quotes[normalizeSymbol(symbol)]
+	if !ok || len(quotes) == 0 {
+		return nil, pricefeed.ErrSymbolNotFound
+	}
+	quote := quotes[len(quotes)-1]
+	return &quote, nil
+}
+
+// GetBatchMarketPrices returns the latest quotes of the symbols found in the file
+func (p *Provider) GetBatchMarketPrices(ctx context.Context, symbols []string) (map[string]*pricefeed.Quote, error) {
+	result := make(map[string]*pricefeed.Quote, len(symbols))
+	for _, symbol := range symbols {
+		quote, err := p.GetMarketPrice(ctx, symbol)
+		if err != nil {
+			continue
+		}
+		result[quote.Symbol] = quote
+	}
+	return result, nil
+}
+
+// GetPriceHistory returns the quotes of a symbol between two dates (inclusive), oldest first
+func (p *Provider) GetPriceHistory(ctx context.Context, symbol string, from, to time.Time) ([]pricefeed.Quote, error) {
+	quotes, ok := p.quotes[normalizeSymbol(symbol)]
+	if !ok {
+		return nil, pricefeed.ErrSymbolNotFound
+	}
+
+	var history []pricefeed.Quote
+	for _, quote := range quotes {
+		if quote.LastUpdated.Before(truncateToDay(from)) || quote.LastUpdated.After(truncateToDay(to)) {
+			continue
+		}
+		history = append(history, quote)
+	}
+	return history, nil
+}
+
+// parseRecord converts one CSV row into a quote
+func parseRecord(record []string, columns map[string]int) (pricefeed.Quote, error) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	quote := pricefeed.Quote{MarketPrice: client.MarketPrice{
+		Symbol:   normalizeSymbol(field("symbol")),
+		Currency: strings.ToUpper(field("currency")),
+	}}
+	if quote.Symbol == "" {
+		return quote, errors.New("symbol is empty")
+	}
+	if quote.Currency == "" {
+		quote.Currency = defaultCurrency
+	}
+
+	date, err := parseDate(field("date"))
+	if err != nil {
+		return quote, err
+	}
+	quote.LastUpdated = date
+
+	price, err := parseNumber(field("price"))
+	if err != nil {
+		return quote, fmt.Errorf("invalid price: %w", err)
+	}
+	if quote.Bid, err = parseNumber(field("bid")); err != nil {
+		return quote, fmt.Errorf("invalid bid: %w", err)
+	}
+	if quote.Ask, err = parseNumber(field("ask")); err != nil {
+		return quote, fmt.Errorf("invalid ask: %w", err)
+	}
+	if quote.Purity, err = parseNumber(field("purity")); err != nil {
+		return quote, fmt.Errorf("invalid purity: %w", err)
+	}
+	quote.Unit = strings.ToLower(field("unit"))
+
+	switch {
+	case price != nil:
+		quote.Price = *price
+	case quote.Bid != nil && quote.Ask != nil:
+		quote.Price = (*quote.Bid + *quote.Ask) / 2
+	case quote.Bid != nil:
+		quote.Price = *quote.Bid
+	}
+	if quote.Price <= 0 {
+		return quote, errors.New("price must be positive")
+	}
+
+	return quote, nil
+}
+
+// withChanges fills each quote's change from the previous quote of the same symbol
+func withChanges(quotes []pricefeed.Quote) []pricefeed.Quote {
+	for i := 1; i < len(quotes); i++ {
+		prev := quotes[i-1].Price
+		quotes[i].Change = quotes[i].Price - prev
+		if prev > 0 {
+			quotes[i].ChangePct = quotes[i].Change / prev * 100
+		}
+	}
+	return quotes
+}
+
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// parseNumber parses an optional number, ignoring thousands separators written as commas or spaces
+func parseNumber(value string) (*float64, error) {
+	value = strings.NewReplacer(",", "", " ", "").Replace(value)
+	if value == "" {
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func normalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,61 @@
+package csvfeed
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/manual_asset/pricefeed"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const goldPrices = `date,symbol,bid,ask,unit,purity
+2025-03-04,SJC,"90,000,000","92,000,000",tael,0.9999
+2025-03-03,sjc,89500000,91500000,tael,0.9999
+03/03/2025,RING_9999,8900000,9050000,chi,0.9999
+`
+
+func TestProvider_ParsesQuotes(t *testing.T) {
+	p, err := NewProvider(strings.NewReader(goldPrices))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"RING_9999", "SJC"}, p.Symbols())
+
+	quote, err := p.GetMarketPrice(context.Background(), "sjc")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), quote.LastUpdated)
+	assert.Equal(t, 91_000_000.0, quote.Price, "midpoint when no price column")
+	assert.Equal(t, 90_000_000.0, *quote.Bid)
+	assert.Equal(t, 500_000.0, quote.Change)
+	assert.Equal(t, "tael", quote.Unit)
+	assert.Equal(t, "VND", quote.Currency)
+
+	history, err := p.GetPriceHistory(context.Background(), "SJC",
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, 89_500_000.0, *history[0].Bid)
+
+	batch, err := p.GetBatchMarketPrices(context.Background(), []string{"RING_9999", "UNKNOWN"})
+	require.NoError(t, err)
+	assert.Len(t, batch, 1)
+
+	_, err = p.GetMarketPrice(context.Background(), "UNKNOWN")
+	assert.ErrorIs(t, err, pricefeed.ErrSymbolNotFound)
+}
+
+func TestProvider_RejectsBadFiles(t *testing.T) {
+	_, err := NewProvider(strings.NewReader(""))
+	assert.Error(t, err)
+
+	_, err = NewProvider(strings.NewReader("date,symbol\n2025-03-03,SJC\n"))
+	assert.Error(t, err, "needs a price or bid column")
+
+	_, err = NewProvider(strings.NewReader("date,symbol,price\n2025-13-03,SJC,1\n"))
+	assert.Error(t, err, "invalid date")
+
+	_, err = NewProvider(strings.NewReader("date,symbol,price\n2025-03-03,SJC,0\n"))
+	assert.Error(t, err, "price must be positive")
+}
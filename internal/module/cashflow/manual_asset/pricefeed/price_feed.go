@@ -0,0 +1,40 @@
+package pricefeed
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"personalfinancedss/internal/module/identify/broker/client"
+)
+
+// ErrSymbolNotFound is returned when a provider has no prices for a symbol
+var ErrSymbolNotFound = errors.New("symbol not found in price feed")
+
+// Provider is the interface price sources for manual assets implement, such as a
+// file of dealer quotes or a live gold price API
+type Provider interface {
+	// Name identifies the provider; it is stored as the source of imported prices
+	Name() string
+
+	// GetMarketPrice retrieves the latest quote for a symbol
+	GetMarketPrice(ctx context.Context, symbol string) (*Quote, error)
+
+	// GetBatchMarketPrices retrieves the latest quotes for multiple symbols, skipping unknown ones
+	GetBatchMarketPrices(ctx context.Context, symbols []string) (map[string]*Quote, error)
+
+	// GetPriceHistory retrieves daily quotes of a symbol within a date range, oldest first
+	GetPriceHistory(ctx context.Context, symbol string, from, to time.Time) ([]Quote, error)
+}
+
+// Quote is a dealer quote in the broker client's market price shape. Price is the reference
+// price; LastUpdated is the quote's date. Gold dealers also quote a bid ("mua vào") and an
+// ask ("bán ra") per unit of a given fineness.
+type Quote struct {
+	client.MarketPrice
+
+	Bid    *float64
+	Ask    *float64
+	Unit   string   // tael, chi, gram or troy_ounce; empty when quoted per asset unit
+	Purity *float64 // e.g. 0.9999
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/manual_asset/domain"
+
+	"github.com/google/uuid"
+)
+
+// AssetFilter narrows a user's manual asset listing
+type AssetFilter struct {
+	Type            *domain.AssetType
+	IncludeDisposed bool
+}
+
+// Repository defines data access for manual assets, their valuations and price series
+type Repository interface {
+	// CreateAsset creates a manual asset
+	CreateAsset(ctx context.Context, asset *domain.ManualAsset) error
+
+	// UpdateAsset saves a manual asset
+	UpdateAsset(ctx context.Context, asset *domain.ManualAsset) error
+
+	// GetAssetByID retrieves a manual asset by ID
+	GetAssetByID(ctx context.Context, id uuid.UUID) (*domain.ManualAsset, error)
+
+	// ListAssetsByUserID retrieves a user's manual assets
+	ListAssetsByUserID(ctx context.Context, userID uuid.UUID, filter AssetFilter) ([]domain.ManualAsset, error)
+
+	// ListAssetsBySymbol retrieves a user's assets valued from a price symbol
+	ListAssetsBySymbol(ctx context.Context, userID uuid.UUID, symbol string) ([]domain.ManualAsset, error)
+
+	// ListAssetsByGoalID retrieves the assets earmarked to fund a goal
+	ListAssetsByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.ManualAsset, error)
+
+	// ListDerivedAssets retrieves all held assets valued from a price series or depreciation schedule
+	ListDerivedAssets(ctx context.Context) ([]domain.ManualAsset, error)
+
+	// DeleteAsset soft-deletes a manual asset
+	DeleteAsset(ctx context.Context, id uuid.UUID) error
+
+	// UpsertValuation writes a valuation, replacing any existing one for the same asset and day
+	UpsertValuation(ctx context.Context, valuation *domain.Valuation) error
+
+	// GetValuationByID retrieves a valuation by ID
+	GetValuationByID(ctx context.Context, id uuid.UUID) (*domain.Valuation, error)
+
+	// ListValuations retrieves an asset's valuations, oldest first
+	ListValuations(ctx context.Context, assetID uuid.UUID) ([]domain.Valuation, error)
+
+	// ListValuationsByUser retrieves all of a user's valuations up to a day, oldest first
+	ListValuationsByUser(ctx context.Context, userID uuid.UUID, to time.Time) ([]domain.Valuation, error)
+
+	// DeleteValuation deletes a valuation
+	DeleteValuation(ctx context.Context, id uuid.UUID) error
+
+	// UpsertPrices writes prices, replacing any existing price for the same symbol and day
+	UpsertPrices(ctx context.Context, prices []domain.AssetPrice) error
+
+	// ListPrices retrieves a symbol's prices within a date range, oldest first
+	ListPrices(ctx context.Context, userID uuid.UUID, symbol string, from, to time.Time) ([]domain.AssetPrice, error)
+
+	// ListPricesBySymbols retrieves prices of several symbols up to a day, oldest first
+	ListPricesBySymbols(ctx context.Context, userID uuid.UUID, symbols []string, to time.Time) ([]domain.AssetPrice, error)
+}
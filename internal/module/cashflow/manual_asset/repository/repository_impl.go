@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/manual_asset/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new manual asset repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateAsset(ctx context.Context, asset *domain.ManualAsset) error {
+	return r.db.WithContext(ctx).Create(asset).Error
+}
+
+func (r *repository) UpdateAsset(ctx context.Context, asset *domain.ManualAsset) error {
+	return r.db.WithContext(ctx).Save(asset).Error
+}
+
+func (r *repository) GetAssetByID(ctx context.Context, id uuid.UUID) (*domain.ManualAsset, error) {
+	var asset domain.ManualAsset
+	if err := r.db.WithContext(ctx).First(&asset, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &asset, nil
+}
+
+func (r *repository) ListAssetsByUserID(ctx context.Context, userID uuid.UUID, filter AssetFilter) ([]domain.ManualAsset, error) {
+	var assets []domain.ManualAsset
+	db := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if filter.Type != nil {
+		db = db.Where("type = ?", *filter.Type)
+	}
+	if !filter.IncludeDisposed {
+		db = db.Where("disposed_date IS NULL")
+	}
+	err := db.Order("created_at ASC").Find(&assets).Error
+	return assets, err
+}
+
+func (r *repository) ListAssetsBySymbol(ctx context.Context, userID uuid.UUID, symbol string) ([]domain.ManualAsset, error) {
+	var assets []domain.ManualAsset
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND price_symbol = ? AND valuation_method = ?", userID, symbol, domain.ValuationPriceSeries).
+		Find(&assets).Error
+	return assets, err
+}
+
+func (r *repository) ListAssetsByGoalID(ctx context.Context, goalID uuid.UUID) ([]domain.ManualAsset, error) {
+	var assets []domain.ManualAsset
+	err := r.db.WithContext(ctx).Where("goal_id = ?", goalID).Find(&assets).Error
+	return assets, err
+}
+
+func (r *repository) ListDerivedAssets(ctx context.Context) ([]domain.ManualAsset, error) {
+	var assets []domain.ManualAsset
+	err := r.db.WithContext(ctx).
+		Where("valuation_method IN ? AND disposed_date IS NULL", []domain.ValuationMethod{domain.ValuationPriceSeries, domain.ValuationDepreciation}).
+		Find(&assets).Error
+	return assets, err
+}
+
+func (r *repository) DeleteAsset(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&domain.ManualAsset{}, "id = ?", id).Error
+}
+
+func (r *repository) UpsertValuation(ctx context.Context, valuation *domain.Valuation) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "asset_id"}, {Name: "valuation_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "unit_price", "source", "note", "updated_at"}),
+		}).
+		Create(valuation).Error
+}
+
+func (r *repository) GetValuationByID(ctx context.Context, id uuid.UUID) (*domain.Valuation, error) {
+	var valuation domain.Valuation
+	if err := r.db.WithContext(ctx).First(&valuation, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &valuation, nil
+}
+
+func (r *repository) ListValuations(ctx context.Context, assetID uuid.UUID) ([]domain.Valuation, error) {
+	var valuations []domain.Valuation
+	err := r.db.WithContext(ctx).
+		Where("asset_id = ?", assetID).
+		Order("valuation_date ASC").
+		Find(&valuations).Error
+	return valuations, err
+}
+
+func (r *repository) ListValuationsByUser(ctx context.Context, userID uuid.UUID, to time.Time) ([]domain.Valuation, error) {
+	var valuations []domain.Valuation
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND valuation_date <= ?", userID, to).
+		Order("valuation_date ASC").
+		Find(&valuations).Error
+	return valuations, err
+}
+
+func (r *repository) DeleteValuation(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&domain.Valuation{}, "id = ?", id).Error
+}
+
+func (r *repository) UpsertPrices(ctx context.Context, prices []domain.AssetPrice) error {
+	if len(prices) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "symbol"}, {Name: "price_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"price", "bid", "ask", "unit", "purity", "source", "updated_at"}),
+		}).
+		CreateInBatches(prices, 500).Error
+}
+
+func (r *repository) ListPrices(ctx context.Context, userID uuid.UUID, symbol string, from, to time.Time) ([]domain.AssetPrice, error) {
+	var prices []domain.AssetPrice
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND symbol = ? AND price_date >= ? AND price_date <= ?", userID, symbol, from, to).
+		Order("price_date ASC").
+		Find(&prices).Error
+	return prices, err
+}
+
+func (r *repository) ListPricesBySymbols(ctx context.Context, userID uuid.UUID, symbols []string, to time.Time) ([]domain.AssetPrice, error) {
+	var prices []domain.AssetPrice
+	if len(symbols) == 0 {
+		return prices, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND symbol IN ? AND price_date <= ?", userID, symbols, to).
+		Order("price_date ASC").
+		Find(&prices).Error
+	return prices, err
+}
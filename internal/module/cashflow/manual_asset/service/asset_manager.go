@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/module/cashflow/manual_asset/domain"
+	"personalfinancedss/internal/module/cashflow/manual_asset/dto"
+	"personalfinancedss/internal/module/cashflow/manual_asset/repository"
+	networthDomain "personalfinancedss/internal/module/cashflow/networth/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateAsset creates a manual asset or liability. A current value, when given, is recorded as today's valuation.
+func (s *manualAssetService) CreateAsset(ctx context.Context, userID uuid.UUID, req dto.CreateManualAssetRequest) (*domain.ManualAsset, error) {
+	asset := &domain.ManualAsset{
+		ID:                uuid.New(),
+		UserID:            userID,
+		Name:              req.Name,
+		Type:              domain.AssetType(req.Type),
+		Quantity:          1,
+		Unit:              domain.UnitPiece,
+		Currency:          exchangerate.BaseCurrency,
+		ValuationMethod:   domain.ValuationManual,
+		IncludeInNetWorth: true,
+		Notes:             req.Notes,
+	}
+	if req.Quantity != nil {
+		asset.Quantity = *req.Quantity
+	}
+	if req.Unit != nil {
+		asset.Unit = domain.Unit(*req.Unit)
+	}
+	if req.Purity != nil {
+		asset.Purity = req.Purity
+	}
+	if req.Currency != nil {
+		asset.Currency = strings.ToUpper(*req.Currency)
+	}
+	if req.ValuationMethod != nil {
+		asset.ValuationMethod = domain.ValuationMethod(*req.ValuationMethod)
+	}
+	if req.IncludeInNetWorth != nil {
+		asset.IncludeInNetWorth = *req.IncludeInNetWorth
+	}
+	applyValuationSettings(asset, req.PriceSymbol, req.AcquiredDate, req.AcquisitionCost,
+		req.DepreciationMethod, req.UsefulLifeMonths, req.DecliningRate, req.SalvageValue)
+
+	if req.GoalID != nil {
+		goalID, err := s.linkableGoal(ctx, userID, *req.GoalID)
+		if err != nil {
+			return nil, err
+		}
+		asset.GoalID = goalID
+	}
+
+	if err := asset.Validate(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("asset", err.Error())
+	}
+
+	if err := s.repo.CreateAsset(ctx, asset); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	if req.CurrentValue != nil {
+		valuation := &domain.Valuation{
+			ID:            uuid.New(),
+			AssetID:       asset.ID,
+			UserID:        userID,
+			ValuationDate: networthDomain.TruncateToDay(time.Now()),
+			Value:         *req.CurrentValue,
+			Source:        domain.ValuationSourceManual,
+		}
+		if err := s.repo.UpsertValuation(ctx, valuation); err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+	}
+
+	if err := s.refreshValue(ctx, asset, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+// UpdateAsset changes an asset's details, valuation settings or disposal date
+func (s *manualAssetService) UpdateAsset(ctx context.Context, userID, assetID uuid.UUID, req dto.UpdateManualAssetRequest) (*domain.ManualAsset, error) {
+	asset, err := s.GetAsset(ctx, userID, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		asset.Name = *req.Name
+	}
+	if req.Quantity != nil {
+		asset.Quantity = *req.Quantity
+	}
+	if req.Unit != nil {
+		asset.Unit = domain.Unit(*req.Unit)
+	}
+	if req.Purity != nil {
+		asset.Purity = req.Purity
+	}
+	if req.ValuationMethod != nil {
+		asset.ValuationMethod = domain.ValuationMethod(*req.ValuationMethod)
+	}
+	if req.DisposedDate != nil {
+		disposed := networthDomain.TruncateToDay(*req.DisposedDate)
+		asset.DisposedDate = &disposed
+	}
+	if req.IncludeInNetWorth != nil {
+		asset.IncludeInNetWorth = *req.IncludeInNetWorth
+	}
+	if req.Notes != nil {
+		asset.Notes = req.Notes
+	}
+	applyValuationSettings(asset, req.PriceSymbol, req.AcquiredDate, req.AcquisitionCost,
+		req.DepreciationMethod, req.UsefulLifeMonths, req.DecliningRate, req.SalvageValue)
+
+	previousGoalID := asset.GoalID
+	if req.GoalID != nil {
+		goalID, err := s.linkableGoal(ctx, userID, *req.GoalID)
+		if err != nil {
+			return nil, err
+		}
+		asset.GoalID = goalID
+	}
+
+	if err := asset.Validate(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("asset", err.Error())
+	}
+
+	if err := s.refreshValue(ctx, asset, time.Now()); err != nil {
+		return nil, err
+	}
+	if previousGoalID != nil && (asset.GoalID == nil || *asset.GoalID != *previousGoalID) {
+		s.syncGoalFunding(ctx, *previousGoalID)
+	}
+
+	return asset, nil
+}
+
+// GetAsset retrieves a manual asset owned by the user
+func (s *manualAssetService) GetAsset(ctx context.Context, userID, assetID uuid.UUID) (*domain.ManualAsset, error) {
+	asset, err := s.repo.GetAssetByID(ctx, assetID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if asset.UserID != userID {
+		return nil, shared.ErrNotFound
+	}
+	return asset, nil
+}
+
+// ListAssets retrieves a user's manual assets and liabilities
+func (s *manualAssetService) ListAssets(ctx context.Context, userID uuid.UUID, query dto.ListManualAssetsQuery) ([]domain.ManualAsset, error) {
+	filter := repository.AssetFilter{IncludeDisposed: query.IncludeDisposed}
+	if query.Type != nil {
+		assetType := domain.AssetType(*query.Type)
+		filter.Type = &assetType
+	}
+
+	assets, err := s.repo.ListAssetsByUserID(ctx, userID, filter)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return assets, nil
+}
+
+// DeleteAsset removes a manual asset from tracking
+func (s *manualAssetService) DeleteAsset(ctx context.Context, userID, assetID uuid.UUID) error {
+	asset, err := s.GetAsset(ctx, userID, assetID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteAsset(ctx, asset.ID); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	if asset.GoalID != nil {
+		s.syncGoalFunding(ctx, *asset.GoalID)
+	}
+	return nil
+}
+
+// refreshValue recomputes an asset's current value from its valuations and prices, then saves it
+func (s *manualAssetService) refreshValue(ctx context.Context, asset *domain.ManualAsset, asOf time.Time) error {
+	day := networthDomain.TruncateToDay(asOf)
+
+	valuations, err := s.repo.ListValuations(ctx, asset.ID)
+	if err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	var prices []domain.AssetPrice
+	if asset.PriceSymbol != nil {
+		prices, err = s.repo.ListPricesBySymbols(ctx, asset.UserID, []string{*asset.PriceSymbol}, day)
+		if err != nil {
+			return shared.ErrInternal.WithError(err)
+		}
+	}
+
+	value, source, ok := asset.ValueAt(day, valuations, prices)
+	if ok {
+		asset.CurrentValue, asset.ValueSource = value, source
+		asset.LastValuedDate = &day
+	} else if !asset.HeldOn(day) {
+		asset.CurrentValue, asset.ValueSource = 0, ""
+	}
+
+	if err := s.repo.UpdateAsset(ctx, asset); err != nil {
+		s.logger.Error("Failed to save manual asset value",
+			zap.String("asset_id", asset.ID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrInternal.WithError(err)
+	}
+	if asset.GoalID != nil {
+		s.syncGoalFunding(ctx, *asset.GoalID)
+	}
+	return nil
+}
+
+// linkableGoal resolves a goal id from a request; an empty id unlinks the asset
+func (s *manualAssetService) linkableGoal(ctx context.Context, userID uuid.UUID, rawID string) (*uuid.UUID, error) {
+	if strings.TrimSpace(rawID) == "" {
+		return nil, nil
+	}
+	goalID, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("goal_id", "invalid goal id")
+	}
+	goal, err := s.goals.GetGoalByID(ctx, goalID)
+	if err != nil || goal.UserID != userID {
+		return nil, shared.ErrBadRequest.WithDetails("goal_id", "goal not found")
+	}
+	return &goalID, nil
+}
+
+// syncGoalFunding sets a goal's asset-funded amount to the value of the assets still earmarked for it.
+// Failures are logged rather than returned so that asset changes never fail on goal bookkeeping.
+func (s *manualAssetService) syncGoalFunding(ctx context.Context, goalID uuid.UUID) {
+	goal, err := s.goals.GetGoalByID(ctx, goalID)
+	if err != nil {
+		s.logger.Warn("Failed to load goal for asset funding", zap.String("goal_id", goalID.String()), zap.Error(err))
+		return
+	}
+	assets, err := s.repo.ListAssetsByGoalID(ctx, goalID)
+	if err != nil {
+		s.logger.Warn("Failed to list assets funding goal", zap.String("goal_id", goalID.String()), zap.Error(err))
+		return
+	}
+
+	rates := s.rates.Rates(ctx)
+	today := networthDomain.TruncateToDay(time.Now())
+	var funded float64
+	for _, asset := range assets {
+		if asset.UserID != goal.UserID || asset.Type.IsLiability() || !asset.HeldOn(today) {
+			continue
+		}
+		value, err := rates.Convert(asset.CurrentValue, asset.Currency, goal.Currency)
+		if err != nil {
+			s.logger.Warn("Skipping asset in goal funding",
+				zap.String("asset_id", asset.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		funded += value
+	}
+
+	if _, err := s.goals.SetAssetFunding(ctx, goalID, funded); err != nil {
+		s.logger.Warn("Failed to update goal asset funding", zap.String("goal_id", goalID.String()), zap.Error(err))
+	}
+}
+
+// applyValuationSettings copies the optional valuation and depreciation fields shared by create and update
+func applyValuationSettings(
+	asset *domain.ManualAsset,
+	priceSymbol *string,
+	acquiredDate *time.Time,
+	acquisitionCost *float64,
+	depreciationMethod *string,
+	usefulLifeMonths *int,
+	decliningRate *float64,
+	salvageValue *float64,
+) {
+	if priceSymbol != nil {
+		symbol := strings.ToUpper(strings.TrimSpace(*priceSymbol))
+		asset.PriceSymbol = &symbol
+	}
+	if acquiredDate != nil {
+		acquired := networthDomain.TruncateToDay(*acquiredDate)
+		asset.AcquiredDate = &acquired
+	}
+	if acquisitionCost != nil {
+		asset.AcquisitionCost = *acquisitionCost
+	}
+	if depreciationMethod != nil {
+		method := domain.DepreciationMethod(*depreciationMethod)
+		asset.DepreciationMethod = &method
+	}
+	if usefulLifeMonths != nil {
+		asset.UsefulLifeMonths = *usefulLifeMonths
+	}
+	if decliningRate != nil {
+		asset.DecliningRate = *decliningRate
+	}
+	if salvageValue != nil {
+		asset.SalvageValue = *salvageValue
+	}
+}
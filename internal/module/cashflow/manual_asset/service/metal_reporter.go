@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/module/cashflow/manual_asset/domain"
+	"personalfinancedss/internal/module/cashflow/manual_asset/repository"
+	networthDomain "personalfinancedss/internal/module/cashflow/networth/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// GetMetalSummary marks each gold holding to the latest quote of its price symbol. Holdings
+// without a quote are included at their current value with no spread.
+func (s *manualAssetService) GetMetalSummary(ctx context.Context, userID uuid.UUID, currency string) (*domain.MetalSummary, error) {
+	currency = strings.ToUpper(currency)
+	if currency == "" {
+		currency = exchangerate.BaseCurrency
+	}
+	rates := s.rates.Rates(ctx)
+	if !rates.Supports(currency) {
+		return nil, shared.ErrBadRequest.WithDetails("currency", "unsupported currency")
+	}
+
+	goldType := domain.AssetTypeGold
+	assets, err := s.repo.ListAssetsByUserID(ctx, userID, repository.AssetFilter{Type: &goldType})
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	today := networthDomain.TruncateToDay(time.Now())
+	var symbols []string
+	seen := make(map[string]bool)
+	for _, asset := range assets {
+		if asset.PriceSymbol != nil && !seen[*asset.PriceSymbol] {
+			seen[*asset.PriceSymbol] = true
+			symbols = append(symbols, *asset.PriceSymbol)
+		}
+	}
+	var prices []domain.AssetPrice
+	if len(symbols) > 0 {
+		prices, err = s.repo.ListPricesBySymbols(ctx, userID, symbols, today)
+		if err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+	}
+	valuer := domain.NewValuer(nil, prices)
+
+	summary := &domain.MetalSummary{}
+	for i := range assets {
+		asset := &assets[i]
+		if !asset.HeldOn(today) {
+			continue
+		}
+
+		holding := domain.MetalHolding{Asset: *asset}
+		marketValue, replacementValue := asset.CurrentValue, asset.CurrentValue
+		if price, ok := valuer.LatestPrice(asset, today); ok {
+			position := asset.MetalPosition(price)
+			holding.Position = &position
+			marketValue, replacementValue = position.MarketValue, position.ReplacementValue
+		}
+
+		converted := make([]float64, 0, 3)
+		for _, amount := range []float64{marketValue, replacementValue, asset.AcquisitionCost} {
+			value, err := rates.Convert(amount, asset.Currency, currency)
+			if err != nil {
+				return nil, shared.ErrBadRequest.WithDetails("currency", err.Error())
+			}
+			converted = append(converted, value)
+		}
+		summary.Add(holding, converted[0], converted[1], converted[2])
+	}
+
+	return summary, nil
+}
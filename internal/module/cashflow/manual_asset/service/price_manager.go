@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/manual_asset/domain"
+	"personalfinancedss/internal/module/cashflow/manual_asset/dto"
+	"personalfinancedss/internal/module/cashflow/manual_asset/pricefeed"
+	"personalfinancedss/internal/module/cashflow/manual_asset/repository"
+	networthDomain "personalfinancedss/internal/module/cashflow/networth/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	priceSourceManual  = "manual"
+	defaultImportYears = 10 // how far back an import without a from date reaches
+)
+
+// UpsertPrices stores prices of a symbol and revalues the assets priced from it
+func (s *manualAssetService) UpsertPrices(ctx context.Context, userID uuid.UUID, symbol string, req dto.UpsertPricesRequest) (int, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if symbol == "" || len(symbol) > 30 {
+		return 0, shared.ErrBadRequest.WithDetails("symbol", "must be 1 to 30 characters")
+	}
+
+	prices := make([]domain.AssetPrice, 0, len(req.Prices))
+	for _, input := range req.Prices {
+		price := domain.AssetPrice{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Symbol:    symbol,
+			PriceDate: networthDomain.TruncateToDay(input.Date),
+			Price:     input.Price,
+			Bid:       input.Bid,
+			Ask:       input.Ask,
+			Purity:    input.Purity,
+			Source:    priceSourceManual,
+		}
+		if input.Unit != nil {
+			price.Unit = domain.Unit(*input.Unit)
+		}
+		prices = append(prices, price)
+	}
+
+	if err := s.repo.UpsertPrices(ctx, prices); err != nil {
+		return 0, shared.ErrInternal.WithError(err)
+	}
+	if err := s.revalueSymbol(ctx, userID, symbol); err != nil {
+		return 0, err
+	}
+
+	return len(prices), nil
+}
+
+// ImportPrices loads quotes from a price feed for the requested symbols, or for every symbol the
+// user's assets are priced from, and revalues the affected assets. Symbols the feed does not
+// carry are skipped; the symbols actually imported are returned with the number of prices stored.
+func (s *manualAssetService) ImportPrices(ctx context.Context, userID uuid.UUID, provider pricefeed.Provider, query dto.ImportPricesQuery) ([]string, int, error) {
+	to := networthDomain.TruncateToDay(time.Now())
+	if query.To != nil {
+		to = networthDomain.TruncateToDay(*query.To)
+	}
+	from := to.AddDate(-defaultImportYears, 0, 0)
+	if query.From != nil {
+		from = networthDomain.TruncateToDay(*query.From)
+	}
+	if to.Before(from) {
+		return nil, 0, shared.ErrBadRequest.WithDetails("to", "must not be before from")
+	}
+
+	symbols, err := s.importSymbols(ctx, userID, query.Symbols)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	imported := make([]string, 0, len(symbols))
+	stored := 0
+	for _, symbol := range symbols {
+		quotes, err := provider.GetPriceHistory(ctx, symbol, from, to)
+		if errors.Is(err, pricefeed.ErrSymbolNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, 0, shared.ErrInternal.WithError(err)
+		}
+		if len(quotes) == 0 {
+			continue
+		}
+
+		prices := make([]domain.AssetPrice, 0, len(quotes))
+		for _, quote := range quotes {
+			prices = append(prices, domain.AssetPrice{
+				ID:        uuid.New(),
+				UserID:    userID,
+				Symbol:    symbol,
+				PriceDate: networthDomain.TruncateToDay(quote.LastUpdated),
+				Price:     quote.Price,
+				Bid:       quote.Bid,
+				Ask:       quote.Ask,
+				Unit:      domain.Unit(quote.Unit),
+				Purity:    quote.Purity,
+				Source:    provider.Name(),
+			})
+		}
+		if err := s.repo.UpsertPrices(ctx, prices); err != nil {
+			return nil, 0, shared.ErrInternal.WithError(err)
+		}
+		if err := s.revalueSymbol(ctx, userID, symbol); err != nil {
+			return nil, 0, err
+		}
+
+		imported = append(imported, symbol)
+		stored += len(prices)
+	}
+
+	s.logger.Info("Imported manual asset prices",
+		zap.String("user_id", userID.String()),
+		zap.String("provider", provider.Name()),
+		zap.Strings("symbols", imported),
+		zap.Int("stored", stored),
+	)
+	return imported, stored, nil
+}
+
+// importSymbols parses a comma-separated symbol list, defaulting to the symbols of the user's assets
+func (s *manualAssetService) importSymbols(ctx context.Context, userID uuid.UUID, raw string) ([]string, error) {
+	var symbols []string
+	for _, symbol := range strings.Split(raw, ",") {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		if symbol != "" {
+			symbols = append(symbols, symbol)
+		}
+	}
+	if len(symbols) > 0 {
+		return symbols, nil
+	}
+
+	assets, err := s.repo.ListAssetsByUserID(ctx, userID, repository.AssetFilter{})
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	symbols = domain.Symbols(assets)
+	if len(symbols) == 0 {
+		return nil, shared.ErrBadRequest.WithDetails("symbols", "no symbols given and no assets are priced from a series")
+	}
+	return symbols, nil
+}
+
+// revalueSymbol refreshes the current value of every asset priced from a symbol
+func (s *manualAssetService) revalueSymbol(ctx context.Context, userID uuid.UUID, symbol string) error {
+	assets, err := s.repo.ListAssetsBySymbol(ctx, userID, symbol)
+	if err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	for i := range assets {
+		if err := s.refreshValue(ctx, &assets[i], time.Now()); err != nil {
+			s.logger.Warn("Failed to revalue manual asset after price update",
+				zap.String("asset_id", assets[i].ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+	return nil
+}
+
+// ListPrices retrieves a symbol's prices within a date range
+func (s *manualAssetService) ListPrices(ctx context.Context, userID uuid.UUID, symbol string, query dto.PricesQuery) ([]domain.AssetPrice, error) {
+	from := networthDomain.TruncateToDay(query.From)
+	to := networthDomain.TruncateToDay(query.To)
+	if to.Before(from) {
+		return nil, shared.ErrBadRequest.WithDetails("to", "must not be before from")
+	}
+
+	prices, err := s.repo.ListPrices(ctx, userID, strings.ToUpper(strings.TrimSpace(symbol)), from, to)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return prices, nil
+}
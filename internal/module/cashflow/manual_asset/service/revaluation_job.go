@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// revaluationJobSpec runs once a night; prices and depreciation change at most daily
+const revaluationJobSpec = "0 0 1 * * *"
+
+// revaluationJob runs the manual asset revaluation on the notification scheduler
+type revaluationJob struct {
+	service Revaluer
+}
+
+// NewRevaluationJob creates the scheduled job that keeps price-series and depreciating asset values current
+func NewRevaluationJob(service Service) notificationService.ScheduledJob {
+	return &revaluationJob{service: service}
+}
+
+func (j *revaluationJob) Name() string {
+	return "manual_asset_revaluation"
+}
+
+func (j *revaluationJob) Spec() string {
+	return revaluationJobSpec
+}
+
+func (j *revaluationJob) Run(ctx context.Context) error {
+	_, err := j.service.RevalueDerivedAssets(ctx, time.Now())
+	return err
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/shared"
+
+	"go.uber.org/zap"
+)
+
+// RevalueDerivedAssets refreshes the current value of price-series and depreciating assets.
+// Failures are logged per asset so one bad record does not stop the run.
+func (s *manualAssetService) RevalueDerivedAssets(ctx context.Context, asOf time.Time) (int, error) {
+	assets, err := s.repo.ListDerivedAssets(ctx)
+	if err != nil {
+		return 0, shared.ErrInternal.WithError(err)
+	}
+
+	revalued := 0
+	for i := range assets {
+		if err := s.refreshValue(ctx, &assets[i], asOf); err != nil {
+			s.logger.Error("Failed to revalue manual asset",
+				zap.String("asset_id", assets[i].ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		revalued++
+	}
+
+	return revalued, nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	goalService "personalfinancedss/internal/module/cashflow/goal/service"
+	"personalfinancedss/internal/module/cashflow/manual_asset/domain"
+	"personalfinancedss/internal/module/cashflow/manual_asset/dto"
+	"personalfinancedss/internal/module/cashflow/manual_asset/pricefeed"
+	"personalfinancedss/internal/module/cashflow/manual_asset/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AssetManager defines manual asset CRUD operations
+type AssetManager interface {
+	CreateAsset(ctx context.Context, userID uuid.UUID, req dto.CreateManualAssetRequest) (*domain.ManualAsset, error)
+	UpdateAsset(ctx context.Context, userID, assetID uuid.UUID, req dto.UpdateManualAssetRequest) (*domain.ManualAsset, error)
+	GetAsset(ctx context.Context, userID, assetID uuid.UUID) (*domain.ManualAsset, error)
+	ListAssets(ctx context.Context, userID uuid.UUID, query dto.ListManualAssetsQuery) ([]domain.ManualAsset, error)
+	DeleteAsset(ctx context.Context, userID, assetID uuid.UUID) error
+}
+
+// ValuationManager defines valuation history operations
+type ValuationManager interface {
+	// RecordValuation stores the value of an asset on a date, replacing any valuation of that day
+	RecordValuation(ctx context.Context, userID, assetID uuid.UUID, req dto.RecordValuationRequest) (*domain.Valuation, error)
+
+	// ListValuations retrieves an asset's recorded valuations, oldest first
+	ListValuations(ctx context.Context, userID, assetID uuid.UUID) ([]domain.Valuation, error)
+
+	// DeleteValuation removes a recorded valuation
+	DeleteValuation(ctx context.Context, userID, assetID, valuationID uuid.UUID) error
+
+	// GetValueHistory values an asset at each interval close between two dates
+	GetValueHistory(ctx context.Context, userID, assetID uuid.UUID, query dto.ValueHistoryQuery) (*domain.ManualAsset, []domain.ValuePoint, error)
+}
+
+// PriceManager defines price series operations
+type PriceManager interface {
+	// UpsertPrices stores prices of a symbol and revalues the assets priced from it
+	UpsertPrices(ctx context.Context, userID uuid.UUID, symbol string, req dto.UpsertPricesRequest) (int, error)
+
+	// ListPrices retrieves a symbol's prices within a date range
+	ListPrices(ctx context.Context, userID uuid.UUID, symbol string, query dto.PricesQuery) ([]domain.AssetPrice, error)
+}
+
+// PriceImporter defines bulk price loading from a price feed
+type PriceImporter interface {
+	// ImportPrices stores a provider's quotes for the requested symbols and revalues the assets priced from them
+	ImportPrices(ctx context.Context, userID uuid.UUID, provider pricefeed.Provider, query dto.ImportPricesQuery) ([]string, int, error)
+}
+
+// MetalReporter defines precious-metal reporting
+type MetalReporter interface {
+	// GetMetalSummary marks the user's gold holdings to their latest dealer quotes
+	GetMetalSummary(ctx context.Context, userID uuid.UUID, currency string) (*domain.MetalSummary, error)
+}
+
+// Revaluer defines periodic revaluation of derived asset values
+type Revaluer interface {
+	// RevalueDerivedAssets refreshes the current value of price-series and depreciating assets
+	RevalueDerivedAssets(ctx context.Context, asOf time.Time) (int, error)
+}
+
+// Service is the composite interface for all manual asset operations
+type Service interface {
+	AssetManager
+	ValuationManager
+	PriceManager
+	PriceImporter
+	MetalReporter
+	Revaluer
+}
+
+// manualAssetService implements all manual asset use cases
+type manualAssetService struct {
+	repo   repository.Repository
+	goals  goalService.Service
+	rates  exchangerate.Provider
+	logger *zap.Logger
+}
+
+// NewService creates a new manual asset service
+func NewService(repo repository.Repository, goals goalService.Service, rates exchangerate.Provider, logger *zap.Logger) Service {
+	return &manualAssetService{
+		repo:   repo,
+		goals:  goals,
+		rates:  rates,
+		logger: logger.Named("manual_asset.service"),
+	}
+}
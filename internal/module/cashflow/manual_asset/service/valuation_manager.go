@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/manual_asset/domain"
+	"personalfinancedss/internal/module/cashflow/manual_asset/dto"
+	networthDomain "personalfinancedss/internal/module/cashflow/networth/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// maxHistoryDays bounds a single value history request
+const maxHistoryDays = 366 * 10
+
+// RecordValuation stores the value of an asset on a date, replacing any valuation of that day.
+// A unit price is multiplied by the asset's quantity.
+func (s *manualAssetService) RecordValuation(ctx context.Context, userID, assetID uuid.UUID, req dto.RecordValuationRequest) (*domain.Valuation, error) {
+	asset, err := s.GetAsset(ctx, userID, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	day := networthDomain.TruncateToDay(req.ValuationDate)
+	if day.After(time.Now()) {
+		return nil, shared.ErrBadRequest.WithDetails("valuation_date", "cannot be in the future")
+	}
+
+	valuation := &domain.Valuation{
+		ID:            uuid.New(),
+		AssetID:       asset.ID,
+		UserID:        userID,
+		ValuationDate: day,
+		UnitPrice:     req.UnitPrice,
+		Source:        domain.ValuationSourceManual,
+		Note:          req.Note,
+	}
+	switch {
+	case req.Value != nil:
+		valuation.Value = *req.Value
+	case req.UnitPrice != nil:
+		valuation.Value = asset.Quantity * *req.UnitPrice
+	default:
+		return nil, shared.ErrBadRequest.WithDetails("value", "value or unit_price is required")
+	}
+
+	if err := s.repo.UpsertValuation(ctx, valuation); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	if err := s.refreshValue(ctx, asset, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return valuation, nil
+}
+
+// ListValuations retrieves an asset's recorded valuations, oldest first
+func (s *manualAssetService) ListValuations(ctx context.Context, userID, assetID uuid.UUID) ([]domain.Valuation, error) {
+	asset, err := s.GetAsset(ctx, userID, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	valuations, err := s.repo.ListValuations(ctx, asset.ID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return valuations, nil
+}
+
+// DeleteValuation removes a recorded valuation and refreshes the asset's current value
+func (s *manualAssetService) DeleteValuation(ctx context.Context, userID, assetID, valuationID uuid.UUID) error {
+	asset, err := s.GetAsset(ctx, userID, assetID)
+	if err != nil {
+		return err
+	}
+
+	valuation, err := s.repo.GetValuationByID(ctx, valuationID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return err
+		}
+		return shared.ErrInternal.WithError(err)
+	}
+	if valuation.AssetID != asset.ID {
+		return shared.ErrNotFound
+	}
+
+	if err := s.repo.DeleteValuation(ctx, valuation.ID); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	return s.refreshValue(ctx, asset, time.Now())
+}
+
+// GetValueHistory values an asset at each interval close between two dates
+func (s *manualAssetService) GetValueHistory(ctx context.Context, userID, assetID uuid.UUID, query dto.ValueHistoryQuery) (*domain.ManualAsset, []domain.ValuePoint, error) {
+	from := networthDomain.TruncateToDay(query.From)
+	to := networthDomain.TruncateToDay(query.To)
+	if to.Before(from) {
+		return nil, nil, shared.ErrBadRequest.WithDetails("to", "must not be before from")
+	}
+	if to.Sub(from) > maxHistoryDays*24*time.Hour {
+		return nil, nil, shared.ErrBadRequest.WithDetails("to", "range must not exceed 10 years")
+	}
+
+	interval := networthDomain.Interval(query.Interval)
+	if interval == "" {
+		interval = networthDomain.IntervalDaily
+	}
+	if !interval.IsValid() {
+		return nil, nil, shared.ErrBadRequest.WithDetails("interval", "must be one of daily, weekly, monthly")
+	}
+
+	asset, err := s.GetAsset(ctx, userID, assetID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valuations, err := s.repo.ListValuations(ctx, asset.ID)
+	if err != nil {
+		return nil, nil, shared.ErrInternal.WithError(err)
+	}
+	var prices []domain.AssetPrice
+	if asset.PriceSymbol != nil {
+		prices, err = s.repo.ListPricesBySymbols(ctx, userID, []string{*asset.PriceSymbol}, to)
+		if err != nil {
+			return nil, nil, shared.ErrInternal.WithError(err)
+		}
+	}
+
+	valuer := domain.NewValuer(valuations, prices)
+	dates := networthDomain.SeriesDates(from, to, interval)
+	points := make([]domain.ValuePoint, 0, len(dates))
+	for _, date := range dates {
+		value, source, ok := valuer.ValueAt(asset, date)
+		if !ok {
+			continue
+		}
+		points = append(points, domain.ValuePoint{Date: date, Value: value, Source: source})
+	}
+
+	return asset, points, nil
+}
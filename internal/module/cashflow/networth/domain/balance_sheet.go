@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BalanceSheetLine is one account, debt or manual asset on the balance sheet
+type BalanceSheetLine struct {
+	OwnerType SnapshotOwnerType `json:"owner_type"`
+	OwnerID   uuid.UUID         `json:"owner_id"`
+	Name      string            `json:"name"`
+	Balance   float64           `json:"balance"`  // In the line's own currency
+	Currency  string            `json:"currency"` // Currency of Balance
+	Value     float64           `json:"value"`    // Converted to the sheet currency, always positive
+}
+
+// BalanceSheetSection groups lines of one category, such as bank accounts or mortgages
+type BalanceSheetSection struct {
+	Category string             `json:"category"`
+	Total    float64            `json:"total"`
+	Lines    []BalanceSheetLine `json:"lines"`
+}
+
+// BalanceSheet lists what a user owns and owes at a point in time, in a single currency
+type BalanceSheet struct {
+	AsOf             time.Time             `json:"as_of"`
+	Currency         string                `json:"currency"`
+	Assets           []BalanceSheetSection `json:"assets"`
+	Liabilities      []BalanceSheetSection `json:"liabilities"`
+	TotalAssets      float64               `json:"total_assets"`
+	TotalLiabilities float64               `json:"total_liabilities"`
+	NetWorth         float64               `json:"net_worth"`
+}
+
+// AddAsset adds a holding with its converted value. Negative values (overdrawn or
+// credit card accounts) are listed as liabilities, as in NetWorthPoint.
+func (b *BalanceSheet) AddAsset(category string, line BalanceSheetLine, value float64) {
+	if value < 0 {
+		b.AddLiability(category, line, -value)
+		return
+	}
+	line.Value = value
+	b.Assets = addLine(b.Assets, category, line)
+	b.TotalAssets += value
+	b.NetWorth = b.TotalAssets - b.TotalLiabilities
+}
+
+// AddLiability adds an amount owed with its converted value. Zero balances are skipped.
+func (b *BalanceSheet) AddLiability(category string, line BalanceSheetLine, value float64) {
+	if value <= 0 {
+		return
+	}
+	line.Value = value
+	b.Liabilities = addLine(b.Liabilities, category, line)
+	b.TotalLiabilities += value
+	b.NetWorth = b.TotalAssets - b.TotalLiabilities
+}
+
+// addLine appends a line to its category's section, creating sections in first-seen order
+func addLine(sections []BalanceSheetSection, category string, line BalanceSheetLine) []BalanceSheetSection {
+	for i := range sections {
+		if sections[i].Category == category {
+			sections[i].Lines = append(sections[i].Lines, line)
+			sections[i].Total += line.Value
+			return sections
+		}
+	}
+	return append(sections, BalanceSheetSection{
+		Category: category,
+		Total:    line.Value,
+		Lines:    []BalanceSheetLine{line},
+	})
+}
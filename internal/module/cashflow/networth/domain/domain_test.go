@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestReplayDailyBalances(t *testing.T) {
+	movements := []BalanceMovement{
+		{Date: day(2025, 1, 1).Add(9 * time.Hour), Delta: 1000},
+		{Date: day(2025, 1, 3).Add(12 * time.Hour), Delta: -300},
+		{Date: day(2025, 1, 3).Add(15 * time.Hour), Delta: 100},
+	}
+
+	balances := ReplayDailyBalances(800, movements, day(2025, 1, 4))
+
+	require.Len(t, balances, 4)
+	assert.Equal(t, 1000.0, balances[0].Balance)
+	assert.Equal(t, 1000.0, balances[1].Balance)
+	assert.Equal(t, 800.0, balances[2].Balance)
+	assert.Equal(t, 800.0, balances[3].Balance)
+	assert.Equal(t, SourceReplay, balances[0].Source)
+}
+
+func TestReplayDailyBalances_RunningBalanceAnchor(t *testing.T) {
+	anchor := 5000.0
+	movements := []BalanceMovement{
+		{Date: day(2025, 1, 1), Delta: 1000},
+		{Date: day(2025, 1, 2), Delta: -200, RunningBalance: &anchor},
+		{Date: day(2025, 1, 3), Delta: 500},
+	}
+
+	balances := ReplayDailyBalances(5500, movements, day(2025, 1, 3))
+
+	require.Len(t, balances, 3)
+	assert.Equal(t, 5200.0, balances[0].Balance)
+	assert.Equal(t, 5000.0, balances[1].Balance)
+	assert.Equal(t, SourceRunningBalance, balances[1].Source)
+	assert.Equal(t, 5500.0, balances[2].Balance)
+}
+
+func TestReplayDailyBalances_NoMovements(t *testing.T) {
+	assert.Nil(t, ReplayDailyBalances(100, nil, day(2025, 1, 1)))
+}
+
+func TestSeriesDates(t *testing.T) {
+	from := day(2025, 1, 1) // Wednesday
+	to := day(2025, 2, 10)
+
+	daily := SeriesDates(from, day(2025, 1, 3), IntervalDaily)
+	assert.Equal(t, []time.Time{day(2025, 1, 1), day(2025, 1, 2), day(2025, 1, 3)}, daily)
+
+	weekly := SeriesDates(from, day(2025, 1, 14), IntervalWeekly)
+	assert.Equal(t, []time.Time{day(2025, 1, 5), day(2025, 1, 12), day(2025, 1, 14)}, weekly)
+
+	monthly := SeriesDates(from, to, IntervalMonthly)
+	assert.Equal(t, []time.Time{day(2025, 1, 31), day(2025, 2, 10)}, monthly)
+
+	assert.Nil(t, SeriesDates(to, from, IntervalDaily))
+}
+
+func TestNetWorthPoint(t *testing.T) {
+	point := NetWorthPoint{}
+	point.AddAsset(1000)
+	point.AddAsset(-200) // overdrawn account
+	point.AddLiability(300)
+	point.AddLiability(-50) // ignored
+
+	assert.Equal(t, 1000.0, point.Assets)
+	assert.Equal(t, 500.0, point.Liabilities)
+	assert.Equal(t, 500.0, point.NetWorth)
+}
+
+func TestBalanceSheet(t *testing.T) {
+	sheet := BalanceSheet{}
+	sheet.AddAsset("bank", BalanceSheetLine{Name: "Checking"}, 1000)
+	sheet.AddAsset("gold", BalanceSheetLine{Name: "SJC bars"}, 5000)
+	sheet.AddAsset("bank", BalanceSheetLine{Name: "Payroll"}, 500)
+	sheet.AddAsset("credit_card", BalanceSheetLine{Name: "Visa"}, -300) // listed as a liability
+	sheet.AddLiability("mortgage", BalanceSheetLine{Name: "Home loan"}, 2000)
+	sheet.AddLiability("other", BalanceSheetLine{Name: "Paid off"}, 0) // skipped
+
+	require.Len(t, sheet.Assets, 2)
+	assert.Equal(t, "bank", sheet.Assets[0].Category)
+	assert.Equal(t, 1500.0, sheet.Assets[0].Total)
+	assert.Len(t, sheet.Assets[0].Lines, 2)
+
+	require.Len(t, sheet.Liabilities, 2)
+	assert.Equal(t, 300.0, sheet.Liabilities[0].Lines[0].Value)
+
+	assert.Equal(t, 6500.0, sheet.TotalAssets)
+	assert.Equal(t, 2300.0, sheet.TotalLiabilities)
+	assert.Equal(t, 4200.0, sheet.NetWorth)
+}
@@ -0,0 +1,40 @@
+package domain
+
+// SnapshotOwnerType identifies which entity a balance snapshot belongs to
+type SnapshotOwnerType string
+
+const (
+	OwnerTypeAccount SnapshotOwnerType = "account"
+	OwnerTypeDebt    SnapshotOwnerType = "debt"
+
+	// OwnerTypeManualAsset marks balance sheet lines of manual assets, which are valued
+	// from their own valuation history rather than snapshots
+	OwnerTypeManualAsset SnapshotOwnerType = "manual_asset"
+)
+
+// SnapshotSource records how a snapshot balance was obtained
+type SnapshotSource string
+
+const (
+	SourceDaily          SnapshotSource = "daily"           // captured by the daily snapshot job
+	SourceRunningBalance SnapshotSource = "running_balance" // taken from a bank-provided running balance
+	SourceReplay         SnapshotSource = "replay"          // reconstructed by replaying transactions
+)
+
+// Interval is the spacing between points of a net-worth series
+type Interval string
+
+const (
+	IntervalDaily   Interval = "daily"
+	IntervalWeekly  Interval = "weekly"
+	IntervalMonthly Interval = "monthly"
+)
+
+// IsValid checks if the interval is valid
+func (i Interval) IsValid() bool {
+	switch i {
+	case IntervalDaily, IntervalWeekly, IntervalMonthly:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+)
+
+// NetWorthPoint is the net worth at the close of a single date
+type NetWorthPoint struct {
+	Date        time.Time `json:"date"`
+	Assets      float64   `json:"assets"`
+	Liabilities float64   `json:"liabilities"`
+	NetWorth    float64   `json:"net_worth"`
+}
+
+// AddAsset adds a balance held by the user. Negative balances (overdrawn or
+// credit card accounts) are counted as liabilities.
+func (p *NetWorthPoint) AddAsset(balance float64) {
+	if balance >= 0 {
+		p.Assets += balance
+	} else {
+		p.Liabilities += -balance
+	}
+	p.NetWorth = p.Assets - p.Liabilities
+}
+
+// AddLiability adds an amount owed by the user
+func (p *NetWorthPoint) AddLiability(balance float64) {
+	if balance <= 0 {
+		return
+	}
+	p.Liabilities += balance
+	p.NetWorth = p.Assets - p.Liabilities
+}
+
+// NetWorthHistory is a net-worth time series in a single currency
+type NetWorthHistory struct {
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	Interval Interval        `json:"interval"`
+	Currency string          `json:"currency"`
+	Points   []NetWorthPoint `json:"points"`
+}
+
+// Change returns the net-worth difference between the last and first point
+func (h *NetWorthHistory) Change() float64 {
+	if len(h.Points) < 2 {
+		return 0
+	}
+	return h.Points[len(h.Points)-1].NetWorth - h.Points[0].NetWorth
+}
+
+// SeriesDates returns the closing dates of each interval between from and to (inclusive).
+// Weekly points fall on Sundays and monthly points on the last day of the month;
+// the final point is always `to` so the series ends on the requested date.
+func SeriesDates(from, to time.Time, interval Interval) []time.Time {
+	from = TruncateToDay(from)
+	to = TruncateToDay(to)
+	if to.Before(from) {
+		return nil
+	}
+
+	var dates []time.Time
+	switch interval {
+	case IntervalWeekly:
+		offset := (7 - int(from.Weekday())) % 7
+		for d := from.AddDate(0, 0, offset); d.Before(to); d = d.AddDate(0, 0, 7) {
+			dates = append(dates, d)
+		}
+	case IntervalMonthly:
+		for d := endOfMonth(from); d.Before(to); d = endOfMonth(d.AddDate(0, 0, 1)) {
+			dates = append(dates, d)
+		}
+	default:
+		for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+			dates = append(dates, d)
+		}
+	}
+
+	return append(dates, to)
+}
+
+func endOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+}
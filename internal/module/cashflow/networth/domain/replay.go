@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// BalanceMovement is the effect of one transaction on an account balance
+type BalanceMovement struct {
+	Date           time.Time
+	Delta          float64  // positive for CREDIT, negative for DEBIT
+	RunningBalance *float64 // balance after the transaction, if the bank reported one
+}
+
+// DailyBalance is an end-of-day balance produced by ReplayDailyBalances
+type DailyBalance struct {
+	Date    time.Time
+	Balance float64
+	Source  SnapshotSource
+}
+
+// ReplayDailyBalances reconstructs end-of-day balances from the first movement up to `until`.
+//
+// The walk goes backwards from currentBalance (the balance at the end of `until`),
+// undoing each day's movements. When a day has a bank-reported RunningBalance,
+// the last one of that day is used as the anchor instead, so drift from missing
+// transactions does not propagate past it.
+func ReplayDailyBalances(currentBalance float64, movements []BalanceMovement, until time.Time) []DailyBalance {
+	if len(movements) == 0 {
+		return nil
+	}
+
+	sorted := make([]BalanceMovement, len(movements))
+	copy(sorted, movements)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	type dayTotals struct {
+		delta  float64
+		anchor *float64
+	}
+	byDay := make(map[time.Time]*dayTotals)
+	for _, m := range sorted {
+		day := TruncateToDay(m.Date)
+		totals, ok := byDay[day]
+		if !ok {
+			totals = &dayTotals{}
+			byDay[day] = totals
+		}
+		totals.delta += m.Delta
+		if m.RunningBalance != nil {
+			rb := *m.RunningBalance
+			totals.anchor = &rb
+		}
+	}
+
+	first := TruncateToDay(sorted[0].Date)
+	last := TruncateToDay(until)
+	if last.Before(first) {
+		last = TruncateToDay(sorted[len(sorted)-1].Date)
+	}
+
+	days := int(last.Sub(first).Hours()/24) + 1
+	result := make([]DailyBalance, days)
+
+	balance := currentBalance
+	source := SourceReplay
+	for i := days - 1; i >= 0; i-- {
+		day := first.AddDate(0, 0, i)
+		totals := byDay[day]
+		if totals != nil && totals.anchor != nil {
+			balance = *totals.anchor
+			source = SourceRunningBalance
+		}
+		result[i] = DailyBalance{Date: day, Balance: balance, Source: source}
+
+		// Step to the previous day's closing balance
+		if totals != nil {
+			balance -= totals.delta
+		}
+		source = SourceReplay
+	}
+
+	return result
+}
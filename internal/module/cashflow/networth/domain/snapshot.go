@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BalanceSnapshot is the end-of-day balance of an account or debt.
+// One row per owner per day; later captures for the same day overwrite earlier ones.
+type BalanceSnapshot struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+
+	OwnerType SnapshotOwnerType `gorm:"type:varchar(20);not null;uniqueIndex:idx_balance_snapshot_owner_date;column:owner_type" json:"owner_type"`
+	OwnerID   uuid.UUID         `gorm:"type:uuid;not null;uniqueIndex:idx_balance_snapshot_owner_date;column:owner_id" json:"owner_id"`
+
+	SnapshotDate time.Time      `gorm:"type:date;not null;uniqueIndex:idx_balance_snapshot_owner_date;index;column:snapshot_date" json:"snapshot_date"`
+	Balance      float64        `gorm:"type:decimal(15,2);not null;column:balance" json:"balance"`
+	Currency     string         `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+	Source       SnapshotSource `gorm:"type:varchar(20);not null;column:source" json:"source"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for BalanceSnapshot
+func (BalanceSnapshot) TableName() string {
+	return "balance_snapshots"
+}
+
+// TruncateToDay returns midnight UTC of the given time's calendar day
+func TruncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// NetWorthHistoryQuery represents query parameters for the net-worth history endpoint
+type NetWorthHistoryQuery struct {
+	From     time.Time `form:"from" time_format:"2006-01-02" binding:"required"`
+	To       time.Time `form:"to" time_format:"2006-01-02" binding:"required"`
+	Interval string    `form:"interval" binding:"omitempty,oneof=daily weekly monthly"`
+	Currency string    `form:"currency" binding:"omitempty,len=3"`
+}
+
+// BackfillRequest represents a request to rebuild balance history
+type BackfillRequest struct {
+	AccountID *string `json:"account_id,omitempty" binding:"omitempty,uuid"`
+}
+
+// BalanceSheetQuery represents query parameters for the balance sheet endpoint
+type BalanceSheetQuery struct {
+	Currency string `form:"currency" binding:"omitempty,len=3"`
+}
@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/networth/domain"
+
+	"github.com/google/uuid"
+)
+
+// NetWorthPointResponse represents a single point of the net-worth series
+type NetWorthPointResponse struct {
+	Date        string  `json:"date"`
+	Assets      float64 `json:"assets"`
+	Liabilities float64 `json:"liabilities"`
+	NetWorth    float64 `json:"net_worth"`
+}
+
+// NetWorthHistoryResponse represents a net-worth time series
+type NetWorthHistoryResponse struct {
+	From     time.Time               `json:"from"`
+	To       time.Time               `json:"to"`
+	Interval string                  `json:"interval"`
+	Currency string                  `json:"currency"`
+	Change   float64                 `json:"change"`
+	Points   []NetWorthPointResponse `json:"points"`
+}
+
+// BackfillResponse reports how many snapshots were written
+type BackfillResponse struct {
+	SnapshotsWritten int `json:"snapshots_written"`
+}
+
+// BalanceSheetLineResponse represents one account, debt or manual asset on the balance sheet
+type BalanceSheetLineResponse struct {
+	OwnerType string    `json:"owner_type"`
+	OwnerID   uuid.UUID `json:"owner_id"`
+	Name      string    `json:"name"`
+	Balance   float64   `json:"balance"`
+	Currency  string    `json:"currency"`
+	Value     float64   `json:"value"`
+}
+
+// BalanceSheetSectionResponse represents the lines of one category
+type BalanceSheetSectionResponse struct {
+	Category string                     `json:"category"`
+	Total    float64                    `json:"total"`
+	Lines    []BalanceSheetLineResponse `json:"lines"`
+}
+
+// BalanceSheetResponse represents what the user owns and owes today
+type BalanceSheetResponse struct {
+	AsOf             string                        `json:"as_of"`
+	Currency         string                        `json:"currency"`
+	Assets           []BalanceSheetSectionResponse `json:"assets"`
+	Liabilities      []BalanceSheetSectionResponse `json:"liabilities"`
+	TotalAssets      float64                       `json:"total_assets"`
+	TotalLiabilities float64                       `json:"total_liabilities"`
+	NetWorth         float64                       `json:"net_worth"`
+}
+
+// ToNetWorthHistoryResponse converts a domain history to response DTO
+func ToNetWorthHistoryResponse(history *domain.NetWorthHistory) *NetWorthHistoryResponse {
+	if history == nil {
+		return nil
+	}
+
+	points := make([]NetWorthPointResponse, len(history.Points))
+	for i, p := range history.Points {
+		points[i] = NetWorthPointResponse{
+			Date:        p.Date.Format("2006-01-02"),
+			Assets:      p.Assets,
+			Liabilities: p.Liabilities,
+			NetWorth:    p.NetWorth,
+		}
+	}
+
+	return &NetWorthHistoryResponse{
+		From:     history.From,
+		To:       history.To,
+		Interval: string(history.Interval),
+		Currency: history.Currency,
+		Change:   history.Change(),
+		Points:   points,
+	}
+}
+
+// ToBalanceSheetResponse converts a domain balance sheet to response DTO
+func ToBalanceSheetResponse(sheet *domain.BalanceSheet) *BalanceSheetResponse {
+	if sheet == nil {
+		return nil
+	}
+
+	return &BalanceSheetResponse{
+		AsOf:             sheet.AsOf.Format("2006-01-02"),
+		Currency:         sheet.Currency,
+		Assets:           toBalanceSheetSections(sheet.Assets),
+		Liabilities:      toBalanceSheetSections(sheet.Liabilities),
+		TotalAssets:      sheet.TotalAssets,
+		TotalLiabilities: sheet.TotalLiabilities,
+		NetWorth:         sheet.NetWorth,
+	}
+}
+
+func toBalanceSheetSections(sections []domain.BalanceSheetSection) []BalanceSheetSectionResponse {
+	responses := make([]BalanceSheetSectionResponse, len(sections))
+	for i, section := range sections {
+		lines := make([]BalanceSheetLineResponse, len(section.Lines))
+		for j, line := range section.Lines {
+			lines[j] = BalanceSheetLineResponse{
+				OwnerType: string(line.OwnerType),
+				OwnerID:   line.OwnerID,
+				Name:      line.Name,
+				Balance:   line.Balance,
+				Currency:  line.Currency,
+				Value:     line.Value,
+			}
+		}
+		responses[i] = BalanceSheetSectionResponse{
+			Category: section.Category,
+			Total:    section.Total,
+			Lines:    lines,
+		}
+	}
+	return responses
+}
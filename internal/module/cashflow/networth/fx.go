@@ -0,0 +1,43 @@
+package networth
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/networth/handler"
+	"personalfinancedss/internal/module/cashflow/networth/repository"
+	"personalfinancedss/internal/module/cashflow/networth/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides net-worth module dependencies. The snapshot job runs on the notification
+// scheduler through the scheduled_jobs group.
+var Module = fx.Module("networth",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Handler
+		handler.NewHandler,
+
+		// Scheduled job
+		fx.Annotate(
+			service.NewSnapshotJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
+	),
+	fx.Invoke(registerNetWorthRoutes),
+)
+
+func registerNetWorthRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/networth/dto"
+	"personalfinancedss/internal/module/cashflow/networth/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages net-worth endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs a net-worth handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("networth.handler"),
+	}
+}
+
+// RegisterRoutes wires net-worth routes under /api/v1/networth.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	networth := r.Group("/api/v1/networth")
+	networth.Use(authMiddleware.AuthMiddleware())
+	{
+		networth.GET("/history", h.getHistory)
+		networth.GET("/balance-sheet", h.getBalanceSheet)
+		networth.POST("/backfill", h.backfill)
+	}
+}
+
+// getHistory godoc
+// @Summary Get net-worth history
+// @Description Get the authenticated user's net worth over time, built from daily balance snapshots and manual asset valuations
+// @Tags networth
+// @Produce json
+// @Security BearerAuth
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Param interval query string false "Point spacing: daily, weekly, monthly" default(daily)
+// @Param currency query string false "Reporting currency" default(VND)
+// @Success 200 {object} dto.NetWorthHistoryResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/networth/history [get]
+func (h *Handler) getHistory(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.NetWorthHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	history, err := h.service.GetNetWorthHistory(c.Request.Context(), currentUser.ID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Net-worth history retrieved successfully", dto.ToNetWorthHistoryResponse(history))
+}
+
+// getBalanceSheet godoc
+// @Summary Get balance sheet
+// @Description Get today's accounts, debts and manual assets grouped into asset and liability categories
+// @Tags networth
+// @Produce json
+// @Security BearerAuth
+// @Param currency query string false "Reporting currency" default(VND)
+// @Success 200 {object} dto.BalanceSheetResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/networth/balance-sheet [get]
+func (h *Handler) getBalanceSheet(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.BalanceSheetQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	sheet, err := h.service.GetBalanceSheet(c.Request.Context(), currentUser.ID, query.Currency)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Balance sheet retrieved successfully", dto.ToBalanceSheetResponse(sheet))
+}
+
+// backfill godoc
+// @Summary Rebuild balance history
+// @Description Reconstruct daily balance snapshots from transaction history for one or all accounts. Existing snapshots are kept.
+// @Tags networth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.BackfillRequest false "Optional account to backfill"
+// @Success 200 {object} dto.BackfillResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/networth/backfill [post]
+func (h *Handler) backfill(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.BackfillRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+			return
+		}
+	}
+
+	var (
+		written int
+		err     error
+	)
+	if req.AccountID != nil {
+		accountID, parseErr := uuid.Parse(*req.AccountID)
+		if parseErr != nil {
+			shared.RespondWithError(c, http.StatusBadRequest, "invalid account id")
+			return
+		}
+		written, err = h.service.BackfillAccount(c.Request.Context(), currentUser.ID, accountID)
+	} else {
+		written, err = h.service.BackfillUser(c.Request.Context(), currentUser.ID)
+	}
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Balance history rebuilt successfully", dto.BackfillResponse{SnapshotsWritten: written})
+}
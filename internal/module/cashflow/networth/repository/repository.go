@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/networth/domain"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines data access for balance snapshots
+type Repository interface {
+	// UpsertMany writes snapshots, replacing any existing row for the same owner and day
+	UpsertMany(ctx context.Context, snapshots []domain.BalanceSnapshot) error
+
+	// InsertMissing writes snapshots only for owner/day pairs that have no row yet
+	InsertMissing(ctx context.Context, snapshots []domain.BalanceSnapshot) error
+
+	// CaptureDaily snapshots the current balance of every live account and debt for the given day
+	CaptureDaily(ctx context.Context, day time.Time) (int64, error)
+
+	// ListByUser retrieves a user's snapshots within a date range, ordered by date
+	ListByUser(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.BalanceSnapshot, error)
+
+	// LatestBefore retrieves the most recent snapshot of each owner strictly before the given day
+	LatestBefore(ctx context.Context, userID uuid.UUID, day time.Time) ([]domain.BalanceSnapshot, error)
+
+	// CountByOwner counts snapshots for a single owner
+	CountByOwner(ctx context.Context, ownerType domain.SnapshotOwnerType, ownerID uuid.UUID) (int64, error)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/networth/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new balance snapshot repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+var snapshotConflictColumns = []clause.Column{{Name: "owner_type"}, {Name: "owner_id"}, {Name: "snapshot_date"}}
+
+func (r *repository) UpsertMany(ctx context.Context, snapshots []domain.BalanceSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   snapshotConflictColumns,
+			DoUpdates: clause.AssignmentColumns([]string{"balance", "currency", "source", "updated_at"}),
+		}).
+		CreateInBatches(snapshots, 500).Error
+}
+
+func (r *repository) InsertMissing(ctx context.Context, snapshots []domain.BalanceSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: snapshotConflictColumns, DoNothing: true}).
+		CreateInBatches(snapshots, 500).Error
+}
+
+func (r *repository) CaptureDaily(ctx context.Context, day time.Time) (int64, error) {
+	day = domain.TruncateToDay(day)
+	var total int64
+
+	accounts := r.db.WithContext(ctx).Exec(`
+		INSERT INTO balance_snapshots (user_id, owner_type, owner_id, snapshot_date, balance, currency, source, created_at, updated_at)
+		SELECT user_id, ?, id, ?, current_balance, currency, ?, NOW(), NOW()
+		FROM accounts
+		WHERE deleted_at IS NULL
+		ON CONFLICT (owner_type, owner_id, snapshot_date)
+		DO UPDATE SET balance = EXCLUDED.balance, currency = EXCLUDED.currency, source = EXCLUDED.source, updated_at = NOW()
+	`, domain.OwnerTypeAccount, day, domain.SourceDaily)
+	if accounts.Error != nil {
+		return 0, accounts.Error
+	}
+	total += accounts.RowsAffected
+
+	debts := r.db.WithContext(ctx).Exec(`
+		INSERT INTO balance_snapshots (user_id, owner_type, owner_id, snapshot_date, balance, currency, source, created_at, updated_at)
+		SELECT user_id, ?, id, ?, current_balance, currency, ?, NOW(), NOW()
+		FROM debts
+		WHERE deleted_at IS NULL
+		ON CONFLICT (owner_type, owner_id, snapshot_date)
+		DO UPDATE SET balance = EXCLUDED.balance, currency = EXCLUDED.currency, source = EXCLUDED.source, updated_at = NOW()
+	`, domain.OwnerTypeDebt, day, domain.SourceDaily)
+	if debts.Error != nil {
+		return total, debts.Error
+	}
+	total += debts.RowsAffected
+
+	return total, nil
+}
+
+func (r *repository) ListByUser(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.BalanceSnapshot, error) {
+	var snapshots []domain.BalanceSnapshot
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND snapshot_date >= ? AND snapshot_date <= ?", userID, from, to).
+		Order("snapshot_date ASC").
+		Find(&snapshots).Error
+	return snapshots, err
+}
+
+func (r *repository) LatestBefore(ctx context.Context, userID uuid.UUID, day time.Time) ([]domain.BalanceSnapshot, error) {
+	var snapshots []domain.BalanceSnapshot
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT DISTINCT ON (owner_type, owner_id) *
+		FROM balance_snapshots
+		WHERE user_id = ? AND snapshot_date < ?
+		ORDER BY owner_type, owner_id, snapshot_date DESC
+	`, userID, day).Scan(&snapshots).Error
+	return snapshots, err
+}
+
+func (r *repository) CountByOwner(ctx context.Context, ownerType domain.SnapshotOwnerType, ownerID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&domain.BalanceSnapshot{}).
+		Where("owner_type = ? AND owner_id = ?", ownerType, ownerID).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	debtDomain "personalfinancedss/internal/module/cashflow/debt/domain"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	manualAssetDomain "personalfinancedss/internal/module/cashflow/manual_asset/domain"
+	manualAssetRepo "personalfinancedss/internal/module/cashflow/manual_asset/repository"
+	"personalfinancedss/internal/module/cashflow/networth/domain"
+	"personalfinancedss/internal/module/cashflow/networth/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxHistoryDays bounds a single history request
+const maxHistoryDays = 366 * 10
+
+// ownerKey identifies a snapshot series
+type ownerKey struct {
+	ownerType domain.SnapshotOwnerType
+	ownerID   uuid.UUID
+}
+
+// GetNetWorthHistory builds a net-worth series from balance snapshots and manual asset valuations.
+// Only the user's own accounts, whose snapshots the past points come from, and manual assets flagged IncludeInNetWorth
+// are counted; all balances are converted to the requested currency.
+func (s *netWorthService) GetNetWorthHistory(ctx context.Context, userID uuid.UUID, query dto.NetWorthHistoryQuery) (*domain.NetWorthHistory, error) {
+	from := domain.TruncateToDay(query.From)
+	to := domain.TruncateToDay(query.To)
+	if to.Before(from) {
+		return nil, shared.ErrBadRequest.WithDetails("to", "must not be before from")
+	}
+	if to.Sub(from) > maxHistoryDays*24*time.Hour {
+		return nil, shared.ErrBadRequest.WithDetails("to", "range must not exceed 10 years")
+	}
+
+	interval := domain.Interval(query.Interval)
+	if interval == "" {
+		interval = domain.IntervalDaily
+	}
+	if !interval.IsValid() {
+		return nil, shared.ErrBadRequest.WithDetails("interval", "must be one of daily, weekly, monthly")
+	}
+
+	currency := query.Currency
+	if currency == "" {
+		currency = exchangerate.BaseCurrency
+	}
+	rates := s.rates.Rates(ctx)
+	if !rates.Supports(currency) {
+		return nil, shared.ErrBadRequest.WithDetails("currency", "unsupported currency")
+	}
+
+	accounts, err := s.accountRepo.ListByUserID(ctx, userID.String(), accountDomain.ListAccountsFilter{OwnedOnly: true})
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	included := make([]accountDomain.Account, 0, len(accounts))
+	cardAccounts := make(map[uuid.UUID]bool)
+	for _, account := range accounts {
+		if account.IncludeInNetWorth {
+			included = append(included, account)
+			if account.AccountType == accountDomain.AccountTypeCreditCard {
+				cardAccounts[account.ID] = true
+			}
+		}
+	}
+
+	// Accounts created before snapshots existed have no history yet; rebuild it on first read
+	for i := range included {
+		count, err := s.repo.CountByOwner(ctx, domain.OwnerTypeAccount, included[i].ID)
+		if err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+		if count == 0 {
+			if _, err := s.backfillAccount(ctx, &included[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	debts, err := s.debtRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	assets, valuer, err := s.loadManualAssets(ctx, userID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := s.repo.LatestBefore(ctx, userID, from)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	snapshots, err := s.repo.ListByUser(ctx, userID, from, to)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	// Carry each owner's latest known balance forward as the series advances
+	latest := make(map[ownerKey]domain.BalanceSnapshot, len(seed))
+	for _, snap := range seed {
+		latest[ownerKey{snap.OwnerType, snap.OwnerID}] = snap
+	}
+
+	today := domain.TruncateToDay(time.Now())
+	dates := domain.SeriesDates(from, to, interval)
+	points := make([]domain.NetWorthPoint, 0, len(dates))
+	next := 0
+
+	for _, date := range dates {
+		for next < len(snapshots) && !snapshots[next].SnapshotDate.After(date) {
+			snap := snapshots[next]
+			latest[ownerKey{snap.OwnerType, snap.OwnerID}] = snap
+			next++
+		}
+
+		point := domain.NetWorthPoint{Date: date}
+		live := !date.Before(today)
+
+		for _, account := range included {
+			balance, fromCurrency := account.CurrentBalance, string(account.Currency)
+			if !live {
+				snap, ok := latest[ownerKey{domain.OwnerTypeAccount, account.ID}]
+				if !ok {
+					continue
+				}
+				balance, fromCurrency = snap.Balance, snap.Currency
+			}
+
+			converted, err := rates.Convert(balance, fromCurrency, currency)
+			if err != nil {
+				s.logger.Warn("Skipping account with unsupported currency",
+					zap.String("account_id", account.ID.String()),
+					zap.String("currency", fromCurrency),
+				)
+				continue
+			}
+			point.AddAsset(converted)
+		}
+
+		for _, debt := range debts {
+			// A card debt mirrors its card account, whose negative balance is already counted
+			if debt.Type == debtDomain.DebtTypeCreditCard && debt.LinkedAccountID != nil && cardAccounts[*debt.LinkedAccountID] {
+				continue
+			}
+
+			balance, fromCurrency := debt.CurrentBalance, debt.Currency
+			if !live {
+				if snap, ok := latest[ownerKey{domain.OwnerTypeDebt, debt.ID}]; ok {
+					balance, fromCurrency = snap.Balance, snap.Currency
+				} else if date.Before(domain.TruncateToDay(debt.StartDate)) {
+					continue
+				}
+			}
+
+			converted, err := rates.Convert(balance, fromCurrency, currency)
+			if err != nil {
+				s.logger.Warn("Skipping debt with unsupported currency",
+					zap.String("debt_id", debt.ID.String()),
+					zap.String("currency", fromCurrency),
+				)
+				continue
+			}
+			// Money lent to others is owed to the user and counts as an asset
+			if debt.IsReceivable() {
+				point.AddAsset(converted)
+			} else {
+				point.AddLiability(converted)
+			}
+		}
+
+		for i := range assets {
+			value, _, ok := valuer.ValueAt(&assets[i], date)
+			if !ok {
+				continue
+			}
+			converted, err := rates.Convert(value, assets[i].Currency, currency)
+			if err != nil {
+				s.logger.Warn("Skipping manual asset with unsupported currency",
+					zap.String("asset_id", assets[i].ID.String()),
+					zap.String("currency", assets[i].Currency),
+				)
+				continue
+			}
+			if assets[i].Type.IsLiability() {
+				point.AddLiability(converted)
+			} else {
+				point.AddAsset(converted)
+			}
+		}
+
+		points = append(points, point)
+	}
+
+	return &domain.NetWorthHistory{
+		From:     from,
+		To:       to,
+		Interval: interval,
+		Currency: currency,
+		Points:   points,
+	}, nil
+}
+
+// GetBalanceSheet lists the user's own current accounts, debts and manual assets by category,
+// converted to the requested currency. Accounts and assets excluded from net worth are left out.
+func (s *netWorthService) GetBalanceSheet(ctx context.Context, userID uuid.UUID, currency string) (*domain.BalanceSheet, error) {
+	if currency == "" {
+		currency = exchangerate.BaseCurrency
+	}
+	rates := s.rates.Rates(ctx)
+	if !rates.Supports(currency) {
+		return nil, shared.ErrBadRequest.WithDetails("currency", "unsupported currency")
+	}
+
+	today := domain.TruncateToDay(time.Now())
+	sheet := &domain.BalanceSheet{AsOf: today, Currency: currency}
+
+	accounts, err := s.accountRepo.ListByUserID(ctx, userID.String(), accountDomain.ListAccountsFilter{OwnedOnly: true})
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	cardAccounts := make(map[uuid.UUID]bool)
+	for _, account := range accounts {
+		if !account.IncludeInNetWorth {
+			continue
+		}
+		if account.AccountType == accountDomain.AccountTypeCreditCard {
+			cardAccounts[account.ID] = true
+		}
+
+		converted, err := rates.Convert(account.CurrentBalance, string(account.Currency), currency)
+		if err != nil {
+			s.logger.Warn("Skipping account with unsupported currency",
+				zap.String("account_id", account.ID.String()),
+				zap.String("currency", string(account.Currency)),
+			)
+			continue
+		}
+		sheet.AddAsset(string(account.AccountType), domain.BalanceSheetLine{
+			OwnerType: domain.OwnerTypeAccount,
+			OwnerID:   account.ID,
+			Name:      account.AccountName,
+			Balance:   account.CurrentBalance,
+			Currency:  string(account.Currency),
+		}, converted)
+	}
+
+	debts, err := s.debtRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	for _, debt := range debts {
+		// A card debt mirrors its card account, whose negative balance is already listed
+		if debt.Type == debtDomain.DebtTypeCreditCard && debt.LinkedAccountID != nil && cardAccounts[*debt.LinkedAccountID] {
+			continue
+		}
+
+		converted, err := rates.Convert(debt.CurrentBalance, debt.Currency, currency)
+		if err != nil {
+			s.logger.Warn("Skipping debt with unsupported currency",
+				zap.String("debt_id", debt.ID.String()),
+				zap.String("currency", debt.Currency),
+			)
+			continue
+		}
+		line := domain.BalanceSheetLine{
+			OwnerType: domain.OwnerTypeDebt,
+			OwnerID:   debt.ID,
+			Name:      debt.Name,
+			Balance:   debt.CurrentBalance,
+			Currency:  debt.Currency,
+		}
+		if debt.IsReceivable() {
+			sheet.AddAsset("receivable", line, converted)
+		} else {
+			sheet.AddLiability(string(debt.Type), line, converted)
+		}
+	}
+
+	assets, valuer, err := s.loadManualAssets(ctx, userID, today)
+	if err != nil {
+		return nil, err
+	}
+	for i := range assets {
+		asset := &assets[i]
+		value, _, ok := valuer.ValueAt(asset, today)
+		if !ok {
+			continue
+		}
+		converted, err := rates.Convert(value, asset.Currency, currency)
+		if err != nil {
+			s.logger.Warn("Skipping manual asset with unsupported currency",
+				zap.String("asset_id", asset.ID.String()),
+				zap.String("currency", asset.Currency),
+			)
+			continue
+		}
+
+		line := domain.BalanceSheetLine{
+			OwnerType: domain.OwnerTypeManualAsset,
+			OwnerID:   asset.ID,
+			Name:      asset.Name,
+			Balance:   value,
+			Currency:  asset.Currency,
+		}
+		if asset.Type.IsLiability() {
+			sheet.AddLiability(string(asset.Type), line, converted)
+		} else {
+			sheet.AddAsset(string(asset.Type), line, converted)
+		}
+	}
+
+	return sheet, nil
+}
+
+// loadManualAssets loads the user's manual assets counted in net worth, including disposed ones
+// for past dates, with a valuer over their valuations and prices up to a day
+func (s *netWorthService) loadManualAssets(ctx context.Context, userID uuid.UUID, to time.Time) ([]manualAssetDomain.ManualAsset, *manualAssetDomain.Valuer, error) {
+	all, err := s.manualAssetRepo.ListAssetsByUserID(ctx, userID, manualAssetRepo.AssetFilter{IncludeDisposed: true})
+	if err != nil {
+		return nil, nil, shared.ErrInternal.WithError(err)
+	}
+	assets := make([]manualAssetDomain.ManualAsset, 0, len(all))
+	for _, asset := range all {
+		if asset.IncludeInNetWorth {
+			assets = append(assets, asset)
+		}
+	}
+	if len(assets) == 0 {
+		return nil, manualAssetDomain.NewValuer(nil, nil), nil
+	}
+
+	valuations, err := s.manualAssetRepo.ListValuationsByUser(ctx, userID, to)
+	if err != nil {
+		return nil, nil, shared.ErrInternal.WithError(err)
+	}
+	var prices []manualAssetDomain.AssetPrice
+	if symbols := manualAssetDomain.Symbols(assets); len(symbols) > 0 {
+		prices, err = s.manualAssetRepo.ListPricesBySymbols(ctx, userID, symbols, to)
+		if err != nil {
+			return nil, nil, shared.ErrInternal.WithError(err)
+		}
+	}
+
+	return assets, manualAssetDomain.NewValuer(valuations, prices), nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	debtRepo "personalfinancedss/internal/module/cashflow/debt/repository"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	manualAssetRepo "personalfinancedss/internal/module/cashflow/manual_asset/repository"
+	"personalfinancedss/internal/module/cashflow/networth/domain"
+	"personalfinancedss/internal/module/cashflow/networth/dto"
+	"personalfinancedss/internal/module/cashflow/networth/repository"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SnapshotManager defines balance snapshot maintenance operations
+type SnapshotManager interface {
+	// BackfillAccount reconstructs daily balances for one account from its transaction history
+	BackfillAccount(ctx context.Context, userID, accountID uuid.UUID) (int, error)
+
+	// BackfillUser reconstructs daily balances for all of a user's accounts
+	BackfillUser(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// CaptureDailySnapshots records today's balance of every account and debt
+	CaptureDailySnapshots(ctx context.Context, day time.Time) (int64, error)
+}
+
+// NetWorthReader defines net-worth reporting operations
+type NetWorthReader interface {
+	GetNetWorthHistory(ctx context.Context, userID uuid.UUID, query dto.NetWorthHistoryQuery) (*domain.NetWorthHistory, error)
+
+	// GetBalanceSheet lists current accounts, debts and manual assets by category
+	GetBalanceSheet(ctx context.Context, userID uuid.UUID, currency string) (*domain.BalanceSheet, error)
+}
+
+// Service is the composite interface for all net-worth operations
+type Service interface {
+	SnapshotManager
+	NetWorthReader
+}
+
+// netWorthService implements all net-worth use cases
+type netWorthService struct {
+	repo            repository.Repository
+	accountRepo     accountRepo.Repository
+	debtRepo        debtRepo.Repository
+	transactionRepo transactionRepo.Repository
+	manualAssetRepo manualAssetRepo.Repository
+	rates           exchangerate.Provider
+	logger          *zap.Logger
+}
+
+// NewService creates a new net-worth service
+func NewService(
+	repo repository.Repository,
+	accountRepo accountRepo.Repository,
+	debtRepo debtRepo.Repository,
+	transactionRepo transactionRepo.Repository,
+	manualAssetRepo manualAssetRepo.Repository,
+	rates exchangerate.Provider,
+	logger *zap.Logger,
+) Service {
+	return &netWorthService{
+		repo:            repo,
+		accountRepo:     accountRepo,
+		debtRepo:        debtRepo,
+		transactionRepo: transactionRepo,
+		manualAssetRepo: manualAssetRepo,
+		rates:           rates,
+		logger:          logger.Named("networth.service"),
+	}
+}
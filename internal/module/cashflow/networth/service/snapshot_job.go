@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// snapshotJobSpec refreshes today's snapshots every hour so the close-of-day balance is kept
+const snapshotJobSpec = "0 5 * * * *"
+
+// snapshotJob runs the balance snapshot capture on the notification scheduler
+type snapshotJob struct {
+	service SnapshotManager
+}
+
+// NewSnapshotJob creates the scheduled job that records the balance of every account and debt
+func NewSnapshotJob(service Service) notificationService.ScheduledJob {
+	return &snapshotJob{service: service}
+}
+
+func (j *snapshotJob) Name() string {
+	return "networth_snapshots"
+}
+
+func (j *snapshotJob) Spec() string {
+	return snapshotJobSpec
+}
+
+func (j *snapshotJob) Run(ctx context.Context) error {
+	_, err := j.service.CaptureDailySnapshots(ctx, time.Now())
+	return err
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/networth/domain"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// BackfillAccount reconstructs daily balances for one account from its transaction history.
+// Existing snapshots are kept; only missing days are filled in.
+func (s *netWorthService) BackfillAccount(ctx context.Context, userID, accountID uuid.UUID) (int, error) {
+	account, err := s.accountRepo.GetEditableByIDAndUserID(ctx, accountID.String(), userID.String())
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return 0, err
+		}
+		return 0, shared.ErrInternal.WithError(err)
+	}
+
+	return s.backfillAccount(ctx, account)
+}
+
+// BackfillUser reconstructs daily balances for all of a user's accounts
+func (s *netWorthService) BackfillUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	accounts, err := s.accountRepo.ListByUserID(ctx, userID.String(), accountDomain.ListAccountsFilter{OwnedOnly: true})
+	if err != nil {
+		return 0, shared.ErrInternal.WithError(err)
+	}
+
+	total := 0
+	for i := range accounts {
+		written, err := s.backfillAccount(ctx, &accounts[i])
+		if err != nil {
+			return total, err
+		}
+		total += written
+	}
+
+	s.logger.Info("Balance history backfilled",
+		zap.String("user_id", userID.String()),
+		zap.Int("accounts", len(accounts)),
+		zap.Int("snapshots_written", total),
+	)
+
+	return total, nil
+}
+
+func (s *netWorthService) backfillAccount(ctx context.Context, account *accountDomain.Account) (int, error) {
+	now := time.Now().UTC()
+	transactions, err := s.transactionRepo.GetTransactionsByDateRange(ctx, account.UserID, &account.ID, time.Time{}, now)
+	if err != nil {
+		s.logger.Error("Failed to load transactions for backfill",
+			zap.String("account_id", account.ID.String()),
+			zap.Error(err),
+		)
+		return 0, shared.ErrInternal.WithError(err)
+	}
+
+	balances := domain.ReplayDailyBalances(account.CurrentBalance, toMovements(transactions), now)
+	if len(balances) == 0 {
+		return 0, nil
+	}
+
+	snapshots := make([]domain.BalanceSnapshot, len(balances))
+	for i, b := range balances {
+		snapshots[i] = domain.BalanceSnapshot{
+			UserID:       account.UserID,
+			OwnerType:    domain.OwnerTypeAccount,
+			OwnerID:      account.ID,
+			SnapshotDate: b.Date,
+			Balance:      b.Balance,
+			Currency:     string(account.Currency),
+			Source:       b.Source,
+		}
+	}
+
+	if err := s.repo.InsertMissing(ctx, snapshots); err != nil {
+		s.logger.Error("Failed to write backfilled snapshots",
+			zap.String("account_id", account.ID.String()),
+			zap.Error(err),
+		)
+		return 0, shared.ErrInternal.WithError(err)
+	}
+
+	return len(snapshots), nil
+}
+
+// CaptureDailySnapshots records the current balance of every account and debt for the given day.
+// Safe to run repeatedly: the day's row is overwritten with the latest balance.
+func (s *netWorthService) CaptureDailySnapshots(ctx context.Context, day time.Time) (int64, error) {
+	written, err := s.repo.CaptureDaily(ctx, day)
+	if err != nil {
+		s.logger.Error("Failed to capture daily balance snapshots",
+			zap.Time("day", day),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	s.logger.Info("Daily balance snapshots captured",
+		zap.Time("day", domain.TruncateToDay(day)),
+		zap.Int64("rows", written),
+	)
+
+	return written, nil
+}
+
+// toMovements converts transactions to signed balance movements
+func toMovements(transactions []*transactionDomain.Transaction) []domain.BalanceMovement {
+	movements := make([]domain.BalanceMovement, 0, len(transactions))
+	for _, txn := range transactions {
+		delta := float64(txn.Amount)
+		if txn.Direction == transactionDomain.DirectionDebit {
+			delta = -delta
+		}
+
+		var runningBalance *float64
+		if txn.RunningBalance != nil {
+			rb := float64(*txn.RunningBalance)
+			runningBalance = &rb
+		}
+
+		movements = append(movements, domain.BalanceMovement{
+			Date:           txn.BookingDate,
+			Delta:          delta,
+			RunningBalance: runningBalance,
+		})
+	}
+	return movements
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Benchmark is a user-supplied price series, such as VN-Index, that portfolios are compared against
+type Benchmark struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+
+	Name        string  `gorm:"type:varchar(100);not null;column:name" json:"name"`
+	Symbol      string  `gorm:"type:varchar(30);not null;column:symbol" json:"symbol"` // e.g. VNINDEX, VN30
+	Currency    string  `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+	Description *string `gorm:"type:text;column:description" json:"description,omitempty"`
+
+	CreatedAt time.Time      `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
+}
+
+// TableName specifies the table name for Benchmark
+func (Benchmark) TableName() string {
+	return "performance_benchmarks"
+}
+
+// BenchmarkPrice is a benchmark's closing price on a day
+type BenchmarkPrice struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	BenchmarkID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_benchmark_price_date;column:benchmark_id" json:"benchmark_id"`
+	PriceDate   time.Time `gorm:"type:date;not null;uniqueIndex:idx_benchmark_price_date;column:price_date" json:"price_date"`
+	Close       float64   `gorm:"type:decimal(20,4);not null;column:close" json:"close"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for BenchmarkPrice
+func (BenchmarkPrice) TableName() string {
+	return "performance_benchmark_prices"
+}
+
+// ToPricePoints converts stored prices to price points
+func ToPricePoints(prices []BenchmarkPrice) []PricePoint {
+	points := make([]PricePoint, len(prices))
+	for i, p := range prices {
+		points[i] = PricePoint{Date: p.PriceDate, Close: p.Close}
+	}
+	return points
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func points(start time.Time, values []float64, flows map[int]float64) []ValuationPoint {
+	result := make([]ValuationPoint, len(values))
+	for i, v := range values {
+		result[i] = ValuationPoint{Date: start.AddDate(0, 0, i), Value: v, Flow: flows[i]}
+	}
+	return result
+}
+
+func TestDailyReturns_IgnoresFlows(t *testing.T) {
+	// +10%, then a 1,100 deposit, then +10% on 2,200
+	series := DailyReturns(points(date(2025, 1, 1), []float64{1000, 1100, 2420}, map[int]float64{2: 1100}))
+
+	assert.InDelta(t, 0.10, series.Returns[1], 1e-9)
+	assert.InDelta(t, 0.10, series.Returns[2], 1e-9)
+	assert.InDelta(t, 0.21, series.Cumulative(), 1e-9)
+}
+
+func TestDailyReturns_NoCapital(t *testing.T) {
+	series := DailyReturns(points(date(2025, 1, 1), []float64{0, 0, 500, 550}, map[int]float64{2: 500}))
+
+	assert.False(t, series.Active[1])
+	assert.True(t, series.Active[2])
+	assert.InDelta(t, 0.10, series.Cumulative(), 1e-9)
+	assert.Len(t, series.ActiveReturns(), 2)
+}
+
+func TestMaxDrawdown(t *testing.T) {
+	series := DailyReturns(points(date(2025, 1, 1), []float64{100, 120, 90, 110, 125}, nil))
+	dd := series.MaxDrawdown()
+
+	assert.InDelta(t, 0.25, dd.Depth, 1e-9)
+	require.NotNil(t, dd.PeakDate)
+	require.NotNil(t, dd.TroughDate)
+	require.NotNil(t, dd.RecoveryDate)
+	assert.Equal(t, date(2025, 1, 2), *dd.PeakDate)
+	assert.Equal(t, date(2025, 1, 3), *dd.TroughDate)
+	assert.Equal(t, date(2025, 1, 5), *dd.RecoveryDate)
+}
+
+func TestXIRR(t *testing.T) {
+	// 1,000 grows to 1,100 in exactly one year
+	rate, err := XIRR([]CashFlow{
+		{Date: date(2023, 1, 1), Amount: -1000},
+		{Date: date(2024, 1, 1), Amount: 1100},
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.10, rate, 1e-3)
+
+	_, err = XIRR([]CashFlow{{Date: date(2023, 1, 1), Amount: 100}, {Date: date(2024, 1, 1), Amount: 100}})
+	assert.ErrorIs(t, err, ErrNoSolution)
+}
+
+func TestMoneyWeightedReturn_WeighsLateDeposits(t *testing.T) {
+	// Flat first half-year, then a large deposit right before a 10% gain:
+	// TWR is 10%, but the money-weighted return is higher because more money earned it
+	values := make([]float64, 366)
+	flows := map[int]float64{}
+	for i := range values {
+		values[i] = 1000
+	}
+	flows[300] = 9000
+	for i := 300; i < 366; i++ {
+		values[i] = 10000
+	}
+	values[365] = 11000
+
+	pts := points(date(2023, 1, 1), values, flows)
+	report := Analyze(pts, "VND")
+
+	assert.InDelta(t, 0.10, report.TimeWeightedReturn, 1e-9)
+	require.NotNil(t, report.AnnualizedTWR)
+	require.NotNil(t, report.MoneyWeightedReturn)
+	assert.Greater(t, *report.MoneyWeightedReturn, report.TimeWeightedReturn)
+	assert.Equal(t, 9000.0, report.NetFlows)
+	assert.Equal(t, 1000.0, report.Gain)
+}
+
+func TestAnnualize(t *testing.T) {
+	assert.Nil(t, Annualize(0.05, 180))
+
+	annual := Annualize(0.21, 730)
+	require.NotNil(t, annual)
+	assert.InDelta(t, 0.10, *annual, 1e-3)
+}
+
+func TestPriceSeries_ForwardFills(t *testing.T) {
+	prices := []PricePoint{
+		{Date: date(2024, 12, 31), Close: 1200}, // before the window: opens the series
+		{Date: date(2025, 1, 3), Close: 1260},
+	}
+	series := PriceSeries(prices, date(2025, 1, 1), date(2025, 1, 4))
+
+	require.Len(t, series, 4)
+	assert.Equal(t, []float64{1200, 1200, 1260, 1260}, []float64{series[0].Value, series[1].Value, series[2].Value, series[3].Value})
+}
+
+func TestCompareBenchmark(t *testing.T) {
+	start := date(2025, 1, 1)
+	// Portfolio moves exactly twice as much as the benchmark each day
+	bench := []float64{100, 101, 99.99, 101.9898}
+	portfolio := []float64{1000}
+	for i := 1; i < len(bench); i++ {
+		r := bench[i]/bench[i-1] - 1
+		portfolio = append(portfolio, portfolio[i-1]*(1+2*r))
+	}
+
+	report := Analyze(points(start, portfolio, nil), "VND")
+	report.CompareBenchmark("b1", "VN-Index", points(start, bench, nil))
+
+	require.NotNil(t, report.Benchmark)
+	assert.InDelta(t, 2.0, report.Benchmark.Beta, 1e-6)
+	assert.InDelta(t, 1.0, report.Benchmark.Correlation, 1e-6)
+	assert.InDelta(t, report.TimeWeightedReturn-report.Benchmark.Return, report.Benchmark.ExcessReturn, 1e-12)
+	require.NotNil(t, report.Series[3].BenchmarkIndex)
+	assert.InDelta(t, 1.019898, *report.Series[3].BenchmarkIndex, 1e-9)
+	assert.False(t, math.IsNaN(report.Benchmark.TrackingError))
+}
+
+func TestSeriesBuilder_SumsAccountsAndCarriesForward(t *testing.T) {
+	b := NewSeriesBuilder()
+	b.AddValue(date(2025, 1, 1), 100)
+	b.AddValue(date(2025, 1, 1), 50)
+	b.AddFlow(date(2025, 1, 2), 20)
+
+	pts := b.Build(date(2025, 1, 1), date(2025, 1, 2))
+	require.Len(t, pts, 2)
+	assert.Equal(t, 150.0, pts[0].Value)
+	assert.Equal(t, 150.0, pts[1].Value)
+	assert.Equal(t, 20.0, pts[1].Flow)
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// capitalEpsilon is the smallest invested amount a daily return is measured on
+const capitalEpsilon = 0.01
+
+// Drawdown describes the largest peak-to-trough fall of a growth index
+type Drawdown struct {
+	Depth        float64 // fraction of the peak lost, 0.25 = 25%
+	PeakDate     *time.Time
+	TroughDate   *time.Time
+	RecoveryDate *time.Time // first day the index regained the peak; nil if not yet recovered
+}
+
+// ReturnSeries holds daily time-weighted returns and the growth index they compound to
+type ReturnSeries struct {
+	Dates   []time.Time
+	Returns []float64 // Returns[i] is the return of Dates[i]; Returns[0] is always 0
+	Index   []float64 // growth of 1 unit invested at the start
+	Active  []bool    // whether capital was invested on the day
+}
+
+// DailyReturns computes daily time-weighted returns, assuming each day's flow arrives
+// at the start of the day: r_t = V_t / (V_{t-1} + F_t) - 1.
+// Days with no invested capital get a zero return and are marked inactive.
+func DailyReturns(points []ValuationPoint) *ReturnSeries {
+	series := &ReturnSeries{
+		Dates:   make([]time.Time, len(points)),
+		Returns: make([]float64, len(points)),
+		Index:   make([]float64, len(points)),
+		Active:  make([]bool, len(points)),
+	}
+	if len(points) == 0 {
+		return series
+	}
+
+	series.Dates[0] = points[0].Date
+	series.Index[0] = 1
+	for i := 1; i < len(points); i++ {
+		series.Dates[i] = points[i].Date
+		base := points[i-1].Value + points[i].Flow
+		r := 0.0
+		if base > capitalEpsilon {
+			r = points[i].Value/base - 1
+			series.Active[i] = true
+		}
+		series.Returns[i] = r
+		series.Index[i] = series.Index[i-1] * (1 + r)
+	}
+	return series
+}
+
+// Cumulative returns the compounded return over the whole series
+func (s *ReturnSeries) Cumulative() float64 {
+	if len(s.Index) == 0 {
+		return 0
+	}
+	return s.Index[len(s.Index)-1] - 1
+}
+
+// ActiveReturns returns the returns of days with invested capital
+func (s *ReturnSeries) ActiveReturns() []float64 {
+	var returns []float64
+	for i, active := range s.Active {
+		if active {
+			returns = append(returns, s.Returns[i])
+		}
+	}
+	return returns
+}
+
+// Volatility returns the annualized standard deviation of daily returns
+func (s *ReturnSeries) Volatility() float64 {
+	return StdDev(s.ActiveReturns()) * math.Sqrt(DaysPerYear)
+}
+
+// MaxDrawdown finds the deepest fall of the growth index from a running peak
+func (s *ReturnSeries) MaxDrawdown() Drawdown {
+	var result Drawdown
+	if len(s.Index) == 0 {
+		return result
+	}
+
+	peak, peakIdx := s.Index[0], 0
+	worstPeak, worstTrough := -1, -1
+	for i, value := range s.Index {
+		if value > peak {
+			peak, peakIdx = value, i
+			continue
+		}
+		if peak <= 0 {
+			continue
+		}
+		if depth := (peak - value) / peak; depth > result.Depth {
+			result.Depth = depth
+			worstPeak, worstTrough = peakIdx, i
+		}
+	}
+	if worstTrough < 0 {
+		return result
+	}
+
+	peakDate, troughDate := s.Dates[worstPeak], s.Dates[worstTrough]
+	result.PeakDate, result.TroughDate = &peakDate, &troughDate
+	for i := worstTrough + 1; i < len(s.Index); i++ {
+		if s.Index[i] >= s.Index[worstPeak] {
+			recovered := s.Dates[i]
+			result.RecoveryDate = &recovered
+			break
+		}
+	}
+	return result
+}
+
+// Annualize converts a cumulative return over `days` calendar days to an annual rate.
+// Returns nil for windows shorter than a year, where annualizing overstates results.
+func Annualize(cumulative float64, days int) *float64 {
+	if days < int(DaysPerYear) || cumulative <= -1 {
+		return nil
+	}
+	annual := math.Pow(1+cumulative, DaysPerYear/float64(days)) - 1
+	return &annual
+}
+
+// MoneyWeightedReturn computes the annual XIRR of a series from the investor's side:
+// the opening value is put in, each flow into the accounts is put in (and each flow
+// out is taken out), and the closing value is taken out at the end.
+func MoneyWeightedReturn(points []ValuationPoint) (float64, error) {
+	if len(points) < 2 {
+		return 0, ErrNoSolution
+	}
+
+	flows := []CashFlow{{Date: points[0].Date, Amount: -points[0].Value}}
+	for _, p := range points[1:] {
+		if p.Flow != 0 {
+			flows = append(flows, CashFlow{Date: p.Date, Amount: -p.Flow})
+		}
+	}
+	last := points[len(points)-1]
+	flows = append(flows, CashFlow{Date: last.Date, Amount: last.Value})
+
+	return XIRR(flows)
+}
+
+// Mean returns the arithmetic mean of values
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+// StdDev returns the sample standard deviation of values
+func StdDev(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	mean := Mean(values)
+	sum := 0.0
+	for _, v := range values {
+		sum += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(sum / float64(len(values)-1))
+}
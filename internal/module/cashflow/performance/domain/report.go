@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// SeriesPoint is one day of a performance report
+type SeriesPoint struct {
+	Date           time.Time
+	Value          float64
+	Flow           float64
+	Index          float64  // portfolio growth of 1 unit
+	BenchmarkIndex *float64 // benchmark growth of 1 unit, when compared
+}
+
+// BenchmarkComparison compares a portfolio's daily returns with a benchmark over the same days
+type BenchmarkComparison struct {
+	BenchmarkID   string
+	Name          string
+	Return        float64  // cumulative benchmark return
+	Annualized    *float64 // nil for windows shorter than a year
+	Volatility    float64
+	MaxDrawdown   Drawdown
+	ExcessReturn  float64 // portfolio TWR minus benchmark return
+	Beta          float64
+	Correlation   float64
+	TrackingError float64 // annualized standard deviation of daily return differences
+}
+
+// Report summarizes the performance of an account group over a window
+type Report struct {
+	From     time.Time
+	To       time.Time
+	Currency string
+
+	StartValue float64
+	EndValue   float64
+	NetFlows   float64 // external money in minus money out
+	Gain       float64 // end value minus start value minus net flows
+
+	TimeWeightedReturn  float64
+	AnnualizedTWR       *float64
+	MoneyWeightedReturn *float64 // annual XIRR of external flows; nil when undefined
+	Volatility          float64
+	MaxDrawdown         Drawdown
+	Benchmark           *BenchmarkComparison
+	Series              []SeriesPoint
+	returns             *ReturnSeries
+}
+
+// Analyze computes time- and money-weighted returns, volatility and drawdown.
+// points[0] is the opening day: its value is the starting capital and its flow is ignored.
+func Analyze(points []ValuationPoint, currency string) *Report {
+	report := &Report{Currency: currency}
+	if len(points) == 0 {
+		return report
+	}
+
+	first, last := points[0], points[len(points)-1]
+	report.From, report.To = first.Date, last.Date
+	report.StartValue, report.EndValue = roundMoney(first.Value), roundMoney(last.Value)
+	for _, p := range points[1:] {
+		report.NetFlows += p.Flow
+	}
+	report.NetFlows = roundMoney(report.NetFlows)
+	report.Gain = roundMoney(last.Value - first.Value - report.NetFlows)
+
+	returns := DailyReturns(points)
+	report.returns = returns
+	report.TimeWeightedReturn = returns.Cumulative()
+	report.AnnualizedTWR = Annualize(report.TimeWeightedReturn, len(points)-1)
+	report.Volatility = returns.Volatility()
+	report.MaxDrawdown = returns.MaxDrawdown()
+
+	if mwr, err := MoneyWeightedReturn(points); err == nil {
+		report.MoneyWeightedReturn = &mwr
+	}
+
+	report.Series = make([]SeriesPoint, len(points))
+	for i, p := range points {
+		report.Series[i] = SeriesPoint{
+			Date:  p.Date,
+			Value: roundMoney(p.Value),
+			Flow:  roundMoney(p.Flow),
+			Index: returns.Index[i],
+		}
+	}
+
+	return report
+}
+
+// CompareBenchmark adds a benchmark comparison to the report.
+// The benchmark series must cover the same days as the report.
+func (r *Report) CompareBenchmark(id, name string, benchmark []ValuationPoint) {
+	if r.returns == nil || len(benchmark) != len(r.Series) {
+		return
+	}
+
+	bench := DailyReturns(benchmark)
+	comparison := &BenchmarkComparison{
+		BenchmarkID: id,
+		Name:        name,
+		Return:      bench.Cumulative(),
+		Annualized:  Annualize(bench.Cumulative(), len(benchmark)-1),
+		Volatility:  bench.Volatility(),
+		MaxDrawdown: bench.MaxDrawdown(),
+	}
+	comparison.ExcessReturn = r.TimeWeightedReturn - comparison.Return
+
+	// Pair the days on which both series were invested
+	var portfolio, market, diffs []float64
+	for i := 1; i < len(r.Series); i++ {
+		if !r.returns.Active[i] || !bench.Active[i] {
+			continue
+		}
+		portfolio = append(portfolio, r.returns.Returns[i])
+		market = append(market, bench.Returns[i])
+		diffs = append(diffs, r.returns.Returns[i]-bench.Returns[i])
+	}
+
+	if variance := covariance(market, market); variance > 0 {
+		comparison.Beta = covariance(portfolio, market) / variance
+	}
+	if sp, sm := StdDev(portfolio), StdDev(market); sp > 0 && sm > 0 {
+		comparison.Correlation = covariance(portfolio, market) / (sp * sm)
+	}
+	comparison.TrackingError = StdDev(diffs) * math.Sqrt(DaysPerYear)
+
+	for i := range r.Series {
+		index := bench.Index[i]
+		r.Series[i].BenchmarkIndex = &index
+	}
+	r.Benchmark = comparison
+}
+
+// covariance returns the sample covariance of two equally long series
+func covariance(a, b []float64) float64 {
+	if len(a) < 2 || len(a) != len(b) {
+		return 0
+	}
+	meanA, meanB := Mean(a), Mean(b)
+	sum := 0.0
+	for i := range a {
+		sum += (a[i] - meanA) * (b[i] - meanB)
+	}
+	return sum / float64(len(a)-1)
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v*100) / 100
+}
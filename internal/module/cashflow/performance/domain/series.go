@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// DaysPerYear annualizes calendar-day series
+const DaysPerYear = 365.0
+
+// ValuationPoint is the end-of-day value of an account group and the external
+// cash that entered (positive) or left (negative) it during that day
+type ValuationPoint struct {
+	Date  time.Time
+	Value float64
+	Flow  float64
+}
+
+// SeriesBuilder accumulates per-day values and flows of several accounts into one series
+type SeriesBuilder struct {
+	values map[time.Time]float64
+	flows  map[time.Time]float64
+}
+
+// NewSeriesBuilder creates an empty series builder
+func NewSeriesBuilder() *SeriesBuilder {
+	return &SeriesBuilder{
+		values: make(map[time.Time]float64),
+		flows:  make(map[time.Time]float64),
+	}
+}
+
+// AddValue adds an end-of-day value for the given day
+func (b *SeriesBuilder) AddValue(day time.Time, value float64) {
+	b.values[truncateToDay(day)] += value
+}
+
+// AddFlow adds an external flow for the given day
+func (b *SeriesBuilder) AddFlow(day time.Time, amount float64) {
+	b.flows[truncateToDay(day)] += amount
+}
+
+// Build returns one point per calendar day from `from` to `to` inclusive.
+// Days without a value carry the previous day's value forward.
+func (b *SeriesBuilder) Build(from, to time.Time) []ValuationPoint {
+	from, to = truncateToDay(from), truncateToDay(to)
+	if to.Before(from) {
+		return nil
+	}
+
+	days := int(to.Sub(from).Hours()/24) + 1
+	points := make([]ValuationPoint, 0, days)
+	var last float64
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		value, ok := b.values[day]
+		if !ok {
+			value = last
+		}
+		points = append(points, ValuationPoint{Date: day, Value: value, Flow: b.flows[day]})
+		last = value
+	}
+	return points
+}
+
+// PricePoint is a closing price of a benchmark on a day
+type PricePoint struct {
+	Date  time.Time
+	Close float64
+}
+
+// PriceSeries turns closing prices into a daily series from `from` to `to`.
+// Non-trading days carry the last close forward; days before the first known
+// close use that first close, so they contribute a zero return.
+func PriceSeries(prices []PricePoint, from, to time.Time) []ValuationPoint {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	sorted := make([]PricePoint, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	builder := NewSeriesBuilder()
+	start := truncateToDay(from)
+	builder.values[start] = sorted[0].Close
+	for _, p := range sorted {
+		day := truncateToDay(p.Date)
+		if day.Before(start) {
+			// The latest close before the window opens the series
+			day = start
+		}
+		builder.values[day] = p.Close
+	}
+	return builder.Build(from, to)
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
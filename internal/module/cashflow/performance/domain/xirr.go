@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// ErrNoSolution is returned when XIRR cannot find a rate that zeroes the flows
+var ErrNoSolution = errors.New("xirr: no solution")
+
+// CashFlow is a dated amount from the investor's point of view:
+// negative when money is put in, positive when it is taken out
+type CashFlow struct {
+	Date   time.Time
+	Amount float64
+}
+
+// XIRR returns the annual rate r that solves Σ CF_i / (1 + r)^(days_i / 365) = 0.
+// Newton's method is tried first; bisection is used when it does not converge.
+func XIRR(flows []CashFlow) (float64, error) {
+	if len(flows) < 2 {
+		return 0, ErrNoSolution
+	}
+
+	hasIn, hasOut := false, false
+	start := flows[0].Date
+	for _, f := range flows {
+		if f.Amount < 0 {
+			hasIn = true
+		}
+		if f.Amount > 0 {
+			hasOut = true
+		}
+		if f.Date.Before(start) {
+			start = f.Date
+		}
+	}
+	if !hasIn || !hasOut {
+		return 0, ErrNoSolution
+	}
+
+	years := make([]float64, len(flows))
+	for i, f := range flows {
+		years[i] = f.Date.Sub(start).Hours() / 24 / DaysPerYear
+	}
+
+	npv := func(rate float64) float64 {
+		total := 0.0
+		for i, f := range flows {
+			total += f.Amount / math.Pow(1+rate, years[i])
+		}
+		return total
+	}
+	derivative := func(rate float64) float64 {
+		total := 0.0
+		for i, f := range flows {
+			total -= years[i] * f.Amount / math.Pow(1+rate, years[i]+1)
+		}
+		return total
+	}
+
+	rate := 0.1
+	for i := 0; i < 100; i++ {
+		value := npv(rate)
+		if math.Abs(value) < 1e-7 {
+			return rate, nil
+		}
+		slope := derivative(rate)
+		if slope == 0 || math.IsNaN(slope) {
+			break
+		}
+		next := rate - value/slope
+		if next <= -1 || math.IsNaN(next) || math.IsInf(next, 0) {
+			break
+		}
+		if math.Abs(next-rate) < 1e-10 {
+			return next, nil
+		}
+		rate = next
+	}
+
+	return bisectRate(npv)
+}
+
+// bisectRate finds a root of npv between -99.99% and a growing upper bound
+func bisectRate(npv func(float64) float64) (float64, error) {
+	lo, hi := -0.9999, 1.0
+	for npv(lo)*npv(hi) > 0 {
+		hi *= 2
+		if hi > 1e6 {
+			return 0, ErrNoSolution
+		}
+	}
+
+	for i := 0; i < 300; i++ {
+		mid := (lo + hi) / 2
+		value := npv(mid)
+		if math.Abs(value) < 1e-7 || (hi-lo)/2 < 1e-12 {
+			return mid, nil
+		}
+		if npv(lo)*value < 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return (lo + hi) / 2, nil
+}
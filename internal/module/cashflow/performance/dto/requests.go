@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// PerformanceQuery represents query parameters for the performance report
+type PerformanceQuery struct {
+	AccountIDs        []string   `form:"account_ids" binding:"omitempty,dive,uuid"`         // defaults to all investment accounts
+	From              *time.Time `form:"from" time_format:"2006-01-02"`                     // defaults to one year before `to`
+	To                *time.Time `form:"to" time_format:"2006-01-02"`                       // defaults to today
+	BenchmarkID       *string    `form:"benchmark_id" binding:"omitempty,uuid"`             // compare against a stored benchmark
+	Currency          string     `form:"currency" binding:"omitempty,len=3"`                // reporting currency, default VND
+	ReturnCategoryIDs []string   `form:"return_category_ids" binding:"omitempty,dive,uuid"` // categories counted as returns (interest, dividends) rather than deposits
+}
+
+// CreateBenchmarkRequest represents a request to create a benchmark
+type CreateBenchmarkRequest struct {
+	Name        string  `json:"name" binding:"required,max=100"`
+	Symbol      string  `json:"symbol" binding:"required,max=30"`
+	Currency    string  `json:"currency" binding:"omitempty,len=3"`
+	Description *string `json:"description,omitempty"`
+}
+
+// BenchmarkPriceInput is one closing price of a benchmark
+type BenchmarkPriceInput struct {
+	Date  string  `json:"date" binding:"required,datetime=2006-01-02"`
+	Close float64 `json:"close" binding:"required,gt=0"`
+}
+
+// UpsertBenchmarkPricesRequest represents a batch of closing prices to store
+type UpsertBenchmarkPricesRequest struct {
+	Prices []BenchmarkPriceInput `json:"prices" binding:"required,min=1,max=5000,dive"`
+}
+
+// BenchmarkPricesQuery represents query parameters for listing benchmark prices
+type BenchmarkPricesQuery struct {
+	From time.Time `form:"from" time_format:"2006-01-02" binding:"required"`
+	To   time.Time `form:"to" time_format:"2006-01-02" binding:"required"`
+}
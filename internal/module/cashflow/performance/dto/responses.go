@@ -0,0 +1,181 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/performance/domain"
+
+	"github.com/google/uuid"
+)
+
+// DrawdownResponse represents the largest peak-to-trough fall
+type DrawdownResponse struct {
+	Depth        float64 `json:"depth"`
+	PeakDate     *string `json:"peak_date,omitempty"`
+	TroughDate   *string `json:"trough_date,omitempty"`
+	RecoveryDate *string `json:"recovery_date,omitempty"`
+}
+
+// BenchmarkComparisonResponse represents portfolio vs benchmark statistics
+type BenchmarkComparisonResponse struct {
+	BenchmarkID   string           `json:"benchmark_id"`
+	Name          string           `json:"name"`
+	Return        float64          `json:"return"`
+	Annualized    *float64         `json:"annualized_return,omitempty"`
+	Volatility    float64          `json:"volatility"`
+	MaxDrawdown   DrawdownResponse `json:"max_drawdown"`
+	ExcessReturn  float64          `json:"excess_return"`
+	Beta          float64          `json:"beta"`
+	Correlation   float64          `json:"correlation"`
+	TrackingError float64          `json:"tracking_error"`
+}
+
+// PerformancePointResponse represents one day of the performance series
+type PerformancePointResponse struct {
+	Date           string   `json:"date"`
+	Value          float64  `json:"value"`
+	Flow           float64  `json:"flow"`
+	Index          float64  `json:"index"`
+	BenchmarkIndex *float64 `json:"benchmark_index,omitempty"`
+}
+
+// PerformanceResponse represents a performance report.
+// Returns are fractions: 0.12 = 12%.
+type PerformanceResponse struct {
+	AccountIDs []uuid.UUID `json:"account_ids"`
+	From       string      `json:"from"`
+	To         string      `json:"to"`
+	Currency   string      `json:"currency"`
+
+	StartValue float64 `json:"start_value"`
+	EndValue   float64 `json:"end_value"`
+	NetFlows   float64 `json:"net_flows"`
+	Gain       float64 `json:"gain"`
+
+	TimeWeightedReturn  float64                      `json:"time_weighted_return"`
+	AnnualizedTWR       *float64                     `json:"annualized_twr,omitempty"`
+	MoneyWeightedReturn *float64                     `json:"money_weighted_return,omitempty"`
+	Volatility          float64                      `json:"volatility"`
+	MaxDrawdown         DrawdownResponse             `json:"max_drawdown"`
+	Benchmark           *BenchmarkComparisonResponse `json:"benchmark,omitempty"`
+	Series              []PerformancePointResponse   `json:"series"`
+}
+
+// BenchmarkResponse represents a benchmark in API responses
+type BenchmarkResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Symbol      string    `json:"symbol"`
+	Currency    string    `json:"currency"`
+	Description *string   `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// BenchmarkPriceResponse represents a benchmark closing price
+type BenchmarkPriceResponse struct {
+	Date  string  `json:"date"`
+	Close float64 `json:"close"`
+}
+
+// ToPerformanceResponse converts a domain report to response DTO
+func ToPerformanceResponse(report *domain.Report, accountIDs []uuid.UUID) *PerformanceResponse {
+	if report == nil {
+		return nil
+	}
+
+	series := make([]PerformancePointResponse, len(report.Series))
+	for i, p := range report.Series {
+		series[i] = PerformancePointResponse{
+			Date:           formatDate(p.Date),
+			Value:          p.Value,
+			Flow:           p.Flow,
+			Index:          p.Index,
+			BenchmarkIndex: p.BenchmarkIndex,
+		}
+	}
+
+	response := &PerformanceResponse{
+		AccountIDs:          accountIDs,
+		From:                formatDate(report.From),
+		To:                  formatDate(report.To),
+		Currency:            report.Currency,
+		StartValue:          report.StartValue,
+		EndValue:            report.EndValue,
+		NetFlows:            report.NetFlows,
+		Gain:                report.Gain,
+		TimeWeightedReturn:  report.TimeWeightedReturn,
+		AnnualizedTWR:       report.AnnualizedTWR,
+		MoneyWeightedReturn: report.MoneyWeightedReturn,
+		Volatility:          report.Volatility,
+		MaxDrawdown:         toDrawdownResponse(report.MaxDrawdown),
+		Series:              series,
+	}
+
+	if b := report.Benchmark; b != nil {
+		response.Benchmark = &BenchmarkComparisonResponse{
+			BenchmarkID:   b.BenchmarkID,
+			Name:          b.Name,
+			Return:        b.Return,
+			Annualized:    b.Annualized,
+			Volatility:    b.Volatility,
+			MaxDrawdown:   toDrawdownResponse(b.MaxDrawdown),
+			ExcessReturn:  b.ExcessReturn,
+			Beta:          b.Beta,
+			Correlation:   b.Correlation,
+			TrackingError: b.TrackingError,
+		}
+	}
+
+	return response
+}
+
+// ToBenchmarkResponse converts a domain benchmark to response DTO
+func ToBenchmarkResponse(b *domain.Benchmark) BenchmarkResponse {
+	return BenchmarkResponse{
+		ID:          b.ID,
+		Name:        b.Name,
+		Symbol:      b.Symbol,
+		Currency:    b.Currency,
+		Description: b.Description,
+		CreatedAt:   b.CreatedAt,
+	}
+}
+
+// ToBenchmarkResponses converts domain benchmarks to response DTOs
+func ToBenchmarkResponses(benchmarks []domain.Benchmark) []BenchmarkResponse {
+	responses := make([]BenchmarkResponse, len(benchmarks))
+	for i := range benchmarks {
+		responses[i] = ToBenchmarkResponse(&benchmarks[i])
+	}
+	return responses
+}
+
+// ToBenchmarkPriceResponses converts domain prices to response DTOs
+func ToBenchmarkPriceResponses(prices []domain.BenchmarkPrice) []BenchmarkPriceResponse {
+	responses := make([]BenchmarkPriceResponse, len(prices))
+	for i, p := range prices {
+		responses[i] = BenchmarkPriceResponse{Date: formatDate(p.PriceDate), Close: p.Close}
+	}
+	return responses
+}
+
+func toDrawdownResponse(d domain.Drawdown) DrawdownResponse {
+	return DrawdownResponse{
+		Depth:        d.Depth,
+		PeakDate:     formatDatePtr(d.PeakDate),
+		TroughDate:   formatDatePtr(d.TroughDate),
+		RecoveryDate: formatDatePtr(d.RecoveryDate),
+	}
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+func formatDatePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := formatDate(*t)
+	return &s
+}
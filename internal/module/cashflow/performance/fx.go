@@ -0,0 +1,36 @@
+package performance
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/performance/handler"
+	"personalfinancedss/internal/module/cashflow/performance/repository"
+	"personalfinancedss/internal/module/cashflow/performance/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides portfolio performance dependencies
+var Module = fx.Module("performance",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Handler
+		handler.NewHandler,
+	),
+	fx.Invoke(registerPerformanceRoutes),
+)
+
+func registerPerformanceRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/performance/dto"
+	"personalfinancedss/internal/module/cashflow/performance/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages portfolio performance endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs a performance handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("performance.handler"),
+	}
+}
+
+// RegisterRoutes wires performance routes under /api/v1/performance.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	performance := r.Group("/api/v1/performance")
+	performance.Use(authMiddleware.AuthMiddleware())
+	{
+		performance.GET("", h.getPerformance)
+
+		performance.POST("/benchmarks", h.createBenchmark)
+		performance.GET("/benchmarks", h.listBenchmarks)
+		performance.DELETE("/benchmarks/:id", h.deleteBenchmark)
+		performance.PUT("/benchmarks/:id/prices", h.upsertPrices)
+		performance.GET("/benchmarks/:id/prices", h.listPrices)
+	}
+}
+
+// getPerformance godoc
+// @Summary Get portfolio performance
+// @Description Time-weighted return, money-weighted return (XIRR on external flows), volatility and max drawdown of an account or account group, optionally compared with a benchmark
+// @Tags performance
+// @Produce json
+// @Security BearerAuth
+// @Param account_ids query []string false "Accounts to include (default: all investment accounts)" collectionFormat(multi)
+// @Param from query string false "Start date (YYYY-MM-DD, default: one year before to)"
+// @Param to query string false "End date (YYYY-MM-DD, default: today)"
+// @Param benchmark_id query string false "Benchmark to compare against"
+// @Param currency query string false "Reporting currency (default VND)"
+// @Param return_category_ids query []string false "Categories counted as returns rather than deposits" collectionFormat(multi)
+// @Success 200 {object} dto.PerformanceResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/performance [get]
+func (h *Handler) getPerformance(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.PerformanceQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	report, accountIDs, err := h.service.GetPerformance(c.Request.Context(), currentUser.ID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Performance retrieved successfully", dto.ToPerformanceResponse(report, accountIDs))
+}
+
+// createBenchmark godoc
+// @Summary Create benchmark
+// @Description Create a benchmark price series, such as VN-Index, to compare portfolios against
+// @Tags performance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateBenchmarkRequest true "Benchmark details"
+// @Success 201 {object} dto.BenchmarkResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/performance/benchmarks [post]
+func (h *Handler) createBenchmark(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.CreateBenchmarkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	benchmark, err := h.service.CreateBenchmark(c.Request.Context(), currentUser.ID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Benchmark created successfully", dto.ToBenchmarkResponse(benchmark))
+}
+
+// listBenchmarks godoc
+// @Summary List benchmarks
+// @Description List the user's benchmark price series
+// @Tags performance
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.BenchmarkResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/performance/benchmarks [get]
+func (h *Handler) listBenchmarks(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	benchmarks, err := h.service.ListBenchmarks(c.Request.Context(), currentUser.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Benchmarks retrieved successfully", dto.ToBenchmarkResponses(benchmarks))
+}
+
+// deleteBenchmark godoc
+// @Summary Delete benchmark
+// @Description Delete a benchmark and its prices
+// @Tags performance
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Benchmark ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/performance/benchmarks/{id} [delete]
+func (h *Handler) deleteBenchmark(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	benchmarkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid benchmark id")
+		return
+	}
+
+	if err := h.service.DeleteBenchmark(c.Request.Context(), currentUser.ID, benchmarkID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Benchmark deleted successfully")
+}
+
+// upsertPrices godoc
+// @Summary Upload benchmark prices
+// @Description Store daily closing prices of a benchmark; prices already stored for the same days are replaced
+// @Tags performance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Benchmark ID"
+// @Param request body dto.UpsertBenchmarkPricesRequest true "Closing prices"
+// @Success 200 {object} map[string]int
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/performance/benchmarks/{id}/prices [put]
+func (h *Handler) upsertPrices(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	benchmarkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid benchmark id")
+		return
+	}
+
+	var req dto.UpsertBenchmarkPricesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	stored, err := h.service.UpsertPrices(c.Request.Context(), currentUser.ID, benchmarkID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Benchmark prices stored successfully", map[string]int{"prices_stored": stored})
+}
+
+// listPrices godoc
+// @Summary List benchmark prices
+// @Description List closing prices of a benchmark within a date range
+// @Tags performance
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Benchmark ID"
+// @Param from query string true "Start date (YYYY-MM-DD)"
+// @Param to query string true "End date (YYYY-MM-DD)"
+// @Success 200 {array} dto.BenchmarkPriceResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/performance/benchmarks/{id}/prices [get]
+func (h *Handler) listPrices(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	benchmarkID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid benchmark id")
+		return
+	}
+
+	var query dto.BenchmarkPricesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	prices, err := h.service.ListPrices(c.Request.Context(), currentUser.ID, benchmarkID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Benchmark prices retrieved successfully", dto.ToBenchmarkPriceResponses(prices))
+}
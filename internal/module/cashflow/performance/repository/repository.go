@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/performance/domain"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines data access for benchmarks and their prices
+type Repository interface {
+	// CreateBenchmark creates a benchmark
+	CreateBenchmark(ctx context.Context, benchmark *domain.Benchmark) error
+
+	// GetBenchmarkByID retrieves a benchmark
+	GetBenchmarkByID(ctx context.Context, id uuid.UUID) (*domain.Benchmark, error)
+
+	// ListBenchmarks retrieves a user's benchmarks
+	ListBenchmarks(ctx context.Context, userID uuid.UUID) ([]domain.Benchmark, error)
+
+	// DeleteBenchmark soft-deletes a benchmark and removes its prices
+	DeleteBenchmark(ctx context.Context, id uuid.UUID) error
+
+	// UpsertPrices writes closing prices, replacing any existing price for the same day
+	UpsertPrices(ctx context.Context, prices []domain.BenchmarkPrice) error
+
+	// ListPrices retrieves a benchmark's prices within a date range, ordered by date
+	ListPrices(ctx context.Context, benchmarkID uuid.UUID, from, to time.Time) ([]domain.BenchmarkPrice, error)
+
+	// LatestPriceBefore retrieves the most recent price strictly before the given day
+	LatestPriceBefore(ctx context.Context, benchmarkID uuid.UUID, day time.Time) (*domain.BenchmarkPrice, error)
+}
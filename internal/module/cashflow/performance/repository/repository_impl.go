@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/performance/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new performance repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateBenchmark(ctx context.Context, benchmark *domain.Benchmark) error {
+	return r.db.WithContext(ctx).Create(benchmark).Error
+}
+
+func (r *repository) GetBenchmarkByID(ctx context.Context, id uuid.UUID) (*domain.Benchmark, error) {
+	var benchmark domain.Benchmark
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&benchmark).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &benchmark, nil
+}
+
+func (r *repository) ListBenchmarks(ctx context.Context, userID uuid.UUID) ([]domain.Benchmark, error) {
+	var benchmarks []domain.Benchmark
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&benchmarks).Error
+	return benchmarks, err
+}
+
+func (r *repository) DeleteBenchmark(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("benchmark_id = ?", id).Delete(&domain.BenchmarkPrice{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Benchmark{}, "id = ?", id).Error
+	})
+}
+
+func (r *repository) UpsertPrices(ctx context.Context, prices []domain.BenchmarkPrice) error {
+	if len(prices) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "benchmark_id"}, {Name: "price_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"close", "updated_at"}),
+		}).
+		CreateInBatches(prices, 500).Error
+}
+
+func (r *repository) ListPrices(ctx context.Context, benchmarkID uuid.UUID, from, to time.Time) ([]domain.BenchmarkPrice, error) {
+	var prices []domain.BenchmarkPrice
+	err := r.db.WithContext(ctx).
+		Where("benchmark_id = ? AND price_date >= ? AND price_date <= ?", benchmarkID, from, to).
+		Order("price_date ASC").
+		Find(&prices).Error
+	return prices, err
+}
+
+func (r *repository) LatestPriceBefore(ctx context.Context, benchmarkID uuid.UUID, day time.Time) (*domain.BenchmarkPrice, error) {
+	var price domain.BenchmarkPrice
+	err := r.db.WithContext(ctx).
+		Where("benchmark_id = ? AND price_date < ?", benchmarkID, day).
+		Order("price_date DESC").
+		First(&price).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &price, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/performance/domain"
+	"personalfinancedss/internal/module/cashflow/performance/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateBenchmark creates a benchmark price series
+func (s *performanceService) CreateBenchmark(ctx context.Context, userID uuid.UUID, req dto.CreateBenchmarkRequest) (*domain.Benchmark, error) {
+	currency := strings.ToUpper(req.Currency)
+	if currency == "" {
+		currency = "VND"
+	}
+
+	benchmark := &domain.Benchmark{
+		UserID:      userID,
+		Name:        strings.TrimSpace(req.Name),
+		Symbol:      strings.ToUpper(strings.TrimSpace(req.Symbol)),
+		Currency:    currency,
+		Description: req.Description,
+	}
+	if err := s.repo.CreateBenchmark(ctx, benchmark); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Benchmark created",
+		zap.String("user_id", userID.String()),
+		zap.String("benchmark_id", benchmark.ID.String()),
+		zap.String("symbol", benchmark.Symbol),
+	)
+	return benchmark, nil
+}
+
+// ListBenchmarks retrieves a user's benchmarks
+func (s *performanceService) ListBenchmarks(ctx context.Context, userID uuid.UUID) ([]domain.Benchmark, error) {
+	benchmarks, err := s.repo.ListBenchmarks(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return benchmarks, nil
+}
+
+// DeleteBenchmark deletes a benchmark and its prices
+func (s *performanceService) DeleteBenchmark(ctx context.Context, userID, benchmarkID uuid.UUID) error {
+	if _, err := s.getOwnedBenchmark(ctx, userID, benchmarkID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteBenchmark(ctx, benchmarkID); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
+
+// UpsertPrices stores closing prices of a benchmark, replacing prices already stored for the same days
+func (s *performanceService) UpsertPrices(ctx context.Context, userID, benchmarkID uuid.UUID, req dto.UpsertBenchmarkPricesRequest) (int, error) {
+	if _, err := s.getOwnedBenchmark(ctx, userID, benchmarkID); err != nil {
+		return 0, err
+	}
+
+	// Later entries for the same day win
+	byDay := make(map[time.Time]float64, len(req.Prices))
+	for _, p := range req.Prices {
+		day, err := time.Parse("2006-01-02", p.Date)
+		if err != nil {
+			return 0, shared.ErrBadRequest.WithDetails("date", "dates must be formatted as YYYY-MM-DD")
+		}
+		byDay[day] = p.Close
+	}
+
+	prices := make([]domain.BenchmarkPrice, 0, len(byDay))
+	for day, closePrice := range byDay {
+		prices = append(prices, domain.BenchmarkPrice{
+			BenchmarkID: benchmarkID,
+			PriceDate:   day,
+			Close:       closePrice,
+		})
+	}
+
+	if err := s.repo.UpsertPrices(ctx, prices); err != nil {
+		return 0, shared.ErrInternal.WithError(err)
+	}
+	return len(prices), nil
+}
+
+// ListPrices retrieves a benchmark's prices within a date range
+func (s *performanceService) ListPrices(ctx context.Context, userID, benchmarkID uuid.UUID, query dto.BenchmarkPricesQuery) ([]domain.BenchmarkPrice, error) {
+	if query.To.Before(query.From) {
+		return nil, shared.ErrBadRequest.WithDetails("to", "must not be before from")
+	}
+	if _, err := s.getOwnedBenchmark(ctx, userID, benchmarkID); err != nil {
+		return nil, err
+	}
+
+	prices, err := s.repo.ListPrices(ctx, benchmarkID, query.From, query.To)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return prices, nil
+}
+
+// getOwnedBenchmark loads a benchmark and checks that it belongs to the user
+func (s *performanceService) getOwnedBenchmark(ctx context.Context, userID, benchmarkID uuid.UUID) (*domain.Benchmark, error) {
+	benchmark, err := s.repo.GetBenchmarkByID(ctx, benchmarkID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if benchmark.UserID != userID {
+		return nil, shared.ErrNotFound
+	}
+	return benchmark, nil
+}
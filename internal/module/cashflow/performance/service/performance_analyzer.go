@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	networthDomain "personalfinancedss/internal/module/cashflow/networth/domain"
+	"personalfinancedss/internal/module/cashflow/performance/domain"
+	"personalfinancedss/internal/module/cashflow/performance/dto"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetPerformance computes TWR, MWR (XIRR), volatility and max drawdown of an account group.
+//
+// Daily values are replayed from each account's transactions (anchored on bank-reported
+// running balances) and overridden by balances captured by the daily snapshot job, which
+// also see market moves that produce no transaction. Every transaction in the window is
+// an external flow, except those in the given return categories (interest, dividends).
+// Transfers between accounts of the group cancel out within the day.
+func (s *performanceService) GetPerformance(ctx context.Context, userID uuid.UUID, query dto.PerformanceQuery) (*domain.Report, []uuid.UUID, error) {
+	today := networthDomain.TruncateToDay(time.Now().UTC())
+	to := today
+	if query.To != nil {
+		to = networthDomain.TruncateToDay(*query.To)
+	}
+	if to.After(today) {
+		to = today
+	}
+	from := to.AddDate(-1, 0, 0)
+	if query.From != nil {
+		from = networthDomain.TruncateToDay(*query.From)
+	}
+	if !from.Before(to) {
+		return nil, nil, shared.ErrBadRequest.WithDetails("from", "must be before to")
+	}
+
+	currency := strings.ToUpper(query.Currency)
+	if currency == "" {
+		currency = exchangerate.BaseCurrency
+	}
+	rates := s.rates.Rates(ctx)
+	if !rates.Supports(currency) {
+		return nil, nil, shared.ErrBadRequest.WithDetails("currency", "unsupported currency")
+	}
+
+	accounts, err := s.resolveAccounts(ctx, userID, query.AccountIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	returnCategories := make(map[uuid.UUID]bool, len(query.ReturnCategoryIDs))
+	for _, id := range query.ReturnCategoryIDs {
+		if parsed, err := uuid.Parse(id); err == nil {
+			returnCategories[parsed] = true
+		}
+	}
+
+	captured, err := s.capturedBalances(ctx, userID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builder := domain.NewSeriesBuilder()
+	accountIDs := make([]uuid.UUID, len(accounts))
+	for i := range accounts {
+		account := &accounts[i]
+		accountIDs[i] = account.ID
+		if err := s.addAccount(ctx, builder, account, from, to, currency, rates, returnCategories, captured[account.ID]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	report := domain.Analyze(builder.Build(from, to), currency)
+
+	if query.BenchmarkID != nil {
+		benchmarkID, err := uuid.Parse(*query.BenchmarkID)
+		if err != nil {
+			return nil, nil, shared.ErrBadRequest.WithDetails("benchmark_id", "invalid benchmark id")
+		}
+		if err := s.compareBenchmark(ctx, userID, benchmarkID, report, from, to); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return report, accountIDs, nil
+}
+
+// resolveAccounts loads the requested accounts, or all investment accounts when none are given
+func (s *performanceService) resolveAccounts(ctx context.Context, userID uuid.UUID, ids []string) ([]accountDomain.Account, error) {
+	if len(ids) == 0 {
+		all, err := s.accountRepo.ListByUserID(ctx, userID.String(), accountDomain.ListAccountsFilter{})
+		if err != nil {
+			return nil, shared.ErrInternal.WithError(err)
+		}
+		var accounts []accountDomain.Account
+		for _, account := range all {
+			if account.AccountType == accountDomain.AccountTypeInvestment || account.AccountType == accountDomain.AccountTypeCryptoWallet {
+				accounts = append(accounts, account)
+			}
+		}
+		if len(accounts) == 0 {
+			return nil, shared.ErrBadRequest.WithDetails("account_ids", "no investment accounts found; specify account_ids")
+		}
+		return accounts, nil
+	}
+
+	seen := make(map[string]bool, len(ids))
+	accounts := make([]accountDomain.Account, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		account, err := s.accountRepo.GetByIDAndUserID(ctx, id, userID.String())
+		if err != nil {
+			if err == shared.ErrNotFound {
+				return nil, err
+			}
+			return nil, shared.ErrInternal.WithError(err)
+		}
+		accounts = append(accounts, *account)
+	}
+	return accounts, nil
+}
+
+// capturedBalances loads balances recorded by the daily snapshot job, keyed by account and day
+func (s *performanceService) capturedBalances(ctx context.Context, userID uuid.UUID, from, to time.Time) (map[uuid.UUID]map[time.Time]float64, error) {
+	snapshots, err := s.snapshotRepo.ListByUser(ctx, userID, from, to)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	captured := make(map[uuid.UUID]map[time.Time]float64)
+	for _, snap := range snapshots {
+		if snap.OwnerType != networthDomain.OwnerTypeAccount || snap.Source != networthDomain.SourceDaily {
+			continue
+		}
+		if captured[snap.OwnerID] == nil {
+			captured[snap.OwnerID] = make(map[time.Time]float64)
+		}
+		captured[snap.OwnerID][networthDomain.TruncateToDay(snap.SnapshotDate)] = snap.Balance
+	}
+	return captured, nil
+}
+
+// addAccount adds one account's daily values and external flows to the series
+func (s *performanceService) addAccount(
+	ctx context.Context,
+	builder *domain.SeriesBuilder,
+	account *accountDomain.Account,
+	from, to time.Time,
+	currency string,
+	rates exchangerate.Rates,
+	returnCategories map[uuid.UUID]bool,
+	captured map[time.Time]float64,
+) error {
+	rate, err := rates.Convert(1, string(account.Currency), currency)
+	if err != nil {
+		return shared.ErrBadRequest.WithDetails("currency", "account "+account.ID.String()+" uses an unsupported currency")
+	}
+
+	now := time.Now().UTC()
+	transactions, err := s.transactionRepo.GetTransactionsByDateRange(ctx, account.UserID, &account.ID, from, now)
+	if err != nil {
+		s.logger.Error("Failed to load transactions for performance",
+			zap.String("account_id", account.ID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrInternal.WithError(err)
+	}
+
+	// A zero movement on the opening day makes the replay cover the whole window
+	movements := []networthDomain.BalanceMovement{{Date: from}}
+	for _, txn := range transactions {
+		delta := signedAmount(txn)
+		var runningBalance *float64
+		if txn.RunningBalance != nil {
+			rb := float64(*txn.RunningBalance)
+			runningBalance = &rb
+		}
+		movements = append(movements, networthDomain.BalanceMovement{
+			Date:           txn.BookingDate,
+			Delta:          delta,
+			RunningBalance: runningBalance,
+		})
+
+		day := networthDomain.TruncateToDay(txn.BookingDate)
+		if !day.After(from) || day.After(to) {
+			continue // opening-day flows are part of the starting value
+		}
+		if txn.UserCategoryID != nil && returnCategories[*txn.UserCategoryID] {
+			continue
+		}
+		builder.AddFlow(day, delta*rate)
+	}
+
+	for _, balance := range networthDomain.ReplayDailyBalances(account.CurrentBalance, movements, now) {
+		if balance.Date.Before(from) || balance.Date.After(to) {
+			continue
+		}
+		value := balance.Balance
+		if capturedValue, ok := captured[balance.Date]; ok {
+			value = capturedValue
+		}
+		builder.AddValue(balance.Date, value*rate)
+	}
+
+	return nil
+}
+
+// compareBenchmark adds a stored benchmark's performance over the same window to the report
+func (s *performanceService) compareBenchmark(ctx context.Context, userID, benchmarkID uuid.UUID, report *domain.Report, from, to time.Time) error {
+	benchmark, err := s.getOwnedBenchmark(ctx, userID, benchmarkID)
+	if err != nil {
+		return err
+	}
+
+	prices, err := s.repo.ListPrices(ctx, benchmarkID, from, to)
+	if err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	if opening, err := s.repo.LatestPriceBefore(ctx, benchmarkID, from); err == nil {
+		prices = append(prices, *opening)
+	} else if err != shared.ErrNotFound {
+		return shared.ErrInternal.WithError(err)
+	}
+	if len(prices) == 0 {
+		return shared.ErrBadRequest.WithDetails("benchmark_id", "benchmark has no prices in the requested window")
+	}
+
+	report.CompareBenchmark(benchmark.ID.String(), benchmark.Name, domain.PriceSeries(domain.ToPricePoints(prices), from, to))
+	return nil
+}
+
+// signedAmount returns a transaction's effect on its account balance
+func signedAmount(txn *transactionDomain.Transaction) float64 {
+	amount := float64(txn.Amount)
+	if txn.Direction == transactionDomain.DirectionDebit {
+		return -amount
+	}
+	return amount
+}
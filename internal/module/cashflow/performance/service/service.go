@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	networthRepo "personalfinancedss/internal/module/cashflow/networth/repository"
+	"personalfinancedss/internal/module/cashflow/performance/domain"
+	"personalfinancedss/internal/module/cashflow/performance/dto"
+	"personalfinancedss/internal/module/cashflow/performance/repository"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PerformanceAnalyzer measures the actual performance of accounts
+type PerformanceAnalyzer interface {
+	// GetPerformance computes TWR, MWR (XIRR), volatility and max drawdown of an account group,
+	// optionally compared with a benchmark. Returns the report and the accounts it covers.
+	GetPerformance(ctx context.Context, userID uuid.UUID, query dto.PerformanceQuery) (*domain.Report, []uuid.UUID, error)
+}
+
+// BenchmarkManager defines benchmark and price series operations
+type BenchmarkManager interface {
+	CreateBenchmark(ctx context.Context, userID uuid.UUID, req dto.CreateBenchmarkRequest) (*domain.Benchmark, error)
+	ListBenchmarks(ctx context.Context, userID uuid.UUID) ([]domain.Benchmark, error)
+	DeleteBenchmark(ctx context.Context, userID, benchmarkID uuid.UUID) error
+	UpsertPrices(ctx context.Context, userID, benchmarkID uuid.UUID, req dto.UpsertBenchmarkPricesRequest) (int, error)
+	ListPrices(ctx context.Context, userID, benchmarkID uuid.UUID, query dto.BenchmarkPricesQuery) ([]domain.BenchmarkPrice, error)
+}
+
+// Service is the composite interface for all performance operations
+type Service interface {
+	PerformanceAnalyzer
+	BenchmarkManager
+}
+
+// performanceService implements all performance use cases
+type performanceService struct {
+	repo            repository.Repository
+	accountRepo     accountRepo.Repository
+	transactionRepo transactionRepo.Repository
+	snapshotRepo    networthRepo.Repository
+	rates           exchangerate.Provider
+	logger          *zap.Logger
+}
+
+// NewService creates a new performance service
+func NewService(
+	repo repository.Repository,
+	accountRepo accountRepo.Repository,
+	transactionRepo transactionRepo.Repository,
+	snapshotRepo networthRepo.Repository,
+	rates exchangerate.Provider,
+	logger *zap.Logger,
+) Service {
+	return &performanceService{
+		repo:            repo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		snapshotRepo:    snapshotRepo,
+		rates:           rates,
+		logger:          logger.Named("performance.service"),
+	}
+}
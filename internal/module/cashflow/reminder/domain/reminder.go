@@ -0,0 +1,341 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	debtDomain "personalfinancedss/internal/module/cashflow/debt/domain"
+	goalDomain "personalfinancedss/internal/module/cashflow/goal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// SendHour is the local hour from which a day's reminders go out
+	SendHour = 9
+	// DebtDueSoonDays is how many days before a debt payment falls due its reminder goes out
+	DebtDueSoonDays = 3
+	// DefaultTimezone applies when the user has not set a timezone
+	DefaultTimezone = "Asia/Ho_Chi_Minh"
+)
+
+// Reminder frequencies accepted in ReminderFrequency
+const (
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+)
+
+// Kind tells which entity a reminder is about
+type Kind string
+
+const (
+	KindGoal Kind = "goal"
+	KindDebt Kind = "debt"
+)
+
+// Reminder is a reminder that is due and ready to be delivered
+type Reminder struct {
+	Kind     Kind
+	EntityID uuid.UUID
+	UserID   uuid.UUID
+	Subject  string
+	Lines    []string // Human-readable details, one per line
+	Data     map[string]interface{}
+}
+
+// Location resolves a timezone name, falling back to DefaultTimezone when it is empty or unknown
+func Location(name string) *time.Location {
+	if name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	if loc, err := time.LoadLocation(DefaultTimezone); err == nil {
+		return loc
+	}
+	return time.FixedZone("ICT", 7*3600)
+}
+
+// GoalReminder returns the reminder due for the goal at localNow, the current time in the owner's
+// timezone, or nil when none is due. Goals without a frequency are reminded monthly.
+func GoalReminder(goal *goalDomain.Goal, localNow time.Time) *Reminder {
+	if !goal.EnableReminders || goal.IsCompleted() {
+		return nil
+	}
+	if goal.Status != goalDomain.GoalStatusActive && goal.Status != goalDomain.GoalStatusOverdue {
+		return nil
+	}
+	if !canSendNow(goal.LastReminderSentAt, localNow) {
+		return nil
+	}
+
+	last := goal.LastReminderSentAt
+	if last == nil && !goal.CreatedAt.IsZero() {
+		// The first reminder comes one period after the goal was set up
+		last = &goal.CreatedAt
+	}
+	if !periodElapsed(last, frequencyOr(goal.ReminderFrequency, FrequencyMonthly), localNow) {
+		return nil
+	}
+
+	today := civilDate(localNow, localNow.Location())
+	remaining := math.Max(goal.TargetAmount-goal.FundedAmount(), 0)
+	behind := goal.AmountBehindSchedule(localNow)
+	overdue := goal.TargetDate != nil && civilDate(*goal.TargetDate, localNow.Location()).Before(today)
+
+	data := map[string]interface{}{
+		"goal_id":          goal.ID.String(),
+		"goal_name":        goal.Name,
+		"funded_amount":    goal.FundedAmount(),
+		"target_amount":    goal.TargetAmount,
+		"remaining_amount": remaining,
+		"amount_behind":    behind,
+		"overdue":          overdue,
+	}
+	lines := []string{
+		fmt.Sprintf("Saved %s of %s", FormatAmount(goal.FundedAmount(), goal.Currency), FormatAmount(goal.TargetAmount, goal.Currency)),
+	}
+
+	var subject string
+	switch {
+	case overdue:
+		subject = fmt.Sprintf("%s is past its target date with %s to go", goal.Name, FormatAmount(remaining, goal.Currency))
+	case behind > 0:
+		subject = fmt.Sprintf("%s is behind schedule by %s", goal.Name, FormatAmount(behind, goal.Currency))
+	default:
+		subject = fmt.Sprintf("Keep going: %s to go for %s", FormatAmount(remaining, goal.Currency), goal.Name)
+	}
+
+	if goal.TargetDate != nil {
+		targetDate := civilDate(*goal.TargetDate, localNow.Location())
+		daysRemaining := daysBetween(today, targetDate)
+		data["target_date"] = targetDate.Format("2006-01-02")
+		data["days_remaining"] = daysRemaining
+		lines = append(lines, "Target date: "+targetDate.Format("2006-01-02"))
+
+		if daysRemaining > 0 && remaining > 0 {
+			months := math.Max(math.Ceil(float64(daysRemaining)/30), 1)
+			suggested := math.Ceil(remaining / months)
+			data["suggested_contribution"] = suggested
+			lines = append(lines, fmt.Sprintf("Save %s a month to finish on time", FormatAmount(suggested, goal.Currency)))
+		}
+	}
+	if behind > 0 && !overdue {
+		lines = append(lines, fmt.Sprintf("Behind schedule by %s", FormatAmount(behind, goal.Currency)))
+	}
+
+	return &Reminder{
+		Kind:     KindGoal,
+		EntityID: goal.ID,
+		UserID:   goal.UserID,
+		Subject:  subject,
+		Lines:    lines,
+		Data:     data,
+	}
+}
+
+// DebtReminder returns the reminder due for the debt at localNow, or nil when none is due.
+// A payment falling due within DebtDueSoonDays is reminded once, an overdue payment once and then
+// at the reminder frequency, and otherwise the balance is reminded at the frequency if one is set.
+// Money lent to others is worded as a repayment the user expects from the counterparty.
+func DebtReminder(debt *debtDomain.Debt, localNow time.Time) *Reminder {
+	if !debt.EnableReminders || debt.IsPaidOff() {
+		return nil
+	}
+	if debt.Status != debtDomain.DebtStatusActive && debt.Status != debtDomain.DebtStatusDefaulted {
+		return nil
+	}
+	if !canSendNow(debt.LastReminderSentAt, localNow) {
+		return nil
+	}
+
+	loc := localNow.Location()
+	today := civilDate(localNow, loc)
+	frequency := frequencyOr(debt.ReminderFrequency, "")
+
+	data := map[string]interface{}{
+		"debt_id":         debt.ID.String(),
+		"debt_name":       debt.Name,
+		"current_balance": debt.CurrentBalance,
+		"minimum_payment": debt.MinimumPayment,
+		"overdue":         false,
+		"direction":       string(debt.Direction),
+	}
+
+	// Wording differs for money the user owes and money owed to the user
+	name, balanceLabel, paymentLabel, dueLabel := debt.Name, "Balance: ", "Minimum payment: ", "Payment due: "
+	if debt.IsReceivable() {
+		if debt.CounterpartyName != nil && *debt.CounterpartyName != "" {
+			name = *debt.CounterpartyName
+			data["counterparty_name"] = name
+		}
+		balanceLabel, paymentLabel, dueLabel = "Owed to you: ", "Expected repayment: ", "Repayment expected: "
+	}
+
+	lines := []string{
+		balanceLabel + FormatAmount(debt.CurrentBalance, debt.Currency),
+	}
+	if debt.MinimumPayment > 0 {
+		lines = append(lines, paymentLabel+FormatAmount(debt.MinimumPayment, debt.Currency))
+	}
+
+	var subject string
+	if debt.NextPaymentDate != nil {
+		dueDate := civilDate(*debt.NextPaymentDate, loc)
+		days := daysBetween(today, dueDate)
+		data["next_payment_date"] = dueDate.Format("2006-01-02")
+		lines = append(lines, dueLabel+dueDate.Format("2006-01-02"))
+
+		switch {
+		case days < 0:
+			// Remind once the payment is missed, then keep reminding at the chosen frequency
+			if sentSince(debt.LastReminderSentAt, dueDate.AddDate(0, 0, 1)) && !periodElapsed(debt.LastReminderSentAt, frequency, localNow) {
+				return nil
+			}
+			data["overdue"] = true
+			data["days_overdue"] = -days
+			if debt.IsReceivable() {
+				subject = fmt.Sprintf("%s's repayment is %s overdue", name, pluralDays(-days))
+			} else {
+				subject = fmt.Sprintf("%s payment is %s overdue", name, pluralDays(-days))
+			}
+		case days <= DebtDueSoonDays:
+			// One reminder per due date
+			if sentSince(debt.LastReminderSentAt, dueDate.AddDate(0, 0, -DebtDueSoonDays)) {
+				return nil
+			}
+			data["days_until_due"] = days
+			when := "today"
+			if days > 0 {
+				when = "in " + pluralDays(days)
+			}
+			if debt.IsReceivable() {
+				subject = fmt.Sprintf("%s's repayment is expected %s", name, when)
+			} else {
+				subject = fmt.Sprintf("%s payment is due %s", name, when)
+			}
+		default:
+			data["days_until_due"] = days
+		}
+	}
+
+	if subject == "" {
+		if !periodElapsed(debt.LastReminderSentAt, frequency, localNow) {
+			return nil
+		}
+		if debt.IsReceivable() {
+			subject = fmt.Sprintf("%s owes you %s", name, FormatAmount(debt.CurrentBalance, debt.Currency))
+		} else {
+			subject = fmt.Sprintf("%s balance: %s", name, FormatAmount(debt.CurrentBalance, debt.Currency))
+		}
+	}
+
+	return &Reminder{
+		Kind:     KindDebt,
+		EntityID: debt.ID,
+		UserID:   debt.UserID,
+		Subject:  subject,
+		Lines:    lines,
+		Data:     data,
+	}
+}
+
+// FormatAmount renders an amount with thousands separators, e.g. "1,500,000 VND"
+func FormatAmount(amount float64, currency string) string {
+	rounded := int64(math.Round(amount))
+	sign := ""
+	if rounded < 0 {
+		sign = "-"
+		rounded = -rounded
+	}
+
+	digits := strconv.FormatInt(rounded, 10)
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(d)
+	}
+
+	if currency == "" {
+		return sign + b.String()
+	}
+	return sign + b.String() + " " + currency
+}
+
+// canSendNow holds reminders until SendHour local time and allows at most one per local day
+func canSendNow(last *time.Time, localNow time.Time) bool {
+	if localNow.Hour() < SendHour {
+		return false
+	}
+	return !sentSince(last, civilDate(localNow, localNow.Location()))
+}
+
+// periodElapsed reports whether a periodic reminder at the given frequency is due. An empty or
+// unknown frequency never is.
+func periodElapsed(last *time.Time, frequency string, localNow time.Time) bool {
+	if frequency == "" {
+		return false
+	}
+	if last == nil {
+		return true
+	}
+
+	loc := localNow.Location()
+	next := civilDate(*last, loc)
+	switch frequency {
+	case FrequencyDaily:
+		next = next.AddDate(0, 0, 1)
+	case FrequencyWeekly:
+		next = next.AddDate(0, 0, 7)
+	case FrequencyMonthly:
+		next = next.AddDate(0, 1, 0)
+	default:
+		return false
+	}
+	return !civilDate(localNow, loc).Before(next)
+}
+
+// sentSince reports whether the last reminder went out on or after the given local day
+func sentSince(last *time.Time, day time.Time) bool {
+	return last != nil && !last.Before(day)
+}
+
+// frequencyOr returns the reminder frequency, or fallback when none is set or it is not recognised
+func frequencyOr(frequency *string, fallback string) string {
+	if frequency == nil {
+		return fallback
+	}
+	switch f := strings.ToLower(*frequency); f {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+		return f
+	}
+	return fallback
+}
+
+// civilDate is the start of t's calendar day in loc. Date-only columns keep their calendar date
+// regardless of the zone they were read in.
+func civilDate(t time.Time, loc *time.Location) time.Time {
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+		y, m, d := t.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	}
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
+
+func pluralDays(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
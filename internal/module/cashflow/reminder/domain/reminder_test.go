@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	debtDomain "personalfinancedss/internal/module/cashflow/debt/domain"
+	goalDomain "personalfinancedss/internal/module/cashflow/goal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLoc = time.FixedZone("ICT", 7*3600)
+
+func at(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, testLoc)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func newGoal() *goalDomain.Goal {
+	return &goalDomain.Goal{
+		ID:              uuid.New(),
+		UserID:          uuid.New(),
+		Name:            "Emergency fund",
+		TargetAmount:    12000000,
+		CurrentAmount:   2000000,
+		Currency:        "VND",
+		StartDate:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		TargetDate:      ptr(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)),
+		Status:          goalDomain.GoalStatusActive,
+		EnableReminders: true,
+		CreatedAt:       at(2026, 1, 1, 10),
+	}
+}
+
+func newDebt(nextPayment time.Time) *debtDomain.Debt {
+	return &debtDomain.Debt{
+		ID:              uuid.New(),
+		UserID:          uuid.New(),
+		Name:            "Car loan",
+		CurrentBalance:  50000000,
+		MinimumPayment:  2500000,
+		Currency:        "VND",
+		Status:          debtDomain.DebtStatusActive,
+		NextPaymentDate: &nextPayment,
+		EnableReminders: true,
+	}
+}
+
+func TestGoalReminder(t *testing.T) {
+	t.Run("behind schedule after a month", func(t *testing.T) {
+		goal := newGoal()
+		reminder := GoalReminder(goal, at(2026, 7, 1, 9))
+
+		if assert.NotNil(t, reminder) {
+			assert.Equal(t, KindGoal, reminder.Kind)
+			assert.Contains(t, reminder.Subject, "behind schedule")
+			assert.Greater(t, reminder.Data["amount_behind"], 0.0)
+			assert.Equal(t, 10000000.0, reminder.Data["remaining_amount"])
+			assert.Contains(t, reminder.Data, "suggested_contribution")
+		}
+	})
+
+	t.Run("held until the send hour", func(t *testing.T) {
+		assert.Nil(t, GoalReminder(newGoal(), at(2026, 7, 1, 8)))
+	})
+
+	t.Run("not again within the frequency", func(t *testing.T) {
+		goal := newGoal()
+		goal.ReminderFrequency = ptr(FrequencyWeekly)
+		goal.LastReminderSentAt = ptr(at(2026, 7, 1, 9))
+
+		assert.Nil(t, GoalReminder(goal, at(2026, 7, 1, 15)))
+		assert.Nil(t, GoalReminder(goal, at(2026, 7, 7, 9)))
+		assert.NotNil(t, GoalReminder(goal, at(2026, 7, 8, 9)))
+	})
+
+	t.Run("first reminder waits a period after creation", func(t *testing.T) {
+		goal := newGoal()
+		goal.CreatedAt = at(2026, 6, 20, 10)
+
+		assert.Nil(t, GoalReminder(goal, at(2026, 7, 1, 9)))
+		assert.NotNil(t, GoalReminder(goal, at(2026, 7, 20, 9)))
+	})
+
+	t.Run("past target date", func(t *testing.T) {
+		goal := newGoal()
+		goal.Status = goalDomain.GoalStatusOverdue
+		reminder := GoalReminder(goal, at(2027, 2, 1, 9))
+
+		if assert.NotNil(t, reminder) {
+			assert.Contains(t, reminder.Subject, "past its target date")
+			assert.Equal(t, true, reminder.Data["overdue"])
+		}
+	})
+
+	t.Run("completed or disabled goals are skipped", func(t *testing.T) {
+		completed := newGoal()
+		completed.CurrentAmount = completed.TargetAmount
+		disabled := newGoal()
+		disabled.EnableReminders = false
+
+		assert.Nil(t, GoalReminder(completed, at(2026, 7, 1, 9)))
+		assert.Nil(t, GoalReminder(disabled, at(2026, 7, 1, 9)))
+	})
+}
+
+func TestDebtReminder(t *testing.T) {
+	due := time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("due soon is sent once per due date", func(t *testing.T) {
+		debt := newDebt(due)
+
+		assert.Nil(t, DebtReminder(debt, at(2026, 7, 6, 9)))
+
+		reminder := DebtReminder(debt, at(2026, 7, 7, 9))
+		if assert.NotNil(t, reminder) {
+			assert.Equal(t, "Car loan payment is due in 3 days", reminder.Subject)
+			assert.Equal(t, 3, reminder.Data["days_until_due"])
+		}
+
+		debt.LastReminderSentAt = ptr(at(2026, 7, 7, 9))
+		assert.Nil(t, DebtReminder(debt, at(2026, 7, 9, 9)))
+	})
+
+	t.Run("overdue once then at the frequency", func(t *testing.T) {
+		debt := newDebt(due)
+		debt.LastReminderSentAt = ptr(at(2026, 7, 7, 9))
+
+		reminder := DebtReminder(debt, at(2026, 7, 12, 9))
+		if assert.NotNil(t, reminder) {
+			assert.Equal(t, "Car loan payment is 2 days overdue", reminder.Subject)
+			assert.Equal(t, 2, reminder.Data["days_overdue"])
+		}
+
+		debt.LastReminderSentAt = ptr(at(2026, 7, 12, 9))
+		assert.Nil(t, DebtReminder(debt, at(2026, 7, 15, 9)))
+
+		debt.ReminderFrequency = ptr(FrequencyDaily)
+		assert.NotNil(t, DebtReminder(debt, at(2026, 7, 13, 9)))
+	})
+
+	t.Run("periodic balance only with a frequency", func(t *testing.T) {
+		debt := newDebt(due)
+		assert.Nil(t, DebtReminder(debt, at(2026, 6, 1, 9)))
+
+		debt.ReminderFrequency = ptr(FrequencyMonthly)
+		reminder := DebtReminder(debt, at(2026, 6, 1, 9))
+		if assert.NotNil(t, reminder) {
+			assert.Equal(t, "Car loan balance: 50,000,000 VND", reminder.Subject)
+		}
+	})
+
+	t.Run("money lent is worded as an expected repayment", func(t *testing.T) {
+		debt := newDebt(due)
+		debt.Direction = debtDomain.DebtDirectionLent
+		debt.CounterpartyName = ptr("Minh")
+
+		reminder := DebtReminder(debt, at(2026, 7, 12, 9))
+		if assert.NotNil(t, reminder) {
+			assert.Equal(t, "Minh's repayment is 2 days overdue", reminder.Subject)
+			assert.Equal(t, "Owed to you: 50,000,000 VND", reminder.Lines[0])
+			assert.Equal(t, "lent", reminder.Data["direction"])
+			assert.Equal(t, "Minh", reminder.Data["counterparty_name"])
+		}
+	})
+
+	t.Run("paid off debts are skipped", func(t *testing.T) {
+		debt := newDebt(due)
+		debt.CurrentBalance = 0
+		assert.Nil(t, DebtReminder(debt, at(2026, 7, 8, 9)))
+	})
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "1,500,000 VND", FormatAmount(1500000, "VND"))
+	assert.Equal(t, "999", FormatAmount(999, ""))
+	assert.Equal(t, "-12,345 USD", FormatAmount(-12345, "USD"))
+}
+
+func TestLocation(t *testing.T) {
+	assert.Equal(t, "America/New_York", Location("America/New_York").String())
+	assert.NotNil(t, Location("Not/AZone"))
+}
@@ -0,0 +1,25 @@
+package reminder
+
+import (
+	"personalfinancedss/internal/module/cashflow/reminder/service"
+
+	"go.uber.org/fx"
+)
+
+// Module provides goal and debt reminder dependencies. The reminder job runs on the
+// notification scheduler through the scheduled_jobs group.
+var Module = fx.Module("reminder",
+	fx.Provide(
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Scheduled job
+		fx.Annotate(
+			service.NewReminderJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
+	),
+)
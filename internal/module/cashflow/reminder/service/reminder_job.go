@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// reminderJobSpec runs at the top of every hour so each timezone reaches its send hour
+const reminderJobSpec = "0 0 * * * *"
+
+// reminderJob runs the reminder service on the notification scheduler
+type reminderJob struct {
+	service Service
+}
+
+// NewReminderJob creates the scheduled job that sends due goal and debt reminders
+func NewReminderJob(service Service) notificationService.ScheduledJob {
+	return &reminderJob{service: service}
+}
+
+func (j *reminderJob) Name() string {
+	return "goal_debt_reminders"
+}
+
+func (j *reminderJob) Spec() string {
+	return reminderJobSpec
+}
+
+func (j *reminderJob) Run(ctx context.Context) error {
+	_, err := j.service.SendDueReminders(ctx, time.Now())
+	return err
+}
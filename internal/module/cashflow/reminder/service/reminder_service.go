@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	debtRepository "personalfinancedss/internal/module/cashflow/debt/repository"
+	goalRepository "personalfinancedss/internal/module/cashflow/goal/repository"
+	"personalfinancedss/internal/module/cashflow/reminder/domain"
+	profileService "personalfinancedss/internal/module/identify/profile/service"
+	userRepository "personalfinancedss/internal/module/identify/user/repository"
+	notificationDomain "personalfinancedss/internal/module/notification/domain"
+	notificationService "personalfinancedss/internal/module/notification/service"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// reminderEmailTemplate is the email template used for goal and debt reminders
+const reminderEmailTemplate = "reminder.html"
+
+type reminderService struct {
+	goalRepo       goalRepository.Repository
+	debtRepo       debtRepository.Repository
+	profileService profileService.Service
+	prefService    notificationService.NotificationPreferenceService
+	notifier       notificationService.InAppNotifier
+	emailService   notificationService.EmailService
+	userRepo       userRepository.Repository
+	logger         *zap.Logger
+}
+
+// NewService creates a new reminder service
+func NewService(
+	goalRepo goalRepository.Repository,
+	debtRepo debtRepository.Repository,
+	profileSvc profileService.Service,
+	prefService notificationService.NotificationPreferenceService,
+	notifier notificationService.InAppNotifier,
+	emailService notificationService.EmailService,
+	userRepo userRepository.Repository,
+	logger *zap.Logger,
+) Service {
+	return &reminderService{
+		goalRepo:       goalRepo,
+		debtRepo:       debtRepo,
+		profileService: profileSvc,
+		prefService:    prefService,
+		notifier:       notifier,
+		emailService:   emailService,
+		userRepo:       userRepo,
+		logger:         logger,
+	}
+}
+
+// SendDueReminders evaluates every goal and debt with reminders enabled in its owner's timezone.
+// LastReminderSentAt is only moved forward when at least one channel delivered the reminder.
+func (s *reminderService) SendDueReminders(ctx context.Context, now time.Time) (int, error) {
+	goals, err := s.goalRepo.FindReminderCandidates(ctx)
+	if err != nil {
+		return 0, err
+	}
+	debts, err := s.debtRepo.FindReminderCandidates(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	locations := make(map[uuid.UUID]*time.Location)
+	sent := 0
+
+	for i := range goals {
+		goal := &goals[i]
+		reminder := domain.GoalReminder(goal, now.In(s.userLocation(ctx, goal.UserID, locations)))
+		if reminder == nil || !s.deliver(ctx, reminder, notificationDomain.NotificationTypeGoalReminder) {
+			continue
+		}
+		if err := s.goalRepo.MarkReminderSent(ctx, goal.ID, now); err != nil {
+			s.logger.Error("Failed to record goal reminder",
+				zap.String("goal_id", goal.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		sent++
+	}
+
+	for i := range debts {
+		debt := &debts[i]
+		reminder := domain.DebtReminder(debt, now.In(s.userLocation(ctx, debt.UserID, locations)))
+		if reminder == nil || !s.deliver(ctx, reminder, notificationDomain.NotificationTypeDebtReminder) {
+			continue
+		}
+		if err := s.debtRepo.MarkReminderSent(ctx, debt.ID, now); err != nil {
+			s.logger.Error("Failed to record debt reminder",
+				zap.String("debt_id", debt.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		s.logger.Info("Reminders sent", zap.Int("count", sent))
+	}
+	return sent, nil
+}
+
+// userLocation resolves the user's timezone from their profile settings, caching it per run
+func (s *reminderService) userLocation(ctx context.Context, userID uuid.UUID, cache map[uuid.UUID]*time.Location) *time.Location {
+	if loc, ok := cache[userID]; ok {
+		return loc
+	}
+
+	timezone := ""
+	if s.profileService != nil {
+		if profile, err := s.profileService.GetProfile(ctx, userID.String()); err == nil && profile != nil {
+			timezone = profile.Settings.Timezone
+		}
+	}
+
+	loc := domain.Location(timezone)
+	cache[userID] = loc
+	return loc
+}
+
+// deliver sends the reminder over the channels the user's notification preferences allow and
+// reports whether any of them succeeded
+func (s *reminderService) deliver(ctx context.Context, reminder *domain.Reminder, notifType notificationDomain.NotificationType) bool {
+	channels := []notificationDomain.NotificationChannel{notificationDomain.ChannelInApp}
+	if s.prefService != nil {
+		effective, err := s.prefService.GetEffectiveChannels(ctx, reminder.UserID.String(), notifType)
+		if err != nil {
+			s.logger.Warn("Failed to load notification channels",
+				zap.String("user_id", reminder.UserID.String()),
+				zap.Error(err),
+			)
+			return false
+		}
+		channels = effective
+	}
+
+	delivered := false
+	for _, channel := range channels {
+		var err error
+		switch channel {
+		case notificationDomain.ChannelInApp:
+			if s.notifier == nil {
+				continue
+			}
+			err = s.notifier.NotifyInApp(ctx, reminder.UserID, notifType, reminder.Subject, reminder.Data)
+		case notificationDomain.ChannelEmail:
+			if s.emailService == nil || s.userRepo == nil {
+				continue
+			}
+			err = s.sendEmail(ctx, reminder)
+		default:
+			continue
+		}
+
+		if err != nil {
+			s.logger.Warn("Failed to deliver reminder",
+				zap.String("kind", string(reminder.Kind)),
+				zap.String("entity_id", reminder.EntityID.String()),
+				zap.String("channel", string(channel)),
+				zap.Error(err),
+			)
+			continue
+		}
+		delivered = true
+	}
+
+	return delivered
+}
+
+func (s *reminderService) sendEmail(ctx context.Context, reminder *domain.Reminder) error {
+	user, err := s.userRepo.GetByID(ctx, reminder.UserID.String())
+	if err != nil {
+		return err
+	}
+
+	return s.emailService.SendCustomEmail(user.Email, reminder.Subject, reminderEmailTemplate, map[string]interface{}{
+		"Name":    user.FullName,
+		"Subject": reminder.Subject,
+		"Lines":   reminder.Lines,
+	})
+}
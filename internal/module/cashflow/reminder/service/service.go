@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// Service defines reminder operations
+type Service interface {
+	// SendDueReminders sends every goal and debt reminder due at now in its owner's timezone and
+	// returns how many were delivered
+	SendDueReminders(ctx context.Context, now time.Time) (int, error)
+}
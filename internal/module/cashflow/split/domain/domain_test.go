@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("equal split gives leftover cents to the first members", func(t *testing.T) {
+		shares, err := Split(100, SplitEqual, []ShareInput{{MemberID: a}, {MemberID: b}, {MemberID: c}})
+		require.NoError(t, err)
+		assert.Equal(t, 33.34, shares[0].Amount)
+		assert.Equal(t, 33.33, shares[1].Amount)
+		assert.Equal(t, 33.33, shares[2].Amount)
+	})
+
+	t.Run("percentage split", func(t *testing.T) {
+		shares, err := Split(300000, SplitPercentage, []ShareInput{{MemberID: a, Value: 50}, {MemberID: b, Value: 30}, {MemberID: c, Value: 20}})
+		require.NoError(t, err)
+		assert.Equal(t, 150000.0, shares[0].Amount)
+		assert.Equal(t, 90000.0, shares[1].Amount)
+		assert.Equal(t, 60000.0, shares[2].Amount)
+		assert.Equal(t, 50.0, *shares[0].Percentage)
+
+		_, err = Split(300000, SplitPercentage, []ShareInput{{MemberID: a, Value: 50}, {MemberID: b, Value: 30}})
+		assert.Error(t, err)
+	})
+
+	t.Run("exact split must add up", func(t *testing.T) {
+		shares, err := Split(500000, SplitExact, []ShareInput{{MemberID: a, Value: 200000}, {MemberID: b, Value: 300000}})
+		require.NoError(t, err)
+		assert.Equal(t, 200000.0, shares[0].Amount)
+
+		_, err = Split(500000, SplitExact, []ShareInput{{MemberID: a, Value: 200000}, {MemberID: b, Value: 200000}})
+		assert.Error(t, err)
+	})
+
+	t.Run("duplicate members are rejected", func(t *testing.T) {
+		_, err := Split(100, SplitEqual, []ShareInput{{MemberID: a}, {MemberID: a}})
+		assert.Error(t, err)
+	})
+}
+
+func TestBalancesAndSimplifyDebts(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	members := []Member{{ID: a}, {ID: b}, {ID: c}, {ID: d}}
+
+	equal := func(payer uuid.UUID, amount float64) Expense {
+		shares, _ := Split(amount, SplitEqual, []ShareInput{{MemberID: a}, {MemberID: b}, {MemberID: c}, {MemberID: d}})
+		return Expense{PaidByMemberID: payer, Amount: amount, Shares: shares}
+	}
+	expenses := []Expense{equal(a, 400000), equal(b, 200000)}
+
+	balances := Balances(members, expenses, nil)
+	assert.Equal(t, 250000.0, balances[a])
+	assert.Equal(t, 50000.0, balances[b])
+	assert.Equal(t, -150000.0, balances[c])
+	assert.Equal(t, -150000.0, balances[d])
+
+	transfers := SimplifyDebts(balances)
+	assert.Len(t, transfers, 3)
+	paid := map[uuid.UUID]float64{}
+	for _, tr := range transfers {
+		paid[tr.FromMemberID] += tr.Amount
+		paid[tr.ToMemberID] -= tr.Amount
+	}
+	assert.Equal(t, 150000.0, paid[c])
+	assert.Equal(t, 150000.0, paid[d])
+	assert.Equal(t, -250000.0, paid[a])
+	assert.Equal(t, -50000.0, paid[b])
+
+	t.Run("settlements clear balances", func(t *testing.T) {
+		var settlements []Settlement
+		for _, tr := range transfers {
+			settlements = append(settlements, Settlement{FromMemberID: tr.FromMemberID, ToMemberID: tr.ToMemberID, Amount: tr.Amount})
+		}
+		settled := Balances(members, expenses, settlements)
+		for _, m := range members {
+			assert.Zero(t, settled[m.ID])
+		}
+		assert.Empty(t, SimplifyDebts(settled))
+	})
+}
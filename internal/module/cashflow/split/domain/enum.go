@@ -0,0 +1,19 @@
+package domain
+
+// SplitMethod determines how an expense is divided between members
+type SplitMethod string
+
+const (
+	SplitEqual      SplitMethod = "equal"      // everyone in the split pays the same
+	SplitPercentage SplitMethod = "percentage" // each member pays a percentage of the amount
+	SplitExact      SplitMethod = "exact"      // each member pays a given amount
+)
+
+// IsValid checks if the split method is valid
+func (m SplitMethod) IsValid() bool {
+	switch m {
+	case SplitEqual, SplitPercentage, SplitExact:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SelfMemberName is the display name of the member representing the group's owner
+const SelfMemberName = "You"
+
+// Group is a set of people sharing expenses, such as a trip or a shared flat
+type Group struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	Name        string    `gorm:"type:varchar(255);not null;column:name" json:"name"`
+	Description *string   `gorm:"type:text;column:description" json:"description,omitempty"`
+	Currency    string    `gorm:"type:varchar(3);not null;default:'VND';column:currency" json:"currency"`
+
+	Members []Member `gorm:"foreignKey:GroupID" json:"members,omitempty"`
+
+	CreatedAt time.Time      `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
+}
+
+// TableName specifies the table name for Group
+func (Group) TableName() string {
+	return "split_groups"
+}
+
+// SelfMember returns the member representing the group's owner, or nil if missing
+func (g *Group) SelfMember() *Member {
+	for i := range g.Members {
+		if g.Members[i].IsSelf {
+			return &g.Members[i]
+		}
+	}
+	return nil
+}
+
+// Member returns the group member with the given ID, or nil if it is not in the group
+func (g *Group) Member(id uuid.UUID) *Member {
+	for i := range g.Members {
+		if g.Members[i].ID == id {
+			return &g.Members[i]
+		}
+	}
+	return nil
+}
+
+// Member is a person in a group. Exactly one member of every group is the owner (IsSelf).
+type Member struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	GroupID   uuid.UUID `gorm:"type:uuid;not null;index;column:group_id" json:"group_id"`
+	Name      string    `gorm:"type:varchar(255);not null;column:name" json:"name"`
+	Contact   *string   `gorm:"type:varchar(255);column:contact" json:"contact,omitempty"` // Phone or email
+	IsSelf    bool      `gorm:"not null;default:false;column:is_self" json:"is_self"`
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+}
+
+// TableName specifies the table name for Member
+func (Member) TableName() string {
+	return "split_members"
+}
+
+// Expense is a group expense paid by one member and split between members
+type Expense struct {
+	ID             uuid.UUID   `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	GroupID        uuid.UUID   `gorm:"type:uuid;not null;index;column:group_id" json:"group_id"`
+	UserID         uuid.UUID   `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	Description    string      `gorm:"type:varchar(255);not null;column:description" json:"description"`
+	Amount         float64     `gorm:"type:decimal(15,2);not null;column:amount" json:"amount"`
+	Currency       string      `gorm:"type:varchar(3);not null;default:'VND';column:currency" json:"currency"`
+	PaidByMemberID uuid.UUID   `gorm:"type:uuid;not null;column:paid_by_member_id" json:"paid_by_member_id"`
+	SplitMethod    SplitMethod `gorm:"type:varchar(20);not null;column:split_method" json:"split_method"`
+	ExpenseDate    time.Time   `gorm:"type:date;not null;index;column:expense_date" json:"expense_date"`
+
+	// Only the owner's share counts toward their budgets and categories
+	CategoryID *uuid.UUID `gorm:"type:uuid;column:category_id" json:"category_id,omitempty"`
+	BudgetID   *uuid.UUID `gorm:"type:uuid;column:budget_id" json:"budget_id,omitempty"`
+
+	// Transactions booked on the owner's account: their own share and, when they paid, the part paid
+	// for others or, when another member paid, what they owe that member
+	AccountID           *uuid.UUID `gorm:"type:uuid;column:account_id" json:"account_id,omitempty"`
+	ShareTransactionID  *uuid.UUID `gorm:"type:uuid;column:share_transaction_id" json:"share_transaction_id,omitempty"`
+	OthersTransactionID *uuid.UUID `gorm:"type:uuid;column:others_transaction_id" json:"others_transaction_id,omitempty"`
+
+	Shares []Share `gorm:"foreignKey:ExpenseID" json:"shares,omitempty"`
+
+	CreatedAt time.Time      `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
+}
+
+// TableName specifies the table name for Expense
+func (Expense) TableName() string {
+	return "split_expenses"
+}
+
+// ShareOf returns the amount the member owes for the expense
+func (e *Expense) ShareOf(memberID uuid.UUID) float64 {
+	for _, share := range e.Shares {
+		if share.MemberID == memberID {
+			return share.Amount
+		}
+	}
+	return 0
+}
+
+// Share is one member's portion of an expense
+type Share struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	ExpenseID  uuid.UUID `gorm:"type:uuid;not null;index;column:expense_id" json:"expense_id"`
+	MemberID   uuid.UUID `gorm:"type:uuid;not null;index;column:member_id" json:"member_id"`
+	Amount     float64   `gorm:"type:decimal(15,2);not null;column:amount" json:"amount"`
+	Percentage *float64  `gorm:"type:decimal(5,2);column:percentage" json:"percentage,omitempty"` // Set for percentage splits
+}
+
+// TableName specifies the table name for Share
+func (Share) TableName() string {
+	return "split_expense_shares"
+}
+
+// Settlement is money one member paid another to settle up
+type Settlement struct {
+	ID            uuid.UUID  `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	GroupID       uuid.UUID  `gorm:"type:uuid;not null;index;column:group_id" json:"group_id"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	FromMemberID  uuid.UUID  `gorm:"type:uuid;not null;column:from_member_id" json:"from_member_id"`
+	ToMemberID    uuid.UUID  `gorm:"type:uuid;not null;column:to_member_id" json:"to_member_id"`
+	Amount        float64    `gorm:"type:decimal(15,2);not null;column:amount" json:"amount"`
+	Currency      string     `gorm:"type:varchar(3);not null;default:'VND';column:currency" json:"currency"`
+	SettledAt     time.Time  `gorm:"type:date;not null;column:settled_at" json:"settled_at"`
+	AccountID     *uuid.UUID `gorm:"type:uuid;column:account_id" json:"account_id,omitempty"`         // Owner's account when they pay or receive
+	TransactionID *uuid.UUID `gorm:"type:uuid;column:transaction_id" json:"transaction_id,omitempty"` // Transaction booked on that account
+	Note          *string    `gorm:"type:text;column:note" json:"note,omitempty"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+}
+
+// TableName specifies the table name for Settlement
+func (Settlement) TableName() string {
+	return "split_settlements"
+}
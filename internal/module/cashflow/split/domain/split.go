@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// ShareInput is a member's requested portion of an expense: a percentage for percentage splits,
+// an amount for exact splits, and ignored for equal splits
+type ShareInput struct {
+	MemberID uuid.UUID
+	Value    float64
+}
+
+// Transfer is a payment from one member to another that settles their balances
+type Transfer struct {
+	FromMemberID uuid.UUID
+	ToMemberID   uuid.UUID
+	Amount       float64
+}
+
+// Split divides an amount between the members of inputs. Amounts are split in cents and
+// leftover cents go to the first members, so the shares always add up to the amount.
+func Split(amount float64, method SplitMethod, inputs []ShareInput) ([]Share, error) {
+	if amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	if len(inputs) == 0 {
+		return nil, errors.New("at least one member must share the expense")
+	}
+	seen := make(map[uuid.UUID]bool, len(inputs))
+	for _, in := range inputs {
+		if seen[in.MemberID] {
+			return nil, errors.New("a member can only appear once in a split")
+		}
+		seen[in.MemberID] = true
+	}
+
+	total := toCents(amount)
+	cents := make([]int64, len(inputs))
+	shares := make([]Share, len(inputs))
+
+	switch method {
+	case SplitEqual:
+		for i := range cents {
+			cents[i] = total / int64(len(inputs))
+		}
+	case SplitPercentage:
+		sum := 0.0
+		for i, in := range inputs {
+			if in.Value < 0 {
+				return nil, errors.New("percentages cannot be negative")
+			}
+			sum += in.Value
+			cents[i] = int64(math.Floor(float64(total) * in.Value / 100))
+			pct := in.Value
+			shares[i].Percentage = &pct
+		}
+		if math.Abs(sum-100) > 0.01 {
+			return nil, errors.New("percentages must add up to 100")
+		}
+	case SplitExact:
+		var sum int64
+		for i, in := range inputs {
+			if in.Value < 0 {
+				return nil, errors.New("share amounts cannot be negative")
+			}
+			cents[i] = toCents(in.Value)
+			sum += cents[i]
+		}
+		if sum != total {
+			return nil, errors.New("share amounts must add up to the expense amount")
+		}
+	default:
+		return nil, errors.New("invalid split method")
+	}
+
+	var allocated int64
+	for _, c := range cents {
+		allocated += c
+	}
+	for i := 0; allocated < total; i = (i + 1) % len(cents) {
+		cents[i]++
+		allocated++
+	}
+
+	for i, in := range inputs {
+		shares[i].MemberID = in.MemberID
+		shares[i].Amount = fromCents(cents[i])
+	}
+	return shares, nil
+}
+
+// Balances returns each member's net position in the group: positive when the others owe the
+// member, negative when the member owes the others. Members without activity have a zero balance.
+func Balances(members []Member, expenses []Expense, settlements []Settlement) map[uuid.UUID]float64 {
+	cents := make(map[uuid.UUID]int64, len(members))
+	for _, m := range members {
+		cents[m.ID] = 0
+	}
+	for _, e := range expenses {
+		cents[e.PaidByMemberID] += toCents(e.Amount)
+		for _, s := range e.Shares {
+			cents[s.MemberID] -= toCents(s.Amount)
+		}
+	}
+	for _, s := range settlements {
+		cents[s.FromMemberID] += toCents(s.Amount)
+		cents[s.ToMemberID] -= toCents(s.Amount)
+	}
+
+	balances := make(map[uuid.UUID]float64, len(cents))
+	for id, c := range cents {
+		balances[id] = fromCents(c)
+	}
+	return balances
+}
+
+// SimplifyDebts returns transfers that settle all balances. The largest debtor repeatedly pays
+// the largest creditor, so each transfer clears at least one member and a group of n members
+// never needs more than n-1 transfers.
+func SimplifyDebts(balances map[uuid.UUID]float64) []Transfer {
+	type position struct {
+		id    uuid.UUID
+		cents int64
+	}
+	var creditors, debtors []position
+	for id, b := range balances {
+		c := toCents(b)
+		switch {
+		case c > 0:
+			creditors = append(creditors, position{id, c})
+		case c < 0:
+			debtors = append(debtors, position{id, -c})
+		}
+	}
+
+	// Largest first; IDs break ties so the plan is stable
+	byAmount := func(p []position) func(i, j int) bool {
+		return func(i, j int) bool {
+			if p[i].cents != p[j].cents {
+				return p[i].cents > p[j].cents
+			}
+			return p[i].id.String() < p[j].id.String()
+		}
+	}
+
+	var transfers []Transfer
+	for len(creditors) > 0 && len(debtors) > 0 {
+		sort.Slice(creditors, byAmount(creditors))
+		sort.Slice(debtors, byAmount(debtors))
+
+		amount := creditors[0].cents
+		if debtors[0].cents < amount {
+			amount = debtors[0].cents
+		}
+		transfers = append(transfers, Transfer{
+			FromMemberID: debtors[0].id,
+			ToMemberID:   creditors[0].id,
+			Amount:       fromCents(amount),
+		})
+
+		creditors[0].cents -= amount
+		debtors[0].cents -= amount
+		if creditors[0].cents == 0 {
+			creditors = creditors[1:]
+		}
+		if debtors[0].cents == 0 {
+			debtors = debtors[1:]
+		}
+	}
+	return transfers
+}
+
+// toCents converts an amount to whole hundredths
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// fromCents converts whole hundredths back to an amount
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+// GroupBalance is a group with its members' balances and the transfers that settle them
+type GroupBalance struct {
+	Group     *Group
+	Balances  map[uuid.UUID]float64
+	Transfers []Transfer
+}
+
+// NewGroupBalance computes the balances and settle-up transfers of a group
+func NewGroupBalance(group *Group, expenses []Expense, settlements []Settlement) *GroupBalance {
+	balances := Balances(group.Members, expenses, settlements)
+	return &GroupBalance{
+		Group:     group,
+		Balances:  balances,
+		Transfers: SimplifyDebts(balances),
+	}
+}
+
+// SelfBalance returns the owner's net balance: positive when the others owe them
+func (b *GroupBalance) SelfBalance() float64 {
+	if self := b.Group.SelfMember(); self != nil {
+		return b.Balances[self.ID]
+	}
+	return 0
+}
@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+// MemberRequest represents a person added to a group
+type MemberRequest struct {
+	Name    string  `json:"name" binding:"required,max=255"`
+	Contact *string `json:"contact,omitempty" binding:"omitempty,max=255"` // Phone or email
+}
+
+// CreateGroupRequest represents a new expense group. The owner is added as a member automatically.
+type CreateGroupRequest struct {
+	Name        string          `json:"name" binding:"required,max=255"`
+	Description *string         `json:"description,omitempty"`
+	Currency    string          `json:"currency" binding:"required,len=3"`
+	Members     []MemberRequest `json:"members" binding:"omitempty,dive"`
+}
+
+// UpdateGroupRequest represents changes to a group
+type UpdateGroupRequest struct {
+	Name        *string `json:"name,omitempty" binding:"omitempty,max=255"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ShareRequest represents one member's portion of an expense
+type ShareRequest struct {
+	MemberID string  `json:"member_id" binding:"required,uuid"`
+	Value    float64 `json:"value" binding:"gte=0"` // Percentage for percentage splits, amount for exact splits, ignored for equal splits
+}
+
+// CreateExpenseRequest represents a group expense paid by one member.
+// The owner's own share is booked as a categorized transaction on the account. When the owner
+// paid, the rest is booked as an uncategorized transaction paid for the others; when another
+// member paid, what the owner owes them is booked back as an uncategorized credit.
+type CreateExpenseRequest struct {
+	Description    string         `json:"description" binding:"required,max=255"`
+	Amount         float64        `json:"amount" binding:"required,gt=0"`
+	PaidByMemberID string         `json:"paid_by_member_id" binding:"required,uuid"`
+	SplitMethod    string         `json:"split_method" binding:"required,oneof=equal percentage exact"`
+	Shares         []ShareRequest `json:"shares,omitempty" binding:"omitempty,dive"` // Defaults to an equal split between all members
+	ExpenseDate    *time.Time     `json:"expense_date,omitempty"`                    // Defaults to today
+
+	AccountID  *string `json:"account_id,omitempty" binding:"omitempty,uuid"` // Required when the owner paid or has a share
+	CategoryID *string `json:"category_id,omitempty" binding:"omitempty,uuid"`
+	BudgetID   *string `json:"budget_id,omitempty" binding:"omitempty,uuid"`
+}
+
+// CreateSettlementRequest represents a payment between two members settling their balances
+type CreateSettlementRequest struct {
+	FromMemberID string     `json:"from_member_id" binding:"required,uuid"`
+	ToMemberID   string     `json:"to_member_id" binding:"required,uuid"`
+	Amount       float64    `json:"amount" binding:"required,gt=0"`
+	SettledAt    *time.Time `json:"settled_at,omitempty"`                          // Defaults to today
+	AccountID    *string    `json:"account_id,omitempty" binding:"omitempty,uuid"` // Required when the owner pays or receives
+	Note         *string    `json:"note,omitempty"`
+}
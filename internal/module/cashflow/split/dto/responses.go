@@ -0,0 +1,300 @@
+package dto
+
+import (
+	"sort"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/split/domain"
+
+	"github.com/google/uuid"
+)
+
+// MemberResponse represents a group member and their balance
+type MemberResponse struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Contact *string   `json:"contact,omitempty"`
+	IsSelf  bool      `json:"is_self"`
+	Balance float64   `json:"balance"` // Positive when the others owe the member
+}
+
+// TransferResponse represents a payment that settles balances between two members
+type TransferResponse struct {
+	FromMemberID uuid.UUID `json:"from_member_id"`
+	FromName     string    `json:"from_name"`
+	ToMemberID   uuid.UUID `json:"to_member_id"`
+	ToName       string    `json:"to_name"`
+	Amount       float64   `json:"amount"`
+}
+
+// GroupResponse represents an expense group with running balances
+type GroupResponse struct {
+	ID          uuid.UUID          `json:"id"`
+	Name        string             `json:"name"`
+	Description *string            `json:"description,omitempty"`
+	Currency    string             `json:"currency"`
+	Members     []MemberResponse   `json:"members"`
+	YourBalance float64            `json:"your_balance"` // Positive when the others owe you
+	SettleUp    []TransferResponse `json:"settle_up"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// ShareResponse represents one member's portion of an expense
+type ShareResponse struct {
+	MemberID   uuid.UUID `json:"member_id"`
+	Amount     float64   `json:"amount"`
+	Percentage *float64  `json:"percentage,omitempty"`
+}
+
+// ExpenseResponse represents a group expense
+type ExpenseResponse struct {
+	ID                  uuid.UUID          `json:"id"`
+	GroupID             uuid.UUID          `json:"group_id"`
+	Description         string             `json:"description"`
+	Amount              float64            `json:"amount"`
+	Currency            string             `json:"currency"`
+	PaidByMemberID      uuid.UUID          `json:"paid_by_member_id"`
+	SplitMethod         domain.SplitMethod `json:"split_method"`
+	ExpenseDate         time.Time          `json:"expense_date"`
+	Shares              []ShareResponse    `json:"shares"`
+	CategoryID          *uuid.UUID         `json:"category_id,omitempty"`
+	BudgetID            *uuid.UUID         `json:"budget_id,omitempty"`
+	AccountID           *uuid.UUID         `json:"account_id,omitempty"`
+	ShareTransactionID  *uuid.UUID         `json:"share_transaction_id,omitempty"`
+	OthersTransactionID *uuid.UUID         `json:"others_transaction_id,omitempty"`
+	CreatedAt           time.Time          `json:"created_at"`
+}
+
+// SettlementResponse represents a recorded settlement
+type SettlementResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	GroupID       uuid.UUID  `json:"group_id"`
+	FromMemberID  uuid.UUID  `json:"from_member_id"`
+	ToMemberID    uuid.UUID  `json:"to_member_id"`
+	Amount        float64    `json:"amount"`
+	Currency      string     `json:"currency"`
+	SettledAt     time.Time  `json:"settled_at"`
+	AccountID     *uuid.UUID `json:"account_id,omitempty"`
+	TransactionID *uuid.UUID `json:"transaction_id,omitempty"`
+	Note          *string    `json:"note,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// PersonAmountResponse represents an amount owed between the owner and one person
+type PersonAmountResponse struct {
+	MemberID uuid.UUID `json:"member_id"`
+	Name     string    `json:"name"`
+	Amount   float64   `json:"amount"`
+}
+
+// GroupPositionResponse represents the owner's position in one group
+type GroupPositionResponse struct {
+	GroupID   uuid.UUID              `json:"group_id"`
+	GroupName string                 `json:"group_name"`
+	Currency  string                 `json:"currency"`
+	Balance   float64                `json:"balance"` // Positive when the others owe you
+	OwedToYou []PersonAmountResponse `json:"owed_to_you"`
+	YouOwe    []PersonAmountResponse `json:"you_owe"`
+}
+
+// CurrencyTotalResponse represents the amounts owed to and by the owner in one currency
+type CurrencyTotalResponse struct {
+	Currency  string  `json:"currency"`
+	OwedToYou float64 `json:"owed_to_you"`
+	YouOwe    float64 `json:"you_owe"`
+}
+
+// BalanceSummaryResponse represents what the owner is owed and owes across all groups
+type BalanceSummaryResponse struct {
+	Groups []GroupPositionResponse `json:"groups"`
+	Totals []CurrencyTotalResponse `json:"totals"`
+}
+
+// ToMemberResponse converts a member without activity to a response
+func ToMemberResponse(member *domain.Member) MemberResponse {
+	return MemberResponse{
+		ID:      member.ID,
+		Name:    member.Name,
+		Contact: member.Contact,
+		IsSelf:  member.IsSelf,
+	}
+}
+
+// ToGroupResponse converts a group and its balances to a response
+func ToGroupResponse(b *domain.GroupBalance) GroupResponse {
+	group := b.Group
+	members := make([]MemberResponse, len(group.Members))
+	for i, m := range group.Members {
+		members[i] = MemberResponse{
+			ID:      m.ID,
+			Name:    m.Name,
+			Contact: m.Contact,
+			IsSelf:  m.IsSelf,
+			Balance: b.Balances[m.ID],
+		}
+	}
+
+	return GroupResponse{
+		ID:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		Currency:    group.Currency,
+		Members:     members,
+		YourBalance: b.SelfBalance(),
+		SettleUp:    ToTransferResponses(group, b.Transfers),
+		CreatedAt:   group.CreatedAt,
+		UpdatedAt:   group.UpdatedAt,
+	}
+}
+
+// ToGroupResponses converts groups and their balances to responses
+func ToGroupResponses(balances []domain.GroupBalance) []GroupResponse {
+	responses := make([]GroupResponse, len(balances))
+	for i := range balances {
+		responses[i] = ToGroupResponse(&balances[i])
+	}
+	return responses
+}
+
+// ToTransferResponses converts transfers to responses with member names
+func ToTransferResponses(group *domain.Group, transfers []domain.Transfer) []TransferResponse {
+	responses := make([]TransferResponse, len(transfers))
+	for i, t := range transfers {
+		responses[i] = TransferResponse{
+			FromMemberID: t.FromMemberID,
+			FromName:     memberName(group, t.FromMemberID),
+			ToMemberID:   t.ToMemberID,
+			ToName:       memberName(group, t.ToMemberID),
+			Amount:       t.Amount,
+		}
+	}
+	return responses
+}
+
+// ToExpenseResponse converts an expense to a response
+func ToExpenseResponse(expense *domain.Expense) ExpenseResponse {
+	shares := make([]ShareResponse, len(expense.Shares))
+	for i, s := range expense.Shares {
+		shares[i] = ShareResponse{
+			MemberID:   s.MemberID,
+			Amount:     s.Amount,
+			Percentage: s.Percentage,
+		}
+	}
+
+	return ExpenseResponse{
+		ID:                  expense.ID,
+		GroupID:             expense.GroupID,
+		Description:         expense.Description,
+		Amount:              expense.Amount,
+		Currency:            expense.Currency,
+		PaidByMemberID:      expense.PaidByMemberID,
+		SplitMethod:         expense.SplitMethod,
+		ExpenseDate:         expense.ExpenseDate,
+		Shares:              shares,
+		CategoryID:          expense.CategoryID,
+		BudgetID:            expense.BudgetID,
+		AccountID:           expense.AccountID,
+		ShareTransactionID:  expense.ShareTransactionID,
+		OthersTransactionID: expense.OthersTransactionID,
+		CreatedAt:           expense.CreatedAt,
+	}
+}
+
+// ToExpenseResponses converts expenses to responses
+func ToExpenseResponses(expenses []domain.Expense) []ExpenseResponse {
+	responses := make([]ExpenseResponse, len(expenses))
+	for i := range expenses {
+		responses[i] = ToExpenseResponse(&expenses[i])
+	}
+	return responses
+}
+
+// ToSettlementResponse converts a settlement to a response
+func ToSettlementResponse(settlement *domain.Settlement) SettlementResponse {
+	return SettlementResponse{
+		ID:            settlement.ID,
+		GroupID:       settlement.GroupID,
+		FromMemberID:  settlement.FromMemberID,
+		ToMemberID:    settlement.ToMemberID,
+		Amount:        settlement.Amount,
+		Currency:      settlement.Currency,
+		SettledAt:     settlement.SettledAt,
+		AccountID:     settlement.AccountID,
+		TransactionID: settlement.TransactionID,
+		Note:          settlement.Note,
+		CreatedAt:     settlement.CreatedAt,
+	}
+}
+
+// ToSettlementResponses converts settlements to responses
+func ToSettlementResponses(settlements []domain.Settlement) []SettlementResponse {
+	responses := make([]SettlementResponse, len(settlements))
+	for i := range settlements {
+		responses[i] = ToSettlementResponse(&settlements[i])
+	}
+	return responses
+}
+
+// ToBalanceSummaryResponse converts the owner's groups to what they are owed and owe per person,
+// using each group's settle-up transfers, with totals per currency
+func ToBalanceSummaryResponse(balances []domain.GroupBalance) BalanceSummaryResponse {
+	summary := BalanceSummaryResponse{
+		Groups: make([]GroupPositionResponse, 0, len(balances)),
+		Totals: []CurrencyTotalResponse{},
+	}
+	totals := make(map[string]*CurrencyTotalResponse)
+
+	for i := range balances {
+		b := &balances[i]
+		self := b.Group.SelfMember()
+		if self == nil {
+			continue
+		}
+
+		position := GroupPositionResponse{
+			GroupID:   b.Group.ID,
+			GroupName: b.Group.Name,
+			Currency:  b.Group.Currency,
+			Balance:   b.SelfBalance(),
+			OwedToYou: []PersonAmountResponse{},
+			YouOwe:    []PersonAmountResponse{},
+		}
+		total, ok := totals[b.Group.Currency]
+		if !ok {
+			total = &CurrencyTotalResponse{Currency: b.Group.Currency}
+			totals[b.Group.Currency] = total
+		}
+
+		for _, t := range b.Transfers {
+			switch self.ID {
+			case t.ToMemberID:
+				position.OwedToYou = append(position.OwedToYou, PersonAmountResponse{
+					MemberID: t.FromMemberID, Name: memberName(b.Group, t.FromMemberID), Amount: t.Amount,
+				})
+				total.OwedToYou += t.Amount
+			case t.FromMemberID:
+				position.YouOwe = append(position.YouOwe, PersonAmountResponse{
+					MemberID: t.ToMemberID, Name: memberName(b.Group, t.ToMemberID), Amount: t.Amount,
+				})
+				total.YouOwe += t.Amount
+			}
+		}
+		summary.Groups = append(summary.Groups, position)
+	}
+
+	for _, total := range totals {
+		summary.Totals = append(summary.Totals, *total)
+	}
+	sort.Slice(summary.Totals, func(i, j int) bool { return summary.Totals[i].Currency < summary.Totals[j].Currency })
+	return summary
+}
+
+// memberName returns the name of a group member, or an empty string if it is not in the group
+func memberName(group *domain.Group, id uuid.UUID) string {
+	if m := group.Member(id); m != nil {
+		return m.Name
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package split
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/split/handler"
+	"personalfinancedss/internal/module/cashflow/split/repository"
+	"personalfinancedss/internal/module/cashflow/split/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides shared expense group dependencies
+var Module = fx.Module("split",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Handler
+		handler.NewHandler,
+	),
+	fx.Invoke(registerSplitRoutes),
+)
+
+func registerSplitRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
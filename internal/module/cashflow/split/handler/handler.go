@@ -0,0 +1,485 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/split/dto"
+	"personalfinancedss/internal/module/cashflow/split/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages shared expense group endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs a shared expense group handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("split.handler"),
+	}
+}
+
+// RegisterRoutes wires shared expense group routes under /api/v1/split-groups.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	groups := r.Group("/api/v1/split-groups")
+	groups.Use(authMiddleware.AuthMiddleware())
+	{
+		groups.POST("", h.createGroup)
+		groups.GET("", h.listGroups)
+		groups.GET("/balances", h.getBalanceSummary)
+		groups.GET("/:id", h.getGroup)
+		groups.PUT("/:id", h.updateGroup)
+		groups.DELETE("/:id", h.deleteGroup)
+		groups.POST("/:id/members", h.addMember)
+		groups.POST("/:id/expenses", h.addExpense)
+		groups.GET("/:id/expenses", h.listExpenses)
+		groups.DELETE("/:id/expenses/:expenseId", h.deleteExpense)
+		groups.POST("/:id/settlements", h.recordSettlement)
+		groups.GET("/:id/settlements", h.listSettlements)
+	}
+}
+
+// createGroup godoc
+// @Summary Create expense group
+// @Description Create a group of people sharing expenses, such as a trip or roommates. You are added as a member automatically
+// @Tags split-groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateGroupRequest true "Group details"
+// @Success 201 {object} dto.GroupResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups [post]
+func (h *Handler) createGroup(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.CreateGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	group, err := h.service.CreateGroup(c.Request.Context(), currentUser.ID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Expense group created successfully", dto.ToGroupResponse(group))
+}
+
+// listGroups godoc
+// @Summary List expense groups
+// @Description List the user's expense groups with running balances and settle-up plans
+// @Tags split-groups
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.GroupResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups [get]
+func (h *Handler) listGroups(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groups, err := h.service.ListGroups(c.Request.Context(), currentUser.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Expense groups retrieved successfully", dto.ToGroupResponses(groups))
+}
+
+// getBalanceSummary godoc
+// @Summary Get shared expense balances
+// @Description What you are owed and what you owe per person across all groups, with totals per currency
+// @Tags split-groups
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.BalanceSummaryResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/balances [get]
+func (h *Handler) getBalanceSummary(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groups, err := h.service.ListGroups(c.Request.Context(), currentUser.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Shared expense balances retrieved successfully", dto.ToBalanceSummaryResponse(groups))
+}
+
+// getGroup godoc
+// @Summary Get expense group
+// @Description Get a group with each member's running balance and the fewest transfers that settle the group
+// @Tags split-groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Success 200 {object} dto.GroupResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id} [get]
+func (h *Handler) getGroup(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+
+	group, err := h.service.GetGroup(c.Request.Context(), currentUser.ID, groupID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Expense group retrieved successfully", dto.ToGroupResponse(group))
+}
+
+// updateGroup godoc
+// @Summary Update expense group
+// @Description Change a group's name or description
+// @Tags split-groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param request body dto.UpdateGroupRequest true "Changes"
+// @Success 200 {object} dto.GroupResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id} [put]
+func (h *Handler) updateGroup(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+
+	var req dto.UpdateGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	group, err := h.service.UpdateGroup(c.Request.Context(), currentUser.ID, groupID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Expense group updated successfully", dto.ToGroupResponse(group))
+}
+
+// deleteGroup godoc
+// @Summary Delete expense group
+// @Description Delete a group and its expenses; transactions already booked are kept
+// @Tags split-groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id} [delete]
+func (h *Handler) deleteGroup(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+
+	if err := h.service.DeleteGroup(c.Request.Context(), currentUser.ID, groupID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Expense group deleted successfully")
+}
+
+// addMember godoc
+// @Summary Add group member
+// @Description Add a person to an expense group
+// @Tags split-groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param request body dto.MemberRequest true "Member"
+// @Success 201 {object} dto.MemberResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id}/members [post]
+func (h *Handler) addMember(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+
+	var req dto.MemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	member, err := h.service.AddMember(c.Request.Context(), currentUser.ID, groupID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Member added successfully", dto.ToMemberResponse(member))
+}
+
+// addExpense godoc
+// @Summary Add group expense
+// @Description Record an expense paid by one member and split equally, by percentage or by exact amounts. Your own share is booked on your account with the category and budget. When you paid, the rest is booked as paid for the others; when someone else paid, what you owe them is booked back so your balance only changes when you settle up
+// @Tags split-groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param request body dto.CreateExpenseRequest true "Expense"
+// @Success 201 {object} dto.ExpenseResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id}/expenses [post]
+func (h *Handler) addExpense(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+
+	var req dto.CreateExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	expense, err := h.service.AddExpense(c.Request.Context(), currentUser.ID, groupID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Expense added successfully", dto.ToExpenseResponse(expense))
+}
+
+// listExpenses godoc
+// @Summary List group expenses
+// @Description List a group's expenses with each member's share, newest first
+// @Tags split-groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Success 200 {array} dto.ExpenseResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id}/expenses [get]
+func (h *Handler) listExpenses(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+
+	expenses, err := h.service.ListExpenses(c.Request.Context(), currentUser.ID, groupID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Expenses retrieved successfully", dto.ToExpenseResponses(expenses))
+}
+
+// deleteExpense godoc
+// @Summary Delete group expense
+// @Description Remove an expense from a group; the transactions booked for it are reversed
+// @Tags split-groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param expenseId path string true "Expense ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id}/expenses/{expenseId} [delete]
+func (h *Handler) deleteExpense(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+	expenseID, err := uuid.Parse(c.Param("expenseId"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid expense id")
+		return
+	}
+
+	if err := h.service.DeleteExpense(c.Request.Context(), currentUser.ID, groupID, expenseID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Expense deleted successfully")
+}
+
+// recordSettlement godoc
+// @Summary Settle up
+// @Description Record a payment between two members. When you pay or receive, a transaction linked to the group is booked on your account
+// @Tags split-groups
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Param request body dto.CreateSettlementRequest true "Settlement"
+// @Success 201 {object} dto.SettlementResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id}/settlements [post]
+func (h *Handler) recordSettlement(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+
+	var req dto.CreateSettlementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	settlement, err := h.service.RecordSettlement(c.Request.Context(), currentUser.ID, groupID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Settlement recorded successfully", dto.ToSettlementResponse(settlement))
+}
+
+// listSettlements godoc
+// @Summary List group settlements
+// @Description List the payments recorded between a group's members, newest first
+// @Tags split-groups
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Group ID"
+// @Success 200 {array} dto.SettlementResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/split-groups/{id}/settlements [get]
+func (h *Handler) listSettlements(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid group id")
+		return
+	}
+
+	settlements, err := h.service.ListSettlements(c.Request.Context(), currentUser.ID, groupID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Settlements retrieved successfully", dto.ToSettlementResponses(settlements))
+}
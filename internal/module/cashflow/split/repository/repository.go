@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	"personalfinancedss/internal/module/cashflow/split/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Repository defines data access for expense groups
+type Repository interface {
+	// CreateGroup creates a group together with its members
+	CreateGroup(ctx context.Context, group *domain.Group) error
+
+	// UpdateGroup saves a group's own fields
+	UpdateGroup(ctx context.Context, group *domain.Group) error
+
+	// GetGroupByID retrieves a group with its members
+	GetGroupByID(ctx context.Context, id uuid.UUID) (*domain.Group, error)
+
+	// ListGroupsByUserID retrieves a user's groups with their members, newest first
+	ListGroupsByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Group, error)
+
+	// DeleteGroup soft-deletes a group and its expenses
+	DeleteGroup(ctx context.Context, id uuid.UUID) error
+
+	// CreateMember adds a member to a group
+	CreateMember(ctx context.Context, member *domain.Member) error
+
+	// CreateExpense creates an expense together with its shares
+	CreateExpense(ctx context.Context, expense *domain.Expense) error
+
+	// CreateExpenseWithTx creates an expense with its shares within an existing database transaction
+	CreateExpenseWithTx(tx *gorm.DB, expense *domain.Expense) error
+
+	// UpdateExpense saves an expense's own fields
+	UpdateExpense(ctx context.Context, expense *domain.Expense) error
+
+	// UpdateExpenseWithTx saves an expense's own fields within an existing database transaction
+	UpdateExpenseWithTx(tx *gorm.DB, expense *domain.Expense) error
+
+	// GetExpenseByID retrieves an expense with its shares
+	GetExpenseByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error)
+
+	// ListExpensesByGroupID retrieves a group's expenses with their shares, newest first
+	ListExpensesByGroupID(ctx context.Context, groupID uuid.UUID) ([]domain.Expense, error)
+
+	// DeleteExpense soft-deletes an expense
+	DeleteExpense(ctx context.Context, id uuid.UUID) error
+
+	// DeleteExpenseWithTx soft-deletes an expense within an existing database transaction
+	DeleteExpenseWithTx(tx *gorm.DB, id uuid.UUID) error
+
+	// CreateSettlement records a settlement
+	CreateSettlement(ctx context.Context, settlement *domain.Settlement) error
+
+	// CreateSettlementWithTx records a settlement within an existing database transaction
+	CreateSettlementWithTx(tx *gorm.DB, settlement *domain.Settlement) error
+
+	// ListSettlementsByGroupID retrieves a group's settlements, newest first
+	ListSettlementsByGroupID(ctx context.Context, groupID uuid.UUID) ([]domain.Settlement, error)
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new expense group repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateGroup(ctx context.Context, group *domain.Group) error {
+	return r.db.WithContext(ctx).Create(group).Error
+}
+
+func (r *repository) UpdateGroup(ctx context.Context, group *domain.Group) error {
+	return r.db.WithContext(ctx).Omit("Members").Save(group).Error
+}
+
+func (r *repository) GetGroupByID(ctx context.Context, id uuid.UUID) (*domain.Group, error) {
+	var group domain.Group
+	err := r.db.WithContext(ctx).
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("is_self DESC, created_at ASC") }).
+		First(&group, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *repository) ListGroupsByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Group, error) {
+	var groups []domain.Group
+	err := r.db.WithContext(ctx).
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("is_self DESC, created_at ASC") }).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&groups).Error
+	return groups, err
+}
+
+func (r *repository) DeleteGroup(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&domain.Expense{}, "group_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Group{}, "id = ?", id).Error
+	})
+}
+
+func (r *repository) CreateMember(ctx context.Context, member *domain.Member) error {
+	return r.db.WithContext(ctx).Create(member).Error
+}
+
+func (r *repository) CreateExpense(ctx context.Context, expense *domain.Expense) error {
+	return r.CreateExpenseWithTx(r.db.WithContext(ctx), expense)
+}
+
+func (r *repository) CreateExpenseWithTx(tx *gorm.DB, expense *domain.Expense) error {
+	return tx.Create(expense).Error
+}
+
+func (r *repository) UpdateExpense(ctx context.Context, expense *domain.Expense) error {
+	return r.UpdateExpenseWithTx(r.db.WithContext(ctx), expense)
+}
+
+func (r *repository) UpdateExpenseWithTx(tx *gorm.DB, expense *domain.Expense) error {
+	return tx.Omit("Shares").Save(expense).Error
+}
+
+func (r *repository) GetExpenseByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error) {
+	var expense domain.Expense
+	if err := r.db.WithContext(ctx).Preload("Shares").First(&expense, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &expense, nil
+}
+
+func (r *repository) ListExpensesByGroupID(ctx context.Context, groupID uuid.UUID) ([]domain.Expense, error) {
+	var expenses []domain.Expense
+	err := r.db.WithContext(ctx).
+		Preload("Shares").
+		Where("group_id = ?", groupID).
+		Order("expense_date DESC, created_at DESC").
+		Find(&expenses).Error
+	return expenses, err
+}
+
+func (r *repository) DeleteExpense(ctx context.Context, id uuid.UUID) error {
+	return r.DeleteExpenseWithTx(r.db.WithContext(ctx), id)
+}
+
+func (r *repository) DeleteExpenseWithTx(tx *gorm.DB, id uuid.UUID) error {
+	return tx.Delete(&domain.Expense{}, "id = ?", id).Error
+}
+
+func (r *repository) CreateSettlement(ctx context.Context, settlement *domain.Settlement) error {
+	return r.CreateSettlementWithTx(r.db.WithContext(ctx), settlement)
+}
+
+func (r *repository) CreateSettlementWithTx(tx *gorm.DB, settlement *domain.Settlement) error {
+	return tx.Create(settlement).Error
+}
+
+func (r *repository) ListSettlementsByGroupID(ctx context.Context, groupID uuid.UUID) ([]domain.Settlement, error) {
+	var settlements []domain.Settlement
+	err := r.db.WithContext(ctx).
+		Where("group_id = ?", groupID).
+		Order("settled_at DESC, created_at DESC").
+		Find(&settlements).Error
+	return settlements, err
+}
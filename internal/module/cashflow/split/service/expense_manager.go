@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	"personalfinancedss/internal/module/cashflow/split/dto"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AddExpense records an expense paid by one member and split between members.
+// The owner's own share is booked on their account with the expense's category and budget, so it
+// counts as their spending whoever paid. When the owner paid, the part paid for the others leaves
+// the account as well; when another member paid, what the owner now owes comes back in against
+// the share, so the account only changes once they settle up. The expense and its transactions
+// are stored in one database transaction.
+func (s *splitService) AddExpense(ctx context.Context, userID, groupID uuid.UUID, req dto.CreateExpenseRequest) (*domain.Expense, error) {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	payerID, err := parseID("paid_by_member_id", req.PaidByMemberID)
+	if err != nil {
+		return nil, err
+	}
+	payer := group.Member(payerID)
+	if payer == nil {
+		return nil, shared.ErrBadRequest.WithDetails("paid_by_member_id", "member not found in group")
+	}
+
+	method := domain.SplitMethod(req.SplitMethod)
+	inputs, err := shareInputs(group, method, req.Shares)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := domain.Split(req.Amount, method, inputs)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("shares", err.Error())
+	}
+
+	categoryID, err := parseOptionalID("category_id", req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	budgetID, err := parseOptionalID("budget_id", req.BudgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	expense := &domain.Expense{
+		ID:             uuid.New(),
+		GroupID:        group.ID,
+		UserID:         userID,
+		Description:    req.Description,
+		Amount:         req.Amount,
+		Currency:       group.Currency,
+		PaidByMemberID: payer.ID,
+		SplitMethod:    method,
+		ExpenseDate:    time.Now(),
+		Shares:         shares,
+		CategoryID:     categoryID,
+		BudgetID:       budgetID,
+	}
+	if req.ExpenseDate != nil {
+		expense.ExpenseDate = *req.ExpenseDate
+	}
+	for i := range expense.Shares {
+		expense.Shares[i].ExpenseID = expense.ID
+	}
+
+	if expense.BudgetID != nil {
+		if _, err := s.budgetService.GetBudgetByIDForUser(ctx, *expense.BudgetID, userID); err != nil {
+			return nil, shared.ErrBadRequest.WithDetails("budget_id", "budget not found")
+		}
+	}
+
+	var account *accountDomain.Account
+	if self := group.SelfMember(); payer.IsSelf || (self != nil && expense.ShareOf(self.ID) > 0) {
+		if req.AccountID == nil {
+			return nil, shared.ErrBadRequest.WithDetails("account_id", "required when you paid the expense or have a share of it")
+		}
+		account, err = s.getAccount(ctx, userID, group, *req.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		expense.AccountID = &account.ID
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.repo.CreateExpenseWithTx(tx, expense); err != nil {
+			return err
+		}
+		if account == nil {
+			return nil
+		}
+		if err := s.bookExpenseWithTx(tx, group, expense, account); err != nil {
+			return err
+		}
+		return s.repo.UpdateExpenseWithTx(tx, expense)
+	}); err != nil {
+		s.logger.Error("Failed to record group expense",
+			zap.String("group_id", group.ID.String()),
+			zap.Error(err),
+		)
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	if expense.ShareTransactionID != nil {
+		s.recalculateBudget(ctx, userID, expense)
+	}
+
+	s.logger.Info("Group expense recorded",
+		zap.String("group_id", group.ID.String()),
+		zap.String("expense_id", expense.ID.String()),
+		zap.Float64("amount", expense.Amount),
+		zap.String("split_method", string(method)),
+	)
+
+	return expense, nil
+}
+
+// ListExpenses retrieves a group's expenses, newest first
+func (s *splitService) ListExpenses(ctx context.Context, userID, groupID uuid.UUID) ([]domain.Expense, error) {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.repo.ListExpensesByGroupID(ctx, group.ID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return expenses, nil
+}
+
+// DeleteExpense removes an expense from a group. The transactions booked for it are deleted and
+// taken back out of the account balance in the same database transaction.
+func (s *splitService) DeleteExpense(ctx context.Context, userID, groupID, expenseID uuid.UUID) error {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return err
+	}
+
+	expense, err := s.repo.GetExpenseByID(ctx, expenseID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return err
+		}
+		return shared.ErrInternal.WithError(err)
+	}
+	if expense.GroupID != group.ID {
+		return shared.ErrNotFound
+	}
+
+	var booked []*transactionDomain.Transaction
+	for _, id := range []*uuid.UUID{expense.ShareTransactionID, expense.OthersTransactionID} {
+		if id == nil {
+			continue
+		}
+		txn, err := s.transactionRepo.GetByID(ctx, *id)
+		if err != nil {
+			// Already deleted from the transaction list, together with its balance change
+			if err == shared.ErrNotFound {
+				continue
+			}
+			return shared.ErrInternal.WithError(err)
+		}
+		booked = append(booked, txn)
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, txn := range booked {
+			if err := s.reverseWithTx(tx, txn); err != nil {
+				return err
+			}
+		}
+		return s.repo.DeleteExpenseWithTx(tx, expense.ID)
+	}); err != nil {
+		s.logger.Error("Failed to delete group expense",
+			zap.String("expense_id", expense.ID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrInternal.WithError(err)
+	}
+
+	if expense.ShareTransactionID != nil {
+		s.recalculateBudget(ctx, userID, expense)
+	}
+
+	s.logger.Info("Group expense deleted",
+		zap.String("group_id", group.ID.String()),
+		zap.String("expense_id", expense.ID.String()),
+		zap.Int("transactions_reversed", len(booked)),
+	)
+
+	return nil
+}
+
+// bookExpenseWithTx books the owner's part of an expense within an existing database transaction:
+// their own share with the expense's category and budget, then, when they paid, the part paid for
+// the others, or, when another member paid, what the owner owes that member; neither of the latter
+// is categorized
+func (s *splitService) bookExpenseWithTx(tx *gorm.DB, group *domain.Group, expense *domain.Expense, account *accountDomain.Account) error {
+	self := group.SelfMember()
+	ownShare := expense.ShareOf(self.ID)
+	key := "split_expense:" + expense.ID.String()
+
+	if ownShare > 0 {
+		links := transactionDomain.TransactionLinks{groupLink(group)}
+		if expense.BudgetID != nil {
+			links = append(links, transactionDomain.TransactionLink{Type: transactionDomain.LinkBudget, ID: expense.BudgetID.String()})
+		}
+		id, err := s.bookWithTx(tx, posting{
+			userID:      expense.UserID,
+			account:     account,
+			direction:   transactionDomain.DirectionDebit,
+			amount:      ownShare,
+			date:        expense.ExpenseDate,
+			externalID:  key + ":share",
+			description: expense.Description,
+			categoryID:  expense.CategoryID,
+			links:       links,
+		})
+		if err != nil {
+			return err
+		}
+		expense.ShareTransactionID = &id
+	}
+
+	if expense.PaidByMemberID != self.ID {
+		if ownShare <= 0 {
+			return nil
+		}
+		payer := group.Member(expense.PaidByMemberID)
+		id, err := s.bookWithTx(tx, posting{
+			userID:       expense.UserID,
+			account:      account,
+			direction:    transactionDomain.DirectionCredit,
+			amount:       ownShare,
+			date:         expense.ExpenseDate,
+			externalID:   key + ":owed",
+			description:  fmt.Sprintf("%s (paid by %s, %s)", expense.Description, payer.Name, group.Name),
+			links:        transactionDomain.TransactionLinks{groupLink(group)},
+			counterparty: &transactionDomain.Counterparty{Name: payer.Name, Type: "PERSON"},
+		})
+		if err != nil {
+			return err
+		}
+		expense.OthersTransactionID = &id
+		return nil
+	}
+
+	if forOthers := expense.Amount - ownShare; forOthers > 0 {
+		id, err := s.bookWithTx(tx, posting{
+			userID:      expense.UserID,
+			account:     account,
+			direction:   transactionDomain.DirectionDebit,
+			amount:      forOthers,
+			date:        expense.ExpenseDate,
+			externalID:  key + ":others",
+			description: fmt.Sprintf("%s (paid for %s)", expense.Description, group.Name),
+			links:       transactionDomain.TransactionLinks{groupLink(group)},
+		})
+		if err != nil {
+			return err
+		}
+		expense.OthersTransactionID = &id
+	}
+
+	return nil
+}
+
+// recalculateBudget refreshes the spending of the budget an expense's share was booked against
+func (s *splitService) recalculateBudget(ctx context.Context, userID uuid.UUID, expense *domain.Expense) {
+	if expense.BudgetID == nil {
+		return
+	}
+	if err := s.budgetService.RecalculateBudgetSpendingForUser(ctx, *expense.BudgetID, userID); err != nil {
+		s.logger.Warn("Failed to recalculate budget after group expense",
+			zap.String("budget_id", expense.BudgetID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// shareInputs resolves the members of a split. Without shares the expense is split equally
+// between all members.
+func shareInputs(group *domain.Group, method domain.SplitMethod, shares []dto.ShareRequest) ([]domain.ShareInput, error) {
+	if len(shares) == 0 {
+		if method != domain.SplitEqual {
+			return nil, shared.ErrBadRequest.WithDetails("shares", "required for percentage and exact splits")
+		}
+		inputs := make([]domain.ShareInput, len(group.Members))
+		for i, m := range group.Members {
+			inputs[i] = domain.ShareInput{MemberID: m.ID}
+		}
+		return inputs, nil
+	}
+
+	inputs := make([]domain.ShareInput, len(shares))
+	for i, share := range shares {
+		memberID, err := parseID("shares", share.MemberID)
+		if err != nil {
+			return nil, err
+		}
+		member := group.Member(memberID)
+		if member == nil {
+			return nil, shared.ErrBadRequest.WithDetails("shares", "member not found in group: "+share.MemberID)
+		}
+		inputs[i] = domain.ShareInput{MemberID: member.ID, Value: share.Value}
+	}
+	return inputs, nil
+}
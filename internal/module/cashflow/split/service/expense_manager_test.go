@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	"personalfinancedss/internal/module/cashflow/split/dto"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func expenseRequest(env *testEnv, paidBy uuid.UUID, amount float64) dto.CreateExpenseRequest {
+	accountID := env.account.ID.String()
+	categoryID := uuid.New().String()
+	budgetID := uuid.New().String()
+	return dto.CreateExpenseRequest{
+		Description:    "Dinner",
+		Amount:         amount,
+		PaidByMemberID: paidBy.String(),
+		SplitMethod:    string(domain.SplitEqual),
+		AccountID:      &accountID,
+		CategoryID:     &categoryID,
+		BudgetID:       &budgetID,
+	}
+}
+
+func TestAddExpense_PaidBySelf(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.repo.On("CreateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+	env.repo.On("UpdateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+
+	expense, err := env.service.AddExpense(ctx, env.userID, env.group.ID, expenseRequest(env, env.self.ID, 300000))
+
+	require.NoError(t, err)
+	share := env.transactions.booked[*expense.ShareTransactionID]
+	others := env.transactions.booked[*expense.OthersTransactionID]
+	assert.Equal(t, int64(150000), share.Amount)
+	assert.Equal(t, expense.CategoryID, share.UserCategoryID)
+	assert.Equal(t, int64(150000), others.Amount)
+	assert.Nil(t, others.UserCategoryID)
+	assert.Equal(t, transactionDomain.DirectionDebit, others.Direction)
+	assert.Equal(t, []float64{-150000, -150000}, env.balanceChanges(t))
+	assert.Equal(t, []uuid.UUID{*expense.BudgetID}, env.budgets.recalculated)
+}
+
+func TestAddExpense_PaidByOtherMemberBooksOwnShare(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.repo.On("CreateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+	env.repo.On("UpdateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+
+	expense, err := env.service.AddExpense(ctx, env.userID, env.group.ID, expenseRequest(env, env.friend.ID, 300000))
+
+	require.NoError(t, err)
+	// The share counts as spending; what is owed to Lan offsets it until settling up
+	share := env.transactions.booked[*expense.ShareTransactionID]
+	owed := env.transactions.booked[*expense.OthersTransactionID]
+	assert.Equal(t, expense.CategoryID, share.UserCategoryID)
+	assert.Equal(t, transactionDomain.DirectionDebit, share.Direction)
+	assert.Equal(t, int64(150000), share.Amount)
+	assert.Equal(t, transactionDomain.DirectionCredit, owed.Direction)
+	assert.Equal(t, int64(150000), owed.Amount)
+	assert.Nil(t, owed.UserCategoryID)
+	assert.Equal(t, "Lan", owed.Counterparty.Name)
+	assert.Equal(t, []float64{-150000, 150000}, env.balanceChanges(t))
+	assert.Equal(t, []uuid.UUID{*expense.BudgetID}, env.budgets.recalculated)
+}
+
+func TestAddExpense_NoShareBooksNothing(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.repo.On("CreateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+	req := expenseRequest(env, env.friend.ID, 300000)
+	req.AccountID = nil
+	req.SplitMethod = string(domain.SplitExact)
+	req.Shares = []dto.ShareRequest{{MemberID: env.friend.ID.String(), Value: 300000}}
+
+	expense, err := env.service.AddExpense(ctx, env.userID, env.group.ID, req)
+
+	require.NoError(t, err)
+	assert.Nil(t, expense.ShareTransactionID)
+	assert.Empty(t, env.transactions.booked)
+	assert.Empty(t, env.budgets.recalculated)
+	env.repo.AssertNotCalled(t, "UpdateExpenseWithTx", mock.Anything, mock.Anything)
+}
+
+func TestAddExpense_RequiresAccountForOwnShare(t *testing.T) {
+	env := newTestEnv(t)
+	req := expenseRequest(env, env.friend.ID, 300000)
+	req.AccountID = nil
+
+	_, err := env.service.AddExpense(context.Background(), env.userID, env.group.ID, req)
+
+	assert.ErrorIs(t, err, shared.ErrBadRequest)
+	env.repo.AssertNotCalled(t, "CreateExpenseWithTx", mock.Anything, mock.Anything)
+}
+
+func TestAddExpense_FailureRollsBackBookings(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.repo.On("CreateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+	env.repo.On("UpdateExpenseWithTx", mock.Anything, mock.Anything).Return(errors.New("connection reset"))
+
+	_, err := env.service.AddExpense(ctx, env.userID, env.group.ID, expenseRequest(env, env.self.ID, 300000))
+
+	assert.ErrorIs(t, err, shared.ErrInternal)
+	assert.Len(t, env.transactions.booked, 2)
+	assert.Zero(t, env.bookedCount(t))
+	assert.Empty(t, env.balanceChanges(t))
+	assert.Empty(t, env.budgets.recalculated)
+}
+
+func TestDeleteExpense_ReversesBookedTransactions(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.repo.On("CreateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+	env.repo.On("UpdateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+	expense, err := env.service.AddExpense(ctx, env.userID, env.group.ID, expenseRequest(env, env.self.ID, 300000))
+	require.NoError(t, err)
+	env.repo.On("GetExpenseByID", ctx, expense.ID).Return(expense, nil)
+	env.repo.On("DeleteExpenseWithTx", mock.Anything, expense.ID).Return(nil)
+
+	err = env.service.DeleteExpense(ctx, env.userID, env.group.ID, expense.ID)
+
+	require.NoError(t, err)
+	assert.Zero(t, env.bookedCount(t))
+	assert.Equal(t, []float64{-150000, -150000, 150000, 150000}, env.balanceChanges(t))
+	assert.Equal(t, []uuid.UUID{*expense.BudgetID, *expense.BudgetID}, env.budgets.recalculated)
+}
+
+func TestDeleteExpense_FailureKeepsTransactions(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.repo.On("CreateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+	env.repo.On("UpdateExpenseWithTx", mock.Anything, mock.Anything).Return(nil)
+	expense, err := env.service.AddExpense(ctx, env.userID, env.group.ID, expenseRequest(env, env.self.ID, 300000))
+	require.NoError(t, err)
+	env.repo.On("GetExpenseByID", ctx, expense.ID).Return(expense, nil)
+	env.repo.On("DeleteExpenseWithTx", mock.Anything, expense.ID).Return(errors.New("connection reset"))
+
+	err = env.service.DeleteExpense(ctx, env.userID, env.group.ID, expense.ID)
+
+	assert.ErrorIs(t, err, shared.ErrInternal)
+	assert.Equal(t, int64(2), env.bookedCount(t))
+	assert.Equal(t, []float64{-150000, -150000}, env.balanceChanges(t))
+}
+
+func TestDeleteExpense_OtherGroup(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	expense := &domain.Expense{ID: uuid.New(), GroupID: uuid.New()}
+	env.repo.On("GetExpenseByID", ctx, expense.ID).Return(expense, nil)
+
+	err := env.service.DeleteExpense(ctx, env.userID, env.group.ID, expense.ID)
+
+	assert.ErrorIs(t, err, shared.ErrNotFound)
+	env.repo.AssertNotCalled(t, "DeleteExpenseWithTx", mock.Anything, mock.Anything)
+}
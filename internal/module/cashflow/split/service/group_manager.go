@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	"personalfinancedss/internal/module/cashflow/split/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateGroup creates a group with the owner and the given people as members
+func (s *splitService) CreateGroup(ctx context.Context, userID uuid.UUID, req dto.CreateGroupRequest) (*domain.GroupBalance, error) {
+	group := &domain.Group{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Currency:    strings.ToUpper(req.Currency),
+	}
+	group.Members = append(group.Members, domain.Member{
+		ID:      uuid.New(),
+		GroupID: group.ID,
+		Name:    domain.SelfMemberName,
+		IsSelf:  true,
+	})
+	for _, m := range req.Members {
+		group.Members = append(group.Members, newMember(group.ID, m))
+	}
+
+	if err := s.repo.CreateGroup(ctx, group); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Expense group created",
+		zap.String("group_id", group.ID.String()),
+		zap.Int("members", len(group.Members)),
+	)
+
+	return domain.NewGroupBalance(group, nil, nil), nil
+}
+
+// UpdateGroup changes a group's name or description
+func (s *splitService) UpdateGroup(ctx context.Context, userID, groupID uuid.UUID, req dto.UpdateGroupRequest) (*domain.GroupBalance, error) {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		group.Name = *req.Name
+	}
+	if req.Description != nil {
+		group.Description = req.Description
+	}
+
+	if err := s.repo.UpdateGroup(ctx, group); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return s.groupBalance(ctx, group)
+}
+
+// GetGroup retrieves a group with its running balances
+func (s *splitService) GetGroup(ctx context.Context, userID, groupID uuid.UUID) (*domain.GroupBalance, error) {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return s.groupBalance(ctx, group)
+}
+
+// ListGroups retrieves the user's groups with their running balances
+func (s *splitService) ListGroups(ctx context.Context, userID uuid.UUID) ([]domain.GroupBalance, error) {
+	groups, err := s.repo.ListGroupsByUserID(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	balances := make([]domain.GroupBalance, 0, len(groups))
+	for i := range groups {
+		balance, err := s.groupBalance(ctx, &groups[i])
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, *balance)
+	}
+	return balances, nil
+}
+
+// DeleteGroup removes a group and its expenses. Booked transactions are kept.
+func (s *splitService) DeleteGroup(ctx context.Context, userID, groupID uuid.UUID) error {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteGroup(ctx, group.ID); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
+
+// AddMember adds a person to a group
+func (s *splitService) AddMember(ctx context.Context, userID, groupID uuid.UUID, req dto.MemberRequest) (*domain.Member, error) {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	member := newMember(group.ID, req)
+	if err := s.repo.CreateMember(ctx, &member); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return &member, nil
+}
+
+// getGroup retrieves a group owned by the user
+func (s *splitService) getGroup(ctx context.Context, userID, groupID uuid.UUID) (*domain.Group, error) {
+	group, err := s.repo.GetGroupByID(ctx, groupID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if group.UserID != userID {
+		return nil, shared.ErrNotFound
+	}
+	return group, nil
+}
+
+// groupBalance loads a group's expenses and settlements and computes its balances
+func (s *splitService) groupBalance(ctx context.Context, group *domain.Group) (*domain.GroupBalance, error) {
+	expenses, err := s.repo.ListExpensesByGroupID(ctx, group.ID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	settlements, err := s.repo.ListSettlementsByGroupID(ctx, group.ID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return domain.NewGroupBalance(group, expenses, settlements), nil
+}
+
+// newMember builds a member of a group from a request
+func newMember(groupID uuid.UUID, req dto.MemberRequest) domain.Member {
+	return domain.Member{
+		ID:      uuid.New(),
+		GroupID: groupID,
+		Name:    req.Name,
+		Contact: req.Contact,
+	}
+}
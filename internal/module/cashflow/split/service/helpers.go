@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// posting is a transaction the group books on the owner's account
+type posting struct {
+	userID       uuid.UUID
+	account      *accountDomain.Account
+	direction    transactionDomain.Direction
+	amount       float64
+	date         time.Time
+	externalID   string
+	description  string
+	categoryID   *uuid.UUID
+	links        transactionDomain.TransactionLinks
+	counterparty *transactionDomain.Counterparty
+}
+
+// bookWithTx creates the transaction of a posting and applies it to the account balance within an
+// existing database transaction
+func (s *splitService) bookWithTx(tx *gorm.DB, p posting) (uuid.UUID, error) {
+	amount := int64(math.Round(p.amount))
+	txn := &transactionDomain.Transaction{
+		ID:             uuid.New(),
+		UserID:         p.userID,
+		AccountID:      p.account.ID,
+		Source:         transactionDomain.SourceManual,
+		ExternalID:     p.externalID,
+		Direction:      p.direction,
+		Channel:        transactionDomain.ChannelUnknown,
+		Instrument:     instrumentFor(p.account),
+		BookingDate:    p.date,
+		ValueDate:      p.date,
+		Amount:         amount,
+		Currency:       string(p.account.Currency),
+		Description:    p.description,
+		UserCategoryID: p.categoryID,
+		Counterparty:   p.counterparty,
+		Links:          &p.links,
+	}
+	if err := s.transactionRepo.CreateWithTx(tx, txn); err != nil {
+		return uuid.Nil, err
+	}
+	if err := s.accountRepo.UpdateBalanceWithTx(tx, p.account.ID.String(), balanceDelta(p.direction, amount)); err != nil {
+		return uuid.Nil, err
+	}
+	return txn.ID, nil
+}
+
+// reverseWithTx deletes a booked transaction and takes it back out of the account balance within
+// an existing database transaction
+func (s *splitService) reverseWithTx(tx *gorm.DB, txn *transactionDomain.Transaction) error {
+	if err := s.transactionRepo.DeleteWithTx(tx, txn.ID); err != nil {
+		return err
+	}
+	return s.accountRepo.UpdateBalanceWithTx(tx, txn.AccountID.String(), -balanceDelta(txn.Direction, txn.Amount))
+}
+
+// balanceDelta returns the change a transaction of the given direction and amount makes to the account balance
+func balanceDelta(direction transactionDomain.Direction, amount int64) float64 {
+	if direction == transactionDomain.DirectionDebit {
+		return -float64(amount)
+	}
+	return float64(amount)
+}
+
+// getAccount loads the owner's account and checks it holds the group's currency
+func (s *splitService) getAccount(ctx context.Context, userID uuid.UUID, group *domain.Group, id string) (*accountDomain.Account, error) {
+	accountID, err := parseID("account_id", id)
+	if err != nil {
+		return nil, err
+	}
+	account, err := s.accountRepo.GetEditableByIDAndUserID(ctx, accountID.String(), userID.String())
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, shared.ErrBadRequest.WithDetails("account_id", "account not found")
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if string(account.Currency) != group.Currency {
+		return nil, shared.ErrBadRequest.WithDetails("account_id", "account currency must match the group currency")
+	}
+	return account, nil
+}
+
+// groupLink links a transaction to the group it was booked for
+func groupLink(group *domain.Group) transactionDomain.TransactionLink {
+	return transactionDomain.TransactionLink{Type: transactionDomain.LinkSplitGroup, ID: group.ID.String()}
+}
+
+// instrumentFor returns the payment instrument of an account
+func instrumentFor(account *accountDomain.Account) transactionDomain.Instrument {
+	switch account.AccountType {
+	case accountDomain.AccountTypeCash:
+		return transactionDomain.InstrumentCash
+	case accountDomain.AccountTypeCreditCard:
+		return transactionDomain.InstrumentCreditCard
+	}
+	return transactionDomain.InstrumentBankAccount
+}
+
+// parseID parses a request ID, reporting the field when it is invalid
+func parseID(field, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, shared.ErrBadRequest.WithDetails(field, "invalid id")
+	}
+	return parsed, nil
+}
+
+// parseOptionalID parses an optional request ID
+func parseOptionalID(field string, id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+	parsed, err := parseID(field, *id)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
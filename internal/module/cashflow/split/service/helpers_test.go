@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	budgetDomain "personalfinancedss/internal/module/cashflow/budget/domain"
+	budgetService "personalfinancedss/internal/module/cashflow/budget/service"
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	"personalfinancedss/internal/module/cashflow/split/repository"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// mockRepository is a mock implementation of repository.Repository
+type mockRepository struct {
+	repository.Repository
+	mock.Mock
+}
+
+func (m *mockRepository) GetGroupByID(ctx context.Context, id uuid.UUID) (*domain.Group, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Group), args.Error(1)
+}
+
+func (m *mockRepository) CreateExpenseWithTx(tx *gorm.DB, expense *domain.Expense) error {
+	args := m.Called(tx, expense)
+	return args.Error(0)
+}
+
+func (m *mockRepository) UpdateExpenseWithTx(tx *gorm.DB, expense *domain.Expense) error {
+	args := m.Called(tx, expense)
+	return args.Error(0)
+}
+
+func (m *mockRepository) GetExpenseByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Expense), args.Error(1)
+}
+
+func (m *mockRepository) DeleteExpenseWithTx(tx *gorm.DB, id uuid.UUID) error {
+	args := m.Called(tx, id)
+	return args.Error(0)
+}
+
+func (m *mockRepository) CreateSettlementWithTx(tx *gorm.DB, settlement *domain.Settlement) error {
+	args := m.Called(tx, settlement)
+	return args.Error(0)
+}
+
+// mockAccountRepository serves one account, which its owner can edit and a household viewer can
+// only see, and writes balance changes to the test database
+type mockAccountRepository struct {
+	accountRepo.Repository
+	account  *accountDomain.Account
+	viewerID uuid.UUID
+}
+
+func (m *mockAccountRepository) GetByIDAndUserID(ctx context.Context, id, userID string) (*accountDomain.Account, error) {
+	if m.account != nil && m.account.ID.String() == id && m.viewerID.String() == userID {
+		return m.account, nil
+	}
+	return m.GetEditableByIDAndUserID(ctx, id, userID)
+}
+
+func (m *mockAccountRepository) GetEditableByIDAndUserID(ctx context.Context, id, userID string) (*accountDomain.Account, error) {
+	if m.account == nil || m.account.ID.String() != id || m.account.UserID.String() != userID {
+		return nil, shared.ErrNotFound
+	}
+	return m.account, nil
+}
+
+func (m *mockAccountRepository) UpdateBalanceWithTx(tx *gorm.DB, accountID string, balanceDelta float64) error {
+	return tx.Exec("INSERT INTO balance_changes (account_id, delta) VALUES (?, ?)", accountID, balanceDelta).Error
+}
+
+// mockTransactionRepository keeps booked transactions in memory and writes them to the test
+// database, so a rolled back booking leaves no row behind
+type mockTransactionRepository struct {
+	transactionRepo.Repository
+	booked map[uuid.UUID]*transactionDomain.Transaction
+}
+
+func (m *mockTransactionRepository) CreateWithTx(tx *gorm.DB, transaction *transactionDomain.Transaction) error {
+	m.booked[transaction.ID] = transaction
+	return tx.Exec("INSERT INTO booked_transactions (id) VALUES (?)", transaction.ID.String()).Error
+}
+
+func (m *mockTransactionRepository) GetByID(ctx context.Context, id uuid.UUID) (*transactionDomain.Transaction, error) {
+	if txn, ok := m.booked[id]; ok {
+		return txn, nil
+	}
+	return nil, shared.ErrNotFound
+}
+
+func (m *mockTransactionRepository) DeleteWithTx(tx *gorm.DB, id uuid.UUID) error {
+	return tx.Exec("DELETE FROM booked_transactions WHERE id = ?", id.String()).Error
+}
+
+// mockBudgetService records the budgets whose spending was recalculated
+type mockBudgetService struct {
+	budgetService.Service
+	recalculated []uuid.UUID
+}
+
+func (m *mockBudgetService) GetBudgetByIDForUser(ctx context.Context, budgetID, userID uuid.UUID) (*budgetDomain.Budget, error) {
+	return &budgetDomain.Budget{ID: budgetID, UserID: userID}, nil
+}
+
+func (m *mockBudgetService) RecalculateBudgetSpendingForUser(ctx context.Context, budgetID, userID uuid.UUID) error {
+	m.recalculated = append(m.recalculated, budgetID)
+	return nil
+}
+
+// testEnv is a split service over mocked repositories and an in-memory database the service
+// runs its transactions on
+type testEnv struct {
+	service      Service
+	repo         *mockRepository
+	accounts     *mockAccountRepository
+	transactions *mockTransactionRepository
+	budgets      *mockBudgetService
+	db           *gorm.DB
+
+	userID  uuid.UUID
+	account *accountDomain.Account
+	group   *domain.Group
+	self    domain.Member
+	friend  domain.Member
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// A single connection keeps every query on the same in-memory database
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.Exec("CREATE TABLE balance_changes (account_id TEXT, delta REAL)").Error)
+	require.NoError(t, db.Exec("CREATE TABLE booked_transactions (id TEXT)").Error)
+
+	userID := uuid.New()
+	account := &accountDomain.Account{ID: uuid.New(), UserID: userID, Currency: accountDomain.CurrencyVND}
+	group := &domain.Group{ID: uuid.New(), UserID: userID, Name: "Da Lat trip", Currency: "VND"}
+	self := domain.Member{ID: uuid.New(), GroupID: group.ID, Name: domain.SelfMemberName, IsSelf: true}
+	friend := domain.Member{ID: uuid.New(), GroupID: group.ID, Name: "Lan"}
+	group.Members = []domain.Member{self, friend}
+
+	env := &testEnv{
+		repo:         new(mockRepository),
+		accounts:     &mockAccountRepository{account: account},
+		transactions: &mockTransactionRepository{booked: make(map[uuid.UUID]*transactionDomain.Transaction)},
+		budgets:      &mockBudgetService{},
+		db:           db,
+		userID:       userID,
+		account:      account,
+		group:        group,
+		self:         self,
+		friend:       friend,
+	}
+	env.service = NewService(env.repo, env.accounts, env.transactions, env.budgets, db, zap.NewNop())
+	env.repo.On("GetGroupByID", mock.Anything, group.ID).Return(group, nil)
+	return env
+}
+
+// balanceChanges returns the committed balance changes in order
+func (e *testEnv) balanceChanges(t *testing.T) []float64 {
+	var deltas []float64
+	require.NoError(t, e.db.Raw("SELECT delta FROM balance_changes ORDER BY rowid").Scan(&deltas).Error)
+	return deltas
+}
+
+// bookedCount returns the number of committed transactions still booked
+func (e *testEnv) bookedCount(t *testing.T) int64 {
+	var count int64
+	require.NoError(t, e.db.Raw("SELECT COUNT(*) FROM booked_transactions").Scan(&count).Error)
+	return count
+}
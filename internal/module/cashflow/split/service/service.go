@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	budgetService "personalfinancedss/internal/module/cashflow/budget/service"
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	"personalfinancedss/internal/module/cashflow/split/dto"
+	"personalfinancedss/internal/module/cashflow/split/repository"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// GroupManager defines expense group and member operations
+type GroupManager interface {
+	// CreateGroup creates a group with the owner and the given people as members
+	CreateGroup(ctx context.Context, userID uuid.UUID, req dto.CreateGroupRequest) (*domain.GroupBalance, error)
+
+	// UpdateGroup changes a group's name or description
+	UpdateGroup(ctx context.Context, userID, groupID uuid.UUID, req dto.UpdateGroupRequest) (*domain.GroupBalance, error)
+
+	// GetGroup retrieves a group with its running balances
+	GetGroup(ctx context.Context, userID, groupID uuid.UUID) (*domain.GroupBalance, error)
+
+	// ListGroups retrieves the user's groups with their running balances
+	ListGroups(ctx context.Context, userID uuid.UUID) ([]domain.GroupBalance, error)
+
+	// DeleteGroup removes a group and its expenses. Booked transactions are kept.
+	DeleteGroup(ctx context.Context, userID, groupID uuid.UUID) error
+
+	// AddMember adds a person to a group
+	AddMember(ctx context.Context, userID, groupID uuid.UUID, req dto.MemberRequest) (*domain.Member, error)
+}
+
+// ExpenseManager defines shared expense operations
+type ExpenseManager interface {
+	// AddExpense records an expense paid by one member and split between members, booking the owner's part on their account
+	AddExpense(ctx context.Context, userID, groupID uuid.UUID, req dto.CreateExpenseRequest) (*domain.Expense, error)
+
+	// ListExpenses retrieves a group's expenses, newest first
+	ListExpenses(ctx context.Context, userID, groupID uuid.UUID) ([]domain.Expense, error)
+
+	// DeleteExpense removes an expense from a group and reverses the transactions booked for it
+	DeleteExpense(ctx context.Context, userID, groupID, expenseID uuid.UUID) error
+}
+
+// SettlementManager defines settle-up operations
+type SettlementManager interface {
+	// RecordSettlement records a payment between two members, booking a transaction when the owner pays or receives
+	RecordSettlement(ctx context.Context, userID, groupID uuid.UUID, req dto.CreateSettlementRequest) (*domain.Settlement, error)
+
+	// ListSettlements retrieves a group's settlements, newest first
+	ListSettlements(ctx context.Context, userID, groupID uuid.UUID) ([]domain.Settlement, error)
+}
+
+// Service is the composite interface for all expense group operations
+type Service interface {
+	GroupManager
+	ExpenseManager
+	SettlementManager
+}
+
+// splitService implements all expense group use cases
+type splitService struct {
+	repo            repository.Repository
+	accountRepo     accountRepo.Repository
+	transactionRepo transactionRepo.Repository
+	budgetService   budgetService.Service
+	db              *gorm.DB
+	logger          *zap.Logger
+}
+
+// NewService creates a new expense group service
+func NewService(
+	repo repository.Repository,
+	accountRepo accountRepo.Repository,
+	transactionRepo transactionRepo.Repository,
+	budgetService budgetService.Service,
+	db *gorm.DB,
+	logger *zap.Logger,
+) Service {
+	return &splitService{
+		repo:            repo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		budgetService:   budgetService,
+		db:              db,
+		logger:          logger.Named("split.service"),
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	"personalfinancedss/internal/module/cashflow/split/dto"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RecordSettlement records a payment between two members. When the owner pays or receives,
+// the payment is booked on their account as a transaction linked to the group, in the same
+// database transaction as the settlement. It is not categorized: the owner's share of what they
+// repay was already booked as spending with the expense.
+func (s *splitService) RecordSettlement(ctx context.Context, userID, groupID uuid.UUID, req dto.CreateSettlementRequest) (*domain.Settlement, error) {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromID, err := parseID("from_member_id", req.FromMemberID)
+	if err != nil {
+		return nil, err
+	}
+	toID, err := parseID("to_member_id", req.ToMemberID)
+	if err != nil {
+		return nil, err
+	}
+	from, to := group.Member(fromID), group.Member(toID)
+	if from == nil {
+		return nil, shared.ErrBadRequest.WithDetails("from_member_id", "member not found in group")
+	}
+	if to == nil {
+		return nil, shared.ErrBadRequest.WithDetails("to_member_id", "member not found in group")
+	}
+	if from.ID == to.ID {
+		return nil, shared.ErrBadRequest.WithDetails("to_member_id", "must differ from the paying member")
+	}
+
+	settlement := &domain.Settlement{
+		ID:           uuid.New(),
+		GroupID:      group.ID,
+		UserID:       userID,
+		FromMemberID: from.ID,
+		ToMemberID:   to.ID,
+		Amount:       req.Amount,
+		Currency:     group.Currency,
+		SettledAt:    time.Now(),
+		Note:         req.Note,
+	}
+	if req.SettledAt != nil {
+		settlement.SettledAt = *req.SettledAt
+	}
+
+	var payment *posting
+	if from.IsSelf || to.IsSelf {
+		if req.AccountID == nil {
+			return nil, shared.ErrBadRequest.WithDetails("account_id", "required when you pay or receive")
+		}
+		account, err := s.getAccount(ctx, userID, group, *req.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		settlement.AccountID = &account.ID
+
+		direction, other := transactionDomain.DirectionDebit, to
+		if to.IsSelf {
+			direction, other = transactionDomain.DirectionCredit, from
+		}
+		payment = &posting{
+			userID:       userID,
+			account:      account,
+			direction:    direction,
+			amount:       settlement.Amount,
+			date:         settlement.SettledAt,
+			externalID:   "split_settlement:" + settlement.ID.String(),
+			description:  fmt.Sprintf("Settle up with %s (%s)", other.Name, group.Name),
+			links:        transactionDomain.TransactionLinks{groupLink(group)},
+			counterparty: &transactionDomain.Counterparty{Name: other.Name, Type: "PERSON"},
+		}
+	}
+
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if payment != nil {
+			id, err := s.bookWithTx(tx, *payment)
+			if err != nil {
+				return err
+			}
+			settlement.TransactionID = &id
+		}
+		return s.repo.CreateSettlementWithTx(tx, settlement)
+	}); err != nil {
+		s.logger.Error("Failed to record group settlement",
+			zap.String("group_id", group.ID.String()),
+			zap.Error(err),
+		)
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Group settlement recorded",
+		zap.String("group_id", group.ID.String()),
+		zap.String("settlement_id", settlement.ID.String()),
+		zap.Float64("amount", settlement.Amount),
+	)
+
+	return settlement, nil
+}
+
+// ListSettlements retrieves a group's settlements, newest first
+func (s *splitService) ListSettlements(ctx context.Context, userID, groupID uuid.UUID) ([]domain.Settlement, error) {
+	group, err := s.getGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements, err := s.repo.ListSettlementsByGroupID(ctx, group.ID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return settlements, nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"personalfinancedss/internal/module/cashflow/split/domain"
+	"personalfinancedss/internal/module/cashflow/split/dto"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordSettlement_SelfPays(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.repo.On("CreateSettlementWithTx", mock.Anything, mock.Anything).Return(nil)
+	accountID := env.account.ID.String()
+
+	settlement, err := env.service.RecordSettlement(ctx, env.userID, env.group.ID, dto.CreateSettlementRequest{
+		FromMemberID: env.self.ID.String(),
+		ToMemberID:   env.friend.ID.String(),
+		Amount:       150000,
+		AccountID:    &accountID,
+	})
+
+	require.NoError(t, err)
+	txn := env.transactions.booked[*settlement.TransactionID]
+	assert.Equal(t, transactionDomain.DirectionDebit, txn.Direction)
+	assert.Nil(t, txn.UserCategoryID, "the share was already booked as spending with the expense")
+	assert.Equal(t, "Lan", txn.Counterparty.Name)
+	assert.Equal(t, []float64{-150000}, env.balanceChanges(t))
+}
+
+func TestRecordSettlement_BetweenOthersBooksNothing(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	minh := domain.Member{ID: uuid.New(), GroupID: env.group.ID, Name: "Minh"}
+	env.group.Members = append(env.group.Members, minh)
+	env.repo.On("CreateSettlementWithTx", mock.Anything, mock.Anything).Return(nil)
+
+	settlement, err := env.service.RecordSettlement(ctx, env.userID, env.group.ID, dto.CreateSettlementRequest{
+		FromMemberID: env.friend.ID.String(),
+		ToMemberID:   minh.ID.String(),
+		Amount:       50000,
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, settlement.TransactionID)
+	assert.Empty(t, env.transactions.booked)
+}
+
+func TestRecordSettlement_FailureRollsBackBooking(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	env.repo.On("CreateSettlementWithTx", mock.Anything, mock.Anything).Return(errors.New("connection reset"))
+	accountID := env.account.ID.String()
+
+	_, err := env.service.RecordSettlement(ctx, env.userID, env.group.ID, dto.CreateSettlementRequest{
+		FromMemberID: env.friend.ID.String(),
+		ToMemberID:   env.self.ID.String(),
+		Amount:       150000,
+		AccountID:    &accountID,
+	})
+
+	assert.ErrorIs(t, err, shared.ErrInternal)
+	assert.Len(t, env.transactions.booked, 1)
+	assert.Zero(t, env.bookedCount(t))
+	assert.Empty(t, env.balanceChanges(t))
+}
+
+func TestRecordSettlement_RequiresAccount(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := env.service.RecordSettlement(context.Background(), env.userID, env.group.ID, dto.CreateSettlementRequest{
+		FromMemberID: env.self.ID.String(),
+		ToMemberID:   env.friend.ID.String(),
+		Amount:       150000,
+	})
+
+	assert.ErrorIs(t, err, shared.ErrBadRequest)
+	env.repo.AssertNotCalled(t, "CreateSettlementWithTx", mock.Anything, mock.Anything)
+}
+
+func TestRecordSettlement_ViewerCannotUseSharedAccount(t *testing.T) {
+	env := newTestEnv(t)
+	// The account belongs to another household member who shares it read-only
+	env.account.UserID = uuid.New()
+	env.accounts.viewerID = env.userID
+	accountID := env.account.ID.String()
+
+	_, err := env.service.RecordSettlement(context.Background(), env.userID, env.group.ID, dto.CreateSettlementRequest{
+		FromMemberID: env.self.ID.String(),
+		ToMemberID:   env.friend.ID.String(),
+		Amount:       150000,
+		AccountID:    &accountID,
+	})
+
+	assert.ErrorIs(t, err, shared.ErrBadRequest)
+	env.repo.AssertNotCalled(t, "CreateSettlementWithTx", mock.Anything, mock.Anything)
+	assert.Empty(t, env.balanceChanges(t))
+}
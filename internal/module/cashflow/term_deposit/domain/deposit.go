@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DaysPerYear is the day-count basis Vietnamese banks use for deposit interest (actual/365)
+const DaysPerYear = 365.0
+
+// TermDeposit is a fixed-term deposit (tiền gửi có kỳ hạn) held in a savings account
+type TermDeposit struct {
+	ID              uuid.UUID  `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	UserID          uuid.UUID  `gorm:"type:uuid;not null;index;column:user_id" json:"user_id"`
+	AccountID       uuid.UUID  `gorm:"type:uuid;not null;index;column:account_id" json:"account_id"`          // Savings account holding the deposit
+	PayoutAccountID *uuid.UUID `gorm:"type:uuid;column:payout_account_id" json:"payout_account_id,omitempty"` // Receives payouts at maturity
+
+	Name     string `gorm:"type:varchar(255);not null;column:name" json:"name"`
+	Currency string `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+
+	// Terms
+	Principal           float64             `gorm:"type:decimal(15,2);not null;column:principal" json:"principal"`    // Principal of the current term
+	AnnualRate          float64             `gorm:"type:decimal(5,2);not null;column:annual_rate" json:"annual_rate"` // Annual interest rate (%)
+	TermMonths          int                 `gorm:"not null;column:term_months" json:"term_months"`
+	Compounding         Compounding         `gorm:"type:varchar(20);not null;default:'simple';column:compounding" json:"compounding"`
+	MaturityInstruction MaturityInstruction `gorm:"type:varchar(30);not null;default:'rollover_with_interest';column:maturity_instruction" json:"maturity_instruction"`
+
+	// Current term
+	TermStartDate   time.Time  `gorm:"type:date;not null;column:term_start_date" json:"term_start_date"`
+	MaturityDate    time.Time  `gorm:"type:date;not null;index;column:maturity_date" json:"maturity_date"`
+	AccruedInterest float64    `gorm:"type:decimal(15,2);default:0;column:accrued_interest" json:"accrued_interest"` // Interest posted in the current term
+	LastAccrualDate *time.Time `gorm:"type:date;column:last_accrual_date" json:"last_accrual_date,omitempty"`
+	RolloverCount   int        `gorm:"default:0;column:rollover_count" json:"rollover_count"`
+
+	Status    DepositStatus `gorm:"type:varchar(20);not null;default:'active';index;column:status" json:"status"`
+	MaturedAt *time.Time    `gorm:"type:date;column:matured_at" json:"matured_at,omitempty"`
+	EventID   *uuid.UUID    `gorm:"type:uuid;column:event_id" json:"event_id,omitempty"` // Calendar event of the maturity date
+
+	CreatedAt time.Time      `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
+}
+
+// TableName specifies the table name for TermDeposit
+func (TermDeposit) TableName() string {
+	return "term_deposits"
+}
+
+// AccrualPeriod is one monthly interest period of a term
+type AccrualPeriod struct {
+	Start       time.Time
+	End         time.Time
+	Base        float64 // balance interest is earned on
+	Interest    float64
+	Capitalized bool // interest joins the base of later periods
+}
+
+// Posting is a transaction a deposit generates on an account.
+// Positive amounts credit the account, negative amounts debit it.
+type Posting struct {
+	Kind      PostingKind
+	AccountID uuid.UUID
+	Amount    float64
+	Date      time.Time
+}
+
+// ExternalID returns the idempotency key of a posting, unique per deposit, kind and date
+func (p Posting) ExternalID(depositID uuid.UUID) string {
+	return "term_deposit:" + depositID.String() + ":" + string(p.Kind) + ":" + p.Date.Format("2006-01-02")
+}
+
+// Validate checks the deposit terms
+func (d *TermDeposit) Validate() error {
+	if d.Principal <= 0 {
+		return errors.New("principal must be positive")
+	}
+	if d.AnnualRate < 0 || d.AnnualRate > 100 {
+		return errors.New("annual rate must be between 0 and 100")
+	}
+	if d.TermMonths < 1 || d.TermMonths > 360 {
+		return errors.New("term must be between 1 and 360 months")
+	}
+	if !d.Compounding.IsValid() {
+		return errors.New("invalid compounding")
+	}
+	if !d.MaturityInstruction.IsValid() {
+		return errors.New("invalid maturity instruction")
+	}
+	if d.MaturityInstruction.PaysOut() && d.PayoutAccountID == nil {
+		return errors.New("payout account is required for this maturity instruction")
+	}
+	if d.PayoutAccountID != nil && *d.PayoutAccountID == d.AccountID {
+		return errors.New("payout account must differ from the deposit account")
+	}
+	return nil
+}
+
+// StartTerm begins a term on the given date with the current principal
+func (d *TermDeposit) StartTerm(start time.Time) {
+	d.TermStartDate = truncateToDay(start)
+	d.MaturityDate = addMonths(d.TermStartDate, d.TermMonths)
+	d.AccruedInterest = 0
+	d.LastAccrualDate = nil
+}
+
+// AccrualSchedule splits the current term into monthly periods and computes their interest.
+// Interest accrues actual/365 on the principal plus any interest capitalized so far.
+func (d *TermDeposit) AccrualSchedule() []AccrualPeriod {
+	interval := d.Compounding.IntervalMonths()
+	periods := make([]AccrualPeriod, 0, d.TermMonths)
+
+	base, pending := d.Principal, 0.0
+	start := d.TermStartDate
+	for k := 1; k <= d.TermMonths; k++ {
+		end := addMonths(d.TermStartDate, k)
+		if k == d.TermMonths {
+			end = d.MaturityDate
+		}
+		days := end.Sub(start).Hours() / 24
+		interest := roundUnits(base * d.AnnualRate / 100 * days / DaysPerYear)
+
+		period := AccrualPeriod{Start: start, End: end, Base: base, Interest: interest}
+		pending += interest
+		if interval > 0 && k%interval == 0 {
+			period.Capitalized = true
+			base += pending
+			pending = 0
+		}
+		periods = append(periods, period)
+		start = end
+	}
+	return periods
+}
+
+// ExpectedInterest returns the interest the current term earns by maturity
+func (d *TermDeposit) ExpectedInterest() float64 {
+	total := 0.0
+	for _, p := range d.AccrualSchedule() {
+		total += p.Interest
+	}
+	return total
+}
+
+// MaturityValue returns principal plus the interest of the current term
+func (d *TermDeposit) MaturityValue() float64 {
+	return d.Principal + d.ExpectedInterest()
+}
+
+// EffectiveAnnualYield returns the annualized return of one term as a decimal (0.055 = 5.5%),
+// assuming proceeds are renewed on the same terms
+func (d *TermDeposit) EffectiveAnnualYield() float64 {
+	days := d.MaturityDate.Sub(d.TermStartDate).Hours() / 24
+	if d.Principal <= 0 || days <= 0 {
+		return 0
+	}
+	termReturn := d.ExpectedInterest() / d.Principal
+	return math.Pow(1+termReturn, DaysPerYear/days) - 1
+}
+
+// DaysToMaturity returns the whole days left until maturity, never negative
+func (d *TermDeposit) DaysToMaturity(asOf time.Time) int {
+	days := int(d.MaturityDate.Sub(truncateToDay(asOf)).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+// Process posts interest for every period ended on or before asOf and handles maturity,
+// rolling over as many terms as have elapsed. It returns the postings to record.
+func (d *TermDeposit) Process(asOf time.Time) []Posting {
+	asOf = truncateToDay(asOf)
+	var postings []Posting
+
+	for d.Status == DepositStatusActive {
+		for _, period := range d.AccrualSchedule() {
+			if period.End.After(asOf) {
+				break
+			}
+			if d.LastAccrualDate != nil && !period.End.After(*d.LastAccrualDate) {
+				continue
+			}
+			if period.Interest > 0 {
+				postings = append(postings, Posting{Kind: PostingInterest, AccountID: d.AccountID, Amount: period.Interest, Date: period.End})
+			}
+			d.AccruedInterest += period.Interest
+			end := period.End
+			d.LastAccrualDate = &end
+		}
+
+		if d.MaturityDate.After(asOf) {
+			break
+		}
+		postings = append(postings, d.mature()...)
+	}
+
+	return postings
+}
+
+// mature applies the maturity instruction to a term whose interest is fully posted
+func (d *TermDeposit) mature() []Posting {
+	maturity := d.MaturityDate
+	var postings []Posting
+	payout := func(amount float64) {
+		if amount <= 0 || d.PayoutAccountID == nil {
+			return
+		}
+		postings = append(postings,
+			Posting{Kind: PostingMaturityOut, AccountID: d.AccountID, Amount: -amount, Date: maturity},
+			Posting{Kind: PostingMaturityIn, AccountID: *d.PayoutAccountID, Amount: amount, Date: maturity},
+		)
+	}
+
+	switch d.MaturityInstruction {
+	case MaturityWithdraw:
+		payout(d.Principal + d.AccruedInterest)
+		d.Status = DepositStatusMatured
+		d.MaturedAt = &maturity
+		return postings
+	case MaturityRolloverPrincipal:
+		payout(d.AccruedInterest)
+	default:
+		d.Principal += d.AccruedInterest
+	}
+
+	d.RolloverCount++
+	d.StartTerm(maturity)
+	return postings
+}
+
+// addMonths adds months to a date, clamping to the last day of shorter months
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// roundUnits rounds to whole currency units, the precision transactions are stored in
+func roundUnits(v float64) float64 {
+	return math.Round(v)
+}
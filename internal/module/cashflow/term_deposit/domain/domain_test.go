@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func testDeposit(compounding Compounding, instruction MaturityInstruction) *TermDeposit {
+	payout := uuid.New()
+	d := &TermDeposit{
+		ID:                  uuid.New(),
+		AccountID:           uuid.New(),
+		PayoutAccountID:     &payout,
+		Currency:            "VND",
+		Principal:           100_000_000,
+		AnnualRate:          6,
+		TermMonths:          6,
+		Compounding:         compounding,
+		MaturityInstruction: instruction,
+		Status:              DepositStatusActive,
+	}
+	d.StartTerm(date(2025, 1, 15))
+	return d
+}
+
+func TestTermDeposit_Validate(t *testing.T) {
+	d := testDeposit(CompoundingSimple, MaturityWithdraw)
+	assert.NoError(t, d.Validate())
+
+	d.PayoutAccountID = nil
+	assert.Error(t, d.Validate(), "withdraw needs a payout account")
+
+	d.MaturityInstruction = MaturityRolloverWithInterest
+	assert.NoError(t, d.Validate())
+
+	d.TermMonths = 0
+	assert.Error(t, d.Validate())
+}
+
+func TestTermDeposit_StartTermClampsMaturity(t *testing.T) {
+	d := testDeposit(CompoundingSimple, MaturityWithdraw)
+	d.TermMonths = 1
+	d.StartTerm(date(2025, 1, 31))
+	assert.Equal(t, date(2025, 2, 28), d.MaturityDate)
+}
+
+func TestTermDeposit_AccrualSchedule(t *testing.T) {
+	simple := testDeposit(CompoundingSimple, MaturityWithdraw)
+	periods := simple.AccrualSchedule()
+	require.Len(t, periods, 6)
+	assert.Equal(t, date(2025, 2, 15), periods[0].End)
+	assert.Equal(t, date(2025, 7, 15), periods[5].End)
+
+	// Simple interest over 181 days on 100M at 6%
+	days := date(2025, 7, 15).Sub(date(2025, 1, 15)).Hours() / 24
+	assert.InDelta(t, 100_000_000*0.06*days/365, simple.ExpectedInterest(), 6)
+	for _, p := range periods {
+		assert.Equal(t, 100_000_000.0, p.Base)
+		assert.False(t, p.Capitalized)
+	}
+
+	monthly := testDeposit(CompoundingMonthly, MaturityWithdraw)
+	assert.Greater(t, monthly.ExpectedInterest(), simple.ExpectedInterest())
+
+	quarterly := testDeposit(CompoundingQuarterly, MaturityWithdraw)
+	qp := quarterly.AccrualSchedule()
+	assert.Equal(t, qp[0].Base, qp[2].Base)
+	assert.Greater(t, qp[3].Base, qp[2].Base)
+	assert.Greater(t, quarterly.ExpectedInterest(), simple.ExpectedInterest())
+	assert.Less(t, quarterly.ExpectedInterest(), monthly.ExpectedInterest())
+}
+
+func TestTermDeposit_ProcessPostsInterestOnce(t *testing.T) {
+	d := testDeposit(CompoundingSimple, MaturityWithdraw)
+
+	postings := d.Process(date(2025, 3, 20))
+	require.Len(t, postings, 2)
+	assert.Equal(t, PostingInterest, postings[0].Kind)
+	assert.Equal(t, date(2025, 2, 15), postings[0].Date)
+	assert.Equal(t, date(2025, 3, 15), *d.LastAccrualDate)
+
+	assert.Empty(t, d.Process(date(2025, 3, 20)), "re-processing the same day posts nothing")
+	assert.NotEqual(t, postings[0].ExternalID(d.ID), postings[1].ExternalID(d.ID))
+}
+
+func TestTermDeposit_ProcessWithdraw(t *testing.T) {
+	d := testDeposit(CompoundingSimple, MaturityWithdraw)
+	interest := d.ExpectedInterest()
+
+	postings := d.Process(date(2025, 8, 1))
+	require.Len(t, postings, 8)
+	out, in := postings[6], postings[7]
+	assert.Equal(t, PostingMaturityOut, out.Kind)
+	assert.Equal(t, d.AccountID, out.AccountID)
+	assert.Equal(t, -(100_000_000 + interest), out.Amount)
+	assert.Equal(t, PostingMaturityIn, in.Kind)
+	assert.Equal(t, *d.PayoutAccountID, in.AccountID)
+	assert.Equal(t, 100_000_000+interest, in.Amount)
+
+	assert.Equal(t, DepositStatusMatured, d.Status)
+	assert.Equal(t, date(2025, 7, 15), *d.MaturedAt)
+	assert.Empty(t, d.Process(date(2026, 1, 1)))
+}
+
+func TestTermDeposit_ProcessRollover(t *testing.T) {
+	withInterest := testDeposit(CompoundingSimple, MaturityRolloverWithInterest)
+	interest := withInterest.ExpectedInterest()
+	postings := withInterest.Process(date(2025, 7, 15))
+	assert.Len(t, postings, 6, "interest stays in the account")
+	assert.Equal(t, DepositStatusActive, withInterest.Status)
+	assert.Equal(t, 1, withInterest.RolloverCount)
+	assert.Equal(t, 100_000_000+interest, withInterest.Principal)
+	assert.Equal(t, date(2025, 7, 15), withInterest.TermStartDate)
+	assert.Equal(t, date(2026, 1, 15), withInterest.MaturityDate)
+	assert.Zero(t, withInterest.AccruedInterest)
+
+	principalOnly := testDeposit(CompoundingSimple, MaturityRolloverPrincipal)
+	postings = principalOnly.Process(date(2026, 2, 1))
+	// Two terms elapsed: 12 interest postings and an interest payout pair per maturity
+	require.Len(t, postings, 16)
+	assert.Equal(t, 2, principalOnly.RolloverCount)
+	assert.Equal(t, 100_000_000.0, principalOnly.Principal)
+	assert.Equal(t, PostingMaturityIn, postings[7].Kind)
+	assert.Equal(t, interest, postings[7].Amount)
+}
+
+func TestTermDeposit_EffectiveAnnualYield(t *testing.T) {
+	d := testDeposit(CompoundingSimple, MaturityRolloverWithInterest)
+	d.TermMonths = 12
+	d.StartTerm(date(2025, 1, 1))
+	assert.InDelta(t, 0.06, d.EffectiveAnnualYield(), 0.0001)
+
+	// Shorter terms renewed with interest compound to a higher annual yield
+	d.TermMonths = 3
+	d.StartTerm(date(2025, 1, 1))
+	assert.Greater(t, d.EffectiveAnnualYield(), 0.06)
+}
+
+func TestBuildLadder(t *testing.T) {
+	a := testDeposit(CompoundingSimple, MaturityWithdraw) // matures 2025-07-15
+	b := testDeposit(CompoundingSimple, MaturityWithdraw)
+	b.AnnualRate = 4
+	b.Principal = 50_000_000
+	b.TermMonths = 1
+	b.StartTerm(date(2025, 7, 1)) // matures 2025-08-01
+	c := testDeposit(CompoundingSimple, MaturityWithdraw)
+	c.Currency = "USD"
+	c.Principal = 1_000
+	c.StartTerm(date(2025, 1, 20)) // matures 2025-07-20
+	closed := testDeposit(CompoundingSimple, MaturityWithdraw)
+	closed.Status = DepositStatusMatured
+
+	convert := func(amount float64, currency string) float64 {
+		if currency == "USD" {
+			return amount * 25_000
+		}
+		return amount
+	}
+	ladder := BuildLadder([]TermDeposit{*b, *a, *closed, *c}, date(2025, 7, 10), convert)
+
+	require.Len(t, ladder.Rungs, 2)
+	assert.Equal(t, date(2025, 7, 1), ladder.Rungs[0].Month)
+	assert.Len(t, ladder.Rungs[0].Deposits, 2)
+	assert.Equal(t, 125_000_000.0, ladder.Rungs[0].Principal)
+	assert.Equal(t, date(2025, 8, 1), ladder.Rungs[1].Month)
+
+	assert.Equal(t, 175_000_000.0, ladder.TotalPrincipal)
+	assert.InDelta(t, (6*125_000_000+4*50_000_000)/175_000_000.0, ladder.WeightedRate, 1e-9)
+	assert.Equal(t, 175_000_000.0, ladder.MaturingWithin30Days)
+	assert.Equal(t, date(2025, 7, 15), *ladder.NextMaturity)
+
+	summary := ladder.Yield()
+	assert.Equal(t, 3, summary.Count)
+	assert.Equal(t, 175_000_000.0, summary.Balance)
+	assert.Greater(t, summary.AnnualYield, 0.04)
+	assert.Less(t, summary.AnnualYield, 0.065)
+}
@@ -0,0 +1,75 @@
+package domain
+
+// Compounding determines when accrued interest is added to the interest-earning base
+type Compounding string
+
+const (
+	CompoundingSimple    Compounding = "simple"    // interest earned on the principal only
+	CompoundingMonthly   Compounding = "monthly"   // interest added to the base every month
+	CompoundingQuarterly Compounding = "quarterly" // interest added to the base every three months
+)
+
+// IsValid checks if the compounding mode is valid
+func (c Compounding) IsValid() bool {
+	switch c {
+	case CompoundingSimple, CompoundingMonthly, CompoundingQuarterly:
+		return true
+	}
+	return false
+}
+
+// IntervalMonths returns how many months pass between capitalizations; 0 for simple interest
+func (c Compounding) IntervalMonths() int {
+	switch c {
+	case CompoundingMonthly:
+		return 1
+	case CompoundingQuarterly:
+		return 3
+	}
+	return 0
+}
+
+// MaturityInstruction tells the bank what to do when a term ends
+type MaturityInstruction string
+
+const (
+	MaturityRolloverPrincipal    MaturityInstruction = "rollover_principal"     // renew the principal, pay out the interest
+	MaturityRolloverWithInterest MaturityInstruction = "rollover_with_interest" // renew principal plus interest
+	MaturityWithdraw             MaturityInstruction = "withdraw"               // pay out principal and interest, close the deposit
+)
+
+// IsValid checks if the maturity instruction is valid
+func (i MaturityInstruction) IsValid() bool {
+	switch i {
+	case MaturityRolloverPrincipal, MaturityRolloverWithInterest, MaturityWithdraw:
+		return true
+	}
+	return false
+}
+
+// PaysOut reports whether the instruction moves money to the payout account at maturity
+func (i MaturityInstruction) PaysOut() bool {
+	return i == MaturityRolloverPrincipal || i == MaturityWithdraw
+}
+
+// DepositStatus represents the lifecycle of a term deposit
+type DepositStatus string
+
+const (
+	DepositStatusActive  DepositStatus = "active"  // earning interest
+	DepositStatusMatured DepositStatus = "matured" // withdrawn at maturity
+)
+
+// IsValid checks if the deposit status is valid
+func (s DepositStatus) IsValid() bool {
+	return s == DepositStatusActive || s == DepositStatusMatured
+}
+
+// PostingKind identifies the purpose of a transaction generated by a deposit
+type PostingKind string
+
+const (
+	PostingInterest    PostingKind = "interest"     // interest credited to the deposit account
+	PostingMaturityOut PostingKind = "maturity_out" // funds leaving the deposit account at maturity
+	PostingMaturityIn  PostingKind = "maturity_in"  // funds arriving in the payout account at maturity
+)
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// LadderRung groups the deposits maturing in one calendar month
+type LadderRung struct {
+	Month         time.Time // first day of the month
+	Deposits      []TermDeposit
+	Principal     float64
+	MaturityValue float64
+	WeightedRate  float64 // principal-weighted annual rate (%)
+}
+
+// Ladder shows how deposits are spread over maturity dates, in the base currency
+type Ladder struct {
+	AsOf                  time.Time
+	Rungs                 []LadderRung
+	TotalPrincipal        float64
+	TotalMaturityValue    float64
+	WeightedRate          float64 // principal-weighted annual rate (%)
+	EffectiveYield        float64 // principal-weighted effective annual yield (decimal)
+	AverageDaysToMaturity float64 // principal-weighted
+	MaturingWithin30Days  float64 // principal becoming available within 30 days
+	NextMaturity          *time.Time
+}
+
+// YieldSummary is the principal-weighted yield of a user's active deposits, in the base currency
+type YieldSummary struct {
+	Balance     float64
+	AnnualYield float64 // effective annual yield (decimal, 0.055 = 5.5%)
+	Count       int
+}
+
+// Converter converts an amount in the given currency to the base currency
+type Converter func(amount float64, currency string) float64
+
+// BuildLadder groups active deposits into monthly maturity rungs
+func BuildLadder(deposits []TermDeposit, asOf time.Time, convert Converter) *Ladder {
+	asOf = truncateToDay(asOf)
+	ladder := &Ladder{AsOf: asOf}
+
+	active := make([]TermDeposit, 0, len(deposits))
+	for _, d := range deposits {
+		if d.Status == DepositStatusActive {
+			active = append(active, d)
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].MaturityDate.Before(active[j].MaturityDate)
+	})
+
+	var rateWeight, yieldWeight, daysWeight float64
+	for i := range active {
+		d := &active[i]
+		principal := convert(d.Principal, d.Currency)
+		maturityValue := convert(d.MaturityValue(), d.Currency)
+		month := time.Date(d.MaturityDate.Year(), d.MaturityDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		if n := len(ladder.Rungs); n == 0 || !ladder.Rungs[n-1].Month.Equal(month) {
+			ladder.Rungs = append(ladder.Rungs, LadderRung{Month: month})
+		}
+		rung := &ladder.Rungs[len(ladder.Rungs)-1]
+		rung.Deposits = append(rung.Deposits, *d)
+		rung.WeightedRate = weightedAverage(rung.WeightedRate, rung.Principal, d.AnnualRate, principal)
+		rung.Principal += principal
+		rung.MaturityValue += maturityValue
+
+		days := d.DaysToMaturity(asOf)
+		if days <= 30 {
+			ladder.MaturingWithin30Days += principal
+		}
+		rateWeight += d.AnnualRate * principal
+		yieldWeight += d.EffectiveAnnualYield() * principal
+		daysWeight += float64(days) * principal
+		ladder.TotalPrincipal += principal
+		ladder.TotalMaturityValue += maturityValue
+	}
+
+	if ladder.TotalPrincipal > 0 {
+		ladder.WeightedRate = rateWeight / ladder.TotalPrincipal
+		ladder.EffectiveYield = yieldWeight / ladder.TotalPrincipal
+		ladder.AverageDaysToMaturity = daysWeight / ladder.TotalPrincipal
+	}
+	if len(active) > 0 {
+		next := active[0].MaturityDate
+		ladder.NextMaturity = &next
+	}
+	return ladder
+}
+
+// Yield summarizes the ladder's balance and yield
+func (l *Ladder) Yield() YieldSummary {
+	count := 0
+	for _, rung := range l.Rungs {
+		count += len(rung.Deposits)
+	}
+	return YieldSummary{Balance: l.TotalPrincipal, AnnualYield: l.EffectiveYield, Count: count}
+}
+
+// weightedAverage folds a new value with weight w into an average over weight total
+func weightedAverage(average, total, value, w float64) float64 {
+	if total+w <= 0 {
+		return 0
+	}
+	return (average*total + value*w) / (total + w)
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+// CreateTermDepositRequest represents a new term deposit held in a savings account
+type CreateTermDepositRequest struct {
+	AccountID           string     `json:"account_id" binding:"required,uuid"` // Savings account holding the deposit
+	Name                string     `json:"name" binding:"required,max=255"`
+	Principal           float64    `json:"principal" binding:"required,gt=0"`
+	AnnualRate          float64    `json:"annual_rate" binding:"min=0,max=100"` // Annual interest rate (%)
+	TermMonths          int        `json:"term_months" binding:"required,min=1,max=360"`
+	Compounding         *string    `json:"compounding,omitempty" binding:"omitempty,oneof=simple monthly quarterly"`
+	MaturityInstruction *string    `json:"maturity_instruction,omitempty" binding:"omitempty,oneof=rollover_principal rollover_with_interest withdraw"`
+	PayoutAccountID     *string    `json:"payout_account_id,omitempty" binding:"omitempty,uuid"` // Required for rollover_principal and withdraw
+	StartDate           *time.Time `json:"start_date,omitempty"`                                 // Defaults to today; past dates catch up on interest
+}
+
+// UpdateTermDepositRequest represents changes to a deposit; rate changes apply from the next accrual
+type UpdateTermDepositRequest struct {
+	Name                *string  `json:"name,omitempty" binding:"omitempty,max=255"`
+	AnnualRate          *float64 `json:"annual_rate,omitempty" binding:"omitempty,min=0,max=100"`
+	MaturityInstruction *string  `json:"maturity_instruction,omitempty" binding:"omitempty,oneof=rollover_principal rollover_with_interest withdraw"`
+	PayoutAccountID     *string  `json:"payout_account_id,omitempty" binding:"omitempty,uuid"`
+}
+
+// ListTermDepositsQuery represents query parameters for listing deposits
+type ListTermDepositsQuery struct {
+	Status *string `form:"status" binding:"omitempty,oneof=active matured"`
+}
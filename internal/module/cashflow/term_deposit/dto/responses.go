@@ -0,0 +1,137 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/term_deposit/domain"
+
+	"github.com/google/uuid"
+)
+
+// TermDepositResponse represents a term deposit in API responses
+type TermDepositResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	AccountID       uuid.UUID  `json:"account_id"`
+	PayoutAccountID *uuid.UUID `json:"payout_account_id,omitempty"`
+	Name            string     `json:"name"`
+	Currency        string     `json:"currency"`
+
+	Principal           float64                    `json:"principal"`
+	AnnualRate          float64                    `json:"annual_rate"`
+	TermMonths          int                        `json:"term_months"`
+	Compounding         domain.Compounding         `json:"compounding"`
+	MaturityInstruction domain.MaturityInstruction `json:"maturity_instruction"`
+
+	TermStartDate    time.Time  `json:"term_start_date"`
+	MaturityDate     time.Time  `json:"maturity_date"`
+	DaysToMaturity   int        `json:"days_to_maturity"`
+	AccruedInterest  float64    `json:"accrued_interest"`
+	ExpectedInterest float64    `json:"expected_interest"`
+	MaturityValue    float64    `json:"maturity_value"`
+	EffectiveYield   float64    `json:"effective_yield"`
+	LastAccrualDate  *time.Time `json:"last_accrual_date,omitempty"`
+	RolloverCount    int        `json:"rollover_count"`
+
+	Status    domain.DepositStatus `json:"status"`
+	MaturedAt *time.Time           `json:"matured_at,omitempty"`
+	EventID   *uuid.UUID           `json:"event_id,omitempty"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// LadderRungResponse represents the deposits maturing in one month
+type LadderRungResponse struct {
+	Month         string                `json:"month"` // YYYY-MM
+	Principal     float64               `json:"principal"`
+	MaturityValue float64               `json:"maturity_value"`
+	WeightedRate  float64               `json:"weighted_rate"`
+	Deposits      []TermDepositResponse `json:"deposits"`
+}
+
+// LadderResponse represents the maturity ladder of a user's deposits, in the base currency
+type LadderResponse struct {
+	AsOf                  time.Time            `json:"as_of"`
+	Currency              string               `json:"currency"`
+	Rungs                 []LadderRungResponse `json:"rungs"`
+	TotalPrincipal        float64              `json:"total_principal"`
+	TotalMaturityValue    float64              `json:"total_maturity_value"`
+	WeightedRate          float64              `json:"weighted_rate"`
+	EffectiveYield        float64              `json:"effective_yield"`
+	AverageDaysToMaturity float64              `json:"average_days_to_maturity"`
+	MaturingWithin30Days  float64              `json:"maturing_within_30_days"`
+	NextMaturity          *time.Time           `json:"next_maturity,omitempty"`
+}
+
+// ToTermDepositResponse converts a domain deposit to response DTO
+func ToTermDepositResponse(deposit *domain.TermDeposit) *TermDepositResponse {
+	if deposit == nil {
+		return nil
+	}
+
+	return &TermDepositResponse{
+		ID:                  deposit.ID,
+		AccountID:           deposit.AccountID,
+		PayoutAccountID:     deposit.PayoutAccountID,
+		Name:                deposit.Name,
+		Currency:            deposit.Currency,
+		Principal:           deposit.Principal,
+		AnnualRate:          deposit.AnnualRate,
+		TermMonths:          deposit.TermMonths,
+		Compounding:         deposit.Compounding,
+		MaturityInstruction: deposit.MaturityInstruction,
+		TermStartDate:       deposit.TermStartDate,
+		MaturityDate:        deposit.MaturityDate,
+		DaysToMaturity:      deposit.DaysToMaturity(time.Now()),
+		AccruedInterest:     deposit.AccruedInterest,
+		ExpectedInterest:    deposit.ExpectedInterest(),
+		MaturityValue:       deposit.MaturityValue(),
+		EffectiveYield:      deposit.EffectiveAnnualYield(),
+		LastAccrualDate:     deposit.LastAccrualDate,
+		RolloverCount:       deposit.RolloverCount,
+		Status:              deposit.Status,
+		MaturedAt:           deposit.MaturedAt,
+		EventID:             deposit.EventID,
+		CreatedAt:           deposit.CreatedAt,
+		UpdatedAt:           deposit.UpdatedAt,
+	}
+}
+
+// ToTermDepositResponses converts domain deposits to response DTOs
+func ToTermDepositResponses(deposits []domain.TermDeposit) []TermDepositResponse {
+	responses := make([]TermDepositResponse, len(deposits))
+	for i := range deposits {
+		responses[i] = *ToTermDepositResponse(&deposits[i])
+	}
+	return responses
+}
+
+// ToLadderResponse converts a domain ladder to response DTO
+func ToLadderResponse(ladder *domain.Ladder, currency string) *LadderResponse {
+	if ladder == nil {
+		return nil
+	}
+
+	rungs := make([]LadderRungResponse, len(ladder.Rungs))
+	for i, rung := range ladder.Rungs {
+		rungs[i] = LadderRungResponse{
+			Month:         rung.Month.Format("2006-01"),
+			Principal:     rung.Principal,
+			MaturityValue: rung.MaturityValue,
+			WeightedRate:  rung.WeightedRate,
+			Deposits:      ToTermDepositResponses(rung.Deposits),
+		}
+	}
+
+	return &LadderResponse{
+		AsOf:                  ladder.AsOf,
+		Currency:              currency,
+		Rungs:                 rungs,
+		TotalPrincipal:        ladder.TotalPrincipal,
+		TotalMaturityValue:    ladder.TotalMaturityValue,
+		WeightedRate:          ladder.WeightedRate,
+		EffectiveYield:        ladder.EffectiveYield,
+		AverageDaysToMaturity: ladder.AverageDaysToMaturity,
+		MaturingWithin30Days:  ladder.MaturingWithin30Days,
+		NextMaturity:          ladder.NextMaturity,
+	}
+}
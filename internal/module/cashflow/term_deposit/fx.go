@@ -0,0 +1,44 @@
+package term_deposit
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/term_deposit/handler"
+	"personalfinancedss/internal/module/cashflow/term_deposit/repository"
+	"personalfinancedss/internal/module/cashflow/term_deposit/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides term deposit dependencies. The accrual job runs on the notification
+// scheduler through the scheduled_jobs group.
+var Module = fx.Module("term_deposit",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+		func(svc service.Service) service.YieldReporter { return svc },
+
+		// Handler
+		handler.NewHandler,
+
+		// Scheduled job
+		fx.Annotate(
+			service.NewAccrualJob,
+			fx.ResultTags(`group:"scheduled_jobs"`),
+		),
+	),
+	fx.Invoke(registerTermDepositRoutes),
+)
+
+func registerTermDepositRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/module/cashflow/term_deposit/dto"
+	"personalfinancedss/internal/module/cashflow/term_deposit/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages term deposit endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs a term deposit handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("term_deposit.handler"),
+	}
+}
+
+// RegisterRoutes wires term deposit routes under /api/v1/term-deposits.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	deposits := r.Group("/api/v1/term-deposits")
+	deposits.Use(authMiddleware.AuthMiddleware())
+	{
+		deposits.POST("", h.createDeposit)
+		deposits.GET("", h.listDeposits)
+		deposits.GET("/ladder", h.getLadder)
+		deposits.GET("/:id", h.getDeposit)
+		deposits.PUT("/:id", h.updateDeposit)
+		deposits.DELETE("/:id", h.deleteDeposit)
+	}
+}
+
+// createDeposit godoc
+// @Summary Create term deposit
+// @Description Record a term deposit held in a savings account. Interest and maturity transactions are posted automatically and the maturity date is added to the calendar
+// @Tags term-deposits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateTermDepositRequest true "Deposit terms"
+// @Success 201 {object} dto.TermDepositResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/term-deposits [post]
+func (h *Handler) createDeposit(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.CreateTermDepositRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	deposit, err := h.service.CreateDeposit(c.Request.Context(), currentUser.ID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Term deposit created successfully", dto.ToTermDepositResponse(deposit))
+}
+
+// listDeposits godoc
+// @Summary List term deposits
+// @Description List the user's term deposits ordered by maturity date
+// @Tags term-deposits
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status (active, matured)"
+// @Success 200 {array} dto.TermDepositResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/term-deposits [get]
+func (h *Handler) listDeposits(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var query dto.ListTermDepositsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid query parameters")
+		return
+	}
+
+	deposits, err := h.service.ListDeposits(c.Request.Context(), currentUser.ID, query)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Term deposits retrieved successfully", dto.ToTermDepositResponses(deposits))
+}
+
+// getLadder godoc
+// @Summary Get deposit ladder
+// @Description Active deposits grouped by maturity month, with principal-weighted rate and yield, in the base currency
+// @Tags term-deposits
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.LadderResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/term-deposits/ladder [get]
+func (h *Handler) getLadder(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	ladder, err := h.service.GetLadder(c.Request.Context(), currentUser.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Deposit ladder retrieved successfully", dto.ToLadderResponse(ladder, exchangerate.BaseCurrency))
+}
+
+// getDeposit godoc
+// @Summary Get term deposit
+// @Description Get a term deposit with its expected interest and effective yield
+// @Tags term-deposits
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Deposit ID"
+// @Success 200 {object} dto.TermDepositResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/term-deposits/{id} [get]
+func (h *Handler) getDeposit(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	depositID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid deposit id")
+		return
+	}
+
+	deposit, err := h.service.GetDeposit(c.Request.Context(), currentUser.ID, depositID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Term deposit retrieved successfully", dto.ToTermDepositResponse(deposit))
+}
+
+// updateDeposit godoc
+// @Summary Update term deposit
+// @Description Change a deposit's name, rate or maturity instruction
+// @Tags term-deposits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Deposit ID"
+// @Param request body dto.UpdateTermDepositRequest true "Changes"
+// @Success 200 {object} dto.TermDepositResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/term-deposits/{id} [put]
+func (h *Handler) updateDeposit(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	depositID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid deposit id")
+		return
+	}
+
+	var req dto.UpdateTermDepositRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	deposit, err := h.service.UpdateDeposit(c.Request.Context(), currentUser.ID, depositID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Term deposit updated successfully", dto.ToTermDepositResponse(deposit))
+}
+
+// deleteDeposit godoc
+// @Summary Delete term deposit
+// @Description Delete a deposit and its maturity event; transactions already posted are kept
+// @Tags term-deposits
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Deposit ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/term-deposits/{id} [delete]
+func (h *Handler) deleteDeposit(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	depositID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid deposit id")
+		return
+	}
+
+	if err := h.service.DeleteDeposit(c.Request.Context(), currentUser.ID, depositID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Term deposit deleted successfully")
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"personalfinancedss/internal/module/cashflow/term_deposit/domain"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines data access for term deposits
+type Repository interface {
+	// Create creates a term deposit
+	Create(ctx context.Context, deposit *domain.TermDeposit) error
+
+	// Update saves a term deposit
+	Update(ctx context.Context, deposit *domain.TermDeposit) error
+
+	// GetByID retrieves a term deposit by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.TermDeposit, error)
+
+	// ListByUserID retrieves a user's deposits, optionally filtered by status, ordered by maturity
+	ListByUserID(ctx context.Context, userID uuid.UUID, status *domain.DepositStatus) ([]domain.TermDeposit, error)
+
+	// ListActive retrieves all active deposits
+	ListActive(ctx context.Context) ([]domain.TermDeposit, error)
+
+	// Delete soft-deletes a term deposit
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"personalfinancedss/internal/module/cashflow/term_deposit/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new term deposit repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, deposit *domain.TermDeposit) error {
+	return r.db.WithContext(ctx).Create(deposit).Error
+}
+
+func (r *repository) Update(ctx context.Context, deposit *domain.TermDeposit) error {
+	return r.db.WithContext(ctx).Save(deposit).Error
+}
+
+func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*domain.TermDeposit, error) {
+	var deposit domain.TermDeposit
+	if err := r.db.WithContext(ctx).First(&deposit, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+func (r *repository) ListByUserID(ctx context.Context, userID uuid.UUID, status *domain.DepositStatus) ([]domain.TermDeposit, error) {
+	var deposits []domain.TermDeposit
+	db := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if status != nil {
+		db = db.Where("status = ?", *status)
+	}
+	err := db.Order("maturity_date ASC").Find(&deposits).Error
+	return deposits, err
+}
+
+func (r *repository) ListActive(ctx context.Context) ([]domain.TermDeposit, error) {
+	var deposits []domain.TermDeposit
+	err := r.db.WithContext(ctx).
+		Where("status = ?", domain.DepositStatusActive).
+		Find(&deposits).Error
+	return deposits, err
+}
+
+func (r *repository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&domain.TermDeposit{}, "id = ?", id).Error
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	notificationService "personalfinancedss/internal/module/notification/service"
+)
+
+// accrualJobSpec checks a few times a day so interest and maturities post on the day they are due
+const accrualJobSpec = "0 45 */6 * * *"
+
+// accrualJob runs the interest and maturity run on the notification scheduler
+type accrualJob struct {
+	service AccrualProcessor
+}
+
+// NewAccrualJob creates the scheduled job that posts term deposit interest and applies maturity instructions
+func NewAccrualJob(service Service) notificationService.ScheduledJob {
+	return &accrualJob{service: service}
+}
+
+func (j *accrualJob) Name() string {
+	return "term_deposit_accruals"
+}
+
+func (j *accrualJob) Spec() string {
+	return accrualJobSpec
+}
+
+func (j *accrualJob) Run(ctx context.Context) error {
+	_, err := j.service.ProcessDueDeposits(ctx, time.Now())
+	return err
+}
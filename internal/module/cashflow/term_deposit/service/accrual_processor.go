@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/term_deposit/domain"
+	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ProcessDueDeposits posts due interest and maturity transactions for all active deposits.
+// Safe to run repeatedly: every posting carries an external ID unique per deposit, kind and date.
+func (s *termDepositService) ProcessDueDeposits(ctx context.Context, asOf time.Time) (int, error) {
+	deposits, err := s.repo.ListActive(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for i := range deposits {
+		if err := s.processDeposit(ctx, &deposits[i], asOf); err != nil {
+			s.logger.Error("Failed to process term deposit",
+				zap.String("deposit_id", deposits[i].ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// processDeposit records a deposit's due postings, saves its new state and keeps the maturity event current
+func (s *termDepositService) processDeposit(ctx context.Context, deposit *domain.TermDeposit, asOf time.Time) error {
+	maturity := deposit.MaturityDate
+	postings := deposit.Process(asOf)
+
+	for _, posting := range postings {
+		if err := s.recordPosting(ctx, deposit, posting); err != nil {
+			return err
+		}
+	}
+
+	if len(postings) > 0 || !deposit.MaturityDate.Equal(maturity) || deposit.Status != domain.DepositStatusActive {
+		if err := s.repo.Update(ctx, deposit); err != nil {
+			return shared.ErrInternal.WithError(err)
+		}
+	}
+
+	if !deposit.MaturityDate.Equal(maturity) {
+		s.logger.Info("Term deposit rolled over",
+			zap.String("deposit_id", deposit.ID.String()),
+			zap.Float64("principal", deposit.Principal),
+			zap.Time("maturity_date", deposit.MaturityDate),
+		)
+	}
+	if deposit.Status == domain.DepositStatusMatured {
+		s.logger.Info("Term deposit matured", zap.String("deposit_id", deposit.ID.String()))
+	}
+
+	if deposit.EventID == nil || !deposit.MaturityDate.Equal(maturity) {
+		s.syncMaturityEvent(ctx, deposit)
+	}
+	return nil
+}
+
+// recordPosting creates the transaction of a posting and applies it to the account balance,
+// skipping postings already recorded by an earlier run
+func (s *termDepositService) recordPosting(ctx context.Context, deposit *domain.TermDeposit, posting domain.Posting) error {
+	externalID := posting.ExternalID(deposit.ID)
+	if existing, err := s.transactionRepo.GetByExternalID(ctx, deposit.UserID, externalID); err == nil && existing != nil {
+		return nil
+	} else if err != nil && err != shared.ErrNotFound {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	direction := transactionDomain.DirectionCredit
+	if posting.Amount < 0 {
+		direction = transactionDomain.DirectionDebit
+	}
+	amount := int64(math.Round(math.Abs(posting.Amount)))
+
+	txn := &transactionDomain.Transaction{
+		ID:          uuid.New(),
+		UserID:      deposit.UserID,
+		AccountID:   posting.AccountID,
+		Source:      transactionDomain.SourceManual,
+		ExternalID:  externalID,
+		Direction:   direction,
+		Channel:     transactionDomain.ChannelUnknown,
+		Instrument:  transactionDomain.InstrumentBankAccount,
+		BookingDate: posting.Date,
+		ValueDate:   posting.Date,
+		Amount:      amount,
+		Currency:    deposit.Currency,
+		Description: postingDescription(deposit, posting.Kind),
+		Reference:   deposit.ID.String(),
+	}
+	if err := s.transactionRepo.Create(ctx, txn); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	if err := s.accountRepo.UpdateBalance(ctx, posting.AccountID.String(), posting.Amount); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
+
+// postingDescription describes a deposit posting on the account statement
+func postingDescription(deposit *domain.TermDeposit, kind domain.PostingKind) string {
+	switch kind {
+	case domain.PostingInterest:
+		return "Term deposit interest: " + deposit.Name
+	case domain.PostingMaturityOut:
+		return "Term deposit payout: " + deposit.Name
+	default:
+		return "Term deposit proceeds: " + deposit.Name
+	}
+}
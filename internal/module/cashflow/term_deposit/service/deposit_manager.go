@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventDomain "personalfinancedss/internal/module/calendar/event/domain"
+	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
+	"personalfinancedss/internal/module/cashflow/term_deposit/domain"
+	"personalfinancedss/internal/module/cashflow/term_deposit/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateDeposit creates a term deposit in a savings account.
+// Deposits started in the past catch up on interest and maturities immediately.
+func (s *termDepositService) CreateDeposit(ctx context.Context, userID uuid.UUID, req dto.CreateTermDepositRequest) (*domain.TermDeposit, error) {
+	accountID, err := uuid.Parse(req.AccountID)
+	if err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("account_id", "invalid account id")
+	}
+	account, err := s.getSavingsAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := &domain.TermDeposit{
+		ID:                  uuid.New(),
+		UserID:              userID,
+		AccountID:           account.ID,
+		Name:                req.Name,
+		Currency:            string(account.Currency),
+		Principal:           req.Principal,
+		AnnualRate:          req.AnnualRate,
+		TermMonths:          req.TermMonths,
+		Compounding:         domain.CompoundingSimple,
+		MaturityInstruction: domain.MaturityRolloverWithInterest,
+		Status:              domain.DepositStatusActive,
+	}
+	if req.Compounding != nil {
+		deposit.Compounding = domain.Compounding(*req.Compounding)
+	}
+	if req.MaturityInstruction != nil {
+		deposit.MaturityInstruction = domain.MaturityInstruction(*req.MaturityInstruction)
+	}
+	if req.PayoutAccountID != nil {
+		payoutID, err := s.getPayoutAccountID(ctx, userID, *req.PayoutAccountID)
+		if err != nil {
+			return nil, err
+		}
+		deposit.PayoutAccountID = &payoutID
+	}
+
+	start := time.Now()
+	if req.StartDate != nil {
+		start = *req.StartDate
+	}
+	if start.After(time.Now()) {
+		return nil, shared.ErrBadRequest.WithDetails("start_date", "cannot be in the future")
+	}
+	deposit.StartTerm(start)
+
+	if err := deposit.Validate(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("deposit", err.Error())
+	}
+
+	if err := s.repo.Create(ctx, deposit); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	if err := s.processDeposit(ctx, deposit, time.Now()); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Term deposit created",
+		zap.String("deposit_id", deposit.ID.String()),
+		zap.String("account_id", deposit.AccountID.String()),
+		zap.Time("maturity_date", deposit.MaturityDate),
+	)
+
+	return deposit, nil
+}
+
+// UpdateDeposit changes a deposit's name, rate or maturity instruction
+func (s *termDepositService) UpdateDeposit(ctx context.Context, userID, depositID uuid.UUID, req dto.UpdateTermDepositRequest) (*domain.TermDeposit, error) {
+	deposit, err := s.GetDeposit(ctx, userID, depositID)
+	if err != nil {
+		return nil, err
+	}
+	if deposit.Status != domain.DepositStatusActive {
+		return nil, shared.ErrBadRequest.WithDetails("status", "matured deposits cannot be changed")
+	}
+
+	if req.Name != nil {
+		deposit.Name = *req.Name
+	}
+	if req.AnnualRate != nil {
+		deposit.AnnualRate = *req.AnnualRate
+	}
+	if req.MaturityInstruction != nil {
+		deposit.MaturityInstruction = domain.MaturityInstruction(*req.MaturityInstruction)
+	}
+	if req.PayoutAccountID != nil {
+		payoutID, err := s.getPayoutAccountID(ctx, userID, *req.PayoutAccountID)
+		if err != nil {
+			return nil, err
+		}
+		deposit.PayoutAccountID = &payoutID
+	}
+
+	if err := deposit.Validate(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("deposit", err.Error())
+	}
+
+	if err := s.repo.Update(ctx, deposit); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	s.syncMaturityEvent(ctx, deposit)
+
+	return deposit, nil
+}
+
+// GetDeposit retrieves a deposit owned by the user
+func (s *termDepositService) GetDeposit(ctx context.Context, userID, depositID uuid.UUID) (*domain.TermDeposit, error) {
+	deposit, err := s.repo.GetByID(ctx, depositID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if deposit.UserID != userID {
+		return nil, shared.ErrNotFound
+	}
+	return deposit, nil
+}
+
+// ListDeposits retrieves a user's deposits ordered by maturity
+func (s *termDepositService) ListDeposits(ctx context.Context, userID uuid.UUID, query dto.ListTermDepositsQuery) ([]domain.TermDeposit, error) {
+	var status *domain.DepositStatus
+	if query.Status != nil {
+		st := domain.DepositStatus(*query.Status)
+		status = &st
+	}
+
+	deposits, err := s.repo.ListByUserID(ctx, userID, status)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return deposits, nil
+}
+
+// DeleteDeposit removes a deposit and its maturity event. Posted transactions are kept.
+func (s *termDepositService) DeleteDeposit(ctx context.Context, userID, depositID uuid.UUID) error {
+	deposit, err := s.GetDeposit(ctx, userID, depositID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, deposit.ID); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	if deposit.EventID != nil {
+		if err := s.events.DeleteEvent(ctx, *deposit.EventID, userID); err != nil {
+			s.logger.Warn("Failed to delete term deposit maturity event",
+				zap.String("deposit_id", deposit.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// getSavingsAccount loads a user's account and checks that it can hold term deposits
+func (s *termDepositService) getSavingsAccount(ctx context.Context, userID, accountID uuid.UUID) (*accountDomain.Account, error) {
+	account, err := s.accountRepo.GetEditableByIDAndUserID(ctx, accountID.String(), userID.String())
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	if account.AccountType != accountDomain.AccountTypeSavings {
+		return nil, shared.ErrBadRequest.WithDetails("account_id", "account is not a savings account")
+	}
+	return account, nil
+}
+
+// getPayoutAccountID parses and checks ownership of a payout account
+func (s *termDepositService) getPayoutAccountID(ctx context.Context, userID uuid.UUID, id string) (uuid.UUID, error) {
+	payoutID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, shared.ErrBadRequest.WithDetails("payout_account_id", "invalid account id")
+	}
+	if _, err := s.accountRepo.GetEditableByIDAndUserID(ctx, payoutID.String(), userID.String()); err != nil {
+		if err == shared.ErrNotFound {
+			return uuid.Nil, shared.ErrBadRequest.WithDetails("payout_account_id", "account not found")
+		}
+		return uuid.Nil, shared.ErrInternal.WithError(err)
+	}
+	return payoutID, nil
+}
+
+// syncMaturityEvent creates or moves the calendar event of an active deposit's maturity date.
+// Calendar failures are logged; they never block deposit processing.
+func (s *termDepositService) syncMaturityEvent(ctx context.Context, deposit *domain.TermDeposit) {
+	if deposit.Status != domain.DepositStatusActive {
+		return
+	}
+
+	name := fmt.Sprintf("Term deposit matures: %s", deposit.Name)
+	description := fmt.Sprintf("Principal %.0f %s at %.2f%%, expected interest %.0f. At maturity: %s.",
+		deposit.Principal, deposit.Currency, deposit.AnnualRate, deposit.ExpectedInterest(), deposit.MaturityInstruction)
+
+	if deposit.EventID != nil {
+		event, err := s.events.GetEvent(ctx, *deposit.EventID, deposit.UserID)
+		if err == nil && event != nil {
+			event.Name = name
+			event.Description = &description
+			event.StartDate = deposit.MaturityDate
+			if err := s.events.UpdateEvent(ctx, event); err != nil {
+				s.logger.Warn("Failed to update term deposit maturity event",
+					zap.String("deposit_id", deposit.ID.String()),
+					zap.Error(err),
+				)
+			}
+			return
+		}
+	}
+
+	event := &eventDomain.Event{
+		UserID:      deposit.UserID,
+		Name:        name,
+		Description: &description,
+		Type:        eventDomain.EventTypeReminder,
+		Source:      eventDomain.SourceSystemGenerated,
+		StartDate:   deposit.MaturityDate,
+		AllDay:      true,
+	}
+	if err := s.events.CreateEvent(ctx, event); err != nil {
+		s.logger.Warn("Failed to create term deposit maturity event",
+			zap.String("deposit_id", deposit.ID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	deposit.EventID = &event.ID
+	if err := s.repo.Update(ctx, deposit); err != nil {
+		s.logger.Error("Failed to link maturity event to term deposit",
+			zap.String("deposit_id", deposit.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	eventService "personalfinancedss/internal/module/calendar/event/service"
+	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/module/cashflow/term_deposit/domain"
+	"personalfinancedss/internal/module/cashflow/term_deposit/dto"
+	"personalfinancedss/internal/module/cashflow/term_deposit/repository"
+	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DepositManager defines term deposit CRUD operations
+type DepositManager interface {
+	CreateDeposit(ctx context.Context, userID uuid.UUID, req dto.CreateTermDepositRequest) (*domain.TermDeposit, error)
+	UpdateDeposit(ctx context.Context, userID, depositID uuid.UUID, req dto.UpdateTermDepositRequest) (*domain.TermDeposit, error)
+	GetDeposit(ctx context.Context, userID, depositID uuid.UUID) (*domain.TermDeposit, error)
+	ListDeposits(ctx context.Context, userID uuid.UUID, query dto.ListTermDepositsQuery) ([]domain.TermDeposit, error)
+	DeleteDeposit(ctx context.Context, userID, depositID uuid.UUID) error
+}
+
+// AccrualProcessor defines interest accrual and maturity processing
+type AccrualProcessor interface {
+	// ProcessDueDeposits posts due interest and maturity transactions for all active deposits
+	ProcessDueDeposits(ctx context.Context, asOf time.Time) (int, error)
+}
+
+// YieldReporter defines deposit ladder and yield reporting
+type YieldReporter interface {
+	// GetLadder groups active deposits by maturity month
+	GetLadder(ctx context.Context, userID uuid.UUID) (*domain.Ladder, error)
+
+	// GetYieldSummary returns the balance and principal-weighted yield of active deposits
+	GetYieldSummary(ctx context.Context, userID uuid.UUID) (*domain.YieldSummary, error)
+}
+
+// Service is the composite interface for all term deposit operations
+type Service interface {
+	DepositManager
+	AccrualProcessor
+	YieldReporter
+}
+
+// termDepositService implements all term deposit use cases
+type termDepositService struct {
+	repo            repository.Repository
+	accountRepo     accountRepo.Repository
+	transactionRepo transactionRepo.Repository
+	events          eventService.Service
+	rates           exchangerate.Provider
+	logger          *zap.Logger
+}
+
+// NewService creates a new term deposit service
+func NewService(
+	repo repository.Repository,
+	accountRepo accountRepo.Repository,
+	transactionRepo transactionRepo.Repository,
+	events eventService.Service,
+	rates exchangerate.Provider,
+	logger *zap.Logger,
+) Service {
+	return &termDepositService{
+		repo:            repo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		events:          events,
+		rates:           rates,
+		logger:          logger.Named("term_deposit.service"),
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"personalfinancedss/internal/module/cashflow/exchangerate"
+	"personalfinancedss/internal/module/cashflow/term_deposit/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetLadder groups a user's active deposits by maturity month, in the base currency
+func (s *termDepositService) GetLadder(ctx context.Context, userID uuid.UUID) (*domain.Ladder, error) {
+	status := domain.DepositStatusActive
+	deposits, err := s.repo.ListByUserID(ctx, userID, &status)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	rates := s.rates.Rates(ctx)
+	toBaseCurrency := func(amount float64, currency string) float64 {
+		return s.toBaseCurrency(rates, amount, currency)
+	}
+	return domain.BuildLadder(deposits, time.Now(), toBaseCurrency), nil
+}
+
+// GetYieldSummary returns the balance and principal-weighted effective yield of active deposits
+func (s *termDepositService) GetYieldSummary(ctx context.Context, userID uuid.UUID) (*domain.YieldSummary, error) {
+	ladder, err := s.GetLadder(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := ladder.Yield()
+	return &summary, nil
+}
+
+// toBaseCurrency converts an amount to the base currency, leaving unsupported currencies as is
+func (s *termDepositService) toBaseCurrency(rates exchangerate.Rates, amount float64, currency string) float64 {
+	converted, err := rates.Convert(amount, currency, exchangerate.BaseCurrency)
+	if err != nil {
+		s.logger.Warn("Unsupported term deposit currency", zap.String("currency", currency))
+		return amount
+	}
+	return converted
+}
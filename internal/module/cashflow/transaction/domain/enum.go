@@ -48,12 +48,14 @@ const (
 	InstrumentCrypto      Instrument = "CRYPTO"
 )
 
-// Unified links (GOAL / BUDGET / DEBT / INCOME_PROFILE)
+// Unified links (GOAL / BUDGET / DEBT / INCOME_PROFILE / SPLIT_GROUP / INSTALLMENT_PLAN)
 type LinkType string
 
 const (
-	LinkGoal          LinkType = "GOAL"
-	LinkBudget        LinkType = "BUDGET"
-	LinkDebt          LinkType = "DEBT"
-	LinkIncomeProfile LinkType = "INCOME_PROFILE"
+	LinkGoal            LinkType = "GOAL"
+	LinkBudget          LinkType = "BUDGET"
+	LinkDebt            LinkType = "DEBT"
+	LinkIncomeProfile   LinkType = "INCOME_PROFILE"
+	LinkSplitGroup      LinkType = "SPLIT_GROUP"      // booked by a shared expense group for an expense or settlement
+	LinkInstallmentPlan LinkType = "INSTALLMENT_PLAN" // booked by an installment plan for a conversion, charge or settlement
 )
@@ -94,8 +94,8 @@ type UpdateTransactionRequest struct {
 
 // TransactionLinkDTO represents a link to another financial entity
 type TransactionLinkDTO struct {
-	Type string `json:"type" binding:"required,oneof=GOAL BUDGET DEBT INCOME_PROFILE"` // GOAL / BUDGET / DEBT / INCOME_PROFILE
-	ID   string `json:"id" binding:"required,uuid"`                                    // Entity ID
+	Type string `json:"type" binding:"required,oneof=GOAL BUDGET DEBT INCOME_PROFILE SPLIT_GROUP INSTALLMENT_PLAN"` // GOAL / BUDGET / DEBT / INCOME_PROFILE / SPLIT_GROUP / INSTALLMENT_PLAN
+	ID   string `json:"id" binding:"required,uuid"`                                                                 // Entity ID
 }
 
 // ListTransactionsQuery represents query parameters for listing transactions
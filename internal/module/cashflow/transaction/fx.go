@@ -4,6 +4,7 @@ import (
 	"personalfinancedss/internal/middleware"
 	budgetService "personalfinancedss/internal/module/cashflow/budget/service"
 	debtService "personalfinancedss/internal/module/cashflow/debt/service"
+	goalService "personalfinancedss/internal/module/cashflow/goal/service"
 	incomeProfileService "personalfinancedss/internal/module/cashflow/income_profile/service"
 	"personalfinancedss/internal/module/cashflow/transaction/handler"
 	"personalfinancedss/internal/module/cashflow/transaction/repository"
@@ -43,9 +44,10 @@ func NewLinkProcessor(
 	budgetSvc budgetService.Service,
 	debtSvc debtService.Service,
 	incomeProfileSvc incomeProfileService.Service,
+	goalSvc goalService.Service,
 	logger *zap.Logger,
 ) *service.LinkProcessor {
-	return service.NewLinkProcessor(budgetSvc, debtSvc, incomeProfileSvc, logger)
+	return service.NewLinkProcessor(budgetSvc, debtSvc, incomeProfileSvc, goalSvc, logger)
 }
 
 func registerTransactionRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
@@ -213,7 +213,12 @@ func (r *gormRepository) Update(ctx context.Context, transaction *domain.Transac
 
 // UpdateColumns updates specific columns of a transaction
 func (r *gormRepository) UpdateColumns(ctx context.Context, id uuid.UUID, columns map[string]interface{}) error {
-	result := r.db.WithContext(ctx).Model(&domain.Transaction{}).Where("id = ?", id).Updates(columns)
+	return r.UpdateColumnsWithTx(r.db.WithContext(ctx), id, columns)
+}
+
+// UpdateColumnsWithTx updates specific columns of a transaction within an existing database transaction
+func (r *gormRepository) UpdateColumnsWithTx(tx *gorm.DB, id uuid.UUID, columns map[string]interface{}) error {
+	result := tx.Model(&domain.Transaction{}).Where("id = ?", id).Updates(columns)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -225,7 +230,12 @@ func (r *gormRepository) UpdateColumns(ctx context.Context, id uuid.UUID, column
 
 // Delete soft deletes a transaction
 func (r *gormRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&domain.Transaction{}, "id = ?", id)
+	return r.DeleteWithTx(r.db.WithContext(ctx), id)
+}
+
+// DeleteWithTx soft deletes a transaction within an existing database transaction
+func (r *gormRepository) DeleteWithTx(tx *gorm.DB, id uuid.UUID) error {
+	result := tx.Delete(&domain.Transaction{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
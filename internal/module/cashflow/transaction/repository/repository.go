@@ -37,9 +37,15 @@ type Repository interface {
 	// UpdateColumns updates specific columns of a transaction
 	UpdateColumns(ctx context.Context, id uuid.UUID, columns map[string]interface{}) error
 
+	// UpdateColumnsWithTx updates specific columns of a transaction within an existing database transaction
+	UpdateColumnsWithTx(tx *gorm.DB, id uuid.UUID, columns map[string]interface{}) error
+
 	// Delete soft deletes a transaction
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// DeleteWithTx soft deletes a transaction within an existing database transaction
+	DeleteWithTx(tx *gorm.DB, id uuid.UUID) error
+
 	// GetAccountBalance calculates the current balance for an account based on transactions
 	GetAccountBalance(ctx context.Context, accountID uuid.UUID) (int64, error)
 
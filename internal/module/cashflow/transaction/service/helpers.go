@@ -199,3 +199,18 @@ func ensureTimestamps(t *domain.Transaction) {
 		t.CreatedAt = now
 	}
 }
+
+// Link utilities
+
+// hasGoalLink reports whether the links include a GOAL link
+func hasGoalLink(links *domain.TransactionLinks) bool {
+	if links == nil {
+		return false
+	}
+	for _, link := range *links {
+		if link.Type == domain.LinkGoal {
+			return true
+		}
+	}
+	return false
+}
@@ -6,12 +6,15 @@ import (
 
 	budgetService "personalfinancedss/internal/module/cashflow/budget/service"
 	debtService "personalfinancedss/internal/module/cashflow/debt/service"
+	goalDomain "personalfinancedss/internal/module/cashflow/goal/domain"
+	goalService "personalfinancedss/internal/module/cashflow/goal/service"
 	incomeProfileService "personalfinancedss/internal/module/cashflow/income_profile/service"
 	"personalfinancedss/internal/module/cashflow/transaction/domain"
 	"personalfinancedss/internal/shared"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // LinkProcessor handles processing of transaction links to update related entities
@@ -19,6 +22,7 @@ type LinkProcessor struct {
 	budgetService        budgetService.Service
 	debtService          debtService.Service
 	incomeProfileService incomeProfileService.Service
+	goalService          goalService.Service
 	logger               *zap.Logger
 }
 
@@ -27,12 +31,14 @@ func NewLinkProcessor(
 	budgetSvc budgetService.Service,
 	debtSvc debtService.Service,
 	incomeProfileSvc incomeProfileService.Service,
+	goalSvc goalService.Service,
 	logger *zap.Logger,
 ) *LinkProcessor {
 	return &LinkProcessor{
 		budgetService:        budgetSvc,
 		debtService:          debtSvc,
 		incomeProfileService: incomeProfileSvc,
+		goalService:          goalSvc,
 		logger:               logger,
 	}
 }
@@ -41,6 +47,8 @@ func NewLinkProcessor(
 // For BUDGET: recalculates budget spending
 // For DEBT: adds payment to the debt
 // For INCOME_PROFILE: validates the link (no update needed, just for analytics)
+// GOAL links are recorded with the transaction itself (see RecordGoalLinksWithTx)
+// SPLIT_GROUP and INSTALLMENT_PLAN links are booked by their owning module and need no update
 func (p *LinkProcessor) ProcessLinks(ctx context.Context, userID uuid.UUID, amount int64, direction domain.Direction, links []domain.TransactionLink) error {
 	if len(links) == 0 {
 		p.logger.Debug("ProcessLinks: No links to process")
@@ -114,6 +122,21 @@ func (p *LinkProcessor) processLink(ctx context.Context, userID uuid.UUID, amoun
 	case domain.LinkIncomeProfile:
 		p.logger.Debug("processLink: Routing to processIncomeProfileLink")
 		return p.processIncomeProfileLink(ctx, userID, linkID)
+	case domain.LinkGoal:
+		p.logger.Debug("processLink: Goal contribution already recorded with the transaction",
+			zap.String("goal_id", linkID.String()),
+		)
+		return nil
+	case domain.LinkSplitGroup:
+		p.logger.Debug("processLink: Split group balances are kept by the expense group",
+			zap.String("group_id", linkID.String()),
+		)
+		return nil
+	case domain.LinkInstallmentPlan:
+		p.logger.Debug("processLink: Installment charges are tracked by the plan",
+			zap.String("plan_id", linkID.String()),
+		)
+		return nil
 	default:
 		p.logger.Error("processLink: Unknown link type",
 			zap.String("link_type", string(link.Type)),
@@ -181,8 +204,9 @@ func (p *LinkProcessor) processBudgetLink(ctx context.Context, userID uuid.UUID,
 	return nil
 }
 
-// processDebtLink adds payment to the debt
-// Only DEBIT transactions pay off debt (money going out to pay debt)
+// processDebtLink adds a repayment to the debt
+// DEBIT transactions repay what the user owes (money going out to the creditor);
+// CREDIT transactions repay money the user lent (money coming back from the counterparty)
 func (p *LinkProcessor) processDebtLink(ctx context.Context, debtID uuid.UUID, amount int64, direction domain.Direction) error {
 	p.logger.Info("processDebtLink: Starting to process debt link",
 		zap.String("debt_id", debtID.String()),
@@ -190,12 +214,26 @@ func (p *LinkProcessor) processDebtLink(ctx context.Context, debtID uuid.UUID, a
 		zap.String("direction", string(direction)),
 	)
 
-	// Only process DEBIT transactions (payment going out)
-	if direction == domain.DirectionDebit {
+	debt, err := p.debtService.GetDebtByID(ctx, debtID)
+	if err != nil {
+		p.logger.Error("processDebtLink: Failed to get debt",
+			zap.String("debt_id", debtID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrNotFound.WithDetails("reason", "debt not found")
+	}
+
+	repayment := domain.DirectionDebit
+	if debt.IsReceivable() {
+		repayment = domain.DirectionCredit
+	}
+
+	if direction == repayment {
 		amountFloat := float64(amount)
-		p.logger.Info("processDebtLink: Processing DEBIT transaction - calling AddPayment",
+		p.logger.Info("processDebtLink: Processing repayment - calling AddPayment",
 			zap.String("debt_id", debtID.String()),
 			zap.Float64("amount", amountFloat),
+			zap.Bool("receivable", debt.IsReceivable()),
 		)
 		debt, err := p.debtService.AddPayment(ctx, debtID, amountFloat)
 		if err != nil {
@@ -215,10 +253,10 @@ func (p *LinkProcessor) processDebtLink(ctx context.Context, debtID uuid.UUID, a
 			zap.Float64("debt_percentage_paid", debt.PercentagePaid),
 		)
 	} else {
-		p.logger.Debug("processDebtLink: Skipping non-DEBIT transaction",
+		p.logger.Debug("processDebtLink: Skipping transaction that does not repay the debt",
 			zap.String("debt_id", debtID.String()),
 			zap.String("direction", string(direction)),
-			zap.String("reason", "only DEBIT transactions can pay off debt"),
+			zap.String("reason", "debts are repaid by DEBIT transactions, money lent by CREDIT transactions"),
 		)
 	}
 
@@ -270,6 +308,12 @@ func (p *LinkProcessor) ValidateLinks(ctx context.Context, userID uuid.UUID, lin
 			if err != nil {
 				return shared.ErrNotFound.WithDetails("reason", fmt.Sprintf("income profile not found: %s", link.ID))
 			}
+		case domain.LinkGoal:
+			if _, err := p.goalService.GetEditableGoal(ctx, linkID, userID); err != nil {
+				return shared.ErrNotFound.WithDetails("reason", fmt.Sprintf("goal not found: %s", link.ID))
+			}
+		case domain.LinkSplitGroup, domain.LinkInstallmentPlan:
+			// Booked by the expense group or installment plan itself; nothing to look up here
 		default:
 			return shared.ErrBadRequest.WithDetails("reason", fmt.Sprintf("unknown link type: %s", link.Type))
 		}
@@ -277,3 +321,119 @@ func (p *LinkProcessor) ValidateLinks(ctx context.Context, userID uuid.UUID, lin
 
 	return nil
 }
+
+// RecordGoalLinksWithTx records a goal contribution for each GOAL link of the transaction:
+// a deposit for DEBIT (money moved into savings) and a withdrawal for CREDIT (money taken out).
+// It runs inside the caller's database transaction.
+func (p *LinkProcessor) RecordGoalLinksWithTx(ctx context.Context, tx *gorm.DB, userID uuid.UUID, transaction *domain.Transaction) error {
+	if transaction.Links == nil {
+		return nil
+	}
+
+	contributionType := goalDomain.ContributionTypeDeposit
+	if transaction.Direction == domain.DirectionCredit {
+		contributionType = goalDomain.ContributionTypeWithdrawal
+	}
+
+	for _, link := range *transaction.Links {
+		if link.Type != domain.LinkGoal {
+			continue
+		}
+
+		goalID, err := uuid.Parse(link.ID)
+		if err != nil {
+			return shared.ErrBadRequest.WithDetails("reason", fmt.Sprintf("invalid link ID: %s", link.ID))
+		}
+		if _, err := p.goalService.GetEditableGoal(ctx, goalID, userID); err != nil {
+			return shared.ErrNotFound.WithDetails("reason", fmt.Sprintf("goal not found: %s", link.ID))
+		}
+
+		goal, err := p.goalService.RecordTransactionContribution(tx, goalID, transaction.ID, transaction.AccountID, contributionType, float64(transaction.Amount))
+		if err != nil {
+			p.logger.Error("RecordGoalLinksWithTx: Failed to record goal contribution",
+				zap.String("goal_id", goalID.String()),
+				zap.String("transaction_id", transaction.ID.String()),
+				zap.Error(err),
+			)
+			if _, ok := err.(*shared.AppError); ok {
+				return err
+			}
+			return shared.ErrInternal.WithError(err)
+		}
+
+		p.logger.Info("RecordGoalLinksWithTx: Recorded goal contribution",
+			zap.String("goal_id", goalID.String()),
+			zap.String("transaction_id", transaction.ID.String()),
+			zap.String("type", string(contributionType)),
+			zap.Float64("goal_current_amount", goal.CurrentAmount),
+		)
+	}
+
+	return nil
+}
+
+// ReverseGoalLinksWithTx reverses the goal contributions recorded for a transaction
+// so an edited or deleted transaction no longer counts towards its goals
+func (p *LinkProcessor) ReverseGoalLinksWithTx(tx *gorm.DB, transactionID uuid.UUID) error {
+	if err := p.goalService.ReverseTransactionContributions(tx, transactionID); err != nil {
+		p.logger.Error("ReverseGoalLinksWithTx: Failed to reverse goal contributions",
+			zap.String("transaction_id", transactionID.String()),
+			zap.Error(err),
+		)
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
+
+// SyncGoalMilestones checks the milestones of every goal linked in the given links. It runs after
+// the transaction commits, so a failure is only logged.
+func (p *LinkProcessor) SyncGoalMilestones(ctx context.Context, links ...*domain.TransactionLinks) {
+	synced := make(map[uuid.UUID]bool)
+	for _, l := range links {
+		if l == nil {
+			continue
+		}
+		for _, link := range *l {
+			if link.Type != domain.LinkGoal {
+				continue
+			}
+			goalID, err := uuid.Parse(link.ID)
+			if err != nil || synced[goalID] {
+				continue
+			}
+			synced[goalID] = true
+
+			if err := p.goalService.SyncMilestones(ctx, goalID); err != nil {
+				p.logger.Warn("SyncGoalMilestones: Failed to sync goal milestones",
+					zap.String("goal_id", goalID.String()),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// LinkWishlistPurchases records an outgoing transaction booked against a budget converted from a
+// wishlist item as that item's purchase. It runs after the transaction commits, so a failure is
+// only logged.
+func (p *LinkProcessor) LinkWishlistPurchases(ctx context.Context, transactionID uuid.UUID, direction domain.Direction, links []domain.TransactionLink) {
+	if direction != domain.DirectionDebit {
+		return
+	}
+	for _, link := range links {
+		if link.Type != domain.LinkBudget {
+			continue
+		}
+		budgetID, err := uuid.Parse(link.ID)
+		if err != nil {
+			continue
+		}
+		if err := p.goalService.LinkBudgetPurchase(ctx, budgetID, transactionID); err != nil {
+			p.logger.Warn("LinkWishlistPurchases: Failed to link wishlist purchase",
+				zap.String("budget_id", budgetID.String()),
+				zap.String("transaction_id", transactionID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -19,6 +19,8 @@ func TestTransactionLink_Types(t *testing.T) {
 		{"Budget link", domain.LinkBudget, "BUDGET"},
 		{"Debt link", domain.LinkDebt, "DEBT"},
 		{"Income profile link", domain.LinkIncomeProfile, "INCOME_PROFILE"},
+		{"Split group link", domain.LinkSplitGroup, "SPLIT_GROUP"},
+		{"Installment plan link", domain.LinkInstallmentPlan, "INSTALLMENT_PLAN"},
 	}
 
 	for _, tt := range tests {
@@ -152,18 +152,31 @@ func (s *transactionService) CreateTransaction(ctx context.Context, userID strin
 		return nil, shared.ErrInternal.WithError(err)
 	}
 
-	// Commit transaction (ACID: transaction + account balance update)
+	// 3. Record goal contributions for GOAL links (within same transaction)
+	if s.linkProcessor != nil && hasGoalLink(transaction.Links) {
+		if err := s.linkProcessor.RecordGoalLinksWithTx(ctx, tx, userUUID, transaction); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// Commit transaction (ACID: transaction + account balance update + goal contributions)
 	if err := tx.Commit().Error; err != nil {
 		return nil, shared.ErrInternal.WithError(err)
 	}
 
-	// 3. Process links after transaction is committed (side effect, not part of ACID)
+	if s.linkProcessor != nil && hasGoalLink(transaction.Links) {
+		s.linkProcessor.SyncGoalMilestones(ctx, transaction.Links)
+	}
+
+	// 4. Process links after transaction is committed (side effect, not part of ACID)
 	// If this fails, transaction and account balance are already committed
 	if s.linkProcessor != nil && len(links) > 0 {
 		if err := s.linkProcessor.ProcessLinks(ctx, userUUID, req.Amount, direction, links); err != nil {
 			// Log the error but don't fail - transaction and balance are already committed
 			// TODO: Consider implementing compensation/rollback for link processing failures
 		}
+		s.linkProcessor.LinkWishlistPurchases(ctx, transaction.ID, direction, links)
 	}
 
 	// Retrieve the created transaction
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"personalfinancedss/internal/module/cashflow/transaction/domain"
 	"personalfinancedss/internal/shared"
 )
 
@@ -21,7 +22,7 @@ func (s *transactionService) DeleteTransaction(ctx context.Context, userID strin
 	}
 
 	// Verify transaction belongs to user
-	_, err = s.repo.GetByUserID(ctx, transactionUUID, userUUID)
+	existing, err := s.repo.GetByUserID(ctx, transactionUUID, userUUID)
 	if err != nil {
 		if err == shared.ErrNotFound {
 			return err
@@ -29,6 +30,11 @@ func (s *transactionService) DeleteTransaction(ctx context.Context, userID strin
 		return shared.ErrInternal.WithError(err)
 	}
 
+	// Reverse goal contributions together with the delete
+	if s.linkProcessor != nil && hasGoalLink(existing.Links) {
+		return s.deleteWithGoalLinks(ctx, existing)
+	}
+
 	// Delete transaction
 	if err := s.repo.Delete(ctx, transactionUUID); err != nil {
 		if err == shared.ErrNotFound {
@@ -39,3 +45,35 @@ func (s *transactionService) DeleteTransaction(ctx context.Context, userID strin
 
 	return nil
 }
+
+// deleteWithGoalLinks deletes the transaction and reverses its goal contributions in one database transaction
+func (s *transactionService) deleteWithGoalLinks(ctx context.Context, existing *domain.Transaction) error {
+	tx := s.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := s.repo.DeleteWithTx(tx, existing.ID); err != nil {
+		tx.Rollback()
+		if err == shared.ErrNotFound {
+			return err
+		}
+		return shared.ErrInternal.WithError(err)
+	}
+
+	if err := s.linkProcessor.ReverseGoalLinksWithTx(tx, existing.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	s.linkProcessor.SyncGoalMilestones(ctx, existing.Links)
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"personalfinancedss/internal/module/cashflow/transaction/domain"
 	"personalfinancedss/internal/module/cashflow/transaction/dto"
 	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
 )
 
 // UpdateTransaction updates an existing transaction
@@ -53,7 +55,12 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, userID strin
 	}
 
 	// Apply updates if any
-	if len(updates) > 0 {
+	if len(updates) > 0 && s.linkProcessor != nil && affectsGoalLinks(existing, updates) {
+		// Goal contributions follow the transaction, so they are adjusted in the same DB transaction
+		if err := s.updateWithGoalLinks(ctx, userUUID, existing, updates); err != nil {
+			return nil, err
+		}
+	} else if len(updates) > 0 {
 		if err := s.repo.UpdateColumns(ctx, transactionUUID, updates); err != nil {
 			if err == shared.ErrNotFound {
 				return nil, err
@@ -77,11 +84,85 @@ func (s *transactionService) UpdateTransaction(ctx context.Context, userID strin
 			// TODO: Consider rollback strategy
 			// Note: Error logging is done in ProcessLinks
 		}
+		s.linkProcessor.LinkWishlistPurchases(ctx, updated.ID, updated.Direction, newLinks)
 	}
 
 	return updated, nil
 }
 
+// updateWithGoalLinks applies the updates, reverses the goal contributions recorded for the
+// old values and records them again for the new values, all in one database transaction
+func (s *transactionService) updateWithGoalLinks(ctx context.Context, userID uuid.UUID, existing *domain.Transaction, updates map[string]interface{}) error {
+	tx := s.db.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := s.repo.UpdateColumnsWithTx(tx, existing.ID, updates); err != nil {
+		tx.Rollback()
+		if err == shared.ErrNotFound {
+			return err
+		}
+		return shared.ErrInternal.WithError(err)
+	}
+
+	if err := s.linkProcessor.ReverseGoalLinksWithTx(tx, existing.ID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	updated := applyGoalLinkUpdates(existing, updates)
+	if err := s.linkProcessor.RecordGoalLinksWithTx(ctx, tx, userID, updated); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	s.linkProcessor.SyncGoalMilestones(ctx, existing.Links, updated.Links)
+
+	return nil
+}
+
+// affectsGoalLinks reports whether the updates change what the transaction contributes to its goals
+func affectsGoalLinks(existing *domain.Transaction, updates map[string]interface{}) bool {
+	if links, ok := updates["links"].(*domain.TransactionLinks); ok && hasGoalLink(links) {
+		return true
+	}
+	if !hasGoalLink(existing.Links) {
+		return false
+	}
+	for _, column := range []string{"amount", "direction", "account_id"} {
+		if _, ok := updates[column]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// applyGoalLinkUpdates returns a copy of the transaction with the updated fields that drive goal contributions
+func applyGoalLinkUpdates(existing *domain.Transaction, updates map[string]interface{}) *domain.Transaction {
+	updated := *existing
+	if amount, ok := updates["amount"].(int64); ok {
+		updated.Amount = amount
+	}
+	if direction, ok := updates["direction"].(domain.Direction); ok {
+		updated.Direction = direction
+	}
+	if accountID, ok := updates["account_id"].(uuid.UUID); ok {
+		updated.AccountID = accountID
+	}
+	if links, ok := updates["links"].(*domain.TransactionLinks); ok {
+		updated.Links = links
+	}
+	return &updated
+}
+
 // collectTransactionUpdates collects and validates update fields from request
 func collectTransactionUpdates(existing *domain.Transaction, req dto.UpdateTransactionRequest) (map[string]interface{}, error) {
 	updates := make(map[string]interface{})
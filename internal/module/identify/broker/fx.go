@@ -5,6 +5,7 @@ import (
 	"personalfinancedss/internal/config"
 	"personalfinancedss/internal/middleware"
 	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	investmentService "personalfinancedss/internal/module/cashflow/investment/service"
 	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
 	"personalfinancedss/internal/module/identify/broker/client/sepay"
 	"personalfinancedss/internal/module/identify/broker/handler"
@@ -56,6 +57,7 @@ func provideSyncService(
 	txnRepo transactionRepo.Repository,
 	encryptionService *internalService.EncryptionService,
 	sepayClient *sepay.Client,
+	investments investmentService.Service,
 	logger *zap.Logger,
 ) *service2.SyncService {
 	return service2.NewSyncService(
@@ -64,6 +66,7 @@ func provideSyncService(
 		txnRepo,
 		encryptionService,
 		sepayClient,
+		investments,
 		logger,
 	)
 }
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	accountDomain "personalfinancedss/internal/module/cashflow/account/domain"
 	accountRepo "personalfinancedss/internal/module/cashflow/account/repository"
+	investmentDto "personalfinancedss/internal/module/cashflow/investment/dto"
+	investmentService "personalfinancedss/internal/module/cashflow/investment/service"
 	transactionDomain "personalfinancedss/internal/module/cashflow/transaction/domain"
 	transactionRepo "personalfinancedss/internal/module/cashflow/transaction/repository"
 	"personalfinancedss/internal/module/identify/broker/client"
@@ -26,6 +28,7 @@ type SyncService struct {
 	transactionRepo   transactionRepo.Repository
 	encryptionService *internalService.EncryptionService
 	sepayClient       *sepay.Client
+	investments       investmentService.BrokerImporter
 	logger            *zap.Logger
 }
 
@@ -36,6 +39,7 @@ func NewSyncService(
 	transactionRepo transactionRepo.Repository,
 	encryptionService *internalService.EncryptionService,
 	sepayClient *sepay.Client,
+	investments investmentService.BrokerImporter,
 	logger *zap.Logger,
 ) *SyncService {
 	return &SyncService{
@@ -44,6 +48,7 @@ func NewSyncService(
 		transactionRepo:   transactionRepo,
 		encryptionService: encryptionService,
 		sepayClient:       sepayClient,
+		investments:       investments,
 		logger:            logger.Named("broker.sync"),
 	}
 }
@@ -156,10 +161,41 @@ func (s *SyncService) SyncBrokerConnection(ctx context.Context, connection *doma
 				}
 			}
 		}
+
+	case domain.BrokerTypeSSI, domain.BrokerTypeOKX:
+		// Investment: sync balance + holdings and trades into the linked account
+		linkedAccount, err := s.findOrCreateLinkedAccount(ctx, connection)
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to get linked account: %v", err)
+			result.Error = &errMsg
+			return result, err
+		}
+
+		if connection.SyncBalance {
+			if err := s.syncAccountBalance(ctx, brokerClient, accessToken, linkedAccount); err != nil {
+				s.logger.Warn("Failed to sync portfolio balance",
+					zap.String("account_id", linkedAccount.ID.String()),
+					zap.Error(err),
+				)
+				result.Details["balance_error"] = err.Error()
+			} else {
+				result.BalanceUpdated = true
+			}
+		}
+
+		if connection.SyncAssets || connection.SyncTransactions {
+			if err := s.syncInvestmentHoldings(ctx, brokerClient, accessToken, connection, linkedAccount, result); err != nil {
+				s.logger.Warn("Failed to sync investment holdings",
+					zap.String("account_id", linkedAccount.ID.String()),
+					zap.Error(err),
+				)
+				result.Details["holdings_error"] = err.Error()
+			}
+		}
 	}
 
 	// Determine overall success
-	result.Success = result.BalanceUpdated || result.TransactionsCount > 0
+	result.Success = result.BalanceUpdated || result.TransactionsCount > 0 || result.AssetsCount > 0
 
 	// Update sync status on connection
 	var syncError *string
@@ -294,6 +330,69 @@ func (s *SyncService) syncBankTransactions(ctx context.Context, brokerClient cli
 	return count, nil
 }
 
+// syncInvestmentHoldings imports broker positions and trades into investment holdings
+func (s *SyncService) syncInvestmentHoldings(ctx context.Context, brokerClient client.BrokerClient, accessToken string, connection *domain.BrokerConnection, account *accountDomain.Account, result *SyncResult) error {
+	var positions []investmentDto.ImportPosition
+	if connection.SyncAssets {
+		brokerPositions, err := brokerClient.GetPositions(ctx, accessToken)
+		if err != nil {
+			return fmt.Errorf("failed to get positions: %w", err)
+		}
+		for _, pos := range brokerPositions {
+			positions = append(positions, investmentDto.ImportPosition{
+				Symbol:       pos.Symbol,
+				Name:         pos.Name,
+				AssetType:    pos.AssetType,
+				Exchange:     pos.Exchange,
+				Quantity:     pos.Quantity,
+				AverageCost:  pos.AverageCostPerUnit,
+				CurrentPrice: pos.CurrentPrice,
+				Currency:     pos.Currency,
+				LastUpdated:  pos.LastUpdated,
+			})
+		}
+	}
+
+	var trades []investmentDto.ImportTrade
+	if connection.SyncTransactions {
+		endDate := time.Now()
+		startDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		if connection.LastSyncAt != nil {
+			startDate = *connection.LastSyncAt
+		}
+
+		brokerTxns, err := brokerClient.GetTransactions(ctx, accessToken, startDate, endDate)
+		if err != nil {
+			return fmt.Errorf("failed to get transactions: %w", err)
+		}
+		for _, txn := range brokerTxns {
+			trades = append(trades, investmentDto.ImportTrade{
+				ExternalID: txn.ExternalID,
+				Type:       txn.TransactionType,
+				Symbol:     txn.Symbol,
+				Quantity:   txn.Quantity,
+				Price:      txn.Price,
+				Amount:     txn.Amount,
+				Fee:        txn.Fee + txn.Commission,
+				Tax:        txn.Tax,
+				Currency:   txn.Currency,
+				TradeDate:  txn.TransactionDate,
+				Notes:      txn.Notes,
+			})
+		}
+	}
+
+	imported, err := s.investments.ImportBrokerData(ctx, account.UserID, account.ID, positions, trades)
+	if err != nil {
+		return err
+	}
+
+	result.AssetsCount += imported.HoldingsUpdated
+	result.TransactionsCount += imported.TradesImported
+	result.UpdatedPricesCount += imported.PricesUpdated
+	return nil
+}
+
 // findOrCreateLinkedAccount finds or creates an account linked to the broker connection
 func (s *SyncService) findOrCreateLinkedAccount(ctx context.Context, connection *domain.BrokerConnection) (*accountDomain.Account, error) {
 	// Find existing account linked to this broker connection
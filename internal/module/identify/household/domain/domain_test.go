@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole_Permissions(t *testing.T) {
+	assert.True(t, RoleOwner.CanManageMembers())
+	assert.False(t, RoleEditor.CanManageMembers())
+
+	assert.True(t, RoleEditor.CanEdit())
+	assert.False(t, RoleViewer.CanEdit())
+	assert.False(t, RoleChild.CanEdit())
+
+	assert.True(t, RoleViewer.CanView(ResourceAccount))
+	assert.True(t, RoleChild.CanView(ResourceGoal))
+	assert.False(t, RoleChild.CanView(ResourceAccount))
+	assert.False(t, Role("guest").CanView(ResourceGoal))
+
+	assert.Equal(t, []Role{RoleOwner, RoleEditor, RoleViewer}, RolesThatCanView(ResourceBudget))
+	assert.Equal(t, []Role{RoleOwner, RoleEditor, RoleViewer, RoleChild}, RolesThatCanView(ResourceGoal))
+}
+
+func TestResourceType_Table(t *testing.T) {
+	assert.Equal(t, "accounts", ResourceAccount.Table())
+	assert.Equal(t, "monthly_budgets", ResourceMonth.Table())
+	assert.False(t, ResourceType("transaction").IsValid())
+}
+
+func TestHousehold_ValidateAndMemberOf(t *testing.T) {
+	owner, partner := uuid.New(), uuid.New()
+	h := &Household{Name: "Nhà mình", Currency: "VND", Members: []Member{
+		{UserID: owner, Role: RoleOwner},
+		{UserID: partner, Role: RoleEditor},
+	}}
+	assert.NoError(t, h.Validate())
+
+	member, ok := h.MemberOf(partner)
+	assert.True(t, ok)
+	assert.Equal(t, RoleEditor, member.Role)
+	_, ok = h.MemberOf(uuid.New())
+	assert.False(t, ok)
+
+	assert.Error(t, (&Household{Name: " ", Currency: "VND"}).Validate())
+	assert.Error(t, (&Household{Name: "Home", Currency: "VN"}).Validate())
+}
@@ -0,0 +1,92 @@
+package domain
+
+// Role is a member's level of access within a household
+type Role string
+
+const (
+	RoleOwner  Role = "owner"  // Manages members and can edit everything shared
+	RoleEditor Role = "editor" // Can edit shared accounts, budgets, goals and months
+	RoleViewer Role = "viewer" // Read-only access to everything shared
+	RoleChild  Role = "child"  // Read-only access to shared goals only
+)
+
+// IsValid checks if the role is valid
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleOwner, RoleEditor, RoleViewer, RoleChild:
+		return true
+	}
+	return false
+}
+
+// CanEdit reports whether the role may change shared resources
+func (r Role) CanEdit() bool {
+	return r == RoleOwner || r == RoleEditor
+}
+
+// CanManageMembers reports whether the role may invite, re-role and remove members
+func (r Role) CanManageMembers() bool {
+	return r == RoleOwner
+}
+
+// CanView reports whether the role may see shared resources of a type
+func (r Role) CanView(resource ResourceType) bool {
+	switch r {
+	case RoleOwner, RoleEditor, RoleViewer:
+		return true
+	case RoleChild:
+		return resource == ResourceGoal
+	}
+	return false
+}
+
+// ResourceType is a kind of record that can be shared into a household
+type ResourceType string
+
+const (
+	ResourceAccount ResourceType = "account"
+	ResourceBudget  ResourceType = "budget"
+	ResourceGoal    ResourceType = "goal"
+	ResourceMonth   ResourceType = "month"
+)
+
+// IsValid checks if the resource type is valid
+func (t ResourceType) IsValid() bool {
+	return t.Table() != ""
+}
+
+// Table returns the table holding resources of the type
+func (t ResourceType) Table() string {
+	switch t {
+	case ResourceAccount:
+		return "accounts"
+	case ResourceBudget:
+		return "budgets"
+	case ResourceGoal:
+		return "goals"
+	case ResourceMonth:
+		return "monthly_budgets"
+	}
+	return ""
+}
+
+// ResourceTypes lists every shareable resource type
+func ResourceTypes() []ResourceType {
+	return []ResourceType{ResourceAccount, ResourceBudget, ResourceGoal, ResourceMonth}
+}
+
+// RolesThatCanView returns the roles allowed to see shared resources of a type
+func RolesThatCanView(resource ResourceType) []Role {
+	var roles []Role
+	for _, role := range []Role{RoleOwner, RoleEditor, RoleViewer, RoleChild} {
+		if role.CanView(resource) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// EditorRoles returns the roles allowed to change shared resources
+func EditorRoles() []Role {
+	return []Role{RoleOwner, RoleEditor}
+}
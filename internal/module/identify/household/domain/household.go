@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Household is a workspace a couple or family shares accounts, budgets, goals and months in.
+// Records stay personal until their owner shares them into a household.
+type Household struct {
+	ID       uuid.UUID `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	OwnerID  uuid.UUID `gorm:"type:uuid;not null;index;column:owner_id" json:"owner_id"`
+	Name     string    `gorm:"type:varchar(100);not null;column:name" json:"name"`
+	Currency string    `gorm:"type:varchar(3);default:'VND';column:currency" json:"currency"`
+
+	Members []Member `gorm:"foreignKey:HouseholdID" json:"members,omitempty"`
+
+	CreatedAt time.Time      `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index;column:deleted_at" json:"-"`
+}
+
+// TableName specifies the table name for Household
+func (Household) TableName() string {
+	return "households"
+}
+
+// Validate checks the household's details
+func (h *Household) Validate() error {
+	if strings.TrimSpace(h.Name) == "" {
+		return errors.New("name is required")
+	}
+	if len(h.Currency) != 3 {
+		return errors.New("currency must be a 3-letter code")
+	}
+	return nil
+}
+
+// Member is a user's membership and role in a household
+type Member struct {
+	ID          uuid.UUID  `gorm:"type:uuid;default:uuidv7();primaryKey" json:"id"`
+	HouseholdID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_household_members_household_user;column:household_id" json:"household_id"`
+	UserID      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_household_members_household_user;index;column:user_id" json:"user_id"`
+	Role        Role       `gorm:"type:varchar(20);not null;column:role" json:"role"`
+	InvitedBy   *uuid.UUID `gorm:"type:uuid;column:invited_by" json:"invited_by,omitempty"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;column:created_at" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;column:updated_at" json:"updated_at"`
+}
+
+// TableName specifies the table name for Member
+func (Member) TableName() string {
+	return "household_members"
+}
+
+// MemberOf returns the user's membership in the household's loaded members
+func (h *Household) MemberOf(userID uuid.UUID) (*Member, bool) {
+	for i := range h.Members {
+		if h.Members[i].UserID == userID {
+			return &h.Members[i], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,30 @@
+package dto
+
+// CreateHouseholdRequest represents a new household workspace
+type CreateHouseholdRequest struct {
+	Name     string  `json:"name" binding:"required,max=100"`
+	Currency *string `json:"currency,omitempty" binding:"omitempty,len=3"` // Defaults to VND
+}
+
+// UpdateHouseholdRequest represents changes to a household's details
+type UpdateHouseholdRequest struct {
+	Name     *string `json:"name,omitempty" binding:"omitempty,max=100"`
+	Currency *string `json:"currency,omitempty" binding:"omitempty,len=3"`
+}
+
+// AddMemberRequest invites an existing user into a household by email
+type AddMemberRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required,oneof=editor viewer child"`
+}
+
+// UpdateMemberRequest changes a member's role
+type UpdateMemberRequest struct {
+	Role string `json:"role" binding:"required,oneof=editor viewer child"`
+}
+
+// ShareResourceRequest shares an account, budget, goal or month the caller owns into a household
+type ShareResourceRequest struct {
+	ResourceType string `json:"resource_type" binding:"required,oneof=account budget goal month"`
+	ResourceID   string `json:"resource_id" binding:"required,uuid"`
+}
@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"time"
+
+	"personalfinancedss/internal/module/identify/household/domain"
+
+	"github.com/google/uuid"
+)
+
+// MemberResponse represents a household member
+type MemberResponse struct {
+	UserID    string    `json:"user_id"`
+	Role      string    `json:"role"`
+	InvitedBy *string   `json:"invited_by,omitempty"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+// HouseholdResponse represents a household with its members and the caller's role
+type HouseholdResponse struct {
+	ID        string           `json:"id"`
+	OwnerID   string           `json:"owner_id"`
+	Name      string           `json:"name"`
+	Currency  string           `json:"currency"`
+	MyRole    string           `json:"my_role,omitempty"`
+	Members   []MemberResponse `json:"members"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// ShareResourceResponse reports a resource's household after sharing or unsharing
+type ShareResourceResponse struct {
+	ResourceType string  `json:"resource_type"`
+	ResourceID   string  `json:"resource_id"`
+	HouseholdID  *string `json:"household_id"`
+}
+
+// ToMemberResponse converts a member to its response
+func ToMemberResponse(member domain.Member) MemberResponse {
+	response := MemberResponse{
+		UserID:   member.UserID.String(),
+		Role:     string(member.Role),
+		JoinedAt: member.CreatedAt,
+	}
+	if member.InvitedBy != nil {
+		invitedBy := member.InvitedBy.String()
+		response.InvitedBy = &invitedBy
+	}
+	return response
+}
+
+// ToHouseholdResponse converts a household to its response as seen by a member
+func ToHouseholdResponse(household *domain.Household, viewer uuid.UUID) *HouseholdResponse {
+	response := &HouseholdResponse{
+		ID:        household.ID.String(),
+		OwnerID:   household.OwnerID.String(),
+		Name:      household.Name,
+		Currency:  household.Currency,
+		Members:   make([]MemberResponse, 0, len(household.Members)),
+		CreatedAt: household.CreatedAt,
+		UpdatedAt: household.UpdatedAt,
+	}
+	for _, member := range household.Members {
+		if member.UserID == viewer {
+			response.MyRole = string(member.Role)
+		}
+		response.Members = append(response.Members, ToMemberResponse(member))
+	}
+	return response
+}
+
+// ToHouseholdResponses converts households to responses as seen by a member
+func ToHouseholdResponses(households []domain.Household, viewer uuid.UUID) []HouseholdResponse {
+	responses := make([]HouseholdResponse, 0, len(households))
+	for i := range households {
+		responses = append(responses, *ToHouseholdResponse(&households[i], viewer))
+	}
+	return responses
+}
@@ -0,0 +1,36 @@
+package household
+
+import (
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/identify/household/handler"
+	"personalfinancedss/internal/module/identify/household/repository"
+	"personalfinancedss/internal/module/identify/household/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+)
+
+// Module provides household dependencies
+var Module = fx.Module("household",
+	fx.Provide(
+		// Repository - provide as interface
+		fx.Annotate(
+			repository.New,
+			fx.As(new(repository.Repository)),
+		),
+
+		// Service - provide as interface
+		fx.Annotate(
+			service.NewService,
+			fx.As(new(service.Service)),
+		),
+
+		// Handler
+		handler.NewHandler,
+	),
+	fx.Invoke(registerHouseholdRoutes),
+)
+
+func registerHouseholdRoutes(router *gin.Engine, h *handler.Handler, authMiddleware *middleware.Middleware) {
+	h.RegisterRoutes(router, authMiddleware)
+}
@@ -0,0 +1,444 @@
+package handler
+
+import (
+	"net/http"
+	"personalfinancedss/internal/middleware"
+	"personalfinancedss/internal/module/identify/household/domain"
+	"personalfinancedss/internal/module/identify/household/dto"
+	"personalfinancedss/internal/module/identify/household/service"
+	"personalfinancedss/internal/shared"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Handler manages household endpoints.
+type Handler struct {
+	service service.Service
+	logger  *zap.Logger
+}
+
+// NewHandler constructs a household handler.
+func NewHandler(service service.Service, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger.Named("household.handler"),
+	}
+}
+
+// RegisterRoutes wires household routes under /api/v1/households.
+func (h *Handler) RegisterRoutes(r *gin.Engine, authMiddleware *middleware.Middleware) {
+	households := r.Group("/api/v1/households")
+	households.Use(authMiddleware.AuthMiddleware())
+	{
+		households.POST("", h.createHousehold)
+		households.GET("", h.listHouseholds)
+		households.DELETE("/shares/:resource_type/:resource_id", h.unshareResource)
+		households.GET("/:id", h.getHousehold)
+		households.PUT("/:id", h.updateHousehold)
+		households.DELETE("/:id", h.deleteHousehold)
+		households.POST("/:id/members", h.addMember)
+		households.PUT("/:id/members/:user_id", h.updateMember)
+		households.DELETE("/:id/members/:user_id", h.removeMember)
+		households.POST("/:id/shares", h.shareResource)
+	}
+}
+
+// createHousehold godoc
+// @Summary Create household
+// @Description Create a shared workspace for a couple or family, with the caller as owner
+// @Tags households
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateHouseholdRequest true "Household details"
+// @Success 201 {object} dto.HouseholdResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households [post]
+func (h *Handler) createHousehold(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	var req dto.CreateHouseholdRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	household, err := h.service.CreateHousehold(c.Request.Context(), currentUser.ID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Household created successfully", dto.ToHouseholdResponse(household, currentUser.ID))
+}
+
+// listHouseholds godoc
+// @Summary List households
+// @Description List the households the caller belongs to
+// @Tags households
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.HouseholdResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households [get]
+func (h *Handler) listHouseholds(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	households, err := h.service.ListHouseholds(c.Request.Context(), currentUser.ID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Households retrieved successfully", dto.ToHouseholdResponses(households, currentUser.ID))
+}
+
+// getHousehold godoc
+// @Summary Get household
+// @Description Get a household the caller belongs to, with its members
+// @Tags households
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Household ID"
+// @Success 200 {object} dto.HouseholdResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households/{id} [get]
+func (h *Handler) getHousehold(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	householdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid household id")
+		return
+	}
+
+	household, err := h.service.GetHousehold(c.Request.Context(), currentUser.ID, householdID)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Household retrieved successfully", dto.ToHouseholdResponse(household, currentUser.ID))
+}
+
+// updateHousehold godoc
+// @Summary Update household
+// @Description Rename a household or change its currency; owner only
+// @Tags households
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Household ID"
+// @Param request body dto.UpdateHouseholdRequest true "Changes"
+// @Success 200 {object} dto.HouseholdResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 403 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households/{id} [put]
+func (h *Handler) updateHousehold(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	householdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid household id")
+		return
+	}
+
+	var req dto.UpdateHouseholdRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	household, err := h.service.UpdateHousehold(c.Request.Context(), currentUser.ID, householdID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Household updated successfully", dto.ToHouseholdResponse(household, currentUser.ID))
+}
+
+// deleteHousehold godoc
+// @Summary Delete household
+// @Description Dissolve a household; everything shared in it becomes personal to its owner again. Owner only
+// @Tags households
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Household ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 403 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households/{id} [delete]
+func (h *Handler) deleteHousehold(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	householdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid household id")
+		return
+	}
+
+	if err := h.service.DeleteHousehold(c.Request.Context(), currentUser.ID, householdID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Household deleted successfully")
+}
+
+// addMember godoc
+// @Summary Add household member
+// @Description Add an existing user by email as editor, viewer or child; owner only
+// @Tags households
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Household ID"
+// @Param request body dto.AddMemberRequest true "Member"
+// @Success 201 {object} dto.HouseholdResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 403 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 409 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households/{id}/members [post]
+func (h *Handler) addMember(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	householdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid household id")
+		return
+	}
+
+	var req dto.AddMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	household, err := h.service.AddMember(c.Request.Context(), currentUser.ID, householdID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusCreated, "Member added successfully", dto.ToHouseholdResponse(household, currentUser.ID))
+}
+
+// updateMember godoc
+// @Summary Change member role
+// @Description Change a member's role; owner only
+// @Tags households
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Household ID"
+// @Param user_id path string true "Member user ID"
+// @Param request body dto.UpdateMemberRequest true "Role"
+// @Success 200 {object} dto.HouseholdResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 403 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households/{id}/members/{user_id} [put]
+func (h *Handler) updateMember(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	householdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid household id")
+		return
+	}
+	memberUserID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	var req dto.UpdateMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	household, err := h.service.UpdateMemberRole(c.Request.Context(), currentUser.ID, householdID, memberUserID, req)
+	if err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Member updated successfully", dto.ToHouseholdResponse(household, currentUser.ID))
+}
+
+// removeMember godoc
+// @Summary Remove household member
+// @Description Remove a member (owner only) or leave a household (pass your own user ID). What the member shared becomes personal again
+// @Tags households
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Household ID"
+// @Param user_id path string true "Member user ID"
+// @Success 200 {object} shared.Success
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 403 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households/{id}/members/{user_id} [delete]
+func (h *Handler) removeMember(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	householdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid household id")
+		return
+	}
+	memberUserID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	if err := h.service.RemoveMember(c.Request.Context(), currentUser.ID, householdID, memberUserID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccessNoData(c, http.StatusOK, "Member removed successfully")
+}
+
+// shareResource godoc
+// @Summary Share into household
+// @Description Share an account, budget, goal or month you own into a household. Requires the owner or editor role
+// @Tags households
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Household ID"
+// @Param request body dto.ShareResourceRequest true "Resource"
+// @Success 200 {object} dto.ShareResourceResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 403 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households/{id}/shares [post]
+func (h *Handler) shareResource(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	householdID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid household id")
+		return
+	}
+
+	var req dto.ShareResourceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid request data")
+		return
+	}
+
+	if err := h.service.ShareResource(c.Request.Context(), currentUser.ID, householdID, req); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	household := householdID.String()
+	shared.RespondWithSuccess(c, http.StatusOK, "Resource shared successfully", dto.ShareResourceResponse{
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceID,
+		HouseholdID:  &household,
+	})
+}
+
+// unshareResource godoc
+// @Summary Make resource personal
+// @Description Take an account, budget, goal or month you own out of its household
+// @Tags households
+// @Produce json
+// @Security BearerAuth
+// @Param resource_type path string true "Resource type (account, budget, goal, month)"
+// @Param resource_id path string true "Resource ID"
+// @Success 200 {object} dto.ShareResourceResponse
+// @Failure 400 {object} shared.ErrorResponse
+// @Failure 401 {object} shared.ErrorResponse
+// @Failure 404 {object} shared.ErrorResponse
+// @Failure 500 {object} shared.ErrorResponse
+// @Router /api/v1/households/shares/{resource_type}/{resource_id} [delete]
+func (h *Handler) unshareResource(c *gin.Context) {
+	currentUser, exists := middleware.GetCurrentUser(c)
+	if !exists {
+		shared.RespondWithError(c, http.StatusUnauthorized, "user not found in context")
+		return
+	}
+
+	resourceID, err := uuid.Parse(c.Param("resource_id"))
+	if err != nil {
+		shared.RespondWithError(c, http.StatusBadRequest, "invalid resource id")
+		return
+	}
+	resource := domain.ResourceType(c.Param("resource_type"))
+
+	if err := h.service.UnshareResource(c.Request.Context(), currentUser.ID, resource, resourceID); err != nil {
+		shared.HandleError(c, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, http.StatusOK, "Resource unshared successfully", dto.ShareResourceResponse{
+		ResourceType: string(resource),
+		ResourceID:   resourceID.String(),
+	})
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"personalfinancedss/internal/module/identify/household/domain"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines data access for households, their members and shared resources
+type Repository interface {
+	// Create creates a household together with its owner's membership
+	Create(ctx context.Context, household *domain.Household, owner *domain.Member) error
+
+	// GetByID retrieves a household with its members
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Household, error)
+
+	// ListByUserID retrieves the households a user is a member of, with their members
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Household, error)
+
+	// Update saves a household's details
+	Update(ctx context.Context, household *domain.Household) error
+
+	// Delete removes a household and its memberships and returns every shared resource to its owner
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetMember retrieves a user's membership in a household
+	GetMember(ctx context.Context, householdID, userID uuid.UUID) (*domain.Member, error)
+
+	// AddMember creates a membership
+	AddMember(ctx context.Context, member *domain.Member) error
+
+	// UpdateMember saves a membership's role
+	UpdateMember(ctx context.Context, member *domain.Member) error
+
+	// RemoveMember deletes a membership and unshares the resources the member shared into the household
+	RemoveMember(ctx context.Context, householdID, userID uuid.UUID) error
+
+	// SetWorkspace shares an owned resource into a household, or makes it personal again when
+	// householdID is nil. It returns shared.ErrNotFound when the user owns no such resource.
+	SetWorkspace(ctx context.Context, resource domain.ResourceType, resourceID, ownerID uuid.UUID, householdID *uuid.UUID) error
+}
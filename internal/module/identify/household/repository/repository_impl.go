@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"personalfinancedss/internal/module/identify/household/domain"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type repository struct {
+	db *gorm.DB
+}
+
+// New creates a new household repository
+func New(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Create(ctx context.Context, household *domain.Household, owner *domain.Member) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Members").Create(household).Error; err != nil {
+			return err
+		}
+		owner.HouseholdID = household.ID
+		return tx.Create(owner).Error
+	})
+}
+
+func (r *repository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Household, error) {
+	var household domain.Household
+	err := r.db.WithContext(ctx).
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		First(&household, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &household, nil
+}
+
+func (r *repository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]domain.Household, error) {
+	var households []domain.Household
+	err := r.db.WithContext(ctx).
+		Preload("Members", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Where("id IN (SELECT household_id FROM household_members WHERE user_id = ?)", userID).
+		Order("created_at ASC").
+		Find(&households).Error
+	return households, err
+}
+
+func (r *repository) Update(ctx context.Context, household *domain.Household) error {
+	return r.db.WithContext(ctx).Omit("Members").Save(household).Error
+}
+
+func (r *repository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, resource := range domain.ResourceTypes() {
+			if err := tx.Table(resource.Table()).
+				Where("workspace_id = ?", id).
+				Update("workspace_id", nil).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("household_id = ?", id).Delete(&domain.Member{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Household{}, "id = ?", id).Error
+	})
+}
+
+func (r *repository) GetMember(ctx context.Context, householdID, userID uuid.UUID) (*domain.Member, error) {
+	var member domain.Member
+	err := r.db.WithContext(ctx).
+		First(&member, "household_id = ? AND user_id = ?", householdID, userID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, shared.ErrNotFound
+		}
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (r *repository) AddMember(ctx context.Context, member *domain.Member) error {
+	return r.db.WithContext(ctx).Create(member).Error
+}
+
+func (r *repository) UpdateMember(ctx context.Context, member *domain.Member) error {
+	return r.db.WithContext(ctx).Save(member).Error
+}
+
+func (r *repository) RemoveMember(ctx context.Context, householdID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, resource := range domain.ResourceTypes() {
+			if err := tx.Table(resource.Table()).
+				Where("workspace_id = ? AND user_id = ?", householdID, userID).
+				Update("workspace_id", nil).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Where("household_id = ? AND user_id = ?", householdID, userID).
+			Delete(&domain.Member{}).Error
+	})
+}
+
+func (r *repository) SetWorkspace(ctx context.Context, resource domain.ResourceType, resourceID, ownerID uuid.UUID, householdID *uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Table(resource.Table()).
+		Where("id = ? AND user_id = ? AND deleted_at IS NULL", resourceID, ownerID).
+		Update("workspace_id", householdID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return shared.ErrNotFound
+	}
+	return nil
+}
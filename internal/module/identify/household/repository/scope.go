@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"personalfinancedss/internal/module/identify/household/domain"
+
+	"gorm.io/gorm"
+)
+
+// membershipSubquery selects the households a user belongs to with one of the given roles
+const membershipSubquery = "SELECT household_id FROM household_members WHERE user_id = ? AND role IN ?"
+
+// VisibleTo restricts a query on a shareable table to rows the user owns or that are shared
+// into a household where the user's role can see that resource type. The table must have
+// user_id and workspace_id columns. userID may be a uuid.UUID or its string form.
+func VisibleTo(userID any, resource domain.ResourceType) func(db *gorm.DB) *gorm.DB {
+	roles := roleNames(domain.RolesThatCanView(resource))
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ? OR workspace_id IN ("+membershipSubquery+")", userID, userID, roles)
+	}
+}
+
+// EditableBy restricts a query on a shareable table to rows the user owns or that are shared
+// into a household where the user is an owner or editor
+func EditableBy(userID any) func(db *gorm.DB) *gorm.DB {
+	roles := roleNames(domain.EditorRoles())
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("user_id = ? OR workspace_id IN ("+membershipSubquery+")", userID, userID, roles)
+	}
+}
+
+// roleNames converts roles to plain strings for query arguments
+func roleNames(roles []domain.Role) []string {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+	return names
+}
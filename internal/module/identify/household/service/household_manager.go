@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"personalfinancedss/internal/module/identify/household/domain"
+	"personalfinancedss/internal/module/identify/household/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const defaultCurrency = "VND"
+
+// CreateHousehold creates a household with the caller as its owner
+func (s *householdService) CreateHousehold(ctx context.Context, userID uuid.UUID, req dto.CreateHouseholdRequest) (*domain.Household, error) {
+	household := &domain.Household{
+		ID:       uuid.New(),
+		OwnerID:  userID,
+		Name:     strings.TrimSpace(req.Name),
+		Currency: defaultCurrency,
+	}
+	if req.Currency != nil {
+		household.Currency = strings.ToUpper(*req.Currency)
+	}
+	if err := household.Validate(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("household", err.Error())
+	}
+
+	owner := &domain.Member{
+		ID:     uuid.New(),
+		UserID: userID,
+		Role:   domain.RoleOwner,
+	}
+	if err := s.repo.Create(ctx, household, owner); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Household created",
+		zap.String("household_id", household.ID.String()),
+		zap.String("user_id", userID.String()),
+	)
+	return s.repo.GetByID(ctx, household.ID)
+}
+
+// ListHouseholds retrieves the households the user belongs to
+func (s *householdService) ListHouseholds(ctx context.Context, userID uuid.UUID) ([]domain.Household, error) {
+	households, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return households, nil
+}
+
+// GetHousehold retrieves a household the user belongs to. Non-members get not found.
+func (s *householdService) GetHousehold(ctx context.Context, userID, householdID uuid.UUID) (*domain.Household, error) {
+	household, err := s.repo.GetByID(ctx, householdID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return nil, err
+		}
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	if _, ok := household.MemberOf(userID); !ok {
+		return nil, shared.ErrNotFound
+	}
+	return household, nil
+}
+
+// UpdateHousehold changes a household's details; owner only
+func (s *householdService) UpdateHousehold(ctx context.Context, userID, householdID uuid.UUID, req dto.UpdateHouseholdRequest) (*domain.Household, error) {
+	household, err := s.householdManagedBy(ctx, userID, householdID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		household.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Currency != nil {
+		household.Currency = strings.ToUpper(*req.Currency)
+	}
+	if err := household.Validate(); err != nil {
+		return nil, shared.ErrBadRequest.WithDetails("household", err.Error())
+	}
+
+	if err := s.repo.Update(ctx, household); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return household, nil
+}
+
+// DeleteHousehold dissolves a household; owner only
+func (s *householdService) DeleteHousehold(ctx context.Context, userID, householdID uuid.UUID) error {
+	household, err := s.householdManagedBy(ctx, userID, householdID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, household.ID); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Household deleted",
+		zap.String("household_id", household.ID.String()),
+		zap.String("user_id", userID.String()),
+	)
+	return nil
+}
+
+// GetRole returns the user's role in a household
+func (s *householdService) GetRole(ctx context.Context, householdID, userID uuid.UUID) (domain.Role, error) {
+	member, err := s.repo.GetMember(ctx, householdID, userID)
+	if err != nil {
+		if err == shared.ErrNotFound {
+			return "", err
+		}
+		return "", shared.ErrInternal.WithError(err)
+	}
+	return member.Role, nil
+}
+
+// householdManagedBy loads a household the user can manage members of
+func (s *householdService) householdManagedBy(ctx context.Context, userID, householdID uuid.UUID) (*domain.Household, error) {
+	household, err := s.GetHousehold(ctx, userID, householdID)
+	if err != nil {
+		return nil, err
+	}
+	member, _ := household.MemberOf(userID)
+	if !member.Role.CanManageMembers() {
+		return nil, shared.ErrForbidden.WithDetails("reason", "only the household owner can do this")
+	}
+	return household, nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+
+	"personalfinancedss/internal/module/identify/household/domain"
+	"personalfinancedss/internal/module/identify/household/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AddMember adds an existing user to the household by email; owner only
+func (s *householdService) AddMember(ctx context.Context, userID, householdID uuid.UUID, req dto.AddMemberRequest) (*domain.Household, error) {
+	household, err := s.householdManagedBy(ctx, userID, householdID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if err == shared.ErrUserNotFound {
+			return nil, shared.ErrBadRequest.WithDetails("email", "no user with this email")
+		}
+		return nil, err
+	}
+	if _, ok := household.MemberOf(user.ID); ok {
+		return nil, shared.ErrConflict.WithDetails("reason", "user is already a member")
+	}
+
+	member := &domain.Member{
+		ID:          uuid.New(),
+		HouseholdID: household.ID,
+		UserID:      user.ID,
+		Role:        domain.Role(req.Role),
+		InvitedBy:   &userID,
+	}
+	if err := s.repo.AddMember(ctx, member); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Household member added",
+		zap.String("household_id", household.ID.String()),
+		zap.String("member_id", user.ID.String()),
+		zap.String("role", req.Role),
+	)
+	return s.repo.GetByID(ctx, household.ID)
+}
+
+// UpdateMemberRole changes a member's role; owner only. The owner's own role cannot change.
+func (s *householdService) UpdateMemberRole(ctx context.Context, userID, householdID, memberUserID uuid.UUID, req dto.UpdateMemberRequest) (*domain.Household, error) {
+	household, err := s.householdManagedBy(ctx, userID, householdID)
+	if err != nil {
+		return nil, err
+	}
+
+	member, ok := household.MemberOf(memberUserID)
+	if !ok {
+		return nil, shared.ErrNotFound
+	}
+	if member.Role == domain.RoleOwner {
+		return nil, shared.ErrBadRequest.WithDetails("role", "the owner's role cannot be changed")
+	}
+
+	member.Role = domain.Role(req.Role)
+	if err := s.repo.UpdateMember(ctx, member); err != nil {
+		return nil, shared.ErrInternal.WithError(err)
+	}
+	return household, nil
+}
+
+// RemoveMember removes a member and unshares what they shared into the household. The owner
+// removes others; any other member may leave. The owner leaves by deleting the household.
+func (s *householdService) RemoveMember(ctx context.Context, userID, householdID, memberUserID uuid.UUID) error {
+	household, err := s.GetHousehold(ctx, userID, householdID)
+	if err != nil {
+		return err
+	}
+
+	caller, _ := household.MemberOf(userID)
+	if memberUserID != userID && !caller.Role.CanManageMembers() {
+		return shared.ErrForbidden.WithDetails("reason", "only the household owner can remove other members")
+	}
+	member, ok := household.MemberOf(memberUserID)
+	if !ok {
+		return shared.ErrNotFound
+	}
+	if member.Role == domain.RoleOwner {
+		return shared.ErrBadRequest.WithDetails("member", "the owner cannot leave; delete the household instead")
+	}
+
+	if err := s.repo.RemoveMember(ctx, household.ID, memberUserID); err != nil {
+		return shared.ErrInternal.WithError(err)
+	}
+
+	s.logger.Info("Household member removed",
+		zap.String("household_id", household.ID.String()),
+		zap.String("member_id", memberUserID.String()),
+	)
+	return nil
+}
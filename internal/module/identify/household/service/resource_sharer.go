@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"personalfinancedss/internal/module/identify/household/domain"
+	"personalfinancedss/internal/module/identify/household/dto"
+	"personalfinancedss/internal/shared"
+
+	"github.com/google/uuid"
+)
+
+// ShareResource moves a resource the user owns into the household. Only owners and editors may
+// share, so read-only members cannot publish records others are then able to edit.
+func (s *householdService) ShareResource(ctx context.Context, userID, householdID uuid.UUID, req dto.ShareResourceRequest) error {
+	resource := domain.ResourceType(req.ResourceType)
+	if !resource.IsValid() {
+		return shared.ErrBadRequest.WithDetails("resource_type", "unsupported resource type")
+	}
+	resourceID, err := uuid.Parse(req.ResourceID)
+	if err != nil {
+		return shared.ErrBadRequest.WithDetails("resource_id", "invalid resource id")
+	}
+
+	role, err := s.GetRole(ctx, householdID, userID)
+	if err != nil {
+		return err
+	}
+	if !role.CanEdit() {
+		return shared.ErrForbidden.WithDetails("reason", "your role cannot share into this household")
+	}
+
+	if err := s.repo.SetWorkspace(ctx, resource, resourceID, userID, &householdID); err != nil {
+		if err == shared.ErrNotFound {
+			return err
+		}
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
+
+// UnshareResource makes a resource the user owns personal again
+func (s *householdService) UnshareResource(ctx context.Context, userID uuid.UUID, resource domain.ResourceType, resourceID uuid.UUID) error {
+	if !resource.IsValid() {
+		return shared.ErrBadRequest.WithDetails("resource_type", "unsupported resource type")
+	}
+
+	if err := s.repo.SetWorkspace(ctx, resource, resourceID, userID, nil); err != nil {
+		if err == shared.ErrNotFound {
+			return err
+		}
+		return shared.ErrInternal.WithError(err)
+	}
+	return nil
+}
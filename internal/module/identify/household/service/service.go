@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"personalfinancedss/internal/module/identify/household/domain"
+	"personalfinancedss/internal/module/identify/household/dto"
+	"personalfinancedss/internal/module/identify/household/repository"
+	userservice "personalfinancedss/internal/module/identify/user/service"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// HouseholdManager defines household lifecycle operations
+type HouseholdManager interface {
+	// CreateHousehold creates a household with the caller as its owner
+	CreateHousehold(ctx context.Context, userID uuid.UUID, req dto.CreateHouseholdRequest) (*domain.Household, error)
+
+	// ListHouseholds retrieves the households the user belongs to
+	ListHouseholds(ctx context.Context, userID uuid.UUID) ([]domain.Household, error)
+
+	// GetHousehold retrieves a household the user belongs to
+	GetHousehold(ctx context.Context, userID, householdID uuid.UUID) (*domain.Household, error)
+
+	// UpdateHousehold changes a household's details; owner only
+	UpdateHousehold(ctx context.Context, userID, householdID uuid.UUID, req dto.UpdateHouseholdRequest) (*domain.Household, error)
+
+	// DeleteHousehold dissolves a household and makes everything shared in it personal again; owner only
+	DeleteHousehold(ctx context.Context, userID, householdID uuid.UUID) error
+}
+
+// MemberManager defines membership operations
+type MemberManager interface {
+	// AddMember adds an existing user to the household by email; owner only
+	AddMember(ctx context.Context, userID, householdID uuid.UUID, req dto.AddMemberRequest) (*domain.Household, error)
+
+	// UpdateMemberRole changes a member's role; owner only
+	UpdateMemberRole(ctx context.Context, userID, householdID, memberUserID uuid.UUID, req dto.UpdateMemberRequest) (*domain.Household, error)
+
+	// RemoveMember removes a member; the owner may remove anyone else and members may remove themselves
+	RemoveMember(ctx context.Context, userID, householdID, memberUserID uuid.UUID) error
+}
+
+// ResourceSharer defines sharing of personal records into a household
+type ResourceSharer interface {
+	// ShareResource moves a resource the user owns into the household; requires an editing role
+	ShareResource(ctx context.Context, userID, householdID uuid.UUID, req dto.ShareResourceRequest) error
+
+	// UnshareResource makes a resource the user owns personal again
+	UnshareResource(ctx context.Context, userID uuid.UUID, resource domain.ResourceType, resourceID uuid.UUID) error
+}
+
+// Authorizer answers membership questions for other modules
+type Authorizer interface {
+	// GetRole returns the user's role in a household, or shared.ErrNotFound when not a member
+	GetRole(ctx context.Context, householdID, userID uuid.UUID) (domain.Role, error)
+}
+
+// Service is the composite interface for household operations
+type Service interface {
+	HouseholdManager
+	MemberManager
+	ResourceSharer
+	Authorizer
+}
+
+type householdService struct {
+	repo   repository.Repository
+	users  userservice.IUserService
+	logger *zap.Logger
+}
+
+// NewService creates a new household service
+func NewService(repo repository.Repository, users userservice.IUserService, logger *zap.Logger) Service {
+	return &householdService{
+		repo:   repo,
+		users:  users,
+		logger: logger.Named("household.service"),
+	}
+}
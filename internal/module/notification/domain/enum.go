@@ -4,12 +4,18 @@ package domain
 type NotificationType string
 
 const (
-	NotificationTypeWelcome           NotificationType = "welcome"
-	NotificationTypeBudgetAlert       NotificationType = "budget_alert"
-	NotificationTypeGoalAchieved      NotificationType = "goal_achieved"
-	NotificationTypeMonthlySummary    NotificationType = "monthly_summary"
-	NotificationTypeEmailVerification NotificationType = "email_verification"
-	NotificationTypePasswordReset     NotificationType = "password_reset"
+	NotificationTypeWelcome              NotificationType = "welcome"
+	NotificationTypeBudgetAlert          NotificationType = "budget_alert"
+	NotificationTypeGoalAchieved         NotificationType = "goal_achieved"
+	NotificationTypeMonthlySummary       NotificationType = "monthly_summary"
+	NotificationTypeEmailVerification    NotificationType = "email_verification"
+	NotificationTypePasswordReset        NotificationType = "password_reset"
+	NotificationTypeGoalAutoContribution NotificationType = "goal_auto_contribution"
+	NotificationTypeGoalMilestone        NotificationType = "goal_milestone"
+	NotificationTypeWishlistCoolingOff   NotificationType = "wishlist_cooling_off"
+	NotificationTypeGoalReminder         NotificationType = "goal_reminder"
+	NotificationTypeDebtReminder         NotificationType = "debt_reminder"
+	NotificationTypeDebtStatus           NotificationType = "debt_status"
 )
 
 // IsValid checks if the notification type is valid
@@ -20,7 +26,13 @@ func (nt NotificationType) IsValid() bool {
 		NotificationTypeGoalAchieved,
 		NotificationTypeMonthlySummary,
 		NotificationTypeEmailVerification,
-		NotificationTypePasswordReset:
+		NotificationTypePasswordReset,
+		NotificationTypeGoalAutoContribution,
+		NotificationTypeGoalMilestone,
+		NotificationTypeWishlistCoolingOff,
+		NotificationTypeGoalReminder,
+		NotificationTypeDebtReminder,
+		NotificationTypeDebtStatus:
 		return true
 	}
 	return false
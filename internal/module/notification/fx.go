@@ -4,6 +4,8 @@ import (
 	"context"
 	"personalfinancedss/internal/config"
 	"personalfinancedss/internal/middleware"
+	transactionrepo "personalfinancedss/internal/module/cashflow/transaction/repository"
+	userrepo "personalfinancedss/internal/module/identify/user/repository"
 	"personalfinancedss/internal/module/notification/domain"
 	"personalfinancedss/internal/module/notification/handler"
 	"personalfinancedss/internal/module/notification/repository"
@@ -67,6 +69,11 @@ func ProvideNotificationService(
 	return service.NewEnhancedNotificationService(emailService, notifRepo, wsHub)
 }
 
+// ProvideInAppNotifier creates the in-app notifier used by other modules
+func ProvideInAppNotifier(notifRepo repository.NotificationRepository, wsHub *service.WebSocketHub) service.InAppNotifier {
+	return service.NewInAppNotifier(notifRepo, wsHub)
+}
+
 // ProvideUserNotificationService creates a user notification service
 func ProvideUserNotificationService(repo repository.NotificationRepository) service.UserNotificationService {
 	return service.NewUserNotificationService(repo)
@@ -83,17 +90,23 @@ func ProvideNotificationPreferenceService(repo repository.NotificationPreference
 }
 
 // ProvideScheduledReportService creates a scheduled report service
-// Note: Requires transaction and user repositories from other modules
 func ProvideScheduledReportService(
-	transactionRepo interface{}, // Will be typed properly when wiring
-	userRepo interface{}, // Will be typed properly when wiring
+	transactionRepo transactionrepo.Repository,
+	userRepo userrepo.Repository,
 	emailService service.EmailService,
 	logger *zap.Logger,
 ) service.ScheduledReportService {
-	// Type assertion will be handled in actual wiring
-	// For now, return nil to avoid compilation errors
-	// This should be properly wired in internal/app/application.go
-	return nil
+	return service.NewScheduledReportService(transactionRepo, userRepo, emailService, logger)
+}
+
+// ProvideSchedulerService creates the cron scheduler with every job registered in the scheduled_jobs group
+func ProvideSchedulerService(
+	reportService service.ScheduledReportService,
+	userRepo userrepo.Repository,
+	jobs []service.ScheduledJob,
+	logger *zap.Logger,
+) service.SchedulerService {
+	return service.NewSchedulerService(reportService, userRepo, jobs, logger)
 }
 
 // Module provides notification module dependencies
@@ -112,17 +125,24 @@ var Module = fx.Module("notification",
 		// Services
 		ProvideEmailService,
 		ProvideNotificationService,
+		ProvideInAppNotifier,
 		ProvideUserNotificationService,
 		ProvideNotificationPreferenceService,
-		// ProvideScheduledReportService, // TODO: Wire properly with transaction/user repos
-		// ProvideSchedulerService,        // TODO: Enable after ScheduledReportService is wired
+		ProvideScheduledReportService,
+		fx.Annotate(
+			ProvideSchedulerService,
+			fx.ParamTags(``, ``, `group:"scheduled_jobs"`, ``),
+		),
 
 		// Handlers
 		handler.NewHandler,
 		handler.NewWebSocketHandler,
 		handler.NewPreferenceHandler,
 	),
-	fx.Invoke(registerNotificationRoutes),
+	fx.Invoke(
+		registerNotificationRoutes,
+		registerSchedulerLifecycle,
+	),
 )
 
 func registerNotificationRoutes(
@@ -136,3 +156,17 @@ func registerNotificationRoutes(
 	wsH.RegisterRoutes(router, authMiddleware)
 	prefH.RegisterRoutes(router, authMiddleware)
 }
+
+// registerSchedulerLifecycle starts the scheduler with the app and stops it on shutdown
+func registerSchedulerLifecycle(lc fx.Lifecycle, scheduler service.SchedulerService) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			scheduler.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			scheduler.Stop()
+			return nil
+		},
+	})
+}
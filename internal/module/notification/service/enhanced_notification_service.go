@@ -31,6 +31,33 @@ func NewEnhancedNotificationService(
 	}
 }
 
+// NewInAppNotifier creates a notifier for in-app notifications only
+func NewInAppNotifier(notifRepo repository.NotificationRepository, wsHub *WebSocketHub) InAppNotifier {
+	return &EnhancedNotificationService{
+		notifRepo: notifRepo,
+		wsHub:     wsHub,
+	}
+}
+
+// NotifyInApp stores an in-app notification and pushes it over WebSocket when the user is connected
+func (s *EnhancedNotificationService) NotifyInApp(
+	ctx context.Context,
+	userID uuid.UUID,
+	notifType domain.NotificationType,
+	subject string,
+	data map[string]interface{},
+) error {
+	return s.sendInAppNotification(ctx, userID, notifType, subject, data)
+}
+
+// PushEvent pushes a live event over WebSocket when the user is connected
+func (s *EnhancedNotificationService) PushEvent(userID uuid.UUID, eventType string, payload map[string]interface{}) {
+	if s.wsHub == nil || !s.wsHub.IsUserConnected(userID) {
+		return
+	}
+	s.wsHub.SendToUser(userID, eventType, payload)
+}
+
 // SendNotificationMultiChannel sends notification through specified channels
 func (s *EnhancedNotificationService) SendNotificationMultiChannel(
 	ctx context.Context,
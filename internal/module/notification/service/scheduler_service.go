@@ -3,32 +3,39 @@ package service
 import (
 	"context"
 	userrepo "personalfinancedss/internal/module/identify/user/repository"
+	"time"
 
 	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 )
 
+// scheduledJobTimeout bounds a single run of a registered job
+const scheduledJobTimeout = 10 * time.Minute
+
 type schedulerService struct {
 	cron          *cron.Cron
 	reportService ScheduledReportService
 	userRepo      userrepo.Repository
+	jobs          []ScheduledJob
 	logger        *zap.Logger
 	isRunning     bool
 }
 
-// NewSchedulerService creates a new scheduler service
+// NewSchedulerService creates a new scheduler service running the built-in reports and the given jobs
 func NewSchedulerService(
 	reportService ScheduledReportService,
 	userRepo userrepo.Repository,
+	jobs []ScheduledJob,
 	logger *zap.Logger,
 ) SchedulerService {
-	// Create cron instance with second precision
-	c := cron.New(cron.WithSeconds())
+	// Create cron instance with second precision; a job still running is not started again
+	c := cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger)))
 
 	return &schedulerService{
 		cron:          c,
 		reportService: reportService,
 		userRepo:      userRepo,
+		jobs:          jobs,
 		logger:        logger,
 		isRunning:     false,
 	}
@@ -60,6 +67,15 @@ func (s *schedulerService) Start() {
 		s.logger.Error("Failed to schedule monthly reports", zap.Error(err))
 	}
 
+	for _, job := range s.jobs {
+		if job == nil {
+			continue
+		}
+		if _, err := s.cron.AddFunc(job.Spec(), s.runJob(job)); err != nil {
+			s.logger.Error("Failed to schedule job", zap.String("job", job.Name()), zap.Error(err))
+		}
+	}
+
 	// Start the cron scheduler
 	s.cron.Start()
 	s.isRunning = true
@@ -86,6 +102,24 @@ func (s *schedulerService) IsRunning() bool {
 	return s.isRunning
 }
 
+// runJob wraps a registered job with a timeout and logging
+func (s *schedulerService) runJob(job ScheduledJob) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), scheduledJobTimeout)
+		defer cancel()
+
+		start := time.Now()
+		if err := job.Run(ctx); err != nil {
+			s.logger.Error("Scheduled job failed", zap.String("job", job.Name()), zap.Error(err))
+			return
+		}
+		s.logger.Debug("Scheduled job completed",
+			zap.String("job", job.Name()),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
+}
+
 // runDailyReports generates daily reports for all users
 // TODO: Implement user-specific daily report scheduling
 func (s *schedulerService) runDailyReports() {
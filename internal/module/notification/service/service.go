@@ -4,6 +4,8 @@ import (
 	"context"
 	"personalfinancedss/internal/module/notification/domain"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // EmailService defines email sending operations
@@ -45,6 +47,14 @@ type NotificationService interface {
 	NotifyMonthlySummary(userEmail, userName, month string, year int, summary map[string]interface{})
 }
 
+// InAppNotifier defines in-app notifications raised by other modules
+type InAppNotifier interface {
+	// NotifyInApp stores an in-app notification and pushes it over WebSocket when the user is connected
+	NotifyInApp(ctx context.Context, userID uuid.UUID, notifType domain.NotificationType, subject string, data map[string]interface{}) error
+	// PushEvent pushes a live event over WebSocket without storing it; dropped when the user is offline
+	PushEvent(userID uuid.UUID, eventType string, payload map[string]interface{})
+}
+
 // ScheduledReportService defines operations for generating scheduled financial reports
 type ScheduledReportService interface {
 	// GenerateDailyReport generates and sends daily summary report
@@ -60,6 +70,16 @@ type ScheduledReportService interface {
 	GenerateCustomReport(ctx context.Context, userID string, startDate, endDate time.Time) error
 }
 
+// ScheduledJob is a recurring job another module runs on the scheduler
+type ScheduledJob interface {
+	// Name identifies the job in logs
+	Name() string
+	// Spec is the cron schedule, with seconds
+	Spec() string
+	// Run executes one pass of the job
+	Run(ctx context.Context) error
+}
+
 // SchedulerService defines operations for managing scheduled tasks
 type SchedulerService interface {
 	// Start starts the scheduler